@@ -0,0 +1,45 @@
+#!/bin/bash -ex
+
+# Runs the Knative ingress conformance suite against a kind cluster, in which
+# Knative Serving and Gloo Edge with Knative support (glooctl install knative) are installed,
+# and in which LoadBalancer services get an address (e.g. with MetalLB).
+
+# The name of the kind cluster to run the suite against
+CLUSTER_NAME="${CLUSTER_NAME:-kind}"
+# The namespace in which Gloo Edge is installed
+INSTALL_NAMESPACE="${INSTALL_NAMESPACE:-gloo-system}"
+# The tests to skip, as a comma-separated list
+SKIP_TESTS="${SKIP_TESTS:-}"
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" &>/dev/null && pwd)"
+ROOT_DIR="$SCRIPT_DIR/../.."
+
+# run the suite of the version of knative.dev/networking which gloo is built with
+NETWORKING_VERSION=$(cd "$ROOT_DIR" && go list -m -f '{{.Version}}' knative.dev/networking)
+NETWORKING_COMMIT="${NETWORKING_VERSION##*-}"
+
+WORK_DIR=$(mktemp -d)
+trap 'rm -rf "$WORK_DIR"' EXIT
+git clone https://github.com/knative/networking.git "$WORK_DIR/networking"
+git -C "$WORK_DIR/networking" checkout "$NETWORKING_COMMIT"
+
+kubectl config use-context "kind-$CLUSTER_NAME"
+
+# the suite runs its upstreams in the serving-tests namespace, which it does not create
+kubectl create namespace serving-tests --dry-run=client -o yaml | kubectl apply -f -
+
+# the test upstreams are reached through the external proxy
+INGRESS_ENDPOINT="${INGRESS_ENDPOINT:-$(kubectl get service knative-external-proxy -n "$INSTALL_NAMESPACE" \
+  -o jsonpath='{.status.loadBalancer.ingress[0].ip}')}"
+
+# build the test upstreams, and load them to the kind cluster
+cd "$WORK_DIR/networking"
+export KO_DOCKER_REPO=kind.local
+export KIND_CLUSTER_NAME="$CLUSTER_NAME"
+./test/upload-test-images.sh
+go test -count=1 -short -timeout=30m -tags=e2e ./test/conformance/ingress/... \
+  --ingressendpoint="$INGRESS_ENDPOINT" \
+  --ingressClass=gloo.ingress.networking.knative.dev \
+  --enable-beta \
+  --enable-alpha \
+  --skip-tests="$SKIP_TESTS"
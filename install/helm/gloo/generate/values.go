@@ -290,6 +290,8 @@ type Gloo struct {
 	DisableLeaderElection      *bool                 `json:"disableLeaderElection,omitempty" desc:"Set to true to disable leader election, and ensure all running replicas are considered the leader. Do not enable this with multiple replicas of Gloo"`
 	HeaderSecretRefNsMatchesUs *bool                 `json:"headerSecretRefNsMatchesUs,omitempty" desc:"Set to true to require that secrets sent in headers via headerSecretRefs come from the same namespace as the destination upstream. Default: false"`
 	PodDisruptionBudget        *PodDisruptionBudget  `json:"podDisruptionBudget,omitempty"`
+	ColdStartScaling           *bool                 `json:"coldStartScaling,omitempty" desc:"Set to true to allow Gloo to scale up the Deployments and HorizontalPodAutoscalers referenced by the cold start of kubernetes upstreams. Default: false"`
+	ColdStartNetworkPolicy     *bool                 `json:"coldStartNetworkPolicy,omitempty" desc:"Set to true to create a NetworkPolicy which only lets the gateway proxies reach the cold start activator of Gloo, on port 9955. The other ports of Gloo stay open. The network plugin of the cluster must enforce NetworkPolicies. Default: false"`
 }
 
 type SecurityOpts struct {
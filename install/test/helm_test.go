@@ -90,6 +90,7 @@ var _ = Describe("Helm Test", func() {
 				{Name: "grpc-validation", ContainerPort: 9988, Protocol: "TCP"},
 				{Name: "grpc-proxydebug", ContainerPort: 9966, Protocol: "TCP"},
 				{Name: "wasm-cache", ContainerPort: 9979, Protocol: "TCP"},
+				{Name: "cold-start", ContainerPort: 9955, Protocol: "TCP"},
 			}
 			selector         map[string]string
 			testManifest     TestManifest
@@ -243,6 +244,63 @@ spec:
 				testManifest.ExpectUnstructured("PodDisruptionBudget", namespace, "gloo-pdb").To(BeEquivalentTo(pdb))
 			})
 
+			It("does not create the cold start network policy by default", func() {
+				prepareMakefile(namespace, helmValues{})
+
+				testManifest.ExpectUnstructured("NetworkPolicy", namespace, "gloo-cold-start").To(BeNil())
+			})
+
+			It("can restrict the access to the cold start activator to the proxies", func() {
+				prepareMakefile(namespace, helmValues{
+					valuesArgs: []string{
+						"gloo.coldStartNetworkPolicy=true",
+					},
+				})
+
+				networkPolicy := makeUnstructured(`
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  labels:
+    app: gloo
+    gloo: gloo
+  name: gloo-cold-start
+  namespace: gloo-system
+spec:
+  podSelector:
+    matchLabels:
+      gloo: gloo
+  policyTypes:
+  - Ingress
+  ingress:
+  - ports:
+    - port: 1
+      endPort: 9954
+      protocol: TCP
+    - port: 9956
+      endPort: 65535
+      protocol: TCP
+    - protocol: UDP
+  - from:
+    - namespaceSelector: {}
+      podSelector:
+        matchExpressions:
+        - key: gloo
+          operator: In
+          values:
+          - gateway-proxy
+          - ingress-proxy
+          - clusteringress-proxy
+          - knative-external-proxy
+          - knative-internal-proxy
+    ports:
+    - port: 9955
+      protocol: TCP
+`)
+
+				testManifest.ExpectUnstructured("NetworkPolicy", namespace, "gloo-cold-start").To(BeEquivalentTo(networkPolicy))
+			})
+
 			It("can create gloo pdb with maxUnavailable", func() {
 
 				prepareMakefile(namespace, helmValues{
@@ -3862,6 +3920,9 @@ spec:
   - name: wasm-cache
     port: 9979
     protocol: TCP
+  - name: cold-start
+    port: 9955
+    protocol: TCP
   - name: https
     port: 443
     protocol: TCP
@@ -4283,6 +4344,9 @@ spec:
         - containerPort: 9979
           name: wasm-cache
           protocol: TCP
+        - containerPort: 9955
+          name: cold-start
+          protocol: TCP
         volumeMounts:
         - mountPath: /etc/gateway/validation-certs
           name: validation-certs
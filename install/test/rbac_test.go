@@ -197,6 +197,11 @@ var _ = Describe("RBAC Test", func() {
 								Resources: []string{"upstreams"},
 								Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
 							},
+							{
+								APIGroups: []string{""},
+								Resources: []string{"events"},
+								Verbs:     []string{"create", "patch"},
+							},
 						},
 						RoleRef: rbacv1.RoleRef{
 							APIGroup: "rbac.authorization.k8s.io",
@@ -102,6 +102,12 @@ func GetServiceAccountPermissions(namespace string) *manifesttestutils.ServiceAc
 		[]string{"gloo.solo.io"},
 		[]string{"upstreams"},
 		[]string{"get", "list", "watch", "create", "update", "patch", "delete"})
+	permissions.AddExpectedPermission(
+		"gloo-system.discovery",
+		namespace,
+		[]string{""},
+		[]string{"events"},
+		[]string{"create", "patch"})
 	permissions.AddExpectedPermission(
 		"gloo-system.discovery",
 		namespace,
@@ -6,10 +6,13 @@ import (
 	"net"
 
 	envoy_data_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
 	pb "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v3"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/service/tap/v3"
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/projects/accesslogger/pkg/loggingservice"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/tapservice"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/healthchecker"
@@ -193,6 +196,7 @@ func StartAccessLog(ctx context.Context, clientSettings Settings, service *loggi
 	srv := grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
 
 	pb.RegisterAccessLogServiceServer(srv, service)
+	envoytap.RegisterTapSinkServiceServer(srv, newTapService(ctx))
 	hc := healthchecker.NewGrpc(clientSettings.ServiceName, health.NewServer(), false, healthpb.HealthCheckResponse_SERVING)
 	healthpb.RegisterHealthServer(srv, hc.GetServer())
 	reflection.Register(srv)
@@ -219,6 +223,54 @@ func StartAccessLog(ctx context.Context, clientSettings Settings, service *loggi
 	return srv.Serve(lis)
 }
 
+// newTapService returns the tap sink service, which logs the traces that Envoy streams from the tap filter
+func newTapService(ctx context.Context) *tapservice.Server {
+	return tapservice.NewServer(tapservice.Options{
+		Callbacks: tapservice.TapCallbackList{
+			func(ctx context.Context, message *envoytap.StreamTapsRequest) error {
+				logger := contextutils.LoggerFrom(ctx)
+				trace := message.GetTrace()
+				switch {
+				case trace.GetHttpBufferedTrace() != nil:
+					request := trace.GetHttpBufferedTrace().GetRequest()
+					response := trace.GetHttpBufferedTrace().GetResponse()
+					logger.With(
+						zap.Uint64("trace_id", message.GetTraceId()),
+						zap.Any("request_headers", request.GetHeaders()),
+						zap.String("request_body", tapBody(request.GetBody())),
+						zap.Bool("request_body_truncated", request.GetBody().GetTruncated()),
+						zap.Any("request_trailers", request.GetTrailers()),
+						zap.Any("response_headers", response.GetHeaders()),
+						zap.String("response_body", tapBody(response.GetBody())),
+						zap.Bool("response_body_truncated", response.GetBody().GetTruncated()),
+						zap.Any("response_trailers", response.GetTrailers()),
+					).Info("received http tap")
+				case trace.GetHttpStreamedTraceSegment() != nil:
+					segment := trace.GetHttpStreamedTraceSegment()
+					logger.With(
+						zap.Uint64("trace_id", segment.GetTraceId()),
+						zap.Any("request_headers", segment.GetRequestHeaders().GetHeaders()),
+						zap.String("request_body_chunk", tapBody(segment.GetRequestBodyChunk())),
+						zap.Any("request_trailers", segment.GetRequestTrailers().GetHeaders()),
+						zap.Any("response_headers", segment.GetResponseHeaders().GetHeaders()),
+						zap.String("response_body_chunk", tapBody(segment.GetResponseBodyChunk())),
+						zap.Any("response_trailers", segment.GetResponseTrailers().GetHeaders()),
+					).Info("received http tap segment")
+				}
+				return nil
+			},
+		},
+		Ctx: ctx,
+	})
+}
+
+func tapBody(body *envoy_data_tap_v3.Body) string {
+	if body.GetAsString() != "" {
+		return body.GetAsString()
+	}
+	return string(body.GetAsBytes())
+}
+
 func getTransformationValueFromDynamicMetadata(key string, filterMetadata map[string]*_struct.Struct) string {
 	transformationMeta := filterMetadata[transformation.FilterName]
 	for tKey, tVal := range transformationMeta.GetFields() {
@@ -0,0 +1,66 @@
+package tapservice
+
+import (
+	"context"
+	"io"
+
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/service/tap/v3"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// server is used to implement envoytap.TapSinkServiceServer.
+
+type TapCallback func(ctx context.Context, message *envoytap.StreamTapsRequest) error
+type TapCallbackList []TapCallback
+
+type Server struct {
+	opts *Options
+}
+
+var _ envoytap.TapSinkServiceServer = new(Server)
+
+// StreamTaps receives the traces of a tap until Envoy closes the stream.
+// The identifier of the tap is only sent in the first message of the stream.
+func (s *Server) StreamTaps(srv envoytap.TapSinkService_StreamTapsServer) error {
+	msg, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	ctx := contextutils.WithLoggerValues(
+		s.opts.Ctx,
+		zap.String("tap_id", msg.GetIdentifier().GetTapId()),
+		zap.String("node_id", msg.GetIdentifier().GetNode().GetId()),
+		zap.String("node_cluster", msg.GetIdentifier().GetNode().GetCluster()),
+	)
+	contextutils.LoggerFrom(ctx).Info("received tap stream")
+
+	for {
+		for _, cb := range s.opts.Callbacks {
+			if err := cb(ctx, msg); err != nil {
+				return err
+			}
+		}
+
+		msg, err = srv.Recv()
+		if err == io.EOF {
+			return srv.SendAndClose(&envoytap.StreamTapsResponse{})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+type Options struct {
+	Callbacks TapCallbackList
+	Ctx       context.Context
+}
+
+func NewServer(opts Options) *Server {
+	if opts.Ctx == nil {
+		opts.Ctx = context.Background()
+	}
+	return &Server{opts: &opts}
+}
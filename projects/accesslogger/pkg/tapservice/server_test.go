@@ -0,0 +1,104 @@
+package tapservice_test
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_data_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/data/tap/v3"
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/service/tap/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/tapservice"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("Server", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		srv    *grpc.Server
+		client envoytap.TapSinkServiceClient
+
+		lock     sync.Mutex
+		received []*envoytap.StreamTapsRequest
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		received = nil
+
+		lis := bufconn.Listen(1024 * 1024)
+		srv = grpc.NewServer()
+		envoytap.RegisterTapSinkServiceServer(srv, tapservice.NewServer(tapservice.Options{
+			Callbacks: tapservice.TapCallbackList{
+				func(ctx context.Context, message *envoytap.StreamTapsRequest) error {
+					lock.Lock()
+					defer lock.Unlock()
+					received = append(received, message)
+					return nil
+				},
+			},
+			Ctx: ctx,
+		}))
+		go srv.Serve(lis)
+
+		conn, err := grpc.DialContext(ctx, "bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		client = envoytap.NewTapSinkServiceClient(conn)
+	})
+
+	AfterEach(func() {
+		srv.Stop()
+		cancel()
+	})
+
+	trace := func(traceId uint64, body string) *envoytap.StreamTapsRequest {
+		return &envoytap.StreamTapsRequest{
+			TraceId: traceId,
+			Trace: &envoy_data_tap_v3.TraceWrapper{
+				Trace: &envoy_data_tap_v3.TraceWrapper_HttpBufferedTrace{
+					HttpBufferedTrace: &envoy_data_tap_v3.HttpBufferedTrace{
+						Request: &envoy_data_tap_v3.HttpBufferedTrace_Message{
+							Body: &envoy_data_tap_v3.Body{
+								BodyType: &envoy_data_tap_v3.Body_AsBytes{AsBytes: []byte(body)},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("receives the traces of a tap stream until it is closed", func() {
+		first := trace(1, "first")
+		first.Identifier = &envoytap.StreamTapsRequest_Identifier{
+			Node:  &envoy_config_core_v3.Node{Id: "gateway-proxy"},
+			TapId: "route",
+		}
+		second := trace(2, "second")
+
+		stream, err := client.StreamTaps(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stream.Send(first)).To(Succeed())
+		Expect(stream.Send(second)).To(Succeed())
+		_, err = stream.CloseAndRecv()
+		Expect(err).NotTo(HaveOccurred())
+
+		lock.Lock()
+		defer lock.Unlock()
+		Expect(received).To(HaveLen(2))
+		Expect(received[0]).To(matchers.MatchProto(first))
+		Expect(received[1]).To(matchers.MatchProto(second))
+	})
+})
@@ -27,6 +27,9 @@ const (
 	AWS_REGION                  = "AWS_REGION"
 )
 
+// DiscoveryName is the name of the aws lambda function discovery in the discovery status of the upstreams
+const DiscoveryName = "aws"
+
 func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
 	return &AWSLambdaFunctionDiscoveryFactory{
 		PollingTime: time.Second,
@@ -51,6 +54,10 @@ type AWSLambdaFunctionDiscovery struct {
 	upstream   *v1.Upstream
 }
 
+func (f *AWSLambdaFunctionDiscovery) Name() string {
+	return DiscoveryName
+}
+
 func (f *AWSLambdaFunctionDiscovery) IsFunctional() bool {
 	_, ok := f.upstream.GetUpstreamType().(*v1.Upstream_Aws)
 	return ok
@@ -79,7 +86,7 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 		// TODO(yuval-k): only update functions if newFunctions != oldFunctions
 		// no need to constantly write to storage
 
-		err = updatecb(func(out *v1.Upstream) error {
+		err = updatecb(fds.DiscoverySucceeded(DiscoveryName, func(out *v1.Upstream) error {
 			// TODO(yuval-k): this should never happen. but it did. add logs?
 			if out == nil {
 				return errors.New("nil upstream")
@@ -95,7 +102,7 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 			}
 			awsSpec.Aws.LambdaFunctions = newFunctions
 			return nil
-		})
+		}))
 
 		if err != nil {
 			return errors.Wrap(err, "unable to update upstream")
@@ -107,13 +114,21 @@ func (f *AWSLambdaFunctionDiscovery) DetectFunctions(ctx context.Context, url *u
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-		// only log other errors as we would like to continue forever.
-		contextutils.LoggerFrom(ctx).Warnf("Unable to perform aws function discovery for upstream %s in namespace %s, error: ",
+		// record other errors on the upstream, as we would like to continue forever.
+		contextutils.LoggerFrom(ctx).Warnf("Unable to perform aws function discovery for upstream %s in namespace %s, error: %s",
 			f.upstream.GetMetadata().GetName(),
 			f.upstream.GetMetadata().GetNamespace(),
 			err.Error(),
 		)
+		if err := updatecb(fds.DiscoveryFailed(DiscoveryName, err)); err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("Unable to record the aws function discovery error on upstream %s in namespace %s, error: %s",
+				f.upstream.GetMetadata().GetName(),
+				f.upstream.GetMetadata().GetNamespace(),
+				err.Error(),
+			)
+		}
 	}
+	fds.RecordAttempt(ctx, DiscoveryName, err)
 
 	// sleep so we are not hogging
 	if err := contextutils.Sleep(ctx, f.timeToWait); err != nil {
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"net/url"
 	"time"
 
@@ -14,12 +15,13 @@ import (
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/go-utils/contextutils"
 	"google.golang.org/grpc"
-	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	grpc_json_plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
 )
 
 func getGrpcspec(u *v1.Upstream) *grpc_json_plugins.GrpcJsonTranscoder {
@@ -55,6 +57,9 @@ func isDeprecatedGrpcspec(u *v1.Upstream) bool {
 	return false
 }
 
+// DiscoveryName is the name of the grpc function discovery in the discovery status of the upstreams
+const DiscoveryName = "grpc"
+
 func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
 	return &FunctionDiscoveryFactory{
 		DetectionTimeout: time.Minute,
@@ -74,9 +79,10 @@ type FunctionDiscoveryFactory struct {
 }
 
 // NewFunctionDiscovery returns a FunctionDiscovery that can be used to discover functions
-func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
+func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, clients fds.AdditionalClients) fds.UpstreamFunctionDiscovery {
 	return &UpstreamFunctionDiscovery{
 		upstream:     u,
+		dependencies: clients.Dependencies,
 		clientGetter: getClient,
 	}
 }
@@ -84,7 +90,19 @@ func (f *FunctionDiscoveryFactory) NewFunctionDiscovery(u *v1.Upstream, _ fds.Ad
 // UpstreamFunctionDiscovery represents a function discovery for upstream
 type UpstreamFunctionDiscovery struct {
 	upstream     *v1.Upstream
-	clientGetter func(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() error, error)
+	dependencies func() fds.Dependencies
+	clientGetter func(ctx context.Context, url *url.URL, upstream *v1.Upstream, secrets v1.SecretList) (*grpcreflect.Client, func() error, error)
+}
+
+func latestDependencies(dependencies func() fds.Dependencies) fds.Dependencies {
+	if dependencies == nil {
+		return fds.Dependencies{}
+	}
+	return dependencies()
+}
+
+func (f *UpstreamFunctionDiscovery) Name() string {
+	return DiscoveryName
 }
 
 // IsFunctional returns true if the upstream is functional
@@ -96,7 +114,7 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	log := contextutils.LoggerFrom(ctx)
 	log.Debugf("attempting to detect GRPC for %s", f.upstream.GetMetadata().GetName())
 
-	refClient, closeConn, err := f.clientGetter(ctx, url)
+	refClient, closeConn, err := f.clientGetter(ctx, url, f.upstream, latestDependencies(f.dependencies).Secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -118,21 +136,29 @@ func (f *UpstreamFunctionDiscovery) DetectType(ctx context.Context, url *url.URL
 	return svcInfo, nil
 }
 
-func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, _ func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
+func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *url.URL, dependencies func() fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	// TODO: get backoff values from config?
 	err := contextutils.NewExponentioalBackoff(contextutils.ExponentioalBackoff{}).Backoff(ctx, func(ctx context.Context) error {
-		return f.DetectFunctionsOnce(ctx, url, updatecb)
+		return f.detectFunctionsOnce(ctx, url, latestDependencies(dependencies), updatecb)
 	})
+	fds.RecordAttempt(ctx, DiscoveryName, err)
 	if err != nil {
 		if ctx.Err() != nil {
 			return multierror.Append(err, ctx.Err())
 		}
-		// only log other errors as we would like to continue forever.
-		contextutils.LoggerFrom(ctx).Warnf("Unable to perform grpc function discovery for upstream %s in namespace %s, error: ",
+		// record other errors on the upstream, as we would like to continue forever.
+		contextutils.LoggerFrom(ctx).Warnf("Unable to perform grpc function discovery for upstream %s in namespace %s, error: %s",
 			f.upstream.GetMetadata().GetName(),
 			f.upstream.GetMetadata().GetNamespace(),
 			err.Error(),
 		)
+		if err := updatecb(fds.DiscoveryFailed(DiscoveryName, err)); err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("Unable to record the grpc function discovery error on upstream %s in namespace %s, error: %s",
+				f.upstream.GetMetadata().GetName(),
+				f.upstream.GetMetadata().GetNamespace(),
+				err.Error(),
+			)
+		}
 	}
 
 	// sleep so we are not hogging
@@ -144,11 +170,20 @@ func (f *UpstreamFunctionDiscovery) DetectFunctions(ctx context.Context, url *ur
 }
 
 func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url *url.URL, updatecb func(fds.UpstreamMutator) error) error {
+	return f.detectFunctionsOnce(ctx, url, latestDependencies(f.dependencies), updatecb)
+}
+
+func (f *UpstreamFunctionDiscovery) detectFunctionsOnce(ctx context.Context, url *url.URL, dependencies fds.Dependencies, updatecb func(fds.UpstreamMutator) error) error {
 	log := contextutils.LoggerFrom(ctx)
 
+	if usesArtifacts(getGrpcspec(f.upstream)) {
+		log.Debugf("loading the proto descriptors of %s from its ConfigMap", f.upstream.GetMetadata().GetName())
+		return f.detectFunctionsFromArtifacts(dependencies.Artifacts, updatecb)
+	}
+
 	log.Infof("%v discovered as a gRPC service", url)
 
-	refClient, closeConn, err := getClient(ctx, url)
+	refClient, closeConn, err := f.clientGetter(ctx, url, f.upstream, dependencies.Secrets)
 	if err != nil {
 		return err
 	}
@@ -163,8 +198,8 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 	// grpcJsonTranscoder API uses list of services
 	var servicesDiscovered []string
 	for _, s := range services {
-		// ignore the reflection descriptor
-		if s == "grpc.reflection.v1alpha.ServerReflection" {
+		// ignore the reflection descriptors
+		if s == "grpc.reflection.v1alpha.ServerReflection" || s == "grpc.reflection.v1.ServerReflection" {
 			continue
 		}
 		// TODO(yuval-k): do not add the same file twice
@@ -183,7 +218,7 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 	if err != nil {
 		return errors.Wrap(err, "marshalling proto descriptors")
 	}
-	return updatecb(func(out *v1.Upstream) error {
+	return updatecb(fds.DiscoverySucceeded(DiscoveryName, func(out *v1.Upstream) error {
 		svcSpec := getGrpcspec(out)
 		if svcSpec == nil {
 			if isDeprecatedGrpcspec(out) {
@@ -196,11 +231,55 @@ func (f *UpstreamFunctionDiscovery) DetectFunctionsOnce(ctx context.Context, url
 		svcSpec.Services = servicesDiscovered
 		svcSpec.MatchIncomingRequestRoute = true
 		return nil
-	})
+	}))
 }
-func getClient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() error, error) {
+
+// usesArtifacts returns whether the descriptors of the transcoder are stored in a ConfigMap, in which case they
+// are loaded from the artifacts rather than with reflection.
+func usesArtifacts(transcoder *grpc_json_plugins.GrpcJsonTranscoder) bool {
+	switch transcoder.GetDescriptorSet().(type) {
+	case *grpc_json_plugins.GrpcJsonTranscoder_ProtoDescriptorConfigMap, *grpc_json_plugins.GrpcJsonTranscoder_ProtoSourcesConfigMap:
+		return true
+	}
+	return false
+}
+
+// detectFunctionsFromArtifacts sets the services of the transcoder to the services of the descriptor set stored in its
+// ConfigMap. The descriptor set itself is left in the ConfigMap, so that Gloo picks up its changes.
+func (f *UpstreamFunctionDiscovery) detectFunctionsFromArtifacts(artifacts v1.ArtifactList, updatecb func(fds.UpstreamMutator) error) error {
+	descriptorSet, err := grpcjson.DescriptorSetFromArtifacts(artifacts, getGrpcspec(f.upstream))
+	if err != nil {
+		return err
+	}
+	services, err := grpcjson.ServicesFromDescriptorSet(descriptorSet)
+	if err != nil {
+		return err
+	}
+	return updatecb(fds.DiscoverySucceeded(DiscoveryName, func(out *v1.Upstream) error {
+		svcSpec := getGrpcspec(out)
+		if svcSpec == nil {
+			return errors.New("not a GRPC upstream")
+		}
+		svcSpec.Services = services
+		return nil
+	}))
+}
+
+// getClient returns a reflection client which uses the v1 reflection API of the upstream, or the v1alpha one if the
+// upstream does not implement the v1 API.
+func getClient(ctx context.Context, url *url.URL, upstream *v1.Upstream, secrets v1.SecretList) (*grpcreflect.Client, func() error, error) {
+	tlsConfig, err := tlsConfig(upstream, secrets)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tlsConfig == nil && url.Scheme == "https" {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
 	var dialOpts []grpc.DialOption
-	if url.Scheme != "https" {
+	if tlsConfig != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
 		dialOpts = append(dialOpts, grpc.WithInsecure())
 	}
 
@@ -208,7 +287,7 @@ func getClient(ctx context.Context, url *url.URL) (*grpcreflect.Client, func() e
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "dialing grpc on %v", url.Host)
 	}
-	refClient := grpcreflect.NewClient(ctx, reflectpb.NewServerReflectionClient(cc))
+	refClient := grpcreflect.NewClientAuto(ctx, cc)
 	closeConn := func() error {
 		refClient.Reset()
 		return cc.Close()
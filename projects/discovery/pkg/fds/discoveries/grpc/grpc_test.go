@@ -0,0 +1,301 @@
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds/discoveries/grpc"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	grpc_json "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	v1alphareflectiongrpc "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+type keyPair struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPem string
+	keyPem  string
+}
+
+func newKeyPair(template *x509.Certificate, parent *keyPair) *keyPair {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, key.Public(), parentKey)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return &keyPair{
+		cert:    cert,
+		key:     key,
+		certPem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPem:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})),
+	}
+}
+
+var _ = Describe("Grpc function discovery", func() {
+
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		server    *grpc.Server
+		upstream  *v1.Upstream
+		secrets   v1.SecretList
+		artifacts v1.ArtifactList
+		address   *url.URL
+	)
+
+	startServer := func(register func(s reflection.GRPCServer), opts ...grpc.ServerOption) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		address, err = url.Parse("tcp://" + listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		server = grpc.NewServer(opts...)
+		healthpb.RegisterHealthServer(server, health.NewServer())
+		register(server)
+		go func() {
+			defer GinkgoRecover()
+			Expect(server.Serve(listener)).NotTo(HaveOccurred())
+		}()
+	}
+
+	detectFunctions := func() (*v1.Upstream, error) {
+		discovery := NewFunctionDiscoveryFactory().NewFunctionDiscovery(upstream, fds.AdditionalClients{
+			Dependencies: func() fds.Dependencies {
+				return fds.Dependencies{Secrets: secrets, Artifacts: artifacts}
+			},
+		}).(*UpstreamFunctionDiscovery)
+		discovered := upstream.Clone().(*v1.Upstream)
+		err := discovery.DetectFunctionsOnce(ctx, address, func(mutator fds.UpstreamMutator) error {
+			return mutator(discovered)
+		})
+		return discovered, err
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+		secrets = nil
+		artifacts = nil
+		address = nil
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "grpc", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					ServiceSpec: &plugins.ServiceSpec{
+						PluginType: &plugins.ServiceSpec_GrpcJsonTranscoder{
+							GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{},
+						},
+					},
+				},
+			},
+			DiscoveryStatus: &v1.DiscoveryStatus{Error: "previous error"},
+		}
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Stop()
+		}
+		cancel()
+	})
+
+	expectDiscoveredHealthService := func(discovered *v1.Upstream) {
+		transcoder := discovered.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder()
+		Expect(transcoder.GetServices()).To(ConsistOf("grpc.health.v1.Health"))
+		Expect(transcoder.GetProtoDescriptorBin()).NotTo(BeEmpty())
+		Expect(discovered.GetDiscoveryStatus().GetError()).To(BeEmpty())
+	}
+
+	It("discovers the services with the v1 reflection api", func() {
+		startServer(reflection.RegisterV1)
+
+		discovered, err := detectFunctions()
+
+		Expect(err).NotTo(HaveOccurred())
+		expectDiscoveredHealthService(discovered)
+	})
+
+	It("discovers the services with the v1alpha reflection api", func() {
+		startServer(func(s reflection.GRPCServer) {
+			v1alphareflectiongrpc.RegisterServerReflectionServer(s, reflection.NewServer(reflection.ServerOptions{Services: s}))
+		})
+
+		discovered, err := detectFunctions()
+
+		Expect(err).NotTo(HaveOccurred())
+		expectDiscoveredHealthService(discovered)
+	})
+
+	Context("descriptors stored in a configmap", func() {
+
+		BeforeEach(func() {
+			artifacts = v1.ArtifactList{{
+				Metadata: &core.Metadata{Name: "bookstore-protos", Namespace: "gloo-system"},
+				Data: map[string]string{
+					"bookstore.proto": `syntax = "proto3";
+package main;
+
+import "google/protobuf/empty.proto";
+
+service Bookstore {
+  rpc ListShelves(google.protobuf.Empty) returns (google.protobuf.Empty);
+}
+`,
+				},
+			}}
+			upstream.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder().DescriptorSet = &grpc_json.GrpcJsonTranscoder_ProtoSourcesConfigMap{
+				ProtoSourcesConfigMap: &grpc_json.GrpcJsonTranscoder_ProtoSources{
+					ConfigMapRef: &core.ResourceRef{Name: "bookstore-protos", Namespace: "gloo-system"},
+				},
+			}
+		})
+
+		It("populates the services from the configmap without reflection", func() {
+			discovered, err := detectFunctions()
+
+			Expect(err).NotTo(HaveOccurred())
+			transcoder := discovered.GetStatic().GetServiceSpec().GetGrpcJsonTranscoder()
+			Expect(transcoder.GetServices()).To(ConsistOf("main.Bookstore"))
+			// the descriptors are still read from the configmap, to pick up its changes
+			Expect(transcoder.GetProtoSourcesConfigMap().GetConfigMapRef().GetName()).To(Equal("bookstore-protos"))
+			Expect(discovered.GetDiscoveryStatus().GetError()).To(BeEmpty())
+		})
+
+		It("fails when the configmap does not exist", func() {
+			artifacts = nil
+
+			_, err := detectFunctions()
+
+			Expect(err).To(MatchError(ContainSubstring("configmap gloo-system:bookstore-protos cannot be found")))
+		})
+	})
+
+	Context("upstream ssl config", func() {
+
+		var (
+			ca, serverPair, clientPair *keyPair
+		)
+
+		BeforeEach(func() {
+			ca = newKeyPair(&x509.Certificate{
+				Subject:               pkix.Name{CommonName: "ca"},
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}, nil)
+			serverPair = newKeyPair(&x509.Certificate{
+				Subject:     pkix.Name{CommonName: "grpc.example.com"},
+				DNSNames:    []string{"grpc.example.com"},
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}, ca)
+			clientPair = newKeyPair(&x509.Certificate{
+				Subject:     pkix.Name{CommonName: "gloo"},
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			}, ca)
+
+			serverCert, err := tls.X509KeyPair([]byte(serverPair.certPem), []byte(serverPair.keyPem))
+			Expect(err).NotTo(HaveOccurred())
+			clientCas := x509.NewCertPool()
+			clientCas.AddCert(ca.cert)
+			startServer(reflection.RegisterV1, grpc.Creds(credentials.NewTLS(&tls.Config{
+				Certificates: []tls.Certificate{serverCert},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    clientCas,
+			})))
+
+			secrets = v1.SecretList{{
+				Metadata: &core.Metadata{Name: "grpc-tls", Namespace: "gloo-system"},
+				Kind: &v1.Secret_Tls{
+					Tls: &v1.TlsSecret{
+						CertChain:  clientPair.certPem,
+						PrivateKey: clientPair.keyPem,
+						RootCa:     ca.certPem,
+					},
+				},
+			}}
+			upstream.SslConfig = &ssl.UpstreamSslConfig{
+				SslSecrets: &ssl.UpstreamSslConfig_SecretRef{
+					SecretRef: &core.ResourceRef{Name: "grpc-tls", Namespace: "gloo-system"},
+				},
+				Sni: "grpc.example.com",
+			}
+		})
+
+		It("discovers the services over mtls with the secret of the upstream", func() {
+			discovered, err := detectFunctions()
+
+			Expect(err).NotTo(HaveOccurred())
+			expectDiscoveredHealthService(discovered)
+		})
+
+		It("verifies the subject alt names of the upstream", func() {
+			upstream.GetSslConfig().VerifySubjectAltName = []string{"grpc.example.com"}
+			_, err := detectFunctions()
+			Expect(err).NotTo(HaveOccurred())
+
+			upstream.GetSslConfig().VerifySubjectAltName = []string{"other.example.com"}
+			_, err = detectFunctions()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the upstream is not signed by the root ca", func() {
+			otherCa := newKeyPair(&x509.Certificate{
+				Subject:               pkix.Name{CommonName: "other-ca"},
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}, nil)
+			secrets[0].GetTls().RootCa = otherCa.certPem
+
+			_, err := detectFunctions()
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the secret of the upstream does not exist", func() {
+			secrets = nil
+
+			_, err := detectFunctions()
+
+			Expect(err).To(MatchError(ContainSubstring("finding the secret of the upstream ssl config")))
+		})
+
+		It("does not support sds", func() {
+			upstream.GetSslConfig().SslSecrets = &ssl.UpstreamSslConfig_Sds{Sds: &ssl.SDSConfig{}}
+
+			_, err := detectFunctions()
+
+			Expect(err).To(MatchError(SdsNotSupportedError))
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+)
+
+var (
+	SdsNotSupportedError = errors.New("grpc function discovery does not support upstream ssl configs which use SDS")
+	NotTlsSecretError    = func(ref string) error {
+		return errors.Errorf("secret %s referenced by the upstream ssl config is not a tls secret", ref)
+	}
+	InvalidRootCaError         = errors.New("the root ca of the upstream ssl config contains no valid PEM certificate")
+	NoSubjectAltNameMatchError = func(sans []string) error {
+		return errors.Errorf("the certificate of the upstream does not match any of the subject alt names %v", sans)
+	}
+)
+
+// tlsConfig returns the TLS config to dial the upstream with, or nil if the upstream does not use TLS.
+// As Envoy does, the certificate of the upstream is only verified if the ssl config has a root CA, and its
+// hostname only if the ssl config lists the subject alt names to verify.
+func tlsConfig(upstream *v1.Upstream, secrets v1.SecretList) (*tls.Config, error) {
+	sslConfig := upstream.GetSslConfig()
+	if sslConfig == nil {
+		if upstream.GetStatic().GetUseTls().GetValue() {
+			return &tls.Config{InsecureSkipVerify: true}, nil
+		}
+		return nil, nil
+	}
+
+	var certChain, privateKey, rootCa string
+	switch sslSecrets := sslConfig.GetSslSecrets().(type) {
+	case *ssl.UpstreamSslConfig_SecretRef:
+		secret, err := secrets.Find(sslSecrets.SecretRef.GetNamespace(), sslSecrets.SecretRef.GetName())
+		if err != nil {
+			return nil, errors.Wrapf(err, "finding the secret of the upstream ssl config")
+		}
+		tlsSecret := secret.GetTls()
+		if tlsSecret == nil {
+			return nil, NotTlsSecretError(sslSecrets.SecretRef.Key())
+		}
+		certChain, privateKey, rootCa = tlsSecret.GetCertChain(), tlsSecret.GetPrivateKey(), tlsSecret.GetRootCa()
+	case *ssl.UpstreamSslConfig_SslFiles:
+		var err error
+		if certChain, err = readFile(sslSecrets.SslFiles.GetTlsCert()); err != nil {
+			return nil, err
+		}
+		if privateKey, err = readFile(sslSecrets.SslFiles.GetTlsKey()); err != nil {
+			return nil, err
+		}
+		if rootCa, err = readFile(sslSecrets.SslFiles.GetRootCa()); err != nil {
+			return nil, err
+		}
+	case *ssl.UpstreamSslConfig_Sds:
+		return nil, SdsNotSupportedError
+	}
+
+	cfg := &tls.Config{
+		ServerName:    sslConfig.GetSni(),
+		MinVersion:    tlsVersion(sslConfig.GetParameters().GetMinimumProtocolVersion()),
+		MaxVersion:    tlsVersion(sslConfig.GetParameters().GetMaximumProtocolVersion()),
+		Renegotiation: tls.RenegotiateNever,
+		// the certificate is verified below, without its hostname unless subject alt names are listed
+		InsecureSkipVerify: true,
+	}
+	if sslConfig.GetAllowRenegotiation().GetValue() {
+		cfg.Renegotiation = tls.RenegotiateFreelyAsClient
+	}
+	if certChain != "" || privateKey != "" {
+		cert, err := tls.X509KeyPair([]byte(certChain), []byte(privateKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading the client certificate of the upstream ssl config")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if rootCa != "" {
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM([]byte(rootCa)) {
+			return nil, InvalidRootCaError
+		}
+		cfg.VerifyPeerCertificate = verifyPeerCertificate(roots, sslConfig.GetVerifySubjectAltName())
+	}
+	return cfg, nil
+}
+
+func verifyPeerCertificate(roots *x509.CertPool, subjectAltNames []string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		var certs []*x509.Certificate
+		for _, rawCert := range rawCerts {
+			cert, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+		if len(certs) == 0 {
+			return errors.New("the upstream presented no certificate")
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		if _, err := certs[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates}); err != nil {
+			return err
+		}
+		if len(subjectAltNames) == 0 {
+			return nil
+		}
+		for _, san := range subjectAltNames {
+			if certs[0].VerifyHostname(san) == nil {
+				return nil
+			}
+			for _, uri := range certs[0].URIs {
+				if uri.String() == san {
+					return nil
+				}
+			}
+		}
+		return NoSubjectAltNameMatchError(subjectAltNames)
+	}
+}
+
+func tlsVersion(version ssl.SslParameters_ProtocolVersion) uint16 {
+	switch version {
+	case ssl.SslParameters_TLSv1_0:
+		return tls.VersionTLS10
+	case ssl.SslParameters_TLSv1_1:
+		return tls.VersionTLS11
+	case ssl.SslParameters_TLSv1_2:
+		return tls.VersionTLS12
+	case ssl.SslParameters_TLSv1_3:
+		return tls.VersionTLS13
+	}
+	return 0
+}
+
+func readFile(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "reading %s from the upstream ssl config", path)
+	}
+	return string(content), nil
+}
@@ -36,6 +36,9 @@ var commonSwaggerURIs = []string{
 // TODO(yuval-k): run this in a back off for a limited amount of time, with high initial retry.
 // maybe backoff with initial 1 minute a total of 10 minutes till giving up. this should probably be configurable
 
+// DiscoveryName is the name of the swagger function discovery in the discovery status of the upstreams
+const DiscoveryName = "swagger"
+
 func NewFunctionDiscoveryFactory() fds.FunctionDiscoveryFactory {
 	return &SwaggerFunctionDiscoveryFactory{
 		DetectionTimeout: time.Minute,
@@ -66,6 +69,10 @@ type SwaggerFunctionDiscovery struct {
 	swaggerUrisToTry []string
 }
 
+func (f *SwaggerFunctionDiscovery) Name() string {
+	return DiscoveryName
+}
+
 func getSwagSpec(u *v1.Upstream) *rest_plugins.ServiceSpec_SwaggerInfo {
 	spec, ok := u.GetUpstreamType().(v1.ServiceSpecGetter)
 	if !ok {
@@ -167,7 +174,7 @@ func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, _ *url.U
 	spec := getSwagSpec(in)
 	if spec == nil || spec.GetSwaggerSpec() == nil {
 		// TODO: make this a fatal error that avoids restarts?
-		return errors.New("upstream doesn't have a swagger spec")
+		return f.discoveryFailed(ctx, errors.New("upstream doesn't have a swagger spec"), updatecb)
 	}
 	switch document := spec.GetSwaggerSpec().(type) {
 	case *rest_plugins.ServiceSpec_SwaggerInfo_Url:
@@ -176,7 +183,7 @@ func (f *SwaggerFunctionDiscovery) DetectFunctions(ctx context.Context, _ *url.U
 		return f.detectFunctionsFromInline(ctx, document.Inline, in, updatecb)
 	}
 
-	return errors.New("upstream doesn't have a swagger source")
+	return f.discoveryFailed(ctx, errors.New("upstream doesn't have a swagger source"), updatecb)
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, url string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
@@ -196,13 +203,10 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-		contextutils.LoggerFrom(ctx).Warnf("Unable to perform Swagger function discovery for upstream %s in namespace %s, error: ",
-			f.upstream.GetMetadata().GetName(),
-			f.upstream.GetMetadata().GetNamespace(),
-			err.Error(),
-		)
-		// ignore other errors as we would like to continue forever.
+		// record other errors on the upstream, as we would like to continue forever.
+		_ = f.discoveryFailed(ctx, err, updatecb)
 	}
+	fds.RecordAttempt(ctx, DiscoveryName, err)
 	if err := contextutils.Sleep(ctx, f.functionPollTime); err != nil {
 		return err
 	}
@@ -211,10 +215,32 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromUrl(ctx context.Context, u
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromInline(ctx context.Context, document string, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
 	spec, err := parseSwaggerDoc([]byte(document))
+	if err == nil {
+		err = f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+	}
+	fds.RecordAttempt(ctx, DiscoveryName, err)
 	if err != nil {
-		return err
+		// the inline document is not discovered again, so its error is recorded on the upstream
+		return f.discoveryFailed(ctx, err, updatecb)
 	}
-	return f.detectFunctionsFromSpec(ctx, spec, in, updatecb)
+	return nil
+}
+
+// discoveryFailed records the error of the discovery on the upstream, and returns it
+func (f *SwaggerFunctionDiscovery) discoveryFailed(ctx context.Context, err error, updatecb func(fds.UpstreamMutator) error) error {
+	contextutils.LoggerFrom(ctx).Warnf("Unable to perform Swagger function discovery for upstream %s in namespace %s, error: %s",
+		f.upstream.GetMetadata().GetName(),
+		f.upstream.GetMetadata().GetNamespace(),
+		err.Error(),
+	)
+	if updateErr := updatecb(fds.DiscoveryFailed(DiscoveryName, err)); updateErr != nil {
+		contextutils.LoggerFrom(ctx).Warnf("Unable to record the Swagger function discovery error on upstream %s in namespace %s, error: %s",
+			f.upstream.GetMetadata().GetName(),
+			f.upstream.GetMetadata().GetNamespace(),
+			updateErr.Error(),
+		)
+	}
+	return err
 }
 
 func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context, swaggerSpec *openapi.Swagger, in *v1.Upstream, updatecb func(fds.UpstreamMutator) error) error {
@@ -244,7 +270,7 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 		createFunctionsForPath(funcs, swaggerSpec.BasePath, functionPath, pathItem.PathItemProps, swaggerSpec.Definitions)
 	}
 
-	return updatecb(func(u *v1.Upstream) error {
+	return updatecb(fds.DiscoverySucceeded(DiscoveryName, func(u *v1.Upstream) error {
 		upstreamSpec, ok := u.GetUpstreamType().(v1.ServiceSpecMutator)
 		if !ok {
 			return errors.New("not a valid upstream")
@@ -265,7 +291,7 @@ func (f *SwaggerFunctionDiscovery) detectFunctionsFromSpec(ctx context.Context,
 
 		upstreamSpec.SetServiceSpec(spec)
 		return nil
-	})
+	}))
 }
 
 func RetrieveSwaggerDocFromUrl(ctx context.Context, url string) (*openapi.Swagger, error) {
@@ -15,8 +15,9 @@ func NewFunctionDiscovery(updater *Updater) *FunctionDiscovery {
 	}
 }
 
-func (d *FunctionDiscovery) Update(upstreams v1.UpstreamList, secrets v1.SecretList) error {
+func (d *FunctionDiscovery) Update(upstreams v1.UpstreamList, secrets v1.SecretList, artifacts v1.ArtifactList) error {
 	d.updater.SetSecrets(secrets)
+	d.updater.SetArtifacts(artifacts)
 	// get new snapshot from sync and update the upstreams and secrets in the updater
 	old := d.prevUpstreams
 	d.prevUpstreams = upstreams
@@ -2,6 +2,7 @@ package fds
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 
 	"github.com/solo-io/solo-kit/pkg/errors"
@@ -15,6 +16,8 @@ type UpstreamMutator func(*v1.Upstream) error
 
 type AdditionalClients struct {
 	GraphqlClient v1beta1.GraphQLApiClient
+	// Dependencies returns the latest dependencies of the discoveries, such as the secrets and the artifacts
+	Dependencies func() Dependencies
 }
 
 /*
@@ -32,6 +35,8 @@ type FunctionDiscoveryFactory interface {
 
 type Dependencies struct {
 	Secrets v1.SecretList
+	// the artifacts, such as the ConfigMaps, which may store the descriptors of the upstreams
+	Artifacts v1.ArtifactList
 }
 
 type UpstreamFunctionDiscovery interface {
@@ -50,9 +55,25 @@ type UpstreamFunctionDiscovery interface {
 	DetectType(ctx context.Context, url *url.URL) (*plugins.ServiceSpec, error)
 
 	// url maybe nil if it couldn't be resolved
+	// The outcome of each attempt is recorded on the upstream with DiscoverySucceeded or DiscoveryFailed, by the
+	// discovery itself. A returned error stops the discovery of the upstream.
 	DetectFunctions(ctx context.Context, url *url.URL, dependencies func() Dependencies, out func(UpstreamMutator) error) error
 }
 
+// NamedUpstreamFunctionDiscovery is implemented by the discoveries which report their name in the discovery status
+// of the upstreams and in the metrics. The other discoveries are reported by their type.
+type NamedUpstreamFunctionDiscovery interface {
+	Name() string
+}
+
+// DiscoveryName returns the name of the discovery, as reported in the discovery status of the upstreams.
+func DiscoveryName(discovery UpstreamFunctionDiscovery) string {
+	if named, ok := discovery.(NamedUpstreamFunctionDiscovery); ok {
+		return named.Name()
+	}
+	return fmt.Sprintf("%T", discovery)
+}
+
 type Resolver interface {
 	/*
 		TCP if not known
@@ -0,0 +1,141 @@
+package fds
+
+import (
+	"context"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+)
+
+const (
+	DetectedTypeRest      = "REST"
+	DetectedTypeGrpc      = "gRPC"
+	DetectedTypeAwsLambda = "AWS Lambda"
+	DetectedTypeGraphql   = "GraphQL"
+
+	attemptSucceeded = "success"
+	attemptFailed    = "failure"
+)
+
+var (
+	mAttempts    = stats.Int64("discovery.gloo.solo.io/fds/attempts", "The number of function discovery attempts", "1")
+	pluginKey, _ = tag.NewKey("plugin")
+	resultKey, _ = tag.NewKey("result")
+
+	attemptsView = &view.View{
+		Name:        "discovery.gloo.solo.io/fds/attempts",
+		Measure:     mAttempts,
+		Description: "The number of function discovery attempts, by discovery plugin and result",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{pluginKey, resultKey},
+	}
+)
+
+func init() {
+	_ = view.Register(attemptsView)
+}
+
+// RecordAttempt records a function discovery attempt of the plugin in the metrics.
+func RecordAttempt(ctx context.Context, plugin string, err error) {
+	result := attemptSucceeded
+	if err != nil {
+		result = attemptFailed
+	}
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(pluginKey, plugin), tag.Upsert(resultKey, result)}, mAttempts.M(1))
+}
+
+// DiscoverySucceeded returns a mutator which applies the functions discovered by the plugin to the upstream,
+// and records the success on its discovery status.
+func DiscoverySucceeded(plugin string, mutator UpstreamMutator) UpstreamMutator {
+	return func(upstream *v1.Upstream) error {
+		if err := mutator(upstream); err != nil {
+			return err
+		}
+		setDiscoveryStatus(upstream, plugin, nil)
+		return nil
+	}
+}
+
+// DiscoveryFailed returns a mutator which records the error of the plugin on the discovery status of the upstream.
+func DiscoveryFailed(plugin string, err error) UpstreamMutator {
+	return func(upstream *v1.Upstream) error {
+		setDiscoveryStatus(upstream, plugin, err)
+		return nil
+	}
+}
+
+func setDiscoveryStatus(upstream *v1.Upstream, plugin string, err error) {
+	previous := upstream.GetDiscoveryStatus()
+	transitionTime := timestamppb.New(time.Now())
+	status := &v1.DiscoveryStatus{
+		DetectedType:       detectedType(upstream),
+		DiscoveryPlugin:    plugin,
+		LastTransitionTime: transitionTime,
+		LastSuccessTime:    previous.GetLastSuccessTime(),
+		FunctionCount:      previous.GetFunctionCount(),
+	}
+	if err != nil {
+		status.Error = err.Error()
+	} else {
+		status.LastSuccessTime = transitionTime
+		status.FunctionCount = functionCount(upstream)
+	}
+
+	// the upstream is written whenever its status changes, so the times are only updated when the
+	// outcome of the discovery transitions, e.g. its error changes, rather than on every attempt
+	if previous != nil {
+		unchanged := status.Clone().(*v1.DiscoveryStatus)
+		unchanged.LastTransitionTime = previous.GetLastTransitionTime()
+		unchanged.LastSuccessTime = previous.GetLastSuccessTime()
+		if unchanged.Equal(previous) {
+			return
+		}
+	}
+
+	upstream.DiscoveryStatus = status
+}
+
+func serviceSpec(upstream *v1.Upstream) *plugins.ServiceSpec {
+	serviceSpecGetter, ok := upstream.GetUpstreamType().(v1.ServiceSpecGetter)
+	if !ok {
+		return nil
+	}
+	return serviceSpecGetter.GetServiceSpec()
+}
+
+func detectedType(upstream *v1.Upstream) string {
+	if upstream.GetAws() != nil {
+		return DetectedTypeAwsLambda
+	}
+	switch serviceSpec(upstream).GetPluginType().(type) {
+	case *plugins.ServiceSpec_Rest:
+		return DetectedTypeRest
+	case *plugins.ServiceSpec_Grpc, *plugins.ServiceSpec_GrpcJsonTranscoder:
+		return DetectedTypeGrpc
+	case *plugins.ServiceSpec_Graphql:
+		return DetectedTypeGraphql
+	}
+	return ""
+}
+
+func functionCount(upstream *v1.Upstream) uint32 {
+	if upstream.GetAws() != nil {
+		return uint32(len(upstream.GetAws().GetLambdaFunctions()))
+	}
+	spec := serviceSpec(upstream)
+	switch {
+	case spec.GetRest() != nil:
+		return uint32(len(spec.GetRest().GetTransformations()))
+	case spec.GetGrpcJsonTranscoder() != nil:
+		return uint32(len(spec.GetGrpcJsonTranscoder().GetServices()))
+	case spec.GetGrpc() != nil:
+		return uint32(len(spec.GetGrpc().GetGrpcServices()))
+	}
+	return 0
+}
@@ -0,0 +1,113 @@
+package fds_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	. "github.com/solo-io/gloo/projects/discovery/pkg/fds"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+)
+
+var _ = Describe("Discovery status", func() {
+
+	var (
+		upstream     *v1.Upstream
+		setServices  UpstreamMutator
+		discoveryErr error
+		pluginName   = "grpc"
+	)
+
+	BeforeEach(func() {
+		upstream = &v1.Upstream{
+			UpstreamType: &v1.Upstream_Kube{
+				Kube: &kubernetes_plugins_gloo_solo_io.UpstreamSpec{},
+			},
+		}
+		setServices = func(u *v1.Upstream) error {
+			u.GetKube().ServiceSpec = &plugins.ServiceSpec{
+				PluginType: &plugins.ServiceSpec_GrpcJsonTranscoder{
+					GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+						Services: []string{"main.Bookstore", "main.Library"},
+					},
+				},
+			}
+			return nil
+		}
+		discoveryErr = errors.New("connection refused")
+	})
+
+	It("records a successful discovery", func() {
+		Expect(DiscoverySucceeded(pluginName, setServices)(upstream)).To(Succeed())
+
+		status := upstream.GetDiscoveryStatus()
+		Expect(status.GetDiscoveryPlugin()).To(Equal(pluginName))
+		Expect(status.GetDetectedType()).To(Equal(DetectedTypeGrpc))
+		Expect(status.GetFunctionCount()).To(BeEquivalentTo(2))
+		Expect(status.GetError()).To(BeEmpty())
+		Expect(status.GetLastTransitionTime()).NotTo(BeNil())
+		Expect(status.GetLastSuccessTime()).To(Equal(status.GetLastTransitionTime()))
+	})
+
+	It("keeps the functions and the last success of a failed discovery", func() {
+		Expect(DiscoverySucceeded(pluginName, setServices)(upstream)).To(Succeed())
+		lastSuccess := upstream.GetDiscoveryStatus().GetLastSuccessTime()
+
+		Expect(DiscoveryFailed(pluginName, discoveryErr)(upstream)).To(Succeed())
+
+		status := upstream.GetDiscoveryStatus()
+		Expect(status.GetError()).To(Equal(discoveryErr.Error()))
+		Expect(status.GetFunctionCount()).To(BeEquivalentTo(2))
+		Expect(status.GetLastSuccessTime()).To(Equal(lastSuccess))
+	})
+
+	It("does not apply the functions if the mutator fails", func() {
+		Expect(DiscoverySucceeded(pluginName, func(u *v1.Upstream) error {
+			return discoveryErr
+		})(upstream)).To(MatchError(discoveryErr))
+		Expect(upstream.GetDiscoveryStatus()).To(BeNil())
+	})
+
+	It("does not update an unchanged status, however old its times are", func() {
+		Expect(DiscoveryFailed(pluginName, discoveryErr)(upstream)).To(Succeed())
+		status := upstream.GetDiscoveryStatus()
+		status.LastTransitionTime = timestamppb.New(time.Now().Add(-time.Hour))
+
+		Expect(DiscoveryFailed(pluginName, errors.New(discoveryErr.Error()))(upstream)).To(Succeed())
+		Expect(upstream.GetDiscoveryStatus()).To(BeIdenticalTo(status))
+	})
+
+	It("updates the status when the error changes", func() {
+		Expect(DiscoveryFailed(pluginName, discoveryErr)(upstream)).To(Succeed())
+		Expect(DiscoveryFailed(pluginName, errors.New("no route to host"))(upstream)).To(Succeed())
+		Expect(upstream.GetDiscoveryStatus().GetError()).To(Equal("no route to host"))
+	})
+
+	It("does not change the hash of the upstream, so that writing the status does not trigger a translation", func() {
+		Expect(setServices(upstream)).To(Succeed())
+		hashBefore, err := upstream.Hash(nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(DiscoveryFailed(pluginName, discoveryErr)(upstream)).To(Succeed())
+		Expect(upstream.GetDiscoveryStatus()).NotTo(BeNil())
+
+		hashAfter, err := upstream.Hash(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashAfter).To(Equal(hashBefore))
+	})
+
+	It("updates a changed status right away", func() {
+		Expect(DiscoveryFailed(pluginName, discoveryErr)(upstream)).To(Succeed())
+		Expect(DiscoverySucceeded(pluginName, setServices)(upstream)).To(Succeed())
+
+		status := upstream.GetDiscoveryStatus()
+		Expect(status.GetError()).To(BeEmpty())
+		Expect(status.GetFunctionCount()).To(BeEquivalentTo(2))
+	})
+})
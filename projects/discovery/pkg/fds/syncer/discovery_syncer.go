@@ -40,7 +40,7 @@ func (s *syncer) Sync(ctx context.Context, snap *v1.DiscoverySnapshot) error {
 		logger.Debug(syncutil.StringifySnapshot(snap))
 	}
 	upstreamsToDetect := selectUpstreamsForDiscovery(s.fdsMode, snap.Upstreams, snap.Kubenamespaces)
-	return s.fd.Update(upstreamsToDetect, snap.Secrets)
+	return s.fd.Update(upstreamsToDetect, snap.Secrets, snap.Artifacts)
 }
 
 const (
@@ -69,6 +69,14 @@ func RunFDSWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	if err := secretClient.Register(); err != nil {
 		return err
 	}
+	// the artifacts may store the proto descriptors of the grpc upstreams
+	artifactClient, err := v1.NewArtifactClient(watchOpts.Ctx, opts.Artifacts)
+	if err != nil {
+		return err
+	}
+	if err := artifactClient.Register(); err != nil {
+		return err
+	}
 	graphqlClient, err := v1beta1.NewGraphQLApiClient(watchOpts.Ctx, opts.GraphQLApis)
 	if err != nil {
 		return err
@@ -84,7 +92,7 @@ func RunFDSWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 		nsClient = &FakeKubeNamespaceWatcher{}
 	}
 
-	cache := v1.NewDiscoveryEmitter(upstreamClient, nsClient, secretClient)
+	cache := v1.NewDiscoveryEmitter(upstreamClient, nsClient, secretClient, artifactClient)
 
 	var resolvers fds.Resolvers
 	for _, plug := range registry.Plugins(opts) {
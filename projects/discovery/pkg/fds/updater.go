@@ -3,7 +3,10 @@ package fds
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -47,7 +50,8 @@ type Updater struct {
 
 	maxInParallelSemaphore chan struct{}
 
-	secrets atomic.Value
+	secrets   atomic.Value
+	artifacts atomic.Value
 }
 
 func getConcurrencyChan(maxOnCurrency uint) chan struct{} {
@@ -81,6 +85,7 @@ func NewUpdater(ctx context.Context, resolver Resolver, graphqlClient v1beta1.Gr
 type detectResult struct {
 	spec *plugins.ServiceSpec
 	fp   UpstreamFunctionDiscovery
+	err  error
 }
 
 func (u *Updater) SetSecrets(secretList v1.SecretList) {
@@ -97,11 +102,31 @@ func (u *Updater) GetSecrets() v1.SecretList {
 	return sl.(v1.SecretList)
 }
 
+func (u *Updater) SetArtifacts(artifactList v1.ArtifactList) {
+	u.artifacts.Store(artifactList)
+}
+
+func (u *Updater) GetArtifacts() v1.ArtifactList {
+	al := u.artifacts.Load()
+	if al == nil {
+		return nil
+	}
+	return al.(v1.ArtifactList)
+}
+
+func (u *Updater) dependencies() Dependencies {
+	return Dependencies{
+		Secrets:   u.GetSecrets(),
+		Artifacts: u.GetArtifacts(),
+	}
+}
+
 func (u *Updater) createDiscoveries(upstream *v1.Upstream) []UpstreamFunctionDiscovery {
 	var ret []UpstreamFunctionDiscovery
 	for _, e := range u.functionalPlugins {
 		ret = append(ret, e.NewFunctionDiscovery(upstream, AdditionalClients{
 			GraphqlClient: u.graphqlClient,
+			Dependencies:  u.dependencies,
 		}))
 	}
 	return ret
@@ -233,6 +258,7 @@ func (u *updaterUpdater) detectSingle(fp UpstreamFunctionDiscovery, url url.URL,
 		result <- detectResult{
 			spec: nil,
 			fp:   fp,
+			err:  err,
 		}
 	}
 }
@@ -259,6 +285,7 @@ func (u *updaterUpdater) detectType(url_ url.URL) ([]*detectResult, error) {
 	}()
 	var numResultsReceived int
 	var results []*detectResult
+	var detectErrors []string
 	for {
 		select {
 		case res, ok := <-result:
@@ -267,10 +294,16 @@ func (u *updaterUpdater) detectType(url_ url.URL) ([]*detectResult, error) {
 				results = append(results, &res)
 			} else if !ok {
 				return results, nil
+			} else if res.err != nil {
+				detectErrors = append(detectErrors, fmt.Sprintf("%s: %s", DiscoveryName(res.fp), res.err.Error()))
 			}
 			if numResultsReceived == len(u.functionalPlugins) {
 				if len(results) == 0 {
-					return nil, errorUndetectableUpstream
+					if len(detectErrors) == 0 {
+						return nil, errorUndetectableUpstream
+					}
+					sort.Strings(detectErrors)
+					return nil, fmt.Errorf("%w (%s)", errorUndetectableUpstream, strings.Join(detectErrors, "; "))
 				}
 				return results, nil
 			}
@@ -281,12 +314,6 @@ func (u *updaterUpdater) detectType(url_ url.URL) ([]*detectResult, error) {
 
 }
 
-func (u *updaterUpdater) dependencies() Dependencies {
-	return Dependencies{
-		Secrets: u.parent.GetSecrets(),
-	}
-}
-
 func (u *updaterUpdater) Run() error {
 	// more than one discovery can operate on an upstream, e.g. Swagger discovery and openapi spec -> graphql schema discovery
 	// this is a (temporary?) work around
@@ -315,9 +342,10 @@ func (u *updaterUpdater) Run() error {
 		// try to detect the type
 		res, err := u.detectType(*resolvedUrl)
 		if err != nil {
-			if err == errorUndetectableUpstream {
+			if errors.Is(err, errorUndetectableUpstream) {
 				// TODO(yuval-k): at this point all discoveries gave up.
 				// do we want to mark an upstream as undetected persistently so we do not detect it anymore?
+				upstreamSave(DiscoveryFailed("", err))
 			}
 			return err
 		}
@@ -361,7 +389,7 @@ func (u *updaterUpdater) Run() error {
 				default:
 					// continue to detect functions, as you were
 				}
-				err := d.DetectFunctions(u.ctx, resolvedUrl, u.dependencies, upstreamSave)
+				err := d.DetectFunctions(u.ctx, resolvedUrl, u.parent.dependencies, upstreamSave)
 				if err != nil {
 					logger.Errorf("Error doing discovery %T: %s", d, err.Error())
 					return
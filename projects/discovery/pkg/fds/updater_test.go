@@ -2,6 +2,7 @@ package fds_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"sync/atomic"
@@ -18,12 +19,26 @@ import (
 	kubernetes_plugins_gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 )
 
-type testUpstreamWriterClient struct{}
+type testUpstreamWriterClient struct {
+	writes  int32
+	written atomic.Value
+}
 
 func (t *testUpstreamWriterClient) Write(resource *v1.Upstream, opts clients.WriteOpts) (*v1.Upstream, error) {
+	atomic.AddInt32(&t.writes, 1)
+	t.written.Store(resource)
 	return resource, nil
 }
 
+func (t *testUpstreamWriterClient) getWrites() int32 {
+	return atomic.LoadInt32(&t.writes)
+}
+
+func (t *testUpstreamWriterClient) getWritten() *v1.Upstream {
+	written, _ := t.written.Load().(*v1.Upstream)
+	return written
+}
+
 func (t *testUpstreamWriterClient) Read(namespace, name string, opts clients.ReadOpts) (*v1.Upstream, error) {
 	return nil, fmt.Errorf("test - no upstream")
 }
@@ -177,4 +192,36 @@ var _ = Describe("Updater", func() {
 		Expect(fc.detectFunctions).To(BeTrue())
 	})
 
+	Context("discovery status", func() {
+
+		var (
+			discoveryErr error
+		)
+
+		BeforeEach(func() {
+			discoveryErr = errors.New("connection refused")
+			testDiscovery1.isUpstreamFunctionalResult = true
+			testDiscovery1.mutate = DiscoveryFailed("test", discoveryErr)
+		})
+
+		It("records the error of a discovery once, when the discovery records it", func() {
+			testDiscovery1.detectFunctionsError = discoveryErr
+			updater.UpstreamAdded(up)
+
+			Eventually(upstreamWriterClient.getWrites).Should(BeEquivalentTo(1))
+			Consistently(upstreamWriterClient.getWrites, "100ms").Should(BeEquivalentTo(1))
+			status := upstreamWriterClient.getWritten().GetDiscoveryStatus()
+			Expect(status.GetDiscoveryPlugin()).To(Equal("test"))
+			Expect(status.GetError()).To(Equal(discoveryErr.Error()))
+		})
+
+		It("writes the upstream only when the error changes", func() {
+			// the discovery keeps failing with the same error
+			updater.UpstreamAdded(up)
+
+			Eventually(upstreamWriterClient.getWrites).Should(BeEquivalentTo(1))
+			Consistently(upstreamWriterClient.getWrites, "100ms").Should(BeEquivalentTo(1))
+		})
+	})
+
 })
@@ -64,8 +64,16 @@ func RunUDS(opts bootstrap.Opts) error {
 		}
 	}
 
+	// upstream discovery does not use the artifacts, so they are not watched
+	artifactClient, err := v1.NewArtifactClient(watchOpts.Ctx, &factory.MemoryResourceClientFactory{
+		Cache: memory.NewInMemoryResourceCache(),
+	})
+	if err != nil {
+		return err
+	}
+
 	emit := make(chan struct{})
-	emitter := v1.NewDiscoveryEmitterWithEmit(upstreamClient, nsClient, secretClient, emit)
+	emitter := v1.NewDiscoveryEmitterWithEmit(upstreamClient, nsClient, secretClient, artifactClient, emit)
 
 	// jumpstart all the watches
 	go func() {
@@ -0,0 +1,104 @@
+package acme
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const (
+	// ResourceLabel marks the secrets which are managed by the ACME manager, its value is the kind of the secret
+	ResourceLabel = "gloo.solo.io/acme"
+
+	challengeKind   = "challenge"
+	accountKind     = "account"
+	certificateKind = "certificate"
+
+	// the keys of the header secrets which store the pending challenges and the account keys
+	domainKey           = "domain"
+	tokenKey            = "token"
+	keyAuthorizationKey = "key-authorization"
+	privateKeyKey       = "private-key"
+
+	// ChallengePathPrefix is the path prefix of the HTTP-01 challenges
+	ChallengePathPrefix = "/.well-known/acme-challenge/"
+)
+
+// Challenge is a pending HTTP-01 challenge, which the proxy must serve for the ACME server to validate the domain.
+type Challenge struct {
+	Domain           string
+	Token            string
+	KeyAuthorization string
+}
+
+// Path returns the path on which the challenge must be served.
+func (c Challenge) Path() string {
+	return ChallengePathPrefix + c.Token
+}
+
+// ChallengesFromSecrets returns the pending challenges stored in the secrets of the namespace where the manager writes
+// them, ordered by domain and token. Challenge secrets in other namespaces are ignored, since anyone who can write
+// them could serve any response for any domain.
+func ChallengesFromSecrets(secrets v1.SecretList, namespace string) []Challenge {
+	var challenges []Challenge
+	for _, secret := range secrets {
+		if secret.GetMetadata().GetNamespace() != namespace || secret.GetMetadata().GetLabels()[ResourceLabel] != challengeKind {
+			continue
+		}
+		headers := secret.GetHeader().GetHeaders()
+		challenge := Challenge{
+			Domain:           headers[domainKey],
+			Token:            headers[tokenKey],
+			KeyAuthorization: headers[keyAuthorizationKey],
+		}
+		if challenge.Domain == "" || challenge.Token == "" {
+			continue
+		}
+		challenges = append(challenges, challenge)
+	}
+	sort.SliceStable(challenges, func(i, j int) bool {
+		if challenges[i].Domain != challenges[j].Domain {
+			return challenges[i].Domain < challenges[j].Domain
+		}
+		return challenges[i].Token < challenges[j].Token
+	})
+	return challenges
+}
+
+// ChallengeSecret returns the secret which stores the challenge. The challenges are stored as header secrets,
+// which are string maps.
+func ChallengeSecret(namespace string, challenge Challenge) *v1.Secret {
+	return &v1.Secret{
+		Metadata: &core.Metadata{
+			Name:      challengeSecretName(challenge.Domain, challenge.Token),
+			Namespace: namespace,
+			Labels:    map[string]string{ResourceLabel: challengeKind},
+		},
+		Kind: &v1.Secret_Header{
+			Header: &v1.HeaderSecret{
+				Headers: map[string]string{
+					domainKey:           challenge.Domain,
+					tokenKey:            challenge.Token,
+					keyAuthorizationKey: challenge.KeyAuthorization,
+				},
+			},
+		},
+	}
+}
+
+func challengeSecretName(domain, token string) string {
+	return "acme-challenge-" + shortHash(domain, token)
+}
+
+// shortHash returns a hash of the values which is valid in a resource name
+func shortHash(values ...string) string {
+	h := fnv.New64a()
+	for _, value := range values {
+		h.Write([]byte(value))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x", h.Sum64())
+}
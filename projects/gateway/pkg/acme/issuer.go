@@ -0,0 +1,223 @@
+package acme
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net/http"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"golang.org/x/crypto/acme"
+)
+
+var (
+	NoHttp01ChallengeError = func(domain string) error {
+		return eris.Errorf("the acme server offered no http-01 challenge for domain %s", domain)
+	}
+	InvalidDirectoryRootCaError = eris.New("the directory root ca contains no valid PEM certificate")
+)
+
+const (
+	// bounds each request to the acme server
+	directoryRequestTimeout = time.Minute
+	// bounds the clean up of a challenge, which happens even if the order timed out
+	cleanUpTimeout = 30 * time.Second
+)
+
+// Certificate is a certificate issued by the ACME server.
+type Certificate struct {
+	// PEM-encoded certificate chain
+	CertChain string
+	// PEM-encoded private key
+	PrivateKey string
+}
+
+// ChallengeSolver serves the HTTP-01 challenges of the ACME server.
+type ChallengeSolver interface {
+	// Present serves the challenge, and returns once the proxy is expected to serve it.
+	Present(ctx context.Context, challenge Challenge) error
+	// CleanUp stops serving the challenge.
+	CleanUp(ctx context.Context, challenge Challenge) error
+}
+
+// Issuer orders certificates from an ACME server.
+type Issuer interface {
+	Issue(ctx context.Context, config *ssl.AcmeConfig, accountKey crypto.Signer, domains []string, solver ChallengeSolver) (*Certificate, error)
+}
+
+type acmeIssuer struct{}
+
+// NewIssuer returns an issuer which validates the domains with HTTP-01 challenges.
+func NewIssuer() Issuer {
+	return &acmeIssuer{}
+}
+
+func (i *acmeIssuer) Issue(
+	ctx context.Context,
+	config *ssl.AcmeConfig,
+	accountKey crypto.Signer,
+	domains []string,
+	solver ChallengeSolver,
+) (*Certificate, error) {
+	httpClient, err := directoryHttpClient(config.GetDirectoryRootCa())
+	if err != nil {
+		return nil, err
+	}
+	client := &acme.Client{
+		Key:          accountKey,
+		DirectoryURL: directoryUrl(config),
+		HTTPClient:   httpClient,
+		UserAgent:    "gloo",
+	}
+
+	account := &acme.Account{}
+	if config.GetEmail() != "" {
+		account.Contact = []string{"mailto:" + config.GetEmail()}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !eris.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, eris.Wrapf(err, "registering acme account")
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return nil, eris.Wrapf(err, "creating acme order")
+	}
+	for _, authzURL := range order.AuthzURLs {
+		if err := authorize(ctx, client, authzURL, solver); err != nil {
+			return nil, err
+		}
+	}
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, eris.Wrapf(err, "waiting for acme order")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, key)
+	if err != nil {
+		return nil, eris.Wrapf(err, "creating certificate request")
+	}
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, eris.Wrapf(err, "finalizing acme order")
+	}
+
+	var certChain bytes.Buffer
+	for _, der := range chain {
+		if err := pem.Encode(&certChain, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+			return nil, err
+		}
+	}
+	privateKey, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Certificate{CertChain: certChain.String(), PrivateKey: privateKey}, nil
+}
+
+// authorize validates the domain of the authorization with its HTTP-01 challenge, unless it is already valid
+func authorize(ctx context.Context, client *acme.Client, authzURL string, solver ChallengeSolver) error {
+	authz, err := client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return eris.Wrapf(err, "getting acme authorization")
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	domain := authz.Identifier.Value
+	var http01 *acme.Challenge
+	for _, challenge := range authz.Challenges {
+		if challenge.Type == "http-01" {
+			http01 = challenge
+			break
+		}
+	}
+	if http01 == nil {
+		return NoHttp01ChallengeError(domain)
+	}
+
+	keyAuthorization, err := client.HTTP01ChallengeResponse(http01.Token)
+	if err != nil {
+		return err
+	}
+	challenge := Challenge{Domain: domain, Token: http01.Token, KeyAuthorization: keyAuthorization}
+	if err := solver.Present(ctx, challenge); err != nil {
+		return eris.Wrapf(err, "presenting acme challenge for domain %s", domain)
+	}
+	defer func() {
+		cleanUpCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cleanUpTimeout)
+		defer cancel()
+		_ = solver.CleanUp(cleanUpCtx, challenge)
+	}()
+
+	if _, err := client.Accept(ctx, http01); err != nil {
+		return eris.Wrapf(err, "accepting acme challenge for domain %s", domain)
+	}
+	if _, err := client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return eris.Wrapf(err, "validating domain %s", domain)
+	}
+	return nil
+}
+
+func directoryUrl(config *ssl.AcmeConfig) string {
+	if config.GetDirectoryUrl() != "" {
+		return config.GetDirectoryUrl()
+	}
+	return acme.LetsEncryptURL
+}
+
+// directoryHttpClient returns the client of the requests to the acme server, which trusts the root ca if set
+func directoryHttpClient(rootCa string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if rootCa != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(rootCa)) {
+			return nil, InvalidDirectoryRootCaError
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	return &http.Client{Transport: transport, Timeout: directoryRequestTimeout}, nil
+}
+
+func encodePrivateKey(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+func decodePrivateKey(in string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(in))
+	if block == nil {
+		return nil, eris.New("no PEM private key found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, eris.New("the private key cannot sign")
+	}
+	return signer, nil
+}
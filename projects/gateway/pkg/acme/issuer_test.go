@@ -0,0 +1,105 @@
+package acme_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/acme"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/test/testutils"
+)
+
+// httpSolver serves the challenges on the port on which Pebble validates them (5002 by default)
+type httpSolver struct {
+	lock             sync.Mutex
+	keyAuthorization map[string]string
+}
+
+func (s *httpSolver) Present(_ context.Context, challenge Challenge) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keyAuthorization[challenge.Path()] = challenge.KeyAuthorization
+	return nil
+}
+
+func (s *httpSolver) CleanUp(_ context.Context, challenge Challenge) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.keyAuthorization, challenge.Path())
+	return nil
+}
+
+func (s *httpSolver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	keyAuthorization, ok := s.keyAuthorization[r.URL.Path]
+	if !ok || !strings.HasPrefix(r.URL.Path, ChallengePathPrefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write([]byte(keyAuthorization))
+}
+
+var _ = Describe("Issuer", func() {
+	if !testutils.IsEnvDefined(testutils.PebbleDirectoryUrl) {
+		log.Printf("This test requires a Pebble ACME test server, whose hostname lookups resolve to this host "+
+			"(ie with pebble-challtestsrv -defaultIPv4 127.0.0.1), and is disabled by default. "+
+			"To enable, set %s and %s in your env.", testutils.PebbleDirectoryUrl, testutils.PebbleRootCa)
+		return
+	}
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		config *ssl.AcmeConfig
+		solver *httpSolver
+		server *http.Server
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Minute)
+		rootCa, err := os.ReadFile(os.Getenv(testutils.PebbleRootCa))
+		Expect(err).NotTo(HaveOccurred())
+		config = &ssl.AcmeConfig{
+			DirectoryUrl:    os.Getenv(testutils.PebbleDirectoryUrl),
+			DirectoryRootCa: string(rootCa),
+			Email:           "admin@example.com",
+		}
+
+		solver = &httpSolver{keyAuthorization: map[string]string{}}
+		server = &http.Server{Addr: ":5002", Handler: solver}
+		go func() {
+			defer GinkgoRecover()
+			if err := server.ListenAndServe(); err != http.ErrServerClosed {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		Expect(server.Close()).NotTo(HaveOccurred())
+		cancel()
+	})
+
+	It("issues a certificate validated with http-01 challenges", func() {
+		accountKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		domains := []string{"example.com", "www.example.com"}
+
+		cert, err := NewIssuer().Issue(ctx, config, accountKey, domains, solver)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(NeedsRenewal(cert.CertChain, domains, time.Hour, time.Now())).To(BeFalse())
+		Expect(solver.keyAuthorization).To(BeEmpty())
+	})
+})
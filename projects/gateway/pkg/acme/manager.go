@@ -0,0 +1,384 @@
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	sk_errors "github.com/solo-io/solo-kit/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultRenewBefore is how long before its expiry a certificate is renewed, unless configured
+	DefaultRenewBefore = 30 * 24 * time.Hour
+	// DefaultSyncInterval is how often the certificates are checked
+	DefaultSyncInterval = time.Hour
+	// DefaultPropagationDelay is how long the manager waits for the proxies to serve a new challenge
+	DefaultPropagationDelay = 10 * time.Second
+	// DefaultOrderTimeout is how long an order may take, including the validation of its challenges
+	DefaultOrderTimeout = 5 * time.Minute
+)
+
+var (
+	NoSecretRefError = func(vs *gatewayv1.VirtualService) error {
+		return eris.Errorf("virtual service %s must specify the secret ref of its acme certificate", vs.GetMetadata().Ref().Key())
+	}
+	NoDomainsError = func(vs *gatewayv1.VirtualService) error {
+		return eris.Errorf("virtual service %s has no domain to issue an acme certificate for", vs.GetMetadata().Ref().Key())
+	}
+	WildcardDomainError = func(vs *gatewayv1.VirtualService, domain string) error {
+		return eris.Errorf("virtual service %s cannot issue an acme certificate for the wildcard domain %s, "+
+			"which cannot be validated with http-01 challenges", vs.GetMetadata().Ref().Key(), domain)
+	}
+	UnmanagedSecretError = func(vs *gatewayv1.VirtualService, ref *core.ResourceRef) error {
+		return eris.Errorf("virtual service %s cannot write its acme certificate to the secret %s, which is not managed "+
+			"by gloo; delete the secret or reference another one", vs.GetMetadata().Ref().Key(), ref.Key())
+	}
+)
+
+type Opts struct {
+	// the namespace where the challenges and the account keys are stored
+	WriteNamespace string
+	// the namespaces of the virtual services
+	WatchNamespaces []string
+	// how often the certificates are checked, defaults to DefaultSyncInterval
+	SyncInterval time.Duration
+	// how long to wait for the proxies to serve a new challenge, defaults to DefaultPropagationDelay
+	PropagationDelay time.Duration
+	// how long an order may take, defaults to DefaultOrderTimeout
+	OrderTimeout time.Duration
+}
+
+// Manager issues the certificates of the virtual services which use an ACME ssl config, and renews them before they expire.
+type Manager struct {
+	opts            Opts
+	virtualServices gatewayv1.VirtualServiceClient
+	secrets         v1.SecretClient
+	issuer          Issuer
+	identity        leaderelector.Identity
+}
+
+func NewManager(
+	opts Opts,
+	virtualServices gatewayv1.VirtualServiceClient,
+	secrets v1.SecretClient,
+	issuer Issuer,
+	identity leaderelector.Identity,
+) *Manager {
+	if opts.SyncInterval == 0 {
+		opts.SyncInterval = DefaultSyncInterval
+	}
+	if opts.PropagationDelay == 0 {
+		opts.PropagationDelay = DefaultPropagationDelay
+	}
+	if opts.OrderTimeout == 0 {
+		opts.OrderTimeout = DefaultOrderTimeout
+	}
+	return &Manager{
+		opts:            opts,
+		virtualServices: virtualServices,
+		secrets:         secrets,
+		issuer:          issuer,
+		identity:        identity,
+	}
+}
+
+// Start syncs the certificates when the acme configs of the virtual services change, and periodically to renew them,
+// while this instance is the leader, until the context is cancelled.
+func (m *Manager) Start(ctx context.Context) {
+	logger := contextutils.LoggerFrom(ctx)
+	changes := m.watchVirtualServices(ctx)
+	ticker := time.NewTicker(m.opts.SyncInterval)
+	defer ticker.Stop()
+	for {
+		if m.identity.IsLeader() {
+			if err := m.Sync(ctx); err != nil {
+				logger.Warnw("failed to sync acme certificates", zap.Error(err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-changes:
+		}
+	}
+}
+
+// watchVirtualServices returns a channel which is signaled when the acme configs or the domains of the virtual
+// services change. The changes to the other fields of the virtual services, such as their status, are ignored.
+func (m *Manager) watchVirtualServices(ctx context.Context) <-chan struct{} {
+	logger := contextutils.LoggerFrom(ctx)
+	changes := make(chan struct{}, 1)
+	for _, namespace := range m.watchNamespaces() {
+		lists, errs, err := m.virtualServices.Watch(namespace, clients.WatchOpts{Ctx: ctx})
+		if err != nil {
+			// the certificates are still synced periodically
+			logger.Warnw("failed to watch virtual services for acme certificates", zap.String("namespace", namespace), zap.Error(err))
+			continue
+		}
+		go func() {
+			var previous string
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case err, ok := <-errs:
+					if !ok {
+						return
+					}
+					logger.Warnw("error watching virtual services for acme certificates", zap.Error(err))
+				case list, ok := <-lists:
+					if !ok {
+						return
+					}
+					current := acmeConfigsKey(list)
+					if current == previous {
+						continue
+					}
+					previous = current
+					select {
+					case changes <- struct{}{}:
+					default:
+					}
+				}
+			}
+		}()
+	}
+	return changes
+}
+
+// acmeConfigsKey returns a key which changes when the acme config or the domains of a virtual service change
+func acmeConfigsKey(virtualServices gatewayv1.VirtualServiceList) string {
+	var keys []string
+	for _, vs := range virtualServices {
+		config := vs.GetSslConfig().GetAcme()
+		if config == nil {
+			continue
+		}
+		configHash, _ := config.Hash(nil)
+		keys = append(keys, fmt.Sprintf("%s/%d/%s/%s", vs.GetMetadata().Ref().Key(), configHash,
+			strings.Join(vs.GetSslConfig().GetSniDomains(), ","), strings.Join(vs.GetVirtualHost().GetDomains(), ",")))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ";")
+}
+
+// Sync issues the certificates which are missing, do not cover the domains of their virtual service, or expire soon.
+// A failure to issue a certificate does not prevent the other certificates from being issued.
+func (m *Manager) Sync(ctx context.Context) error {
+	var virtualServices gatewayv1.VirtualServiceList
+	for _, namespace := range m.watchNamespaces() {
+		list, err := m.virtualServices.List(namespace, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			return eris.Wrapf(err, "listing virtual services")
+		}
+		virtualServices = append(virtualServices, list...)
+	}
+
+	var errs []error
+	for _, vs := range virtualServices {
+		config := vs.GetSslConfig().GetAcme()
+		if config == nil {
+			continue
+		}
+		if err := m.syncCertificate(ctx, vs, config); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return eris.Errorf("%v", errs)
+	}
+	return nil
+}
+
+func (m *Manager) watchNamespaces() []string {
+	if len(m.opts.WatchNamespaces) == 0 {
+		return []string{""}
+	}
+	return m.opts.WatchNamespaces
+}
+
+func (m *Manager) syncCertificate(ctx context.Context, vs *gatewayv1.VirtualService, config *ssl.AcmeConfig) error {
+	ref := config.GetSecretRef()
+	if ref == nil {
+		return NoSecretRefError(vs)
+	}
+	domains, err := Domains(vs)
+	if err != nil {
+		return err
+	}
+
+	existing, err := m.secrets.Read(ref.GetNamespace(), ref.GetName(), clients.ReadOpts{Ctx: ctx})
+	if err != nil && !sk_errors.IsNotExist(err) {
+		return err
+	}
+	if !NeedsRenewal(existing.GetTls().GetCertChain(), domains, renewBefore(config), time.Now()) {
+		return nil
+	}
+	// never overwrite a secret which was not written by the manager
+	if existing != nil && existing.GetMetadata().GetLabels()[ResourceLabel] != certificateKind {
+		return UnmanagedSecretError(vs, ref)
+	}
+
+	contextutils.LoggerFrom(ctx).Infow("issuing acme certificate",
+		zap.String("virtualService", vs.GetMetadata().Ref().Key()), zap.Strings("domains", domains))
+	accountKey, err := m.accountKey(ctx, config)
+	if err != nil {
+		return err
+	}
+	orderCtx, cancel := context.WithTimeout(ctx, m.opts.OrderTimeout)
+	defer cancel()
+	cert, err := m.issuer.Issue(orderCtx, config, accountKey, domains, m)
+	if err != nil {
+		return eris.Wrapf(err, "issuing acme certificate for virtual service %s", vs.GetMetadata().Ref().Key())
+	}
+
+	secret := &v1.Secret{
+		Metadata: &core.Metadata{
+			Name:      ref.GetName(),
+			Namespace: ref.GetNamespace(),
+			Labels:    map[string]string{ResourceLabel: certificateKind},
+		},
+		Kind: &v1.Secret_Tls{
+			Tls: &v1.TlsSecret{
+				CertChain:  cert.CertChain,
+				PrivateKey: cert.PrivateKey,
+			},
+		},
+	}
+	if existing != nil {
+		secret.GetMetadata().ResourceVersion = existing.GetMetadata().GetResourceVersion()
+	}
+	_, err = m.secrets.Write(secret, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+	return err
+}
+
+// Present stores the challenge in a secret, from which the gateway translator adds a route to the proxies.
+func (m *Manager) Present(ctx context.Context, challenge Challenge) error {
+	if _, err := m.secrets.Write(ChallengeSecret(m.opts.WriteNamespace, challenge), clients.WriteOpts{
+		Ctx:               ctx,
+		OverwriteExisting: true,
+	}); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(m.opts.PropagationDelay):
+		return nil
+	}
+}
+
+// CleanUp deletes the secret of the challenge.
+func (m *Manager) CleanUp(ctx context.Context, challenge Challenge) error {
+	return m.secrets.Delete(m.opts.WriteNamespace, challengeSecretName(challenge.Domain, challenge.Token), clients.DeleteOpts{
+		Ctx:            ctx,
+		IgnoreNotExist: true,
+	})
+}
+
+// accountKey returns the key of the ACME account of the directory and email, which is created if needed
+func (m *Manager) accountKey(ctx context.Context, config *ssl.AcmeConfig) (crypto.Signer, error) {
+	name := "acme-account-" + shortHash(directoryUrl(config), config.GetEmail())
+	existing, err := m.secrets.Read(m.opts.WriteNamespace, name, clients.ReadOpts{Ctx: ctx})
+	if err == nil {
+		return decodePrivateKey(existing.GetHeader().GetHeaders()[privateKeyKey])
+	}
+	if !sk_errors.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	// the account keys are stored as header secrets, which are string maps
+	if _, err := m.secrets.Write(&v1.Secret{
+		Metadata: &core.Metadata{
+			Name:      name,
+			Namespace: m.opts.WriteNamespace,
+			Labels:    map[string]string{ResourceLabel: accountKind},
+		},
+		Kind: &v1.Secret_Header{
+			Header: &v1.HeaderSecret{
+				Headers: map[string]string{privateKeyKey: encoded},
+			},
+		},
+	}, clients.WriteOpts{Ctx: ctx}); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func renewBefore(config *ssl.AcmeConfig) time.Duration {
+	if config.GetRenewBefore() != nil {
+		return config.GetRenewBefore().AsDuration()
+	}
+	return DefaultRenewBefore
+}
+
+// Domains returns the domains of the certificate of the virtual service: the sni domains of its ssl config,
+// or else the domains of its virtual host.
+func Domains(vs *gatewayv1.VirtualService) ([]string, error) {
+	domains := vs.GetSslConfig().GetSniDomains()
+	if len(domains) == 0 {
+		domains = vs.GetVirtualHost().GetDomains()
+	}
+	if len(domains) == 0 {
+		return nil, NoDomainsError(vs)
+	}
+	for _, domain := range domains {
+		if strings.Contains(domain, "*") {
+			return nil, WildcardDomainError(vs, domain)
+		}
+	}
+	return domains, nil
+}
+
+// NeedsRenewal returns whether the PEM certificate chain is missing, invalid, does not cover all the domains,
+// or expires within renewBefore.
+func NeedsRenewal(certChain string, domains []string, renewBefore time.Duration, now time.Time) bool {
+	if certChain == "" {
+		return true
+	}
+	// the leaf certificate is the first one of the chain
+	block, _ := pem.Decode([]byte(certChain))
+	if block == nil {
+		return true
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+	if now.Add(renewBefore).After(leaf.NotAfter) {
+		return true
+	}
+	for _, domain := range domains {
+		if leaf.VerifyHostname(domain) != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,329 @@
+package acme_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector/singlereplica"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/acme"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+const ns = "gloo-system"
+
+type fakeIssuer struct {
+	secrets  v1.SecretClient
+	validFor time.Duration
+	// if set, the orders never complete
+	pending bool
+
+	lock        sync.Mutex
+	accountKeys []crypto.Signer
+	domains     [][]string
+	challenges  []Challenge
+}
+
+// Issue serves a challenge for each domain, and issues a self-signed certificate
+func (i *fakeIssuer) Issue(ctx context.Context, _ *ssl.AcmeConfig, accountKey crypto.Signer, domains []string, solver ChallengeSolver) (*Certificate, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.accountKeys = append(i.accountKeys, accountKey)
+	i.domains = append(i.domains, domains)
+	if i.pending {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	for _, domain := range domains {
+		challenge := Challenge{Domain: domain, Token: "token-" + domain, KeyAuthorization: "key-" + domain}
+		if err := solver.Present(ctx, challenge); err != nil {
+			return nil, err
+		}
+		secrets, err := i.secrets.List(ns, clients.ListOpts{Ctx: ctx})
+		if err != nil {
+			return nil, err
+		}
+		i.challenges = append(i.challenges, ChallengesFromSecrets(secrets, ns)...)
+		if err := solver.CleanUp(ctx, challenge); err != nil {
+			return nil, err
+		}
+	}
+	return selfSignedCertificate(domains, time.Now().Add(i.validFor)), nil
+}
+
+func (i *fakeIssuer) issuedDomains() [][]string {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return append([][]string{}, i.domains...)
+}
+
+func selfSignedCertificate(domains []string, notAfter time.Time) *Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: domains[0]},
+		DNSNames:     domains,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).NotTo(HaveOccurred())
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return &Certificate{
+		CertChain:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})),
+	}
+}
+
+var _ = Describe("Manager", func() {
+
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		vsc     gatewayv1.VirtualServiceClient
+		secrets v1.SecretClient
+		issuer  *fakeIssuer
+		manager *Manager
+		vs      *gatewayv1.VirtualService
+		certRef *core.ResourceRef
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		resourceClientFactory := &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		}
+		vsc, err = gatewayv1.NewVirtualServiceClient(ctx, resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+		secrets, err = v1.NewSecretClient(ctx, resourceClientFactory)
+		Expect(err).NotTo(HaveOccurred())
+
+		issuer = &fakeIssuer{secrets: secrets, validFor: 90 * 24 * time.Hour}
+		manager = NewManager(Opts{
+			WriteNamespace:   ns,
+			WatchNamespaces:  []string{ns},
+			PropagationDelay: time.Nanosecond,
+		}, vsc, secrets, issuer, singlereplica.Identity())
+
+		certRef = &core.ResourceRef{Name: "example-cert", Namespace: ns}
+		vs = &gatewayv1.VirtualService{
+			Metadata: &core.Metadata{Name: "example", Namespace: ns},
+			VirtualHost: &gatewayv1.VirtualHost{
+				Domains: []string{"example.com", "www.example.com"},
+			},
+			SslConfig: &ssl.SslConfig{
+				SslSecrets: &ssl.SslConfig_Acme{
+					Acme: &ssl.AcmeConfig{
+						Email:     "admin@example.com",
+						SecretRef: certRef,
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	writeVirtualService := func() {
+		_, err := vsc.Write(vs, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	readCertificate := func() *v1.Secret {
+		secret, err := secrets.Read(certRef.GetNamespace(), certRef.GetName(), clients.ReadOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		return secret
+	}
+
+	It("issues the certificate of the domains of the virtual host", func() {
+		writeVirtualService()
+
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(issuer.domains).To(Equal([][]string{{"example.com", "www.example.com"}}))
+		secret := readCertificate()
+		Expect(secret.GetMetadata().GetLabels()).To(HaveKeyWithValue(ResourceLabel, "certificate"))
+		Expect(NeedsRenewal(secret.GetTls().GetCertChain(), []string{"example.com", "www.example.com"}, DefaultRenewBefore, time.Now())).To(BeFalse())
+		Expect(secret.GetTls().GetPrivateKey()).NotTo(BeEmpty())
+	})
+
+	It("issues the certificate of the sni domains", func() {
+		vs.GetSslConfig().SniDomains = []string{"api.example.com"}
+		writeVirtualService()
+
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(issuer.domains).To(Equal([][]string{{"api.example.com"}}))
+	})
+
+	It("serves the challenges through secrets until they are cleaned up", func() {
+		writeVirtualService()
+
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(issuer.challenges).To(Equal([]Challenge{
+			{Domain: "example.com", Token: "token-example.com", KeyAuthorization: "key-example.com"},
+			{Domain: "www.example.com", Token: "token-www.example.com", KeyAuthorization: "key-www.example.com"},
+		}))
+		remaining, err := secrets.List(ns, clients.ListOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ChallengesFromSecrets(remaining, ns)).To(BeEmpty())
+	})
+
+	It("does not issue a valid certificate again", func() {
+		writeVirtualService()
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(issuer.domains).To(HaveLen(1))
+	})
+
+	It("renews a certificate which expires soon, with the same account", func() {
+		issuer.validFor = 7 * 24 * time.Hour
+		writeVirtualService()
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(issuer.domains).To(HaveLen(2))
+		Expect(issuer.accountKeys[1]).To(Equal(issuer.accountKeys[0]))
+	})
+
+	It("renews a certificate which does not cover the domains of the virtual host", func() {
+		writeVirtualService()
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		vs = readVirtualService(ctx, vsc, vs)
+		vs.GetVirtualHost().Domains = append(vs.GetVirtualHost().GetDomains(), "api.example.com")
+		writeVirtualService()
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(issuer.domains).To(HaveLen(2))
+		Expect(issuer.domains[1]).To(ContainElement("api.example.com"))
+	})
+
+	It("ignores the virtual services without acme config", func() {
+		vs.SslConfig = nil
+		writeVirtualService()
+
+		Expect(manager.Sync(ctx)).NotTo(HaveOccurred())
+
+		Expect(issuer.domains).To(BeEmpty())
+	})
+
+	It("does not issue certificates for wildcard domains", func() {
+		vs.GetVirtualHost().Domains = []string{"*.example.com"}
+		writeVirtualService()
+
+		err := manager.Sync(ctx)
+
+		Expect(err).To(MatchError(ContainSubstring(WildcardDomainError(vs, "*.example.com").Error())))
+		Expect(issuer.domains).To(BeEmpty())
+	})
+
+	It("does not overwrite a secret which is not managed by the manager", func() {
+		existing := &v1.Secret{
+			Metadata: &core.Metadata{Name: certRef.GetName(), Namespace: certRef.GetNamespace()},
+			Kind: &v1.Secret_Tls{
+				Tls: &v1.TlsSecret{CertChain: "user provided", PrivateKey: "user provided"},
+			},
+		}
+		_, err := secrets.Write(existing, clients.WriteOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		writeVirtualService()
+
+		err = manager.Sync(ctx)
+
+		Expect(err).To(MatchError(ContainSubstring(UnmanagedSecretError(vs, certRef).Error())))
+		Expect(issuer.domains).To(BeEmpty())
+		Expect(readCertificate().GetTls().GetCertChain()).To(Equal("user provided"))
+	})
+
+	It("gives up on an order which does not complete in time", func() {
+		issuer.pending = true
+		manager = NewManager(Opts{
+			WriteNamespace:   ns,
+			WatchNamespaces:  []string{ns},
+			PropagationDelay: time.Nanosecond,
+			OrderTimeout:     100 * time.Millisecond,
+		}, vsc, secrets, issuer, singlereplica.Identity())
+		writeVirtualService()
+
+		err := manager.Sync(ctx)
+
+		Expect(err).To(MatchError(ContainSubstring(context.DeadlineExceeded.Error())))
+		_, err = secrets.Read(certRef.GetNamespace(), certRef.GetName(), clients.ReadOpts{Ctx: ctx})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("issues the certificates when the virtual services change, without waiting for the sync interval", func() {
+		go manager.Start(ctx)
+		Consistently(issuer.issuedDomains, 100*time.Millisecond).Should(BeEmpty())
+
+		writeVirtualService()
+		Eventually(issuer.issuedDomains, 5*time.Second).Should(Equal([][]string{{"example.com", "www.example.com"}}))
+
+		vs = readVirtualService(ctx, vsc, vs)
+		vs.GetVirtualHost().Domains = append(vs.GetVirtualHost().GetDomains(), "api.example.com")
+		writeVirtualService()
+		Eventually(issuer.issuedDomains, 5*time.Second).Should(HaveLen(2))
+	})
+
+	It("requires the secret ref of the certificate", func() {
+		vs.GetSslConfig().GetAcme().SecretRef = nil
+		writeVirtualService()
+
+		err := manager.Sync(ctx)
+
+		Expect(err).To(MatchError(ContainSubstring(NoSecretRefError(vs).Error())))
+	})
+})
+
+var _ = Describe("NeedsRenewal", func() {
+
+	now := time.Now()
+
+	It("renews a missing or invalid certificate", func() {
+		Expect(NeedsRenewal("", []string{"example.com"}, DefaultRenewBefore, now)).To(BeTrue())
+		Expect(NeedsRenewal("invalid", []string{"example.com"}, DefaultRenewBefore, now)).To(BeTrue())
+	})
+
+	It("renews a certificate before it expires", func() {
+		cert := selfSignedCertificate([]string{"example.com"}, now.Add(10*24*time.Hour))
+		Expect(NeedsRenewal(cert.CertChain, []string{"example.com"}, 7*24*time.Hour, now)).To(BeFalse())
+		Expect(NeedsRenewal(cert.CertChain, []string{"example.com"}, 14*24*time.Hour, now)).To(BeTrue())
+	})
+
+	It("renews a certificate which does not cover all the domains", func() {
+		cert := selfSignedCertificate([]string{"example.com"}, now.Add(90*24*time.Hour))
+		Expect(NeedsRenewal(cert.CertChain, []string{"example.com", "www.example.com"}, DefaultRenewBefore, now)).To(BeTrue())
+	})
+})
+
+func readVirtualService(ctx context.Context, vsc gatewayv1.VirtualServiceClient, vs *gatewayv1.VirtualService) *gatewayv1.VirtualService {
+	read, err := vsc.Read(vs.GetMetadata().GetNamespace(), vs.GetMetadata().GetName(), clients.ReadOpts{Ctx: ctx})
+	Expect(err).NotTo(HaveOccurred())
+	return read
+}
@@ -80,6 +80,47 @@ func (m *HttpGateway) Clone() proto.Message {
 		target.Options = proto.Clone(m.GetOptions()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1.HttpListenerOptions)
 	}
 
+	if h, ok := interface{}(m.GetCertificateSelector()).(clone.Cloner); ok {
+		target.CertificateSelector = h.Clone().(*CertificateSelector)
+	} else {
+		target.CertificateSelector = proto.Clone(m.GetCertificateSelector()).(*CertificateSelector)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *CertificateSelector) Clone() proto.Message {
+	var target *CertificateSelector
+	if m == nil {
+		return target
+	}
+	target = &CertificateSelector{}
+
+	if m.GetSecretSelector() != nil {
+		target.SecretSelector = make(map[string]string, len(m.GetSecretSelector()))
+		for k, v := range m.GetSecretSelector() {
+
+			target.SecretSelector[k] = v
+
+		}
+	}
+
+	if m.GetSecretNamespaces() != nil {
+		target.SecretNamespaces = make([]string, len(m.GetSecretNamespaces()))
+		for idx, v := range m.GetSecretNamespaces() {
+
+			target.SecretNamespaces[idx] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetDefaultSecret()).(clone.Cloner); ok {
+		target.DefaultSecret = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.DefaultSecret = proto.Clone(m.GetDefaultSecret()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
 	return target
 }
 
@@ -105,6 +105,72 @@ func (m *HttpGateway) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCertificateSelector()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCertificateSelector()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCertificateSelector(), target.GetCertificateSelector()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CertificateSelector) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CertificateSelector)
+	if !ok {
+		that2, ok := that.(CertificateSelector)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetSecretSelector()) != len(target.GetSecretSelector()) {
+		return false
+	}
+	for k, v := range m.GetSecretSelector() {
+
+		if strings.Compare(v, target.GetSecretSelector()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetSecretNamespaces()) != len(target.GetSecretNamespaces()) {
+		return false
+	}
+	for idx, v := range m.GetSecretNamespaces() {
+
+		if strings.Compare(v, target.GetSecretNamespaces()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetDefaultSecret()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDefaultSecret()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDefaultSecret(), target.GetDefaultSecret()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -98,7 +98,7 @@ func (x VirtualServiceSelectorExpressions_Expression_Operator) Number() protoref
 
 // Deprecated: Use VirtualServiceSelectorExpressions_Expression_Operator.Descriptor instead.
 func (VirtualServiceSelectorExpressions_Expression_Operator) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDescGZIP(), []int{1, 0, 0}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDescGZIP(), []int{2, 0, 0}
 }
 
 type HttpGateway struct {
@@ -128,6 +128,9 @@ type HttpGateway struct {
 	VirtualServiceNamespaces []string `protobuf:"bytes,3,rep,name=virtual_service_namespaces,json=virtualServiceNamespaces,proto3" json:"virtual_service_namespaces,omitempty"`
 	// HTTP Gateway configuration
 	Options *v1.HttpListenerOptions `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	// Select the certificates of the virtual services which do not have an `sslConfig` from a set of TLS secrets,
+	// instead of listing a secret on each virtual service. Only applies to ssl gateways, which then also serve these virtual services.
+	CertificateSelector *CertificateSelector `protobuf:"bytes,10,opt,name=certificate_selector,json=certificateSelector,proto3" json:"certificate_selector,omitempty"`
 }
 
 func (x *HttpGateway) Reset() {
@@ -197,6 +200,89 @@ func (x *HttpGateway) GetOptions() *v1.HttpListenerOptions {
 	return nil
 }
 
+func (x *HttpGateway) GetCertificateSelector() *CertificateSelector {
+	if x != nil {
+		return x.CertificateSelector
+	}
+	return nil
+}
+
+// Selects the certificates of virtual services by matching the DNS SANs of the certificates of TLS secrets to the domains
+// of the virtual services, and serves each certificate for the domains it matches with SNI.
+// A SAN matches a domain if they are equal, or if the SAN is a wildcard such as `*.example.com` which matches the domain.
+// Exact SANs take precedence over wildcard SANs. If several certificates match a domain, the certificate which expires last
+// is served, and a warning is reported on the virtual service.
+// The domains which are the SNI domains of the `sslConfig` of another virtual service are not matched.
+type CertificateSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The labels of the TLS secrets to select. Required.
+	SecretSelector map[string]string `protobuf:"bytes,1,rep,name=secret_selector,json=secretSelector,proto3" json:"secret_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The namespaces of the selected secrets. If it is omitted, the certificates of a virtual service are only selected from
+	// the secrets of its own namespace. Listing namespaces opts in to serving their secrets for the virtual services of all
+	// the namespaces, and '*' selects the secrets of all the namespaces watched by Gloo.
+	SecretNamespaces []string `protobuf:"bytes,2,rep,name=secret_namespaces,json=secretNamespaces,proto3" json:"secret_namespaces,omitempty"`
+	// The TLS secret which is served for the domains that no selected certificate matches, such as `*`, and to the clients
+	// which do not send an SNI. If it is not set, these domains are not served, and a warning is reported on their virtual service.
+	// It is ignored if the `sslConfig` of a virtual service of the gateway does not have SNI domains.
+	DefaultSecret *core.ResourceRef `protobuf:"bytes,3,opt,name=default_secret,json=defaultSecret,proto3" json:"default_secret,omitempty"`
+}
+
+func (x *CertificateSelector) Reset() {
+	*x = CertificateSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateSelector) ProtoMessage() {}
+
+func (x *CertificateSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateSelector.ProtoReflect.Descriptor instead.
+func (*CertificateSelector) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CertificateSelector) GetSecretSelector() map[string]string {
+	if x != nil {
+		return x.SecretSelector
+	}
+	return nil
+}
+
+func (x *CertificateSelector) GetSecretNamespaces() []string {
+	if x != nil {
+		return x.SecretNamespaces
+	}
+	return nil
+}
+
+func (x *CertificateSelector) GetDefaultSecret() *core.ResourceRef {
+	if x != nil {
+		return x.DefaultSecret
+	}
+	return nil
+}
+
 // Expressions to define which virtual services to select
 // Example:
 // expressions:
@@ -216,7 +302,7 @@ type VirtualServiceSelectorExpressions struct {
 func (x *VirtualServiceSelectorExpressions) Reset() {
 	*x = VirtualServiceSelectorExpressions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[1]
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -229,7 +315,7 @@ func (x *VirtualServiceSelectorExpressions) String() string {
 func (*VirtualServiceSelectorExpressions) ProtoMessage() {}
 
 func (x *VirtualServiceSelectorExpressions) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[1]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,7 +328,7 @@ func (x *VirtualServiceSelectorExpressions) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use VirtualServiceSelectorExpressions.ProtoReflect.Descriptor instead.
 func (*VirtualServiceSelectorExpressions) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDescGZIP(), []int{1}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *VirtualServiceSelectorExpressions) GetExpressions() []*VirtualServiceSelectorExpressions_Expression {
@@ -268,7 +354,7 @@ type VirtualServiceSelectorExpressions_Expression struct {
 func (x *VirtualServiceSelectorExpressions_Expression) Reset() {
 	*x = VirtualServiceSelectorExpressions_Expression{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[3]
+		mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -281,7 +367,7 @@ func (x *VirtualServiceSelectorExpressions_Expression) String() string {
 func (*VirtualServiceSelectorExpressions_Expression) ProtoMessage() {}
 
 func (x *VirtualServiceSelectorExpressions_Expression) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[3]
+	mi := &file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +380,7 @@ func (x *VirtualServiceSelectorExpressions_Expression) ProtoReflect() protorefle
 
 // Deprecated: Use VirtualServiceSelectorExpressions_Expression.ProtoReflect.Descriptor instead.
 func (*VirtualServiceSelectorExpressions_Expression) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDescGZIP(), []int{1, 0}
+	return file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *VirtualServiceSelectorExpressions_Expression) GetKey() string {
@@ -337,7 +423,7 @@ var file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawD
 	0x6f, 0x1a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
 	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
 	0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x04,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x04,
 	0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x44, 0x0a,
 	0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
@@ -365,43 +451,67 @@ var file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawD
 	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6c, 0x6f,
 	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4c, 0x69,
 	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x49, 0x0a, 0x1b, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xab, 0x03, 0x0a, 0x21, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56,
-	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xa4, 0x02, 0x0a, 0x0a, 0x45, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x62, 0x0a, 0x08, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x10, 0x00, 0x12, 0x10,
-	0x0a, 0x0c, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x10, 0x01,
-	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x10, 0x02, 0x12,
-	0x06, 0x0a, 0x02, 0x49, 0x6e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x6f, 0x74, 0x49, 0x6e,
-	0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x05, 0x12, 0x10,
-	0x0a, 0x0c, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x10, 0x06,
-	0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x10,
-	0x07, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x65, 0x73, 0x73, 0x54, 0x68, 0x61, 0x6e, 0x10, 0x08, 0x42,
-	0x41, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x33, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69,
-	0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x57, 0x0a, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x13, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a,
+	0x49, 0x0a, 0x1b, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x02, 0x0a, 0x13, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x61, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x03, 0x0a, 0x21, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5f, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xa4,
+	0x02, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x62, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x46, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x08,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x71, 0x75, 0x61,
+	0x6c, 0x73, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x45, 0x71, 0x75,
+	0x61, 0x6c, 0x73, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x6e, 0x10, 0x03, 0x12, 0x09, 0x0a,
+	0x05, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x6f, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x72, 0x54, 0x68, 0x61, 0x6e, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x65, 0x73, 0x73, 0x54,
+	0x68, 0x61, 0x6e, 0x10, 0x08, 0x42, 0x41, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0,
+	0xf5, 0x04, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -417,28 +527,33 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_raw
 }
 
 var file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_goTypes = []interface{}{
 	(VirtualServiceSelectorExpressions_Expression_Operator)(0), // 0: gateway.solo.io.VirtualServiceSelectorExpressions.Expression.Operator
 	(*HttpGateway)(nil),                       // 1: gateway.solo.io.HttpGateway
-	(*VirtualServiceSelectorExpressions)(nil), // 2: gateway.solo.io.VirtualServiceSelectorExpressions
-	nil, // 3: gateway.solo.io.HttpGateway.VirtualServiceSelectorEntry
-	(*VirtualServiceSelectorExpressions_Expression)(nil), // 4: gateway.solo.io.VirtualServiceSelectorExpressions.Expression
-	(*core.ResourceRef)(nil),                             // 5: core.solo.io.ResourceRef
-	(*v1.HttpListenerOptions)(nil),                       // 6: gloo.solo.io.HttpListenerOptions
+	(*CertificateSelector)(nil),               // 2: gateway.solo.io.CertificateSelector
+	(*VirtualServiceSelectorExpressions)(nil), // 3: gateway.solo.io.VirtualServiceSelectorExpressions
+	nil, // 4: gateway.solo.io.HttpGateway.VirtualServiceSelectorEntry
+	nil, // 5: gateway.solo.io.CertificateSelector.SecretSelectorEntry
+	(*VirtualServiceSelectorExpressions_Expression)(nil), // 6: gateway.solo.io.VirtualServiceSelectorExpressions.Expression
+	(*core.ResourceRef)(nil),                             // 7: core.solo.io.ResourceRef
+	(*v1.HttpListenerOptions)(nil),                       // 8: gloo.solo.io.HttpListenerOptions
 }
 var file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_depIdxs = []int32{
-	5, // 0: gateway.solo.io.HttpGateway.virtual_services:type_name -> core.solo.io.ResourceRef
-	3, // 1: gateway.solo.io.HttpGateway.virtual_service_selector:type_name -> gateway.solo.io.HttpGateway.VirtualServiceSelectorEntry
-	2, // 2: gateway.solo.io.HttpGateway.virtual_service_expressions:type_name -> gateway.solo.io.VirtualServiceSelectorExpressions
-	6, // 3: gateway.solo.io.HttpGateway.options:type_name -> gloo.solo.io.HttpListenerOptions
-	4, // 4: gateway.solo.io.VirtualServiceSelectorExpressions.expressions:type_name -> gateway.solo.io.VirtualServiceSelectorExpressions.Expression
-	0, // 5: gateway.solo.io.VirtualServiceSelectorExpressions.Expression.operator:type_name -> gateway.solo.io.VirtualServiceSelectorExpressions.Expression.Operator
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7, // 0: gateway.solo.io.HttpGateway.virtual_services:type_name -> core.solo.io.ResourceRef
+	4, // 1: gateway.solo.io.HttpGateway.virtual_service_selector:type_name -> gateway.solo.io.HttpGateway.VirtualServiceSelectorEntry
+	3, // 2: gateway.solo.io.HttpGateway.virtual_service_expressions:type_name -> gateway.solo.io.VirtualServiceSelectorExpressions
+	8, // 3: gateway.solo.io.HttpGateway.options:type_name -> gloo.solo.io.HttpListenerOptions
+	2, // 4: gateway.solo.io.HttpGateway.certificate_selector:type_name -> gateway.solo.io.CertificateSelector
+	5, // 5: gateway.solo.io.CertificateSelector.secret_selector:type_name -> gateway.solo.io.CertificateSelector.SecretSelectorEntry
+	7, // 6: gateway.solo.io.CertificateSelector.default_secret:type_name -> core.solo.io.ResourceRef
+	6, // 7: gateway.solo.io.VirtualServiceSelectorExpressions.expressions:type_name -> gateway.solo.io.VirtualServiceSelectorExpressions.Expression
+	0, // 8: gateway.solo.io.VirtualServiceSelectorExpressions.Expression.operator:type_name -> gateway.solo.io.VirtualServiceSelectorExpressions.Expression.Operator
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_init() }
@@ -460,6 +575,18 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_ini
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VirtualServiceSelectorExpressions); i {
 			case 0:
 				return &v.state
@@ -471,7 +598,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_ini
 				return nil
 			}
 		}
-		file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VirtualServiceSelectorExpressions_Expression); i {
 			case 0:
 				return &v.state
@@ -490,7 +617,7 @@ func file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_ini
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gateway_api_v1_http_gateway_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
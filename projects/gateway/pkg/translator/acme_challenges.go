@@ -0,0 +1,143 @@
+package translator
+
+import (
+	"strings"
+
+	"github.com/solo-io/gloo/projects/gateway/pkg/acme"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+)
+
+const acmeChallengeRouteName = "acme-challenge"
+
+// addAcmeChallengeRoutes serves the pending ACME HTTP-01 challenges on the listener if it does not terminate TLS,
+// so that the ACME server can validate the domains of the virtual services which request a certificate.
+func addAcmeChallengeRoutes(listener *gloov1.Listener, sslGateway bool, challenges []acme.Challenge) {
+	if len(challenges) == 0 || sslGateway {
+		return
+	}
+	switch listenerType := listener.GetListenerType().(type) {
+	case *gloov1.Listener_HttpListener:
+		listenerType.HttpListener.VirtualHosts = addAcmeChallengeVirtualHosts(listenerType.HttpListener.GetVirtualHosts(), challenges)
+	case *gloov1.Listener_HybridListener:
+		for _, matchedListener := range listenerType.HybridListener.GetMatchedListeners() {
+			httpListener := matchedListener.GetHttpListener()
+			if httpListener == nil || matchedListener.GetMatcher().GetSslConfig() != nil {
+				continue
+			}
+			httpListener.VirtualHosts = addAcmeChallengeVirtualHosts(httpListener.GetVirtualHosts(), challenges)
+		}
+	case *gloov1.Listener_AggregateListener:
+		httpResources := listenerType.AggregateListener.GetHttpResources()
+		for _, filterChain := range listenerType.AggregateListener.GetHttpFilterChains() {
+			if filterChain.GetMatcher().GetSslConfig() != nil {
+				continue
+			}
+			var virtualHosts []*gloov1.VirtualHost
+			for _, ref := range filterChain.GetVirtualHostRefs() {
+				if virtualHost, ok := httpResources.GetVirtualHosts()[ref]; ok {
+					virtualHosts = append(virtualHosts, virtualHost)
+				}
+			}
+			existing := len(virtualHosts)
+			virtualHosts = addAcmeChallengeVirtualHosts(virtualHosts, challenges)
+			// the challenges may have added virtual hosts to the filter chain
+			for _, virtualHost := range virtualHosts[existing:] {
+				if httpResources.GetVirtualHosts() == nil {
+					httpResources.VirtualHosts = map[string]*gloov1.VirtualHost{}
+				}
+				httpResources.GetVirtualHosts()[virtualHost.GetName()] = virtualHost
+				filterChain.VirtualHostRefs = append(filterChain.GetVirtualHostRefs(), virtualHost.GetName())
+			}
+		}
+	}
+}
+
+// addAcmeChallengeVirtualHosts prepends the routes of the challenges to the virtual host which serves their domain,
+// or adds a virtual host for the domain if none does.
+func addAcmeChallengeVirtualHosts(virtualHosts []*gloov1.VirtualHost, challenges []acme.Challenge) []*gloov1.VirtualHost {
+	var challengeVirtualHosts []*gloov1.VirtualHost
+	challengeRoutes := map[*gloov1.VirtualHost][]*gloov1.Route{}
+	for _, challenge := range challenges {
+		virtualHost := virtualHostForDomain(virtualHosts, challenge.Domain)
+		if virtualHost == nil {
+			virtualHost = &gloov1.VirtualHost{
+				Name:    acmeChallengeRouteName + "." + challenge.Domain,
+				Domains: []string{challenge.Domain},
+			}
+			virtualHosts = append(virtualHosts, virtualHost)
+		}
+		if _, ok := challengeRoutes[virtualHost]; !ok {
+			challengeVirtualHosts = append(challengeVirtualHosts, virtualHost)
+		}
+		challengeRoutes[virtualHost] = append(challengeRoutes[virtualHost], acmeChallengeRoute(challenge))
+	}
+	for _, virtualHost := range challengeVirtualHosts {
+		virtualHost.Routes = append(challengeRoutes[virtualHost], virtualHost.GetRoutes()...)
+	}
+	return virtualHosts
+}
+
+// virtualHostForDomain returns the virtual host which Envoy selects for the domain: the one with an exact domain
+// match, else the longest suffix wildcard, else the longest prefix wildcard, else the catch-all one.
+func virtualHostForDomain(virtualHosts []*gloov1.VirtualHost, domain string) *gloov1.VirtualHost {
+	var suffixMatch, prefixMatch, catchAll *gloov1.VirtualHost
+	var suffixLen, prefixLen int
+	for _, virtualHost := range virtualHosts {
+		for _, vhDomain := range virtualHost.GetDomains() {
+			switch {
+			case vhDomain == domain:
+				return virtualHost
+			case vhDomain == "*":
+				if catchAll == nil {
+					catchAll = virtualHost
+				}
+			case strings.HasPrefix(vhDomain, "*") && strings.HasSuffix(domain, vhDomain[1:]) && len(vhDomain) > suffixLen:
+				suffixMatch, suffixLen = virtualHost, len(vhDomain)
+			case strings.HasSuffix(vhDomain, "*") && strings.HasPrefix(domain, vhDomain[:len(vhDomain)-1]) && len(vhDomain) > prefixLen:
+				prefixMatch, prefixLen = virtualHost, len(vhDomain)
+			}
+		}
+	}
+	switch {
+	case suffixMatch != nil:
+		return suffixMatch
+	case prefixMatch != nil:
+		return prefixMatch
+	}
+	return catchAll
+}
+
+// acmeChallengeRoute serves the challenge. The ACME server does not authenticate, so the route disables the
+// authentication and authorization of the virtual host it is added to.
+func acmeChallengeRoute(challenge acme.Challenge) *gloov1.Route {
+	return &gloov1.Route{
+		Name: acmeChallengeRouteName,
+		Matchers: []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Exact{
+				Exact: challenge.Path(),
+			},
+		}},
+		Action: &gloov1.Route_DirectResponseAction{
+			DirectResponseAction: &gloov1.DirectResponseAction{
+				Status: 200,
+				Body:   challenge.KeyAuthorization,
+			},
+		},
+		Options: &gloov1.RouteOptions{
+			Extauth: &extauthv1.ExtAuthExtension{
+				Spec: &extauthv1.ExtAuthExtension_Disable{Disable: true},
+			},
+			JwtConfig: &gloov1.RouteOptions_JwtStaged{
+				JwtStaged: &jwt.JwtStagedRouteExtension{
+					BeforeExtAuth: &jwt.RouteExtension{Disable: true},
+					AfterExtAuth:  &jwt.RouteExtension{Disable: true},
+				},
+			},
+			Rbac: &rbac.ExtensionSettings{Disable: true},
+		},
+	}
+}
@@ -0,0 +1,260 @@
+package translator_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway/pkg/acme"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	extauthv1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extauth/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Acme", func() {
+
+	var (
+		snap       *gloov1snap.ApiSnapshot
+		translator Translator
+		acmeVs     *v1.VirtualService
+		certRef    *core.ResourceRef
+	)
+
+	listenerOnPort := func(proxy *gloov1.Proxy, port uint32) *gloov1.Listener {
+		for _, listener := range proxy.GetListeners() {
+			if listener.GetBindPort() == port {
+				return listener
+			}
+		}
+		return nil
+	}
+
+	virtualHostForDomain := func(virtualHosts []*gloov1.VirtualHost, domain string) *gloov1.VirtualHost {
+		for _, virtualHost := range virtualHosts {
+			for _, vhDomain := range virtualHost.GetDomains() {
+				if vhDomain == domain {
+					return virtualHost
+				}
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		translator = NewDefaultTranslator(Opts{
+			WriteNamespace: ns,
+			AcmeNamespace:  ns,
+		})
+		certRef = &core.ResourceRef{Name: "example-cert", Namespace: ns}
+		acmeVs = helpers.NewVirtualServiceBuilder().
+			WithName("acme").
+			WithNamespace(ns).
+			WithDomain("example.com").
+			WithRoutePrefixMatcher("route", "/").
+			WithRouteDirectResponseAction("route", &gloov1.DirectResponseAction{Status: http.StatusOK}).
+			WithSslConfig(&ssl.SslConfig{
+				SslSecrets: &ssl.SslConfig_Acme{
+					Acme: &ssl.AcmeConfig{
+						SecretRef: certRef,
+					},
+				},
+			}).
+			Build()
+		snap = &gloov1snap.ApiSnapshot{
+			Gateways: v1.GatewayList{
+				{
+					Metadata:    &core.Metadata{Namespace: ns, Name: "http"},
+					GatewayType: &v1.Gateway_HttpGateway{HttpGateway: &v1.HttpGateway{}},
+					BindPort:    8080,
+				},
+				{
+					Metadata:    &core.Metadata{Namespace: ns, Name: "https"},
+					GatewayType: &v1.Gateway_HttpGateway{HttpGateway: &v1.HttpGateway{}},
+					BindPort:    8443,
+					Ssl:         true,
+				},
+			},
+			VirtualServices: v1.VirtualServiceList{
+				acmeVs,
+				helpers.NewVirtualServiceBuilder().
+					WithName("plain").
+					WithNamespace(ns).
+					WithDomain("plain.com").
+					WithRoutePrefixMatcher("route", "/").
+					WithRouteDirectResponseAction("route", &gloov1.DirectResponseAction{Status: http.StatusOK}).
+					Build(),
+			},
+		}
+	})
+
+	Context("acme certificates", func() {
+
+		It("does not serve the virtual service over ssl until its certificate is issued", func() {
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+			Expect(reports.ValidateStrict()).To(MatchError(ContainSubstring(AcmeCertificatePendingWarning(acmeVs, certRef.Key()))))
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+			Expect(reports[acmeVs].Warnings).To(HaveLen(1))
+			httpsListener := listenerOnPort(proxy, 8443)
+			Expect(httpsListener.GetHttpListener().GetVirtualHosts()).To(BeEmpty())
+			Expect(httpsListener.GetSslConfigurations()).To(BeEmpty())
+		})
+
+		It("serves the virtual service over ssl once its certificate is issued", func() {
+			snap.Secrets = gloov1.SecretList{{
+				Metadata: &core.Metadata{Name: certRef.GetName(), Namespace: certRef.GetNamespace()},
+				Kind:     &gloov1.Secret_Tls{Tls: &gloov1.TlsSecret{}},
+			}}
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+			Expect(reports.ValidateStrict()).NotTo(HaveOccurred())
+			httpsListener := listenerOnPort(proxy, 8443)
+			Expect(httpsListener.GetHttpListener().GetVirtualHosts()).To(HaveLen(1))
+			Expect(httpsListener.GetSslConfigurations()).To(HaveLen(1))
+			Expect(httpsListener.GetSslConfigurations()[0].GetAcme().GetSecretRef()).To(Equal(certRef))
+		})
+
+		It("reports an error when the certificate has no secret ref", func() {
+			acmeVs.GetSslConfig().GetAcme().SecretRef = nil
+
+			_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+
+			Expect(reports.Validate()).To(MatchError(ContainSubstring(AcmeMissingSecretRefErr(acmeVs).Error())))
+		})
+	})
+
+	Context("acme challenges", func() {
+
+		var (
+			exampleChallenge, plainChallenge acme.Challenge
+		)
+
+		BeforeEach(func() {
+			exampleChallenge = acme.Challenge{Domain: "example.com", Token: "token1", KeyAuthorization: "token1.thumbprint"}
+			plainChallenge = acme.Challenge{Domain: "plain.com", Token: "token2", KeyAuthorization: "token2.thumbprint"}
+			snap.Secrets = gloov1.SecretList{
+				acme.ChallengeSecret(ns, exampleChallenge),
+				acme.ChallengeSecret(ns, plainChallenge),
+			}
+		})
+
+		expectChallengeRoute := func(route *gloov1.Route, challenge acme.Challenge) {
+			Expect(route.GetMatchers()).To(HaveLen(1))
+			Expect(route.GetMatchers()[0].GetExact()).To(Equal("/.well-known/acme-challenge/" + challenge.Token))
+			Expect(route.GetDirectResponseAction().GetStatus()).To(BeEquivalentTo(http.StatusOK))
+			Expect(route.GetDirectResponseAction().GetBody()).To(Equal(challenge.KeyAuthorization))
+		}
+
+		It("serves the challenges on the listeners without ssl", func() {
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+
+			httpVirtualHosts := listenerOnPort(proxy, 8080).GetHttpListener().GetVirtualHosts()
+			Expect(httpVirtualHosts).To(HaveLen(2))
+
+			// the challenge of a domain without virtual host on the listener is served by a new virtual host
+			exampleVirtualHost := virtualHostForDomain(httpVirtualHosts, "example.com")
+			Expect(exampleVirtualHost).NotTo(BeNil())
+			Expect(exampleVirtualHost.GetRoutes()).To(HaveLen(1))
+			expectChallengeRoute(exampleVirtualHost.GetRoutes()[0], exampleChallenge)
+
+			// the challenge of a domain with a virtual host is served before its routes
+			plainVirtualHost := virtualHostForDomain(httpVirtualHosts, "plain.com")
+			Expect(plainVirtualHost.GetRoutes()).To(HaveLen(2))
+			expectChallengeRoute(plainVirtualHost.GetRoutes()[0], plainChallenge)
+
+			Expect(listenerOnPort(proxy, 8443).GetHttpListener().GetVirtualHosts()).To(BeEmpty())
+		})
+
+		It("ignores the challenge secrets outside of the namespace of the acme manager", func() {
+			otherNamespaceSecret := acme.ChallengeSecret("other", acme.Challenge{Domain: "plain.com", Token: "token3", KeyAuthorization: "token3.forged"})
+			foreignDomainSecret := acme.ChallengeSecret("other", acme.Challenge{Domain: "foreign.com", Token: "token4", KeyAuthorization: "token4.forged"})
+			snap.Secrets = append(snap.Secrets, otherNamespaceSecret, foreignDomainSecret)
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+
+			httpVirtualHosts := listenerOnPort(proxy, 8080).GetHttpListener().GetVirtualHosts()
+			Expect(httpVirtualHosts).To(HaveLen(2))
+			Expect(virtualHostForDomain(httpVirtualHosts, "foreign.com")).To(BeNil())
+			plainVirtualHost := virtualHostForDomain(httpVirtualHosts, "plain.com")
+			Expect(plainVirtualHost.GetRoutes()).To(HaveLen(2))
+			expectChallengeRoute(plainVirtualHost.GetRoutes()[0], plainChallenge)
+		})
+
+		It("disables the authentication of the virtual host on the challenge routes", func() {
+			snap.VirtualServices[1].GetVirtualHost().Options = &gloov1.VirtualHostOptions{
+				Extauth: &extauthv1.ExtAuthExtension{
+					Spec: &extauthv1.ExtAuthExtension_CustomAuth{CustomAuth: &extauthv1.CustomAuth{}},
+				},
+				JwtConfig: &gloov1.VirtualHostOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedVhostExtension{
+						AfterExtAuth: &jwt.VhostExtension{Providers: map[string]*jwt.Provider{"provider": {}}},
+					},
+				},
+				Rbac: &rbac.ExtensionSettings{
+					Policies: map[string]*rbac.Policy{"admins": {}},
+				},
+			}
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+
+			plainVirtualHost := virtualHostForDomain(listenerOnPort(proxy, 8080).GetHttpListener().GetVirtualHosts(), "plain.com")
+			Expect(plainVirtualHost.GetOptions().GetRbac().GetPolicies()).To(HaveKey("admins"))
+			Expect(plainVirtualHost.GetRoutes()).To(HaveLen(2))
+
+			challengeRoute := plainVirtualHost.GetRoutes()[0]
+			expectChallengeRoute(challengeRoute, plainChallenge)
+			Expect(challengeRoute.GetOptions().GetExtauth().GetDisable()).To(BeTrue())
+			Expect(challengeRoute.GetOptions().GetJwtStaged().GetBeforeExtAuth().GetDisable()).To(BeTrue())
+			Expect(challengeRoute.GetOptions().GetJwtStaged().GetAfterExtAuth().GetDisable()).To(BeTrue())
+			Expect(challengeRoute.GetOptions().GetRbac().GetDisable()).To(BeTrue())
+
+			// the routes of the virtual service keep the authentication of the virtual host
+			Expect(plainVirtualHost.GetRoutes()[1].GetOptions()).To(BeNil())
+		})
+
+		It("serves the challenges with the catch-all virtual host", func() {
+			snap.VirtualServices[1].GetVirtualHost().Domains = []string{"*"}
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+
+			httpVirtualHosts := listenerOnPort(proxy, 8080).GetHttpListener().GetVirtualHosts()
+			Expect(httpVirtualHosts).To(HaveLen(1))
+			Expect(httpVirtualHosts[0].GetRoutes()).To(HaveLen(3))
+			expectChallengeRoute(httpVirtualHosts[0].GetRoutes()[0], exampleChallenge)
+			expectChallengeRoute(httpVirtualHosts[0].GetRoutes()[1], plainChallenge)
+		})
+
+		It("serves the challenges on the filter chains without ssl of aggregate listeners", func() {
+			for _, gateway := range snap.Gateways {
+				gateway.GetMetadata().Annotations = map[string]string{IsolateVirtualHostsAnnotation: "true"}
+			}
+
+			proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+			Expect(reports.Validate()).NotTo(HaveOccurred())
+
+			aggregateListener := listenerOnPort(proxy, 8080).GetAggregateListener()
+			Expect(aggregateListener.GetHttpFilterChains()).To(HaveLen(1))
+			var virtualHosts []*gloov1.VirtualHost
+			for _, ref := range aggregateListener.GetHttpFilterChains()[0].GetVirtualHostRefs() {
+				virtualHosts = append(virtualHosts, aggregateListener.GetHttpResources().GetVirtualHosts()[ref])
+			}
+			Expect(virtualHosts).To(HaveLen(2))
+			expectChallengeRoute(virtualHostForDomain(virtualHosts, "example.com").GetRoutes()[0], exampleChallenge)
+			expectChallengeRoute(virtualHostForDomain(virtualHosts, "plain.com").GetRoutes()[0], plainChallenge)
+		})
+	})
+})
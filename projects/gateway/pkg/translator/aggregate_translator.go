@@ -100,7 +100,7 @@ func (a *AggregateTranslator) computeAggregateListenerForHttpGateway(params Para
 	builder := newBuilder()
 	if gateway.GetSsl() {
 		// for an ssl gateway, create an HttpFilterChain per unique SslConfig
-		orderedSslConfigs, virtualServicesBySslConfig := GroupVirtualServicesBySslConfig(virtualServices)
+		orderedSslConfigs, virtualServicesBySslConfig := groupVirtualServicesForSslGateway(params, gateway, httpGateway, virtualServices)
 		for _, vsSslConfig := range orderedSslConfigs {
 			virtualServiceList := virtualServicesBySslConfig[vsSslConfig]
 			virtualHosts := a.VirtualServiceTranslator.ComputeVirtualHosts(params, gateway, virtualServiceList, proxyName)
@@ -190,7 +190,7 @@ func (a *AggregateTranslator) computeListenerFromMatchedGateways(
 
 			if gatewaySsl != nil {
 				// for an ssl gateway, create an HttpFilterChain per unique SslConfig
-				orderedSslConfigs, virtualServicesBySslConfig := GroupVirtualServicesBySslConfig(virtualServices)
+				orderedSslConfigs, virtualServicesBySslConfig := groupVirtualServicesForSslGateway(params, gateway, gt.HttpGateway, virtualServices)
 				for _, vsSslConfig := range orderedSslConfigs {
 					virtualServiceList := virtualServicesBySslConfig[vsSslConfig]
 					// SslConfig is evaluated by having the VS definition merged into the Gateway, and overriding
@@ -286,7 +286,7 @@ func (a *AggregateTranslator) processMatchableHttpGateway(
 
 	if sslGateway {
 		// for an ssl gateway, create an HttpFilterChain per unique SslConfig
-		orderedSslConfigs, virtualServicesBySslConfig := GroupVirtualServicesBySslConfig(virtualServices)
+		orderedSslConfigs, virtualServicesBySslConfig := groupVirtualServicesForSslGateway(params, parentGateway, matchableHttpGateway.GetHttpGateway(), virtualServices)
 		for _, vsSslConfig := range orderedSslConfigs {
 			virtualServiceList := virtualServicesBySslConfig[vsSslConfig]
 			// SslConfig is evaluated by having the VS definition merged into the Gateway, and overriding
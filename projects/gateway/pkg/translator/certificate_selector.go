@@ -0,0 +1,310 @@
+package translator
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/lru"
+
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+)
+
+// the number of parsed certificates which are cached, the least recently used are evicted first
+const maxParsedCertificates = 10000
+
+var (
+	InvalidSelectedCertificateWarning = func(secretRef string, err error) string {
+		return fmt.Sprintf("selected tls secret [%s] does not contain a valid certificate: %v", secretRef, err)
+	}
+	ConflictingCertificatesWarning = func(vs *v1.VirtualService, domain string, secretRefs []string) string {
+		return fmt.Sprintf("domain %s of virtual service [%s] matches the certificates of several secrets %v, serving the certificate of [%s]",
+			domain, vs.GetMetadata().Ref().Key(), secretRefs, secretRefs[0])
+	}
+	DomainClaimedBySslConfigWarning = func(vs *v1.VirtualService, domain string) string {
+		return fmt.Sprintf("domain %s of virtual service [%s] is an sni domain of the ssl config of another virtual service, "+
+			"its certificate is not selected", domain, vs.GetMetadata().Ref().Key())
+	}
+	NoMatchingCertificateWarning = func(vs *v1.VirtualService, domains []string) string {
+		return fmt.Sprintf("domains %v of virtual service [%s] do not match any selected certificate, and the gateway does not have "+
+			"a default secret, they are not served over ssl", domains, vs.GetMetadata().Ref().Key())
+	}
+	NoSecretSelectorErr = func(gateway *v1.Gateway) error {
+		return errors.Errorf("the certificate selector of gateway [%s] must have a secret selector", gateway.GetMetadata().Ref().Key())
+	}
+	DefaultSecretIgnoredWarning = func(vs *v1.VirtualService) string {
+		return fmt.Sprintf("the default secret of the certificate selector is ignored, since the ssl config of virtual service [%s] "+
+			"does not have sni domains", vs.GetMetadata().Ref().Key())
+	}
+
+	// (sha256 of the certificate chain) -> parsed leaf certificate
+	parsedCertificates = lru.New(maxParsedCertificates)
+)
+
+type parsedCertificate struct {
+	dnsNames []string
+	notAfter time.Time
+	err      error
+}
+
+type selectedCertificate struct {
+	ref      *core.ResourceRef
+	notAfter time.Time
+	// whether a DNS SAN of the certificate is the host, rather than a wildcard SAN which matches it
+	exact bool
+}
+
+// selectCertificates returns the ssl configs of the virtual services which do not have an ssl config, with the certificates
+// selected for their domains. A virtual service which is not assigned any ssl config is not served over ssl.
+func selectCertificates(
+	params Params,
+	parentGateway *v1.Gateway,
+	selector *v1.CertificateSelector,
+	virtualServices v1.VirtualServiceList,
+) map[*v1.VirtualService][]*ssl.SslConfig {
+	exactCertificates, wildcardCertificates := indexSelectedCertificates(params, parentGateway, selector, virtualServices)
+
+	// the sni domains of the explicit ssl configs are served with these configs
+	claimedDomains := map[string]bool{}
+	var catchAllVirtualService *v1.VirtualService
+	for _, vs := range virtualServices {
+		if !hasSsl(vs) {
+			continue
+		}
+		if len(vs.GetSslConfig().GetSniDomains()) == 0 && catchAllVirtualService == nil {
+			catchAllVirtualService = vs
+		}
+		for _, domain := range vs.GetSslConfig().GetSniDomains() {
+			claimedDomains[strings.ToLower(domain)] = true
+		}
+	}
+	defaultSecret := selector.GetDefaultSecret()
+	if defaultSecret != nil && catchAllVirtualService != nil {
+		addWarningOnce(params, parentGateway, DefaultSecretIgnoredWarning(catchAllVirtualService))
+		defaultSecret = nil
+	}
+
+	sslConfigs := map[*v1.VirtualService][]*ssl.SslConfig{}
+	for _, vs := range virtualServices {
+		if hasSsl(vs) {
+			continue
+		}
+
+		domainsBySecret := map[string][]string{}
+		secretRefs := map[string]*core.ResourceRef{}
+		var unmatchedDomains []string
+		for _, domain := range vs.GetVirtualHost().GetDomains() {
+			host := sniHost(domain)
+			if claimedDomains[host] {
+				addWarningOnce(params, vs, DomainClaimedBySslConfigWarning(vs, host))
+				continue
+			}
+			candidates := matchingCertificates(host, vs, selector, exactCertificates, wildcardCertificates)
+			if len(candidates) == 0 {
+				unmatchedDomains = append(unmatchedDomains, domain)
+				continue
+			}
+			selected := candidates[0]
+			// an exact SAN is not in conflict with the wildcard SANs which also match the host
+			var conflictingRefs []string
+			for _, candidate := range candidates {
+				if candidate.exact == selected.exact {
+					conflictingRefs = append(conflictingRefs, candidate.ref.Key())
+				}
+			}
+			if len(conflictingRefs) > 1 {
+				addWarningOnce(params, vs, ConflictingCertificatesWarning(vs, host, conflictingRefs))
+			}
+			domainsBySecret[selected.ref.Key()] = merge(domainsBySecret[selected.ref.Key()], host)
+			secretRefs[selected.ref.Key()] = selected.ref
+		}
+
+		var secretKeys []string
+		for secretKey := range domainsBySecret {
+			secretKeys = append(secretKeys, secretKey)
+		}
+		sort.Strings(secretKeys)
+		for _, secretKey := range secretKeys {
+			sniDomains := domainsBySecret[secretKey]
+			sort.Strings(sniDomains)
+			sslConfigs[vs] = append(sslConfigs[vs], &ssl.SslConfig{
+				SslSecrets: &ssl.SslConfig_SecretRef{SecretRef: secretRefs[secretKey]},
+				SniDomains: sniDomains,
+			})
+		}
+
+		if len(unmatchedDomains) > 0 {
+			if defaultSecret == nil {
+				addWarningOnce(params, vs, NoMatchingCertificateWarning(vs, unmatchedDomains))
+				continue
+			}
+			sslConfigs[vs] = append(sslConfigs[vs], &ssl.SslConfig{
+				SslSecrets: &ssl.SslConfig_SecretRef{SecretRef: defaultSecret},
+			})
+		}
+	}
+	return sslConfigs
+}
+
+// indexSelectedCertificates indexes the certificates of the selected secrets by their DNS SANs, and by the parent domain
+// of their wildcard SANs. Unless the selector lists secret namespaces, only the secrets in the namespaces of the virtual
+// services are selected.
+func indexSelectedCertificates(
+	params Params,
+	parentGateway *v1.Gateway,
+	selector *v1.CertificateSelector,
+	virtualServices v1.VirtualServiceList,
+) (map[string][]*selectedCertificate, map[string][]*selectedCertificate) {
+	exactCertificates := map[string][]*selectedCertificate{}
+	wildcardCertificates := map[string][]*selectedCertificate{}
+
+	if len(selector.GetSecretSelector()) == 0 {
+		// an empty selector would select all the secrets
+		params.reports.AddError(parentGateway, NoSecretSelectorErr(parentGateway))
+		return exactCertificates, wildcardCertificates
+	}
+	secretNamespaces := selector.GetSecretNamespaces()
+	if len(secretNamespaces) == 0 {
+		for _, vs := range virtualServices {
+			secretNamespaces = append(secretNamespaces, vs.GetMetadata().GetNamespace())
+		}
+	}
+	secretSelector := labels.SelectorFromSet(selector.GetSecretSelector())
+	for _, secret := range params.snapshot.Secrets {
+		if secret.GetTls() == nil || !namespaceSelected(secretNamespaces, secret.GetMetadata().GetNamespace()) ||
+			!secretSelector.Matches(labels.Set(secret.GetMetadata().GetLabels())) {
+			continue
+		}
+		certificate := parseCertificate(secret.GetTls().GetCertChain())
+		if certificate.err != nil {
+			addWarningOnce(params, parentGateway, InvalidSelectedCertificateWarning(secret.GetMetadata().Ref().Key(), certificate.err))
+			continue
+		}
+		selected := &selectedCertificate{
+			ref:      secret.GetMetadata().Ref(),
+			notAfter: certificate.notAfter,
+		}
+		for _, dnsName := range certificate.dnsNames {
+			exactCertificates[dnsName] = append(exactCertificates[dnsName], selected)
+			if parentDomain := strings.TrimPrefix(dnsName, "*."); parentDomain != dnsName {
+				wildcardCertificates[parentDomain] = append(wildcardCertificates[parentDomain], selected)
+			}
+		}
+	}
+	return exactCertificates, wildcardCertificates
+}
+
+// matchingCertificates returns the certificates which match the host and may be served for the virtual service,
+// sorted by precedence
+func matchingCertificates(
+	host string,
+	vs *v1.VirtualService,
+	selector *v1.CertificateSelector,
+	exactCertificates, wildcardCertificates map[string][]*selectedCertificate,
+) []*selectedCertificate {
+	var candidates []*selectedCertificate
+	addCandidates := func(certificates []*selectedCertificate, exact bool) {
+		for _, certificate := range certificates {
+			if !certificateSelectedFor(vs, selector, certificate) {
+				continue
+			}
+			candidates = append(candidates, &selectedCertificate{
+				ref:      certificate.ref,
+				notAfter: certificate.notAfter,
+				exact:    exact,
+			})
+		}
+	}
+	addCandidates(exactCertificates[host], true)
+	// a wildcard SAN only matches a single label
+	if labelEnd := strings.Index(host, "."); labelEnd > 0 && !strings.HasPrefix(host, "*") {
+		addCandidates(wildcardCertificates[host[labelEnd+1:]], false)
+	}
+	sortByPrecedence(candidates)
+	return candidates
+}
+
+// certificateSelectedFor returns whether the certificate may be served for the virtual service: the certificates of
+// other namespaces are only served if the selector lists secret namespaces
+func certificateSelectedFor(vs *v1.VirtualService, selector *v1.CertificateSelector, certificate *selectedCertificate) bool {
+	if len(selector.GetSecretNamespaces()) > 0 {
+		return true
+	}
+	return certificate.ref.GetNamespace() == vs.GetMetadata().GetNamespace()
+}
+
+// sortByPrecedence sorts the exact SANs before the wildcard SANs, then the certificates by the latest expiry, then by
+// secret ref, so that rotated certificates are served as soon as they are added
+func sortByPrecedence(certificates []*selectedCertificate) {
+	sort.SliceStable(certificates, func(i, j int) bool {
+		if certificates[i].exact != certificates[j].exact {
+			return certificates[i].exact
+		}
+		if !certificates[i].notAfter.Equal(certificates[j].notAfter) {
+			return certificates[i].notAfter.After(certificates[j].notAfter)
+		}
+		return certificates[i].ref.Key() < certificates[j].ref.Key()
+	})
+}
+
+// sniHost returns the host of a virtual host domain, which is matched with the SNI
+func sniHost(domain string) string {
+	host := strings.ToLower(domain)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return host
+}
+
+func namespaceSelected(namespaces []string, namespace string) bool {
+	for _, selected := range namespaces {
+		if selected == "*" || selected == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCertificate returns the DNS SANs and the expiry of the leaf certificate of the chain
+func parseCertificate(certChain string) *parsedCertificate {
+	hash := sha256.Sum256([]byte(certChain))
+	if certificate, ok := parsedCertificates.Get(hash); ok {
+		return certificate.(*parsedCertificate)
+	}
+
+	certificate := &parsedCertificate{}
+	block, _ := pem.Decode([]byte(certChain))
+	if block == nil {
+		certificate.err = errors.New("no pem encoded certificate")
+	} else if leaf, err := x509.ParseCertificate(block.Bytes); err != nil {
+		certificate.err = err
+	} else {
+		for _, dnsName := range leaf.DNSNames {
+			certificate.dnsNames = append(certificate.dnsNames, strings.ToLower(dnsName))
+		}
+		certificate.notAfter = leaf.NotAfter
+	}
+
+	parsedCertificates.Add(hash, certificate)
+	return certificate
+}
+
+// addWarningOnce adds the warning to the resource, unless it has it already because it belongs to several gateways
+func addWarningOnce(params Params, resource resources.InputResource, warning string) {
+	for _, existing := range params.reports[resource].Warnings {
+		if existing == warning {
+			return
+		}
+	}
+	params.reports.AddWarning(resource, warning)
+}
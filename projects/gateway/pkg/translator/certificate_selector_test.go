@@ -0,0 +1,256 @@
+package translator_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	. "github.com/solo-io/gloo/projects/gateway/pkg/translator"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	"github.com/solo-io/gloo/test/helpers"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var _ = Describe("Certificate selector", func() {
+
+	var (
+		snap        *gloov1snap.ApiSnapshot
+		httpsGw     *v1.Gateway
+		selector    *v1.CertificateSelector
+		tenantLabel = map[string]string{"tenant-certs": "true"}
+	)
+
+	tlsSecret := func(name string, validFor time.Duration, hosts string) *gloov1.Secret {
+		cert, key := helpers.GetCerts(helpers.Params{
+			Hosts:      hosts,
+			ValidFor:   &validFor,
+			EcdsaCurve: "P256",
+		})
+		return &gloov1.Secret{
+			Metadata: &core.Metadata{Name: name, Namespace: ns, Labels: tenantLabel},
+			Kind:     &gloov1.Secret_Tls{Tls: &gloov1.TlsSecret{CertChain: cert, PrivateKey: key}},
+		}
+	}
+
+	virtualService := func(name string, domains ...string) *v1.VirtualService {
+		return helpers.NewVirtualServiceBuilder().
+			WithName(name).
+			WithNamespace(ns).
+			WithDomains(domains).
+			WithRoutePrefixMatcher("route", "/").
+			WithRouteDirectResponseAction("route", &gloov1.DirectResponseAction{Status: http.StatusOK}).
+			Build()
+	}
+
+	secretRef := func(name string) *core.ResourceRef {
+		return &core.ResourceRef{Name: name, Namespace: ns}
+	}
+
+	sniConfig := func(secret string, sniDomains ...string) *ssl.SslConfig {
+		return &ssl.SslConfig{
+			SslSecrets: &ssl.SslConfig_SecretRef{SecretRef: secretRef(secret)},
+			SniDomains: sniDomains,
+		}
+	}
+
+	// describes the ssl config compactly, so that failures are readable
+	describe := func(sslConfig *ssl.SslConfig) string {
+		return fmt.Sprintf("%s %v", sslConfig.GetSecretRef().Key(), sslConfig.GetSniDomains())
+	}
+	describeAll := func(sslConfigs ...*ssl.SslConfig) []string {
+		var descriptions []string
+		for _, sslConfig := range sslConfigs {
+			descriptions = append(descriptions, describe(sslConfig))
+		}
+		return descriptions
+	}
+
+	BeforeEach(func() {
+		selector = &v1.CertificateSelector{SecretSelector: tenantLabel}
+		httpsGw = &v1.Gateway{
+			Metadata: &core.Metadata{Namespace: ns, Name: "https"},
+			GatewayType: &v1.Gateway_HttpGateway{HttpGateway: &v1.HttpGateway{
+				CertificateSelector: selector,
+			}},
+			BindPort: 8443,
+			Ssl:      true,
+		}
+		snap = &gloov1snap.ApiSnapshot{
+			Gateways: v1.GatewayList{httpsGw},
+			Secrets: gloov1.SecretList{
+				tlsSecret("tenant-a", time.Hour, "a.example.com"),
+				tlsSecret("wildcard", time.Hour, "*.example.com,example.com"),
+				tlsSecret("tenant-c", time.Hour, "c.other.com"),
+			},
+		}
+	})
+
+	filterChainSslConfigs := func(isolateVirtualHosts bool) ([]string, error) {
+		translator := NewDefaultTranslator(Opts{
+			WriteNamespace:                 ns,
+			IsolateVirtualHostsBySslConfig: isolateVirtualHosts,
+		})
+		proxy, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+		Expect(reports.Validate()).NotTo(HaveOccurred())
+		Expect(proxy.GetListeners()).To(HaveLen(1))
+
+		if !isolateVirtualHosts {
+			return describeAll(proxy.GetListeners()[0].GetSslConfigurations()...), reports.ValidateStrict()
+		}
+		var sslConfigs []*ssl.SslConfig
+		for _, filterChain := range proxy.GetListeners()[0].GetAggregateListener().GetHttpFilterChains() {
+			sslConfigs = append(sslConfigs, filterChain.GetMatcher().GetSslConfig())
+		}
+		return describeAll(sslConfigs...), reports.ValidateStrict()
+	}
+
+	It("serves each certificate for the domains it matches", func() {
+		snap.VirtualServices = v1.VirtualServiceList{
+			virtualService("tenants", "a.example.com", "b.example.com", "c.other.com:8443"),
+			virtualService("apex", "example.com"),
+		}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sslConfigs).To(ConsistOf(describeAll(
+			sniConfig("tenant-a", "a.example.com"),
+			sniConfig("tenant-c", "c.other.com"),
+			// the sni domains of the virtual services are merged
+			sniConfig("wildcard", "b.example.com", "example.com"),
+		)))
+	})
+
+	It("selects the certificates with the http translator", func() {
+		snap.VirtualServices = v1.VirtualServiceList{
+			virtualService("tenants", "a.example.com", "b.example.com"),
+		}
+
+		sslConfigs, err := filterChainSslConfigs(false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sslConfigs).To(ConsistOf(describeAll(
+			sniConfig("tenant-a", "a.example.com"),
+			sniConfig("wildcard", "b.example.com"),
+		)))
+	})
+
+	It("does not match wildcard certificates across several labels", func() {
+		snap.VirtualServices = v1.VirtualServiceList{virtualService("deep", "a.b.example.com")}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).To(MatchError(ContainSubstring(NoMatchingCertificateWarning(snap.VirtualServices[0], []string{"a.b.example.com"}))))
+		Expect(sslConfigs).To(BeEmpty())
+	})
+
+	It("serves the default secret for the unmatched domains", func() {
+		selector.DefaultSecret = secretRef("default-cert")
+		snap.VirtualServices = v1.VirtualServiceList{virtualService("tenants", "unknown.com", "c.other.com")}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sslConfigs).To(ConsistOf(describeAll(
+			sniConfig("tenant-c", "c.other.com"),
+			sniConfig("default-cert"),
+		)))
+	})
+
+	It("serves the certificate which expires last when several certificates match", func() {
+		snap.Secrets = append(snap.Secrets, tlsSecret("tenant-a-renewed", 2*time.Hour, "a.example.com"))
+		snap.VirtualServices = v1.VirtualServiceList{virtualService("tenants", "a.example.com")}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).To(MatchError(ContainSubstring(ConflictingCertificatesWarning(snap.VirtualServices[0], "a.example.com",
+			[]string{secretRef("tenant-a-renewed").Key(), secretRef("tenant-a").Key()}))))
+		Expect(sslConfigs).To(ConsistOf(describe(sniConfig("tenant-a-renewed", "a.example.com"))))
+	})
+
+	It("serves the certificate of an exact SAN rather than a wildcard SAN which expires later", func() {
+		snap.Secrets = append(snap.Secrets, tlsSecret("wildcard-renewed", 2*time.Hour, "*.example.com"))
+		snap.VirtualServices = v1.VirtualServiceList{virtualService("tenants", "a.example.com")}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sslConfigs).To(ConsistOf(describe(sniConfig("tenant-a", "a.example.com"))))
+	})
+
+	It("only selects the secrets in the namespace of each virtual service by default", func() {
+		otherNamespaceSecret := tlsSecret("other-namespace-cert", 2*time.Hour, "a.example.com,d.other.com")
+		otherNamespaceSecret.GetMetadata().Namespace = "other-namespace"
+		snap.Secrets = append(snap.Secrets, otherNamespaceSecret)
+		otherNamespaceVs := virtualService("other-tenants", "c.other.com")
+		otherNamespaceVs.GetMetadata().Namespace = "other-namespace"
+		snap.VirtualServices = v1.VirtualServiceList{
+			virtualService("tenants", "a.example.com", "d.other.com"),
+			otherNamespaceVs,
+		}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).To(MatchError(ContainSubstring(NoMatchingCertificateWarning(snap.VirtualServices[0], []string{"d.other.com"}))))
+		Expect(err).To(MatchError(ContainSubstring(NoMatchingCertificateWarning(otherNamespaceVs, []string{"c.other.com"}))))
+		Expect(sslConfigs).To(ConsistOf(describe(sniConfig("tenant-a", "a.example.com"))))
+	})
+
+	It("does not select the certificates of the sni domains of explicit ssl configs", func() {
+		explicit := virtualService("explicit", "a.example.com")
+		explicit.SslConfig = sniConfig("explicit-cert", "a.example.com")
+		snap.VirtualServices = v1.VirtualServiceList{explicit, virtualService("tenants", "a.example.com", "b.example.com")}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).To(MatchError(ContainSubstring(DomainClaimedBySslConfigWarning(snap.VirtualServices[1], "a.example.com"))))
+		Expect(sslConfigs).To(ConsistOf(describeAll(
+			sniConfig("explicit-cert", "a.example.com"),
+			sniConfig("wildcard", "b.example.com"),
+		)))
+	})
+
+	It("ignores the default secret if an explicit ssl config does not have sni domains", func() {
+		selector.DefaultSecret = secretRef("default-cert")
+		explicit := virtualService("explicit", "explicit.com")
+		explicit.SslConfig = sniConfig("explicit-cert")
+		snap.VirtualServices = v1.VirtualServiceList{explicit, virtualService("tenants", "b.example.com", "unknown.com")}
+
+		sslConfigs, err := filterChainSslConfigs(true)
+		Expect(err).To(MatchError(ContainSubstring(DefaultSecretIgnoredWarning(explicit))))
+		Expect(sslConfigs).To(ConsistOf(describeAll(
+			sniConfig("explicit-cert"),
+			sniConfig("wildcard", "b.example.com"),
+		)))
+	})
+
+	It("only selects the secrets with the labels in the secret namespaces", func() {
+		snap.Secrets[0].GetMetadata().Labels = nil
+		selector.SecretNamespaces = []string{"other-namespace"}
+		snap.Secrets = append(snap.Secrets, func() *gloov1.Secret {
+			secret := tlsSecret("other-namespace-cert", time.Hour, "a.example.com")
+			secret.GetMetadata().Namespace = "other-namespace"
+			return secret
+		}())
+		snap.VirtualServices = v1.VirtualServiceList{virtualService("tenants", "a.example.com", "b.example.com")}
+
+		sslConfigs, _ := filterChainSslConfigs(true)
+		Expect(sslConfigs).To(ConsistOf("other-namespace.other-namespace-cert [a.example.com]"))
+	})
+
+	It("reports invalid certificates on the gateway", func() {
+		snap.Secrets[0].GetTls().CertChain = "not a certificate"
+		snap.VirtualServices = v1.VirtualServiceList{virtualService("tenants", "b.example.com")}
+
+		_, err := filterChainSslConfigs(true)
+		Expect(err).To(MatchError(ContainSubstring("selected tls secret [" + secretRef("tenant-a").Key() + "] does not contain a valid certificate")))
+	})
+
+	It("requires a secret selector", func() {
+		selector.SecretSelector = nil
+		snap.VirtualServices = v1.VirtualServiceList{virtualService("tenants", "a.example.com")}
+
+		translator := NewDefaultTranslator(Opts{WriteNamespace: ns, IsolateVirtualHostsBySslConfig: true})
+		_, reports := translator.Translate(context.Background(), defaults.GatewayProxyName, snap, snap.Gateways)
+		Expect(reports.Validate()).To(MatchError(ContainSubstring(NoSecretSelectorErr(httpsGw).Error())))
+	})
+})
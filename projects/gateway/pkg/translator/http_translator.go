@@ -4,6 +4,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/hashutils"
 )
@@ -37,6 +38,27 @@ func (t *HttpTranslator) ComputeListener(params Params, proxyName string, gatewa
 	sslGateway := gateway.GetSsl()
 	virtualServices := getVirtualServicesForHttpGateway(params, gateway, httpGateway, sslGateway)
 
+	var sslConfigurations []*ssl.SslConfig
+	if sslGateway {
+		var selectedSslConfigs map[*v1.VirtualService][]*ssl.SslConfig
+		if httpGateway.GetCertificateSelector() != nil {
+			selectedSslConfigs = selectCertificates(params, gateway, httpGateway.GetCertificateSelector(), virtualServices)
+		}
+		var servedVirtualServices v1.VirtualServiceList
+		virtualServices.Each(func(vs *v1.VirtualService) {
+			if hasSsl(vs) {
+				sslConfigurations = append(sslConfigurations, vs.GetSslConfig())
+			} else if len(selectedSslConfigs[vs]) > 0 {
+				sslConfigurations = append(sslConfigurations, selectedSslConfigs[vs]...)
+			} else {
+				// no certificate was selected for the virtual service
+				return
+			}
+			servedVirtualServices = append(servedVirtualServices, vs)
+		})
+		virtualServices = servedVirtualServices
+	}
+
 	listener := makeListener(gateway)
 	listener.ListenerType = &gloov1.Listener_HttpListener{
 		HttpListener: &gloov1.HttpListener{
@@ -45,11 +67,7 @@ func (t *HttpTranslator) ComputeListener(params Params, proxyName string, gatewa
 		},
 	}
 
-	if sslGateway {
-		virtualServices.Each(func(vs *v1.VirtualService) {
-			listener.SslConfigurations = append(listener.GetSslConfigurations(), vs.GetSslConfig())
-		})
-	}
+	listener.SslConfigurations = sslConfigurations
 
 	if err := appendSource(listener, gateway); err != nil {
 		// should never happen
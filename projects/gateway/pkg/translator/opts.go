@@ -9,6 +9,7 @@ import (
 type Opts struct {
 	GlooNamespace                  string
 	WriteNamespace                 string
+	AcmeNamespace                  string
 	StatusReporterNamespace        string
 	WatchNamespaces                []string
 	Gateways                       factory.ResourceClientFactory
@@ -12,28 +12,60 @@ import (
 // GroupVirtualServicesBySslConfig returning a stable order of sslConfigs
 // and a map of sslconfigs to their associated Virtual service lists to use on.
 func GroupVirtualServicesBySslConfig(virtualServices []*v1.VirtualService) ([]*ssl.SslConfig, map[*ssl.SslConfig][]*v1.VirtualService) {
+	return groupVirtualServicesBySslConfigs(virtualServices, func(virtualService *v1.VirtualService) []*ssl.SslConfig {
+		return []*ssl.SslConfig{virtualService.GetSslConfig()}
+	})
+}
+
+// groupVirtualServicesForSslGateway groups the virtual services of an ssl gateway by ssl config, with the certificates
+// selected by the gateway for the virtual services which do not have an ssl config.
+func groupVirtualServicesForSslGateway(
+	params Params,
+	parentGateway *v1.Gateway,
+	httpGateway *v1.HttpGateway,
+	virtualServices []*v1.VirtualService,
+) ([]*ssl.SslConfig, map[*ssl.SslConfig][]*v1.VirtualService) {
+	if httpGateway.GetCertificateSelector() == nil {
+		return GroupVirtualServicesBySslConfig(virtualServices)
+	}
+	selectedSslConfigs := selectCertificates(params, parentGateway, httpGateway.GetCertificateSelector(), virtualServices)
+	return groupVirtualServicesBySslConfigs(virtualServices, func(virtualService *v1.VirtualService) []*ssl.SslConfig {
+		if hasSsl(virtualService) {
+			return []*ssl.SslConfig{virtualService.GetSslConfig()}
+		}
+		return selectedSslConfigs[virtualService]
+	})
+}
+
+// groupVirtualServicesBySslConfigs groups the virtual services by ssl config, given the ssl configs of each virtual service.
+// A virtual service belongs to the group of each of its ssl configs.
+func groupVirtualServicesBySslConfigs(
+	virtualServices []*v1.VirtualService,
+	sslConfigs func(virtualService *v1.VirtualService) []*ssl.SslConfig,
+) ([]*ssl.SslConfig, map[*ssl.SslConfig][]*v1.VirtualService) {
 	mergedSslConfig := map[string]*ssl.SslConfig{}
 	groupedVirtualServices := map[string][]*v1.VirtualService{}
 
 	for _, virtualService := range virtualServices {
-		sslConfig := virtualService.GetSslConfig()
-		sslConfigHash := hashSslConfig(sslConfig)
+		for _, sslConfig := range sslConfigs(virtualService) {
+			sslConfigHash := hashSslConfig(sslConfig)
+
+			if matchingCfg, ok := mergedSslConfig[sslConfigHash]; ok {
+				// there is an existing sslConfig that differs only by sni domain, update the entry
+				if len(matchingCfg.GetSniDomains()) == 0 || len(sslConfig.GetSniDomains()) == 0 {
+					// if either of the configs match on everything; then match on everything
+					matchingCfg.SniDomains = nil
+				} else {
+					matchingCfg.SniDomains = merge(matchingCfg.GetSniDomains(), sslConfig.GetSniDomains()...)
+				}
+				groupedVirtualServices[sslConfigHash] = append(groupedVirtualServices[sslConfigHash], virtualService)
 
-		if matchingCfg, ok := mergedSslConfig[sslConfigHash]; ok {
-			// there is an existing sslConfig that differs only by sni domain, update the entry
-			if len(matchingCfg.GetSniDomains()) == 0 || len(sslConfig.GetSniDomains()) == 0 {
-				// if either of the configs match on everything; then match on everything
-				matchingCfg.SniDomains = nil
 			} else {
-				matchingCfg.SniDomains = merge(matchingCfg.GetSniDomains(), sslConfig.GetSniDomains()...)
+				// there is no existing sslConfig, create a new entry
+				ptrToCopy := proto.Clone(sslConfig).(*ssl.SslConfig)
+				mergedSslConfig[sslConfigHash] = ptrToCopy
+				groupedVirtualServices[sslConfigHash] = []*v1.VirtualService{virtualService}
 			}
-			groupedVirtualServices[sslConfigHash] = append(groupedVirtualServices[sslConfigHash], virtualService)
-
-		} else {
-			// there is no existing sslConfig, create a new entry
-			ptrToCopy := proto.Clone(sslConfig).(*ssl.SslConfig)
-			mergedSslConfig[sslConfigHash] = ptrToCopy
-			groupedVirtualServices[sslConfigHash] = []*v1.VirtualService{virtualService}
 		}
 	}
 
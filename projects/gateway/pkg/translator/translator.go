@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/solo-io/gloo/projects/gateway/pkg/acme"
 	v1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	"github.com/solo-io/go-utils/hashutils"
 
@@ -37,6 +38,9 @@ type GwTranslator struct {
 	// writeNamespace is the namespace that all Proxy CRs will be written to
 	writeNamespace string
 
+	// acmeNamespace is the namespace where the ACME manager writes the challenges, the only one they are served from
+	acmeNamespace string
+
 	// predicate is used to determine which Gateways to process during translation
 	predicate Predicate
 
@@ -82,6 +86,7 @@ func NewDefaultTranslator(opts Opts) *GwTranslator {
 	return &GwTranslator{
 		listenerTranslators:            translatorsByName,
 		writeNamespace:                 opts.WriteNamespace,
+		acmeNamespace:                  opts.AcmeNamespace,
 		predicate:                      GetPredicate(opts.WriteNamespace, opts.ReadGatewaysFromAllNamespaces),
 		isolateVirtualHostsBySslConfig: opts.IsolateVirtualHostsBySslConfig,
 	}
@@ -109,11 +114,13 @@ func (t *GwTranslator) Translate(ctx context.Context, proxyName string, snap *gl
 	params := NewTranslatorParams(ctx, snap, reports)
 	validateGateways(filteredGateways, snap.VirtualServices, reports)
 
+	acmeChallenges := acme.ChallengesFromSecrets(snap.Secrets, t.acmeNamespace)
 	listeners := make([]*gloov1.Listener, 0, len(filteredGateways))
 	for _, gateway := range filteredGateways {
 		listenerTranslator := t.getListenerTranslatorForGateway(gateway)
 		listener := listenerTranslator.ComputeListener(params, proxyName, gateway)
 		if listener != nil {
+			addAcmeChallengeRoutes(listener, gateway.GetSsl(), acmeChallenges)
 			listeners = append(listeners, listener)
 		}
 	}
@@ -55,6 +55,13 @@ var (
 		return errors.Errorf("virtual host [%s] has unordered prefix routes, earlier prefix [%s] short-circuited "+
 			"later route [%v]", vh, prefix, matcher)
 	}
+	AcmeMissingSecretRefErr = func(vs *v1.VirtualService) error {
+		return errors.Errorf("virtual service [%s] must specify the secret ref of its acme certificate", vs.GetMetadata().Ref().Key())
+	}
+	AcmeCertificatePendingWarning = func(vs *v1.VirtualService, secretRef string) string {
+		return fmt.Sprintf("virtual service [%s] is not served over ssl until its acme certificate [%s] is issued",
+			vs.GetMetadata().Ref().Key(), secretRef)
+	}
 	UnorderedRegexErr = func(vh, regex string, matcher *matchers.Matcher) error {
 		return errors.Errorf("virtual host [%s] has unordered regex routes, earlier regex [%s] short-circuited "+
 			"later route [%v]", vh, regex, matcher)
@@ -158,11 +165,19 @@ func getVirtualServicesForHttpGateway(
 	var virtualServicesForGateway v1.VirtualServiceList
 
 	for _, vs := range params.snapshot.VirtualServices {
-		contains, err := HttpGatewayContainsVirtualService(httpGateway, vs, gatewaySsl)
+		vsSsl := gatewaySsl
+		if gatewaySsl && httpGateway.GetCertificateSelector() != nil && !hasSsl(vs) {
+			// the certificates of the virtual services without ssl config are selected by the gateway
+			vsSsl = false
+		}
+		contains, err := HttpGatewayContainsVirtualService(httpGateway, vs, vsSsl)
 		if err != nil {
 			params.reports.AddError(parentGateway, err)
 			continue
 		}
+		if contains && gatewaySsl && !acmeCertificateIssued(params, vs) {
+			continue
+		}
 		if contains {
 			virtualServicesForGateway = append(virtualServicesForGateway, vs)
 		}
@@ -171,6 +186,25 @@ func getVirtualServicesForHttpGateway(
 	return virtualServicesForGateway
 }
 
+// acmeCertificateIssued returns false if the virtual service uses an ACME ssl config whose certificate has not been
+// issued yet, in which case the virtual service is not served until the certificate is written.
+func acmeCertificateIssued(params Params, vs *v1.VirtualService) bool {
+	acmeConfig := vs.GetSslConfig().GetAcme()
+	if acmeConfig == nil {
+		return true
+	}
+	ref := acmeConfig.GetSecretRef()
+	if ref == nil {
+		params.reports.AddError(vs, AcmeMissingSecretRefErr(vs))
+		return false
+	}
+	if _, err := params.snapshot.Secrets.Find(ref.GetNamespace(), ref.GetName()); err != nil {
+		addWarningOnce(params, vs, AcmeCertificatePendingWarning(vs, ref.Key()))
+		return false
+	}
+	return true
+}
+
 // HttpGatewayContainsVirtualService determines whether the VS has the same selector/expression matching and the same namespace
 // so that the two resources can co-exist.  A VS must match on these terms. Else see if the VS matches the same refs
 // that are currently on the gateway.
@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"strconv"
@@ -112,6 +113,8 @@ func statsCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra
 	cmd := &cobra.Command{
 		Use:   "stats",
 		Short: "stats for one of the proxy instances",
+		Example: `# show the hits and misses of the response cache
+glooctl proxy stats --filter cache`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfgDump, err := getEnvoyStatsDump(opts)
 			if err != nil {
@@ -121,10 +124,18 @@ func statsCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra
 			return nil
 		},
 	}
+	cmd.Flags().StringVar(&opts.Proxy.StatsFilter, "filter", "", "only show the stats whose name matches this regular expression")
 	cliutils.ApplyOptions(cmd, optionsFunc)
 	return cmd
 }
 
 func getEnvoyStatsDump(opts *options.Options) (string, error) {
-	return GetEnvoyAdminData(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.GetNamespace(), "/stats", 30*time.Second)
+	return GetEnvoyAdminData(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.GetNamespace(), statsPath(opts.Proxy.StatsFilter), 30*time.Second)
+}
+
+func statsPath(filter string) string {
+	if filter == "" {
+		return "/stats"
+	}
+	return "/stats?filter=" + url.QueryEscape(filter)
 }
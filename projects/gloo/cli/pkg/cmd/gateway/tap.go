@@ -0,0 +1,169 @@
+package gateway
+
+import (
+	"fmt"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	NoVirtualServiceError = eris.New("a virtual service must be specified with --virtual-service")
+	NoTapSinkError        = eris.New("exactly one of --tap-server and --path-prefix must be specified")
+	QueryParameterError   = eris.New("routes which match query parameters cannot be tapped")
+	NotHttpGatewayError   = func(name string) error {
+		return eris.Errorf("gateway %s is not an http gateway", name)
+	}
+	RouteIndexError = func(index uint32, routes int) error {
+		return eris.Errorf("route index %d is out of range, the virtual service has %d routes", index, routes)
+	}
+)
+
+func tapCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tap",
+		Short: "start or stop tapping the requests of a route (experimental)",
+		Long: "these commands configure the Envoy tap filter on a gateway to record the requests and responses matching " +
+			"a route and the domains of its virtual service. The traces are sent to a tap server, such as the Gloo access " +
+			"logger, or written to files in the proxy container. A gateway has at most one tap session at a time.",
+	}
+	cmd.PersistentFlags().StringVar(&opts.Tap.Gateway, "gateway", defaults.GatewayProxyName, "the name of the gateway to tap")
+
+	cmd.AddCommand(tapStartCmd(opts))
+	cmd.AddCommand(tapStopCmd(opts))
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func tapStartCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "start tapping the requests of a route",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := startTap(opts); err != nil {
+				return err
+			}
+			fmt.Printf("started tap of route %d of virtual service %s on gateway %s\n",
+				opts.Tap.RouteIndex, opts.Tap.VirtualService, opts.Tap.Gateway)
+			return nil
+		},
+	}
+
+	pflags := cmd.Flags()
+	pflags.StringVar(&opts.Tap.VirtualService, "virtual-service", "", "the name of the virtual service of the route")
+	pflags.StringVar(&opts.Tap.VirtualServiceNamespace, "virtual-service-namespace", "", "the namespace of the virtual service, defaults to the namespace of the gateway")
+	pflags.Uint32VarP(&opts.Tap.RouteIndex, "index", "x", 0, "the index of the route in the virtual service")
+	pflags.StringVar(&opts.Tap.TapServer.Name, "tap-server", "", "the name of the upstream of the tap server which receives the traces")
+	pflags.StringVar(&opts.Tap.TapServer.Namespace, "tap-server-namespace", "", "the namespace of the tap server upstream, defaults to the namespace of the gateway")
+	pflags.StringVar(&opts.Tap.PathPrefix, "path-prefix", "", "write the traces to files in the proxy container whose paths start with this prefix")
+	pflags.Uint32Var(&opts.Tap.MaxBufferedBytes, "max-buffered-bytes", 0, "the maximum number of bytes of the request and response bodies to record, defaults to 1KiB")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func tapStopCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "stop tapping the requests of the gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := updateGatewayTap(opts, nil); err != nil {
+				return err
+			}
+			fmt.Printf("stopped tap on gateway %s\n", opts.Tap.Gateway)
+			return nil
+		},
+	}
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+func startTap(opts *options.Options) error {
+	if opts.Tap.VirtualService == "" {
+		return NoVirtualServiceError
+	}
+	if (opts.Tap.TapServer.GetName() == "") == (opts.Tap.PathPrefix == "") {
+		return NoTapSinkError
+	}
+
+	vsNamespace := opts.Tap.VirtualServiceNamespace
+	if vsNamespace == "" {
+		vsNamespace = opts.Metadata.GetNamespace()
+	}
+	vs, err := helpers.MustNamespacedVirtualServiceClient(opts.Top.Ctx, vsNamespace).Read(vsNamespace, opts.Tap.VirtualService, clients.ReadOpts{Ctx: opts.Top.Ctx})
+	if err != nil {
+		return err
+	}
+	routes := vs.GetVirtualHost().GetRoutes()
+	if int(opts.Tap.RouteIndex) >= len(routes) {
+		return RouteIndexError(opts.Tap.RouteIndex, len(routes))
+	}
+
+	route := routes[opts.Tap.RouteIndex]
+	for _, matcher := range route.GetMatchers() {
+		if len(matcher.GetQueryParameters()) > 0 {
+			return QueryParameterError
+		}
+	}
+
+	// routes without matchers match all the requests to the domains of the virtual service
+	tapConfig := &tap.Tap{
+		Match: &tap.Match{
+			RouteMatchers: route.GetMatchers(),
+			Domains:       vs.GetVirtualHost().GetDomains(),
+		},
+		Sinks: []*tap.Sink{tapSink(opts)},
+	}
+	if opts.Tap.MaxBufferedBytes > 0 {
+		tapConfig.MaxBufferedRxBytes = &wrapperspb.UInt32Value{Value: opts.Tap.MaxBufferedBytes}
+		tapConfig.MaxBufferedTxBytes = &wrapperspb.UInt32Value{Value: opts.Tap.MaxBufferedBytes}
+	}
+	return updateGatewayTap(opts, tapConfig)
+}
+
+func tapSink(opts *options.Options) *tap.Sink {
+	if opts.Tap.PathPrefix != "" {
+		return &tap.Sink{
+			SinkType: &tap.Sink_FileSink{
+				FileSink: &tap.FileSink{PathPrefix: opts.Tap.PathPrefix},
+			},
+		}
+	}
+	tapServer := opts.Tap.TapServer
+	if tapServer.GetNamespace() == "" {
+		tapServer.Namespace = opts.Metadata.GetNamespace()
+	}
+	return &tap.Sink{
+		SinkType: &tap.Sink_GrpcService{
+			GrpcService: &tap.GrpcService{TapServer: &tapServer},
+		},
+	}
+}
+
+// updateGatewayTap sets the tap of the http gateway, or removes it if tapConfig is nil
+func updateGatewayTap(opts *options.Options, tapConfig *tap.Tap) error {
+	namespace := opts.Metadata.GetNamespace()
+	gatewayClient := helpers.MustNamespacedGatewayClient(opts.Top.Ctx, namespace)
+	gateway, err := gatewayClient.Read(namespace, opts.Tap.Gateway, clients.ReadOpts{Ctx: opts.Top.Ctx})
+	if err != nil {
+		return err
+	}
+	httpGateway := gateway.GetHttpGateway()
+	if httpGateway == nil {
+		return NotHttpGatewayError(opts.Tap.Gateway)
+	}
+	if httpGateway.GetOptions() == nil {
+		httpGateway.Options = &gloov1.HttpListenerOptions{}
+	}
+	httpGateway.GetOptions().Tap = tapConfig
+
+	_, err = gatewayClient.Write(gateway, clients.WriteOpts{Ctx: opts.Top.Ctx, OverwriteExisting: true})
+	return err
+}
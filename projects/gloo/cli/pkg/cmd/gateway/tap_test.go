@@ -0,0 +1,162 @@
+package gateway_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/gateway"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/helpers"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	test_matchers "github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Tap", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		helpers.UseMemoryClients()
+		ctx, cancel = context.WithCancel(context.Background())
+
+		_, err := helpers.MustNamespacedGatewayClient(ctx, "gloo-system").Write(&gatewayv1.Gateway{
+			Metadata:    &core.Metadata{Name: "gateway-proxy", Namespace: "gloo-system"},
+			GatewayType: &gatewayv1.Gateway_HttpGateway{HttpGateway: &gatewayv1.HttpGateway{}},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		vsClient := helpers.MustVirtualServiceClient(ctx)
+		_, err = vsClient.Write(&gatewayv1.VirtualService{
+			Metadata: &core.Metadata{Name: "vs", Namespace: "default"},
+			VirtualHost: &gatewayv1.VirtualHost{
+				Domains: []string{"petstore.example.com"},
+				Routes: []*gatewayv1.Route{{
+					Matchers: []*matchers.Matcher{{
+						PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+					}},
+				}, {
+					Matchers: []*matchers.Matcher{{
+						PathSpecifier: &matchers.Matcher_Exact{Exact: "/status"},
+						Methods:       []string{"GET"},
+					}, {
+						PathSpecifier: &matchers.Matcher_Regex{Regex: "/api/v[0-9]+"},
+						Headers:       []*matchers.HeaderMatcher{{Name: "x-version", Value: "2"}},
+					}},
+				}, {
+					Matchers: []*matchers.Matcher{{
+						PathSpecifier:   &matchers.Matcher_Prefix{Prefix: "/search"},
+						QueryParameters: []*matchers.QueryParameterMatcher{{Name: "q"}},
+					}},
+				}},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// a second virtual service with a route to the same path
+		_, err = vsClient.Write(&gatewayv1.VirtualService{
+			Metadata: &core.Metadata{Name: "other-vs", Namespace: "default"},
+			VirtualHost: &gatewayv1.VirtualHost{
+				Domains: []string{"*.other.example.com", "other.example.com"},
+				Routes: []*gatewayv1.Route{{
+					Matchers: []*matchers.Matcher{{
+						PathSpecifier: &matchers.Matcher_Exact{Exact: "/status"},
+					}},
+				}},
+			},
+		}, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() { cancel() })
+
+	gatewayTap := func() *tap.Tap {
+		gw, err := helpers.MustNamespacedGatewayClient(ctx, "gloo-system").Read("gloo-system", "gateway-proxy", clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return gw.GetHttpGateway().GetOptions().GetTap()
+	}
+
+	It("taps the requests matching the route and the domains of its virtual service", func() {
+		err := testutils.Glooctl("proxy tap start --virtual-service vs --virtual-service-namespace default --index 1 " +
+			"--path-prefix /tmp/tap --max-buffered-bytes 4096")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gatewayTap()).To(test_matchers.MatchProto(&tap.Tap{
+			Match: &tap.Match{
+				RouteMatchers: []*matchers.Matcher{{
+					PathSpecifier: &matchers.Matcher_Exact{Exact: "/status"},
+					Methods:       []string{"GET"},
+				}, {
+					PathSpecifier: &matchers.Matcher_Regex{Regex: "/api/v[0-9]+"},
+					Headers:       []*matchers.HeaderMatcher{{Name: "x-version", Value: "2"}},
+				}},
+				Domains: []string{"petstore.example.com"},
+			},
+			Sinks: []*tap.Sink{{
+				SinkType: &tap.Sink_FileSink{FileSink: &tap.FileSink{PathPrefix: "/tmp/tap"}},
+			}},
+			MaxBufferedRxBytes: &wrapperspb.UInt32Value{Value: 4096},
+			MaxBufferedTxBytes: &wrapperspb.UInt32Value{Value: 4096},
+		}))
+	})
+
+	It("distinguishes the routes of virtual services which share a path", func() {
+		err := testutils.Glooctl("proxy tap start --virtual-service other-vs --virtual-service-namespace default --path-prefix /tmp/tap")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gatewayTap().GetMatch()).To(test_matchers.MatchProto(&tap.Match{
+			RouteMatchers: []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Exact{Exact: "/status"},
+			}},
+			Domains: []string{"*.other.example.com", "other.example.com"},
+		}))
+	})
+
+	It("streams the traces to a tap server", func() {
+		err := testutils.Glooctl("proxy tap start --virtual-service vs --virtual-service-namespace default --tap-server access-logger")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(gatewayTap().GetSinks()).To(test_matchers.ConsistOfProtos(&tap.Sink{
+			SinkType: &tap.Sink_GrpcService{GrpcService: &tap.GrpcService{
+				TapServer: &core.ResourceRef{Name: "access-logger", Namespace: "gloo-system"},
+			}},
+		}))
+	})
+
+	It("stops the tap", func() {
+		err := testutils.Glooctl("proxy tap start --virtual-service vs --virtual-service-namespace default --path-prefix /tmp/tap")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gatewayTap()).NotTo(BeNil())
+
+		err = testutils.Glooctl("proxy tap stop")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gatewayTap()).To(BeNil())
+	})
+
+	It("requires exactly one sink", func() {
+		err := testutils.Glooctl("proxy tap start --virtual-service vs --virtual-service-namespace default")
+		Expect(err).To(MatchError(gateway.NoTapSinkError))
+
+		err = testutils.Glooctl("proxy tap start --virtual-service vs --virtual-service-namespace default " +
+			"--tap-server access-logger --path-prefix /tmp/tap")
+		Expect(err).To(MatchError(gateway.NoTapSinkError))
+	})
+
+	It("rejects routes which match query parameters", func() {
+		err := testutils.Glooctl("proxy tap start --virtual-service vs --virtual-service-namespace default --index 2 --path-prefix /tmp/tap")
+		Expect(err).To(MatchError(gateway.QueryParameterError))
+	})
+
+	It("errors when the route does not exist", func() {
+		err := testutils.Glooctl("proxy tap start --virtual-service vs --virtual-service-namespace default --index 3 --path-prefix /tmp/tap")
+		Expect(err).To(MatchError(gateway.RouteIndexError(3, 3)))
+	})
+})
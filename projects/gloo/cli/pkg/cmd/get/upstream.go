@@ -14,12 +14,15 @@ func Upstream(opts *options.Options) *cobra.Command {
 		Use:     constants.UPSTREAM_COMMAND.Use,
 		Aliases: constants.UPSTREAM_COMMAND.Aliases,
 		Short:   "read an upstream or list upstreams in a namespace",
-		Long:    "usage: glooctl get upstream [NAME] [--namespace=namespace] [-o FORMAT]",
+		Long:    "usage: glooctl get upstream [NAME] [--namespace=namespace] [-o FORMAT] [--discovery]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			upstreams, err := common.GetUpstreams(common.GetName(args, opts), opts)
 			if err != nil {
 				return err
 			}
+			if opts.Get.Discovery {
+				return printers.PrintUpstreamDiscovery(upstreams, opts.Top.Output)
+			}
 			var xdsDump *xdsinspection.XdsDump
 			if opts.Top.Output == printers.WIDE {
 				xdsDump, err = xdsinspection.GetGlooXdsDump(opts.Top.Ctx, opts.Proxy.Name, opts.Metadata.GetNamespace(), false)
@@ -30,5 +33,6 @@ func Upstream(opts *options.Options) *cobra.Command {
 			return printers.PrintUpstreams(upstreams, opts.Top.Output, xdsDump)
 		},
 	}
+	cmd.Flags().BoolVar(&opts.Get.Discovery, "discovery", false, "print the function discovery status of the upstreams")
 	return cmd
 }
@@ -18,23 +18,25 @@ import (
 )
 
 type Options struct {
-	Metadata  core.Metadata
-	Top       Top
-	Install   Install
-	Uninstall Uninstall
-	Proxy     Proxy
-	Upgrade   Upgrade
-	Create    Create
-	Delete    Delete
-	Edit      Edit
-	Route     Route
-	Get       Get
-	Add       Add
-	Istio     Istio
-	Remove    Remove
-	Cluster   Cluster
-	Check     Check
-	CheckCRD  CheckCRD
+	Metadata       core.Metadata
+	Top            Top
+	Install        Install
+	Uninstall      Uninstall
+	Proxy          Proxy
+	Tap            Tap
+	Upgrade        Upgrade
+	Create         Create
+	Delete         Delete
+	Edit           Edit
+	Route          Route
+	Get            Get
+	Add            Add
+	Istio          Istio
+	Remove         Remove
+	Cluster        Cluster
+	Check          Check
+	CheckCRD       CheckCRD
+	Transformation Transformation
 }
 type Top struct {
 	contextoptions.ContextAccessible
@@ -92,6 +94,17 @@ type Proxy struct {
 	Port             string
 	FollowLogs       bool
 	DebugLogs        bool
+	StatsFilter      string
+}
+
+type Tap struct {
+	Gateway                 string
+	VirtualService          string
+	VirtualServiceNamespace string
+	RouteIndex              uint32
+	TapServer               core.ResourceRef
+	PathPrefix              string
+	MaxBufferedBytes        uint32
 }
 
 type Upgrade struct {
@@ -101,6 +114,8 @@ type Upgrade struct {
 
 type Get struct {
 	Selector InputMapStringString
+	// print the discovery status of the upstreams instead of the upstreams
+	Discovery bool
 }
 
 type Delete struct {
@@ -163,6 +178,13 @@ type Istio struct {
 	IstioDiscoveryAddress string // IstioDiscoveryAddress sets discoveryAddress field within PROXY_CONFIG env var
 }
 
+type Transformation struct {
+	InputFile        string // file containing the request, the response and the environment variables to evaluate
+	Route            string // name or index of the route whose transformation is evaluated
+	ExpectedFile     string // file containing the expected output, which fails the command when it differs
+	EscapeCharacters bool   // default for escape_characters, as set in Settings.Gloo.TransformationEscapeCharacters
+}
+
 type InputRoute struct {
 	InsertIndex uint32
 	Matcher     RouteMatchers
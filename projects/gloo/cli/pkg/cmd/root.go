@@ -25,6 +25,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/install"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/remove"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/route"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/transformation"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/upgrade"
 	versioncmd "github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/version"
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
@@ -126,6 +127,7 @@ func GlooCli() *cobra.Command {
 			federation.RootCmd(opts),
 			plugin.RootCmd(opts),
 			istio.RootCmd(opts),
+			transformation.RootCmd(opts),
 			initpluginmanager.Command(context.Background()),
 			completionCmd(),
 		)
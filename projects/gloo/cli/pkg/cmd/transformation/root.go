@@ -0,0 +1,22 @@
+package transformation
+
+import (
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+)
+
+func RootCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.TRANSFORMATION_COMMAND.Use,
+		Short: constants.TRANSFORMATION_COMMAND.Short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return constants.SubcommandError
+		},
+	}
+
+	cmd.AddCommand(TestCmd(opts))
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
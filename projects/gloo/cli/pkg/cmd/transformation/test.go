@@ -0,0 +1,253 @@
+package transformation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/protoutils"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/flagutils"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/printers"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation/evaluator"
+	"github.com/solo-io/go-utils/cliutils"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	MissingFlagError = func(flag string) error {
+		return eris.Errorf("the --%s flag is required", flag)
+	}
+	UnsupportedKindError = func(kind string) error {
+		return eris.Errorf("resources of kind %s have no transformations, expected one of "+
+			"VirtualService, RouteTable, RouteOption or VirtualHostOption", kind)
+	}
+	RouteNotFoundError = func(route string) error {
+		return eris.Errorf("route %q not found", route)
+	}
+	RouteRequiredError = func(kind string) error {
+		return eris.Errorf("a %s with more than one route requires the --route flag", kind)
+	}
+	UnexpectedOutputError = func(expectedFile string) error {
+		return eris.Errorf("the output differs from the expected output in %s", expectedFile)
+	}
+)
+
+// Input is the content of the input file of the test command
+type Input struct {
+	Request  *evaluator.HttpMessage `json:"request"`
+	Response *evaluator.HttpMessage `json:"response,omitempty"`
+	// Env holds the environment variables used by the env() template function.
+	// Variables that are not set here are read from the environment of glooctl.
+	Env map[string]string `json:"env,omitempty"`
+}
+
+func TestCmd(opts *options.Options, optionsFunc ...cliutils.OptionsFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   constants.TRANSFORMATION_TEST_COMMAND.Use,
+		Short: constants.TRANSFORMATION_TEST_COMMAND.Short,
+		Long:  constants.TRANSFORMATION_TEST_COMMAND.Long,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return TestTransformation(opts, cmd.OutOrStdout())
+		},
+	}
+
+	pflags := cmd.PersistentFlags()
+	flagutils.AddFileFlag(pflags, &opts.Top.File)
+	flagutils.AddOutputFlag(pflags, &opts.Top.Output)
+	pflags.StringVar(&opts.Transformation.InputFile, "input", "",
+		"file containing the request, and optionally the response and the environment variables, to transform")
+	pflags.StringVar(&opts.Transformation.Route, "route", "",
+		"name or index of the route whose transformation is evaluated. "+
+			"If not set, the transformation of the virtual host is evaluated")
+	pflags.StringVar(&opts.Transformation.ExpectedFile, "expected", "",
+		"file containing the expected output. The command fails if the output differs from it")
+	pflags.BoolVar(&opts.Transformation.EscapeCharacters, "escape-characters", false,
+		"escape characters in templates by default, as set by Settings.Gloo.TransformationEscapeCharacters")
+	cliutils.ApplyOptions(cmd, optionsFunc)
+	return cmd
+}
+
+// TestTransformation evaluates the transformation of the resource in the file flag against the input file,
+// and writes the result to out
+func TestTransformation(opts *options.Options, out io.Writer) error {
+	if opts.Top.File == "" {
+		return MissingFlagError(flagutils.FileFlag)
+	}
+	if opts.Transformation.InputFile == "" {
+		return MissingFlagError("input")
+	}
+
+	ctx := opts.Top.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	resourceYaml, err := os.ReadFile(opts.Top.File)
+	if err != nil {
+		return eris.Wrapf(err, "reading resource file")
+	}
+	transformations, stagedTransformations, err := transformationsFromYaml(resourceYaml, opts.Transformation.Route)
+	if err != nil {
+		return eris.Wrapf(err, "finding transformation in %s", opts.Top.File)
+	}
+
+	inputYaml, err := os.ReadFile(opts.Transformation.InputFile)
+	if err != nil {
+		return eris.Wrapf(err, "reading input file")
+	}
+	var input Input
+	if err := yaml.Unmarshal(inputYaml, &input); err != nil {
+		return eris.Wrapf(err, "parsing input file")
+	}
+
+	routeTransformations, err := evaluator.ConvertTransformations(ctx, transformations, stagedTransformations,
+		wrapperspb.Bool(opts.Transformation.EscapeCharacters))
+	if err != nil {
+		return err
+	}
+	result, err := evaluator.Evaluate(routeTransformations, input.Request, input.Response, func(name string) string {
+		if value, ok := input.Env[name]; ok {
+			return value
+		}
+		return os.Getenv(name)
+	})
+	if err != nil {
+		return err
+	}
+
+	output, err := marshalResult(result, opts.Top.Output)
+	if err != nil {
+		return err
+	}
+	if _, err := out.Write(output); err != nil {
+		return err
+	}
+
+	if opts.Transformation.ExpectedFile == "" {
+		return nil
+	}
+	expectedYaml, err := os.ReadFile(opts.Transformation.ExpectedFile)
+	if err != nil {
+		return eris.Wrapf(err, "reading expected output file")
+	}
+	var expected evaluator.Result
+	if err := yaml.Unmarshal(expectedYaml, &expected); err != nil {
+		return eris.Wrapf(err, "parsing expected output file")
+	}
+	// compare the canonical JSON forms, so that the order of the fields and the headers does not matter
+	actualJson, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	expectedJson, err := json.Marshal(&expected)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(actualJson, expectedJson) {
+		return UnexpectedOutputError(opts.Transformation.ExpectedFile)
+	}
+	return nil
+}
+
+func marshalResult(result *evaluator.Result, outputType printers.OutputType) ([]byte, error) {
+	if outputType == printers.JSON {
+		output, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(output, '\n'), nil
+	}
+	return yaml.Marshal(result)
+}
+
+// transformationsFromYaml returns the transformations of a gateway.solo.io resource in kubernetes format.
+// route selects a route of a VirtualService or a RouteTable, by name or by index.
+func transformationsFromYaml(
+	yml []byte,
+	route string,
+) (*transformation.Transformations, *transformation.TransformationStages, error) {
+	var untypedObj struct {
+		Kind string      `json:"kind"`
+		Spec interface{} `json:"spec"`
+	}
+	if err := yaml.Unmarshal(yml, &untypedObj); err != nil {
+		return nil, nil, err
+	}
+	spec, err := json.Marshal(untypedObj.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch untypedObj.Kind {
+	case "VirtualService":
+		var vs gatewayv1.VirtualService
+		if err := protoutils.UnmarshalBytes(spec, &vs); err != nil {
+			return nil, nil, err
+		}
+		vhostOptions := vs.GetVirtualHost().GetOptions()
+		if route == "" {
+			return vhostOptions.GetTransformations(), vhostOptions.GetStagedTransformations(), nil
+		}
+		routeOptions, err := findRouteOptions(vs.GetVirtualHost().GetRoutes(), route)
+		if err != nil {
+			return nil, nil, err
+		}
+		// the most specific transformation is used, so the virtual host transformation only applies to routes without one
+		if routeOptions.GetTransformations() == nil && routeOptions.GetStagedTransformations() == nil {
+			return vhostOptions.GetTransformations(), vhostOptions.GetStagedTransformations(), nil
+		}
+		return routeOptions.GetTransformations(), routeOptions.GetStagedTransformations(), nil
+	case "RouteTable":
+		var rt gatewayv1.RouteTable
+		if err := protoutils.UnmarshalBytes(spec, &rt); err != nil {
+			return nil, nil, err
+		}
+		if route == "" {
+			if len(rt.GetRoutes()) != 1 {
+				return nil, nil, RouteRequiredError(untypedObj.Kind)
+			}
+			route = "0"
+		}
+		routeOptions, err := findRouteOptions(rt.GetRoutes(), route)
+		if err != nil {
+			return nil, nil, err
+		}
+		return routeOptions.GetTransformations(), routeOptions.GetStagedTransformations(), nil
+	case "RouteOption":
+		var routeOption gatewayv1.RouteOption
+		if err := protoutils.UnmarshalBytes(spec, &routeOption); err != nil {
+			return nil, nil, err
+		}
+		return routeOption.GetOptions().GetTransformations(), routeOption.GetOptions().GetStagedTransformations(), nil
+	case "VirtualHostOption":
+		var vhostOption gatewayv1.VirtualHostOption
+		if err := protoutils.UnmarshalBytes(spec, &vhostOption); err != nil {
+			return nil, nil, err
+		}
+		return vhostOption.GetOptions().GetTransformations(), vhostOption.GetOptions().GetStagedTransformations(), nil
+	}
+	return nil, nil, UnsupportedKindError(untypedObj.Kind)
+}
+
+func findRouteOptions(routes []*gatewayv1.Route, route string) (*gloov1.RouteOptions, error) {
+	for _, r := range routes {
+		if r.GetName() == route {
+			return r.GetOptions(), nil
+		}
+	}
+	if index, err := strconv.Atoi(route); err == nil && index >= 0 && index < len(routes) {
+		return routes[index].GetOptions(), nil
+	}
+	return nil, RouteNotFoundError(route)
+}
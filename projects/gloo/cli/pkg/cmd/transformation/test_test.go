@@ -0,0 +1,159 @@
+package transformation_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/options"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/cmd/transformation"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/constants"
+	"github.com/solo-io/gloo/projects/gloo/cli/pkg/testutils"
+)
+
+const virtualService = `
+apiVersion: gateway.solo.io/v1
+kind: VirtualService
+metadata:
+  name: default
+  namespace: gloo-system
+spec:
+  virtualHost:
+    domains:
+    - '*'
+    options:
+      transformations:
+        requestTransformation:
+          transformationTemplate:
+            headers:
+              x-vhost:
+                text: 'true'
+    routes:
+    - name: greet
+      matchers:
+      - prefix: /greet
+      options:
+        transformations:
+          requestTransformation:
+            transformationTemplate:
+              extractors:
+                user:
+                  header: ':path'
+                  regex: '/greet/(.*)'
+                  subgroup: 1
+              headers:
+                x-user:
+                  text: '{{ user }}'
+              body:
+                text: '{"greeting": "hello {{ user }} from {{ env("REGION") }}"}'
+    - matchers:
+      - prefix: /
+`
+
+const input = `
+request:
+  headers:
+    :method: GET
+    :path: /greet/gloo
+env:
+  REGION: eu
+`
+
+const expected = `
+request:
+  headers:
+    :method: GET
+    :path: /greet/gloo
+    content-length: "34"
+    x-user: gloo
+  body: '{"greeting": "hello gloo from eu"}'
+`
+
+var _ = Describe("Test", func() {
+
+	var (
+		dir  string
+		opts *options.Options
+		out  *bytes.Buffer
+	)
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0644)).NotTo(HaveOccurred())
+		return path
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		out = &bytes.Buffer{}
+		opts = &options.Options{}
+		opts.Top.File = writeFile("vs.yaml", virtualService)
+		opts.Transformation.InputFile = writeFile("input.yaml", input)
+	})
+
+	It("should expect a subcommand after transformation", func() {
+		err := testutils.Glooctl("transformation")
+		Expect(err).To(Equal(constants.SubcommandError))
+	})
+
+	It("evaluates the transformation of a route", func() {
+		opts.Transformation.Route = "greet"
+		Expect(transformation.TestTransformation(opts, out)).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring(`body: '{"greeting": "hello gloo from eu"}'`))
+		Expect(out.String()).To(ContainSubstring("x-user: gloo"))
+	})
+
+	It("selects routes by index", func() {
+		opts.Transformation.Route = "0"
+		opts.Transformation.ExpectedFile = writeFile("expected.yaml", expected)
+		Expect(transformation.TestTransformation(opts, out)).NotTo(HaveOccurred())
+	})
+
+	It("uses the transformation of the virtual host for routes without one", func() {
+		opts.Transformation.Route = "1"
+		Expect(transformation.TestTransformation(opts, out)).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("x-vhost: \"true\""))
+	})
+
+	It("fails when the output differs from the expected output", func() {
+		opts.Transformation.Route = "greet"
+		opts.Transformation.ExpectedFile = writeFile("expected.yaml", expected+"dynamicMetadata:\n  io.solo.transformation:\n    key: value\n")
+		err := transformation.TestTransformation(opts, out)
+		Expect(err).To(MatchError(transformation.UnexpectedOutputError(opts.Transformation.ExpectedFile).Error()))
+	})
+
+	It("evaluates the transformation of a RouteOption", func() {
+		opts.Top.File = writeFile("routeoption.yaml", `
+apiVersion: gateway.solo.io/v1
+kind: RouteOption
+metadata:
+  name: default
+spec:
+  options:
+    transformations:
+      requestTransformation:
+        transformationTemplate:
+          headers:
+            x-method:
+              text: '{{ header(":method") }}'
+`)
+		Expect(transformation.TestTransformation(opts, out)).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("x-method: GET"))
+	})
+
+	It("errors on routes that do not exist", func() {
+		opts.Transformation.Route = "missing"
+		err := transformation.TestTransformation(opts, out)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(transformation.RouteNotFoundError("missing").Error()))
+	})
+
+	It("errors on resources without transformations", func() {
+		opts.Top.File = writeFile("upstream.yaml", "apiVersion: gloo.solo.io/v1\nkind: Upstream\nspec: {}\n")
+		err := transformation.TestTransformation(opts, out)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(transformation.UnsupportedKindError("Upstream").Error()))
+	})
+})
@@ -227,4 +227,18 @@ var (
 		Use:   "istio",
 		Short: "Commands for interacting with Istio in Gloo",
 	}
+
+	TRANSFORMATION_COMMAND = cobra.Command{
+		Use:   "transformation",
+		Short: "Commands for working with transformations",
+	}
+
+	TRANSFORMATION_TEST_COMMAND = cobra.Command{
+		Use:   "test",
+		Short: "Evaluate the transformation of a resource against a sample request and response",
+		Long: "Evaluate the transformation of a VirtualService, RouteTable, RouteOption or VirtualHostOption against " +
+			"a sample request and response, without a running Envoy, and print the transformed messages and the " +
+			"dynamic metadata that was set. If an expected output file is given, the command fails when the output " +
+			"differs from it, so that transformation templates can be tested in CI.",
+	}
 )
@@ -5,12 +5,14 @@ import (
 	"io"
 	"os"
 	"sort"
+	"time"
 
 	"github.com/solo-io/gloo/projects/gloo/cli/pkg/xdsinspection"
 	plugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws/ec2"
 	"github.com/solo-io/go-utils/cliutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/olekukonko/tablewriter"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -57,6 +59,51 @@ func UpstreamTable(xdsDump *xdsinspection.XdsDump, upstreams []*v1.Upstream, w i
 	table.Render()
 }
 
+// PrintUpstreamDiscovery prints the function discovery status of the upstreams
+func PrintUpstreamDiscovery(upstreams v1.UpstreamList, outputType OutputType) error {
+	if outputType == KUBE_YAML {
+		return PrintKubeCrdList(upstreams.AsInputResources(), v1.UpstreamCrd)
+	}
+	return cliutils.PrintList(outputType.String(), "", upstreams,
+		func(data interface{}, w io.Writer) error {
+			UpstreamDiscoveryTable(data.(v1.UpstreamList), w)
+			return nil
+		}, os.Stdout)
+}
+
+// UpstreamDiscoveryTable prints the function discovery status of the upstreams using tables to io.Writer
+func UpstreamDiscoveryTable(upstreams []*v1.Upstream, w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Upstream", "plugin", "detected type", "functions", "last transition", "last success", "error"})
+
+	for _, us := range upstreams {
+		status := us.GetDiscoveryStatus()
+		if status == nil {
+			table.Append([]string{us.GetMetadata().GetName(), "", "", "", "", "", ""})
+			continue
+		}
+		table.Append([]string{
+			us.GetMetadata().GetName(),
+			status.GetDiscoveryPlugin(),
+			status.GetDetectedType(),
+			fmt.Sprintf("%d", status.GetFunctionCount()),
+			discoveryTime(status.GetLastTransitionTime()),
+			discoveryTime(status.GetLastSuccessTime()),
+			status.GetError(),
+		})
+	}
+
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+func discoveryTime(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	return timestamp.AsTime().Format(time.RFC3339)
+}
+
 func upstreamStatus(us *v1.Upstream) string {
 	return AggregateNamespacedStatuses(us.GetNamespacedStatuses(), func(status *core.Status) string {
 		return status.GetState().String()
@@ -1,9 +1,14 @@
 package printers
 
 import (
+	"bytes"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ = Describe("UpstreamTable", func() {
@@ -14,3 +19,29 @@ var _ = Describe("UpstreamTable", func() {
 		}).NotTo(Panic())
 	})
 })
+
+var _ = Describe("UpstreamDiscoveryTable", func() {
+	It("prints the discovery status of the upstreams", func() {
+		upstreams := []*v1.Upstream{
+			{
+				Metadata: &core.Metadata{Name: "discovered"},
+				DiscoveryStatus: &v1.DiscoveryStatus{
+					DiscoveryPlugin:    "grpc",
+					DetectedType:       "gRPC",
+					FunctionCount:      3,
+					LastTransitionTime: timestamppb.New(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)),
+					Error:              "connection refused",
+				},
+			},
+			{
+				Metadata: &core.Metadata{Name: "undiscovered"},
+			},
+		}
+		var out bytes.Buffer
+		UpstreamDiscoveryTable(upstreams, &out)
+		Expect(out.String()).To(ContainSubstring("2022-01-02T03:04:05Z"))
+		Expect(out.String()).To(MatchRegexp(`discovered\s+\|\s+grpc\s+\|\s+gRPC\s+\|\s+3\s+\|`))
+		Expect(out.String()).To(ContainSubstring("connection refused"))
+		Expect(out.String()).To(ContainSubstring("undiscovered"))
+	})
+})
@@ -308,6 +308,8 @@ func sslConfig(v *v1.VirtualService) string {
 		return "ssl_files"
 	case *ssl.SslConfig_Sds:
 		return "sds"
+	case *ssl.SslConfig_Acme:
+		return "acme"
 	default:
 		return "unknown"
 	}
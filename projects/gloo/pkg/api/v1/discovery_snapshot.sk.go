@@ -21,6 +21,7 @@ type DiscoverySnapshot struct {
 	Upstreams      UpstreamList
 	Kubenamespaces github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceList
 	Secrets        SecretList
+	Artifacts      ArtifactList
 }
 
 func (s DiscoverySnapshot) Clone() DiscoverySnapshot {
@@ -28,6 +29,7 @@ func (s DiscoverySnapshot) Clone() DiscoverySnapshot {
 		Upstreams:      s.Upstreams.Clone(),
 		Kubenamespaces: s.Kubenamespaces.Clone(),
 		Secrets:        s.Secrets.Clone(),
+		Artifacts:      s.Artifacts.Clone(),
 	}
 }
 
@@ -44,6 +46,9 @@ func (s DiscoverySnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashSecrets(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashArtifacts(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -59,6 +64,14 @@ func (s DiscoverySnapshot) hashSecrets(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Secrets.AsInterfaces()...)
 }
 
+func (s DiscoverySnapshot) hashArtifacts(hasher hash.Hash64) (uint64, error) {
+	clonedList := s.Artifacts.Clone()
+	for _, v := range clonedList {
+		v.Metadata.Annotations = nil
+	}
+	return hashutils.HashAllSafe(hasher, clonedList.AsInterfaces()...)
+}
+
 func (s DiscoverySnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -77,6 +90,11 @@ func (s DiscoverySnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("secrets", SecretsHash))
+	ArtifactsHash, err := s.hashArtifacts(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("artifacts", ArtifactsHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -92,6 +110,8 @@ func (s *DiscoverySnapshot) GetResourcesList(resource resources.Resource) (resou
 		return s.Kubenamespaces.AsResources(), nil
 	case *Secret:
 		return s.Secrets.AsResources(), nil
+	case *Artifact:
+		return s.Artifacts.AsResources(), nil
 	default:
 		return resources.ResourceList{}, eris.New("did not contain the input resource type returning empty list")
 	}
@@ -127,6 +147,15 @@ func (s *DiscoverySnapshot) RemoveFromResourceList(resource resources.Resource)
 			}
 		}
 		return nil
+	case *Artifact:
+
+		for i, res := range s.Artifacts {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Artifacts = append(s.Artifacts[:i], s.Artifacts[i+1:]...)
+				break
+			}
+		}
+		return nil
 	default:
 		return eris.Errorf("did not remove the resource because its type does not exist [%T]", resource)
 	}
@@ -174,6 +203,19 @@ func (s *DiscoverySnapshot) UpsertToResourceList(resource resources.Resource) er
 		}
 		s.Secrets.Sort()
 		return nil
+	case *Artifact:
+		updated := false
+		for i, res := range s.Artifacts {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Artifacts[i] = typed
+				updated = true
+			}
+		}
+		if !updated {
+			s.Artifacts = append(s.Artifacts, typed)
+		}
+		s.Artifacts.Sort()
+		return nil
 	default:
 		return eris.Errorf("did not add/replace the resource type because it does not exist %T", resource)
 	}
@@ -184,6 +226,7 @@ type DiscoverySnapshotStringer struct {
 	Upstreams      []string
 	Kubenamespaces []string
 	Secrets        []string
+	Artifacts      []string
 }
 
 func (ss DiscoverySnapshotStringer) String() string {
@@ -204,6 +247,11 @@ func (ss DiscoverySnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  Artifacts %v\n", len(ss.Artifacts))
+	for _, name := range ss.Artifacts {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -217,11 +265,13 @@ func (s DiscoverySnapshot) Stringer() DiscoverySnapshotStringer {
 		Upstreams:      s.Upstreams.NamespacesDotNames(),
 		Kubenamespaces: s.Kubenamespaces.Names(),
 		Secrets:        s.Secrets.NamespacesDotNames(),
+		Artifacts:      s.Artifacts.NamespacesDotNames(),
 	}
 }
 
 var DiscoveryGvkToHashableResource = map[schema.GroupVersionKind]func() resources.HashableResource{
 	UpstreamGVK: NewUpstreamHashableResource,
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceGVK: github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.NewKubeNamespaceHashableResource,
-	SecretGVK: NewSecretHashableResource,
+	SecretGVK:   NewSecretHashableResource,
+	ArtifactGVK: NewArtifactHashableResource,
 }
@@ -85,17 +85,19 @@ type DiscoveryEmitter interface {
 	Upstream() UpstreamClient
 	KubeNamespace() github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceClient
 	Secret() SecretClient
+	Artifact() ArtifactClient
 }
 
-func NewDiscoveryEmitter(upstreamClient UpstreamClient, kubeNamespaceClient github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceClient, secretClient SecretClient) DiscoveryEmitter {
-	return NewDiscoveryEmitterWithEmit(upstreamClient, kubeNamespaceClient, secretClient, make(chan struct{}))
+func NewDiscoveryEmitter(upstreamClient UpstreamClient, kubeNamespaceClient github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceClient, secretClient SecretClient, artifactClient ArtifactClient) DiscoveryEmitter {
+	return NewDiscoveryEmitterWithEmit(upstreamClient, kubeNamespaceClient, secretClient, artifactClient, make(chan struct{}))
 }
 
-func NewDiscoveryEmitterWithEmit(upstreamClient UpstreamClient, kubeNamespaceClient github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceClient, secretClient SecretClient, emit <-chan struct{}) DiscoveryEmitter {
+func NewDiscoveryEmitterWithEmit(upstreamClient UpstreamClient, kubeNamespaceClient github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceClient, secretClient SecretClient, artifactClient ArtifactClient, emit <-chan struct{}) DiscoveryEmitter {
 	return &discoveryEmitter{
 		upstream:      upstreamClient,
 		kubeNamespace: kubeNamespaceClient,
 		secret:        secretClient,
+		artifact:      artifactClient,
 		forceEmit:     emit,
 	}
 }
@@ -105,6 +107,7 @@ type discoveryEmitter struct {
 	upstream      UpstreamClient
 	kubeNamespace github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.KubeNamespaceClient
 	secret        SecretClient
+	artifact      ArtifactClient
 }
 
 func (c *discoveryEmitter) Register() error {
@@ -117,6 +120,9 @@ func (c *discoveryEmitter) Register() error {
 	if err := c.secret.Register(); err != nil {
 		return err
 	}
+	if err := c.artifact.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -132,6 +138,10 @@ func (c *discoveryEmitter) Secret() SecretClient {
 	return c.secret
 }
 
+func (c *discoveryEmitter) Artifact() ArtifactClient {
+	return c.artifact
+}
+
 func (c *discoveryEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *DiscoverySnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -165,10 +175,19 @@ func (c *discoveryEmitter) Snapshots(watchNamespaces []string, opts clients.Watc
 	secretChan := make(chan secretListWithNamespace)
 
 	var initialSecretList SecretList
+	/* Create channel for Artifact */
+	type artifactListWithNamespace struct {
+		list      ArtifactList
+		namespace string
+	}
+	artifactChan := make(chan artifactListWithNamespace)
+
+	var initialArtifactList ArtifactList
 
 	currentSnapshot := DiscoverySnapshot{}
 	upstreamsByNamespace := make(map[string]UpstreamList)
 	secretsByNamespace := make(map[string]SecretList)
+	artifactsByNamespace := make(map[string]ArtifactList)
 
 	for _, namespace := range watchNamespaces {
 		/* Setup namespaced watch for Upstream */
@@ -209,6 +228,25 @@ func (c *discoveryEmitter) Snapshots(watchNamespaces []string, opts clients.Watc
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, secretErrs, namespace+"-secrets")
 		}(namespace)
+		/* Setup namespaced watch for Artifact */
+		{
+			artifacts, err := c.artifact.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial Artifact list")
+			}
+			initialArtifactList = append(initialArtifactList, artifacts...)
+			artifactsByNamespace[namespace] = artifacts
+		}
+		artifactNamespacesChan, artifactErrs, err := c.artifact.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting Artifact watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, artifactErrs, namespace+"-artifacts")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -234,6 +272,15 @@ func (c *discoveryEmitter) Snapshots(watchNamespaces []string, opts clients.Watc
 						return
 					case secretChan <- secretListWithNamespace{list: secretList, namespace: namespace}:
 					}
+				case artifactList, ok := <-artifactNamespacesChan:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case artifactChan <- artifactListWithNamespace{list: artifactList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -257,6 +304,8 @@ func (c *discoveryEmitter) Snapshots(watchNamespaces []string, opts clients.Watc
 	}()
 	/* Initialize snapshot for Secrets */
 	currentSnapshot.Secrets = initialSecretList.Sort()
+	/* Initialize snapshot for Artifacts */
+	currentSnapshot.Artifacts = initialArtifactList.Sort()
 
 	snapshots := make(chan *DiscoverySnapshot)
 	go func() {
@@ -365,6 +414,28 @@ func (c *discoveryEmitter) Snapshots(watchNamespaces []string, opts clients.Watc
 					secretList = append(secretList, secrets...)
 				}
 				currentSnapshot.Secrets = secretList.Sort()
+			case artifactNamespacedList, ok := <-artifactChan:
+				if !ok {
+					return
+				}
+				record()
+
+				namespace := artifactNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"artifact",
+					mDiscoveryResourcesIn,
+				)
+
+				// merge lists by namespace
+				artifactsByNamespace[namespace] = artifactNamespacedList.list
+				var artifactList ArtifactList
+				for _, artifacts := range artifactsByNamespace {
+					artifactList = append(artifactList, artifacts...)
+				}
+				currentSnapshot.Artifacts = artifactList.Sort()
 			}
 		}
 	}()
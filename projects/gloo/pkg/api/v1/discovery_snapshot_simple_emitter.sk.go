@@ -96,6 +96,8 @@ func (c *discoverySimpleEmitter) Snapshots(ctx context.Context) (<-chan *Discove
 						currentSnapshot.Kubenamespaces = append(currentSnapshot.Kubenamespaces, typed)
 					case *Secret:
 						currentSnapshot.Secrets = append(currentSnapshot.Secrets, typed)
+					case *Artifact:
+						currentSnapshot.Artifacts = append(currentSnapshot.Artifacts, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("DiscoverySnapshotEmitter "+
@@ -74,3 +74,40 @@ func (m *Settings) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *CachePolicy) Clone() proto.Message {
+	var target *CachePolicy
+	if m == nil {
+		return target
+	}
+	target = &CachePolicy{}
+
+	target.Disabled = m.GetDisabled()
+
+	if m.GetCacheableMethods() != nil {
+		target.CacheableMethods = make([]string, len(m.GetCacheableMethods()))
+		for idx, v := range m.GetCacheableMethods() {
+
+			target.CacheableMethods[idx] = v
+
+		}
+	}
+
+	if m.GetVaryHeaders() != nil {
+		target.VaryHeaders = make([]string, len(m.GetVaryHeaders()))
+		for idx, v := range m.GetVaryHeaders() {
+
+			target.VaryHeaders[idx] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetTtl()).(clone.Cloner); ok {
+		target.Ttl = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.Ttl = proto.Clone(m.GetTtl()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
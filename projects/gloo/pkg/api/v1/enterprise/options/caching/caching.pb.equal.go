@@ -95,3 +95,63 @@ func (m *Settings) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *CachePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CachePolicy)
+	if !ok {
+		that2, ok := that.(CachePolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetDisabled() != target.GetDisabled() {
+		return false
+	}
+
+	if len(m.GetCacheableMethods()) != len(target.GetCacheableMethods()) {
+		return false
+	}
+	for idx, v := range m.GetCacheableMethods() {
+
+		if strings.Compare(v, target.GetCacheableMethods()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetVaryHeaders()) != len(target.GetVaryHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetVaryHeaders() {
+
+		if strings.Compare(v, target.GetVaryHeaders()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTtl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTtl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTtl(), target.GetTtl()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -26,17 +26,20 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Settings of Envoy's [cache filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/cache_filter).
+// Unless a caching service is referenced, responses are stored in the in-memory cache of each Envoy instance.
 type Settings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The basic reference for the service. Details name and namespace.
+	// Enterprise-only: The basic reference for the caching service. Details name and namespace.
+	// If unset, the responses are cached in memory by Envoy.
 	CachingServiceRef *core.ResourceRef `protobuf:"bytes,1,opt,name=caching_service_ref,json=cachingServiceRef,proto3" json:"caching_service_ref,omitempty"`
 	// A list of string matchers that state what headers are allowed to vary
 	// and still be cached. Per upstream envoy allowed vary headers.
 	AllowedVaryHeaders []*v3.StringMatcher `protobuf:"bytes,2,rep,name=allowed_vary_headers,json=allowedVaryHeaders,proto3" json:"allowed_vary_headers,omitempty"`
-	// Connection timeout  for retrieval from an sync cache
+	// Enterprise-only: Connection timeout  for retrieval from an sync cache
 	Timeout *duration.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	// Max payload size to cache. If unset defaults to a reasonable value.
 	// If explicitly set to 0 will prevent anything with a body from
@@ -104,6 +107,89 @@ func (x *Settings) GetMaxPayloadSize() *wrappers.UInt32Value {
 	return nil
 }
 
+// The caching policy of a route.
+// Envoy only caches the responses to GET and HEAD requests, according to their `Cache-Control` header.
+type CachePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disable the caching of the responses of the route.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The methods whose responses may be cached, either `GET` or `HEAD`. Defaults to both.
+	// If only some of them are listed, the matchers of the route must only match the listed methods,
+	// or the methods which are never cached; the caching of the route is disabled if it matches no listed method.
+	CacheableMethods []string `protobuf:"bytes,2,rep,name=cacheable_methods,json=cacheableMethods,proto3" json:"cacheable_methods,omitempty"`
+	// Headers which are added to the `Vary` header of the responses, so that a response is cached separately
+	// for each value of these request headers. Each header must also be allowed by the `allowedVaryHeaders`
+	// of the listener, or the responses are not cached.
+	VaryHeaders []string `protobuf:"bytes,3,rep,name=vary_headers,json=varyHeaders,proto3" json:"vary_headers,omitempty"`
+	// Time to live of the cached responses which have no `Cache-Control` header. Such responses get the header
+	// `public, max-age=<ttl>`. The `Cache-Control` header set by the upstream, such as `private` or `no-store`,
+	// is never overridden. Must be at least one second.
+	Ttl *duration.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *CachePolicy) Reset() {
+	*x = CachePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CachePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachePolicy) ProtoMessage() {}
+
+func (x *CachePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachePolicy.ProtoReflect.Descriptor instead.
+func (*CachePolicy) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CachePolicy) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *CachePolicy) GetCacheableMethods() []string {
+	if x != nil {
+		return x.CacheableMethods
+	}
+	return nil
+}
+
+func (x *CachePolicy) GetVaryHeaders() []string {
+	if x != nil {
+		return x.VaryHeaders
+	}
+	return nil
+}
+
+func (x *CachePolicy) GetTtl() *duration.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_rawDesc = []byte{
@@ -147,13 +233,23 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching
 	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
 	0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53,
-	0x69, 0x7a, 0x65, 0x42, 0x59, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04,
-	0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x7a, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x61, 0x63, 0x68, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x76, 0x61, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x76, 0x61, 0x72, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x42, 0x59, 0xb8, 0xf5,
+	0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x63, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -168,24 +264,26 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_cachin
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_goTypes = []interface{}{
 	(*Settings)(nil),             // 0: caching.options.gloo.solo.io.Settings
-	(*core.ResourceRef)(nil),     // 1: core.solo.io.ResourceRef
-	(*v3.StringMatcher)(nil),     // 2: solo.io.envoy.type.matcher.v3.StringMatcher
-	(*duration.Duration)(nil),    // 3: google.protobuf.Duration
-	(*wrappers.UInt32Value)(nil), // 4: google.protobuf.UInt32Value
+	(*CachePolicy)(nil),          // 1: caching.options.gloo.solo.io.CachePolicy
+	(*core.ResourceRef)(nil),     // 2: core.solo.io.ResourceRef
+	(*v3.StringMatcher)(nil),     // 3: solo.io.envoy.type.matcher.v3.StringMatcher
+	(*duration.Duration)(nil),    // 4: google.protobuf.Duration
+	(*wrappers.UInt32Value)(nil), // 5: google.protobuf.UInt32Value
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_depIdxs = []int32{
-	1, // 0: caching.options.gloo.solo.io.Settings.caching_service_ref:type_name -> core.solo.io.ResourceRef
-	2, // 1: caching.options.gloo.solo.io.Settings.allowed_vary_headers:type_name -> solo.io.envoy.type.matcher.v3.StringMatcher
-	3, // 2: caching.options.gloo.solo.io.Settings.timeout:type_name -> google.protobuf.Duration
-	4, // 3: caching.options.gloo.solo.io.Settings.max_payload_size:type_name -> google.protobuf.UInt32Value
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 0: caching.options.gloo.solo.io.Settings.caching_service_ref:type_name -> core.solo.io.ResourceRef
+	3, // 1: caching.options.gloo.solo.io.Settings.allowed_vary_headers:type_name -> solo.io.envoy.type.matcher.v3.StringMatcher
+	4, // 2: caching.options.gloo.solo.io.Settings.timeout:type_name -> google.protobuf.Duration
+	5, // 3: caching.options.gloo.solo.io.Settings.max_payload_size:type_name -> google.protobuf.UInt32Value
+	4, // 4: caching.options.gloo.solo.io.CachePolicy.ttl:type_name -> google.protobuf.Duration
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() {
@@ -208,6 +306,18 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_cachin
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CachePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -215,7 +325,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_cachin
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_caching_caching_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
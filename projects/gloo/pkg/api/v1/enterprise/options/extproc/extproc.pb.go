@@ -32,7 +32,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Enterprise-only: Configuration for Envoy's [External Processing Filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/ext_proc_filter).
+// Configuration for Envoy's [External Processing Filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/ext_proc_filter).
 // The External Processing filter allows for calling out to an external gRPC service at a specified
 // point within a HTTP filter chain. The external service may access and modify various parts of the
 // request or response, and may terminate processing.
@@ -112,18 +112,22 @@ type Settings struct {
 	// Prevents clearing the route-cache when the
 	// [clear_route_cache](https://www.envoyproxy.io/docs/envoy/latest/api-v3/service/ext_proc/v3/external_processor.proto#envoy-v3-api-field-service-ext-proc-v3-commonresponse-clear-route-cache)
 	// field is set in an external processor response.
+	// Enterprise-only: open source Gloo Edge rejects settings that set this field.
 	DisableClearRouteCache *wrappers.BoolValue `protobuf:"bytes,12,opt,name=disable_clear_route_cache,json=disableClearRouteCache,proto3" json:"disable_clear_route_cache,omitempty"`
 	// Allow headers matching the `forward_rules` to be forwarded to the external processing server.
 	// If not set, all headers are forwarded to the external processing server.
+	// Enterprise-only: open source Gloo Edge rejects settings that set this field.
 	ForwardRules *HeaderForwardingRules `protobuf:"bytes,13,opt,name=forward_rules,json=forwardRules,proto3" json:"forward_rules,omitempty"`
 	// Additional metadata to be added to the filter state for logging purposes. The metadata
 	// will be added to StreamInfo's filter state under the namespace corresponding to the
 	// ext_proc filter name.
+	// Enterprise-only: open source Gloo Edge rejects settings that set this field.
 	FilterMetadata *_struct.Struct `protobuf:"bytes,14,opt,name=filter_metadata,json=filterMetadata,proto3" json:"filter_metadata,omitempty"`
 	// If `allow_mode_override` is set to true, the filter config [processing_mode](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/ext_proc/v3/ext_proc.proto#envoy-v3-api-field-extensions-filters-http-ext-proc-v3-externalprocessor-processing-mode)
 	// can be overridden by the response message from the external processing server
 	// [mode_override](https://www.envoyproxy.io/docs/envoy/latest/api-v3/service/ext_proc/v3/external_processor.proto#envoy-v3-api-field-service-ext-proc-v3-processingresponse-mode-override).
 	// If not set, `mode_override` API in the response message will be ignored.
+	// Enterprise-only: open source Gloo Edge rejects settings that set this field.
 	AllowModeOverride *wrappers.BoolValue `protobuf:"bytes,15,opt,name=allow_mode_override,json=allowModeOverride,proto3" json:"allow_mode_override,omitempty"`
 	// Specifies a list of metadata namespaces whose values, if present, will be passed to the
 	// ext_proc service as an opaque *protobuf::Struct*.
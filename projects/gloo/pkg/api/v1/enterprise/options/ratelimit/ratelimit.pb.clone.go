@@ -188,6 +188,12 @@ func (m *RateLimitVhostExtension) Clone() proto.Message {
 		target.LocalRatelimit = proto.Clone(m.GetLocalRatelimit()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.TokenBucket)
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimitDescriptors()).(clone.Cloner); ok {
+		target.LocalRatelimitDescriptors = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.DescriptorLimits)
+	} else {
+		target.LocalRatelimitDescriptors = proto.Clone(m.GetLocalRatelimitDescriptors()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.DescriptorLimits)
+	}
+
 	return target
 }
 
@@ -220,5 +226,11 @@ func (m *RateLimitRouteExtension) Clone() proto.Message {
 		target.LocalRatelimit = proto.Clone(m.GetLocalRatelimit()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.TokenBucket)
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimitDescriptors()).(clone.Cloner); ok {
+		target.LocalRatelimitDescriptors = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.DescriptorLimits)
+	} else {
+		target.LocalRatelimitDescriptors = proto.Clone(m.GetLocalRatelimitDescriptors()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_local_ratelimit.DescriptorLimits)
+	}
+
 	return target
 }
@@ -304,6 +304,16 @@ func (m *RateLimitVhostExtension) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimitDescriptors()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalRatelimitDescriptors()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalRatelimitDescriptors(), target.GetLocalRatelimitDescriptors()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -359,5 +369,15 @@ func (m *RateLimitRouteExtension) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimitDescriptors()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalRatelimitDescriptors()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalRatelimitDescriptors(), target.GetLocalRatelimitDescriptors()) {
+			return false
+		}
+	}
+
 	return true
 }
@@ -360,6 +360,9 @@ type RateLimitVhostExtension struct {
 	// This overrides any local rate limit configured on the gateway and requests to this vHost do not count against requests to the gateway's http local rate limit.
 	// All routes that are part of this vHost will share this rate limit unless explicity configured with another limit.
 	LocalRatelimit *local_ratelimit.TokenBucket `protobuf:"bytes,2,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	// Descriptor-based local rate limits for this vHost. Requests that generate a matching descriptor consume tokens from
+	// the token bucket of that descriptor instead of the vHost's `local_ratelimit` (or the gateway's default limit).
+	LocalRatelimitDescriptors *local_ratelimit.DescriptorLimits `protobuf:"bytes,3,opt,name=local_ratelimit_descriptors,json=localRatelimitDescriptors,proto3" json:"local_ratelimit_descriptors,omitempty"`
 }
 
 func (x *RateLimitVhostExtension) Reset() {
@@ -408,6 +411,13 @@ func (x *RateLimitVhostExtension) GetLocalRatelimit() *local_ratelimit.TokenBuck
 	return nil
 }
 
+func (x *RateLimitVhostExtension) GetLocalRatelimitDescriptors() *local_ratelimit.DescriptorLimits {
+	if x != nil {
+		return x.LocalRatelimitDescriptors
+	}
+	return nil
+}
+
 // Use this field if you want to inline the Envoy rate limits for this Route.
 // Note that this does not configure the rate limit server. If you are running Gloo Enterprise, you need to
 // specify the server configuration via the appropriate field in the Gloo `Settings` resource. If you are
@@ -427,6 +437,10 @@ type RateLimitRouteExtension struct {
 	// If the token is available, the request will be allowed. If no tokens are available, the request will receive the configured rate limit status.
 	// This overrides any local rate limit configured on the vHost or gateway and requests to this route do not count against requests to the vHost or gateway's http local rate limit.
 	LocalRatelimit *local_ratelimit.TokenBucket `protobuf:"bytes,3,opt,name=local_ratelimit,json=localRatelimit,proto3" json:"local_ratelimit,omitempty"`
+	// Descriptor-based local rate limits for this route. Requests that generate a matching descriptor consume tokens from
+	// the token bucket of that descriptor instead of the route's `local_ratelimit`.
+	// This overrides any descriptor-based local rate limits configured on the vHost.
+	LocalRatelimitDescriptors *local_ratelimit.DescriptorLimits `protobuf:"bytes,4,opt,name=local_ratelimit_descriptors,json=localRatelimitDescriptors,proto3" json:"local_ratelimit_descriptors,omitempty"`
 }
 
 func (x *RateLimitRouteExtension) Reset() {
@@ -482,6 +496,13 @@ func (x *RateLimitRouteExtension) GetLocalRatelimit() *local_ratelimit.TokenBuck
 	return nil
 }
 
+func (x *RateLimitRouteExtension) GetLocalRatelimitDescriptors() *local_ratelimit.DescriptorLimits {
+	if x != nil {
+		return x.LocalRatelimitDescriptors
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelimit_ratelimit_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelimit_ratelimit_proto_rawDesc = []byte{
@@ -562,7 +583,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelim
 	0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x17, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x61, 0x63, 0x65, 0x22, 0xb7, 0x02, 0x0a, 0x17, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
 	0x74, 0x56, 0x68, 0x6f, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
 	0x48, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74,
@@ -574,22 +595,37 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelim
 	0x69, 0x6d, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
 	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
 	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x17, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x33, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x68, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x68, 0x52, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x61,
-	0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x12, 0x5a, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x76, 0x0a, 0x1b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x19, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xec, 0x02,
+	0x0a, 0x17, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x56, 0x68, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x48,
+	0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x76, 0x0a, 0x1b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6c, 0x6f, 0x63, 0x61,
 	0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x5b, 0xb8, 0xf5,
+	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x52, 0x19, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x5b, 0xb8, 0xf5,
 	0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67,
 	0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f,
@@ -613,20 +649,21 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_rateli
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelimit_ratelimit_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelimit_ratelimit_proto_goTypes = []interface{}{
-	(*IngressRateLimit)(nil),            // 0: ratelimit.options.gloo.solo.io.IngressRateLimit
-	(*Settings)(nil),                    // 1: ratelimit.options.gloo.solo.io.Settings
-	(*ServiceSettings)(nil),             // 2: ratelimit.options.gloo.solo.io.ServiceSettings
-	(*RateLimitConfigRefs)(nil),         // 3: ratelimit.options.gloo.solo.io.RateLimitConfigRefs
-	(*RateLimitConfigRef)(nil),          // 4: ratelimit.options.gloo.solo.io.RateLimitConfigRef
-	(*RateLimitVhostExtension)(nil),     // 5: ratelimit.options.gloo.solo.io.RateLimitVhostExtension
-	(*RateLimitRouteExtension)(nil),     // 6: ratelimit.options.gloo.solo.io.RateLimitRouteExtension
-	(*v1alpha1.RateLimit)(nil),          // 7: ratelimit.api.solo.io.RateLimit
-	(*core.ResourceRef)(nil),            // 8: core.solo.io.ResourceRef
-	(*duration.Duration)(nil),           // 9: google.protobuf.Duration
-	(*v1alpha1.Descriptor)(nil),         // 10: ratelimit.api.solo.io.Descriptor
-	(*v1alpha1.SetDescriptor)(nil),      // 11: ratelimit.api.solo.io.SetDescriptor
-	(*v1alpha1.RateLimitActions)(nil),   // 12: ratelimit.api.solo.io.RateLimitActions
-	(*local_ratelimit.TokenBucket)(nil), // 13: local_ratelimit.options.gloo.solo.io.TokenBucket
+	(*IngressRateLimit)(nil),                 // 0: ratelimit.options.gloo.solo.io.IngressRateLimit
+	(*Settings)(nil),                         // 1: ratelimit.options.gloo.solo.io.Settings
+	(*ServiceSettings)(nil),                  // 2: ratelimit.options.gloo.solo.io.ServiceSettings
+	(*RateLimitConfigRefs)(nil),              // 3: ratelimit.options.gloo.solo.io.RateLimitConfigRefs
+	(*RateLimitConfigRef)(nil),               // 4: ratelimit.options.gloo.solo.io.RateLimitConfigRef
+	(*RateLimitVhostExtension)(nil),          // 5: ratelimit.options.gloo.solo.io.RateLimitVhostExtension
+	(*RateLimitRouteExtension)(nil),          // 6: ratelimit.options.gloo.solo.io.RateLimitRouteExtension
+	(*v1alpha1.RateLimit)(nil),               // 7: ratelimit.api.solo.io.RateLimit
+	(*core.ResourceRef)(nil),                 // 8: core.solo.io.ResourceRef
+	(*duration.Duration)(nil),                // 9: google.protobuf.Duration
+	(*v1alpha1.Descriptor)(nil),              // 10: ratelimit.api.solo.io.Descriptor
+	(*v1alpha1.SetDescriptor)(nil),           // 11: ratelimit.api.solo.io.SetDescriptor
+	(*v1alpha1.RateLimitActions)(nil),        // 12: ratelimit.api.solo.io.RateLimitActions
+	(*local_ratelimit.TokenBucket)(nil),      // 13: local_ratelimit.options.gloo.solo.io.TokenBucket
+	(*local_ratelimit.DescriptorLimits)(nil), // 14: local_ratelimit.options.gloo.solo.io.DescriptorLimits
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelimit_ratelimit_proto_depIdxs = []int32{
 	7,  // 0: ratelimit.options.gloo.solo.io.IngressRateLimit.authorized_limits:type_name -> ratelimit.api.solo.io.RateLimit
@@ -638,13 +675,15 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_enterprise_options_ratelim
 	4,  // 6: ratelimit.options.gloo.solo.io.RateLimitConfigRefs.refs:type_name -> ratelimit.options.gloo.solo.io.RateLimitConfigRef
 	12, // 7: ratelimit.options.gloo.solo.io.RateLimitVhostExtension.rate_limits:type_name -> ratelimit.api.solo.io.RateLimitActions
 	13, // 8: ratelimit.options.gloo.solo.io.RateLimitVhostExtension.local_ratelimit:type_name -> local_ratelimit.options.gloo.solo.io.TokenBucket
-	12, // 9: ratelimit.options.gloo.solo.io.RateLimitRouteExtension.rate_limits:type_name -> ratelimit.api.solo.io.RateLimitActions
-	13, // 10: ratelimit.options.gloo.solo.io.RateLimitRouteExtension.local_ratelimit:type_name -> local_ratelimit.options.gloo.solo.io.TokenBucket
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	14, // 9: ratelimit.options.gloo.solo.io.RateLimitVhostExtension.local_ratelimit_descriptors:type_name -> local_ratelimit.options.gloo.solo.io.DescriptorLimits
+	12, // 10: ratelimit.options.gloo.solo.io.RateLimitRouteExtension.rate_limits:type_name -> ratelimit.api.solo.io.RateLimitActions
+	13, // 11: ratelimit.options.gloo.solo.io.RateLimitRouteExtension.local_ratelimit:type_name -> local_ratelimit.options.gloo.solo.io.TokenBucket
+	14, // 12: ratelimit.options.gloo.solo.io.RateLimitRouteExtension.local_ratelimit_descriptors:type_name -> local_ratelimit.options.gloo.solo.io.DescriptorLimits
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() {
@@ -337,6 +337,26 @@ func (m *RateLimitVhostExtension) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimitDescriptors()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("LocalRatelimitDescriptors")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetLocalRatelimitDescriptors(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("LocalRatelimitDescriptors")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -402,5 +422,25 @@ func (m *RateLimitRouteExtension) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalRatelimitDescriptors()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("LocalRatelimitDescriptors")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetLocalRatelimitDescriptors(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("LocalRatelimitDescriptors")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
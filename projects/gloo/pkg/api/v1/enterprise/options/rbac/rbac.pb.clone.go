@@ -12,6 +12,10 @@ import (
 
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 )
 
 // ensure the imports are used
@@ -61,6 +65,19 @@ func (m *ExtensionSettings) Clone() proto.Message {
 		}
 	}
 
+	if m.GetShadowPolicies() != nil {
+		target.ShadowPolicies = make(map[string]*Policy, len(m.GetShadowPolicies()))
+		for k, v := range m.GetShadowPolicies() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.ShadowPolicies[k] = h.Clone().(*Policy)
+			} else {
+				target.ShadowPolicies[k] = proto.Clone(v).(*Policy)
+			}
+
+		}
+	}
+
 	return target
 }
 
@@ -110,6 +127,63 @@ func (m *Principal) Clone() proto.Message {
 		target.JwtPrincipal = proto.Clone(m.GetJwtPrincipal()).(*JWTPrincipal)
 	}
 
+	if h, ok := interface{}(m.GetClientCertificate()).(clone.Cloner); ok {
+		target.ClientCertificate = h.Clone().(*ClientCertificatePrincipal)
+	} else {
+		target.ClientCertificate = proto.Clone(m.GetClientCertificate()).(*ClientCertificatePrincipal)
+	}
+
+	if h, ok := interface{}(m.GetSourceIp()).(clone.Cloner); ok {
+		target.SourceIp = h.Clone().(*SourceIpPrincipal)
+	} else {
+		target.SourceIp = proto.Clone(m.GetSourceIp()).(*SourceIpPrincipal)
+	}
+
+	if h, ok := interface{}(m.GetHeader()).(clone.Cloner); ok {
+		target.Header = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+	} else {
+		target.Header = proto.Clone(m.GetHeader()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ClientCertificatePrincipal) Clone() proto.Message {
+	var target *ClientCertificatePrincipal
+	if m == nil {
+		return target
+	}
+	target = &ClientCertificatePrincipal{}
+
+	target.PrincipalName = m.GetPrincipalName()
+
+	return target
+}
+
+// Clone function
+func (m *SourceIpPrincipal) Clone() proto.Message {
+	var target *SourceIpPrincipal
+	if m == nil {
+		return target
+	}
+	target = &SourceIpPrincipal{}
+
+	if m.GetCidrRanges() != nil {
+		target.CidrRanges = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange, len(m.GetCidrRanges()))
+		for idx, v := range m.GetCidrRanges() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.CidrRanges[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			} else {
+				target.CidrRanges[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.CidrRange)
+			}
+
+		}
+	}
+
+	target.DirectRemoteIp = m.GetDirectRemoteIp()
+
 	return target
 }
 
@@ -95,6 +95,23 @@ func (m *ExtensionSettings) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetShadowPolicies()) != len(target.GetShadowPolicies()) {
+		return false
+	}
+	for k, v := range m.GetShadowPolicies() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetShadowPolicies()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetShadowPolicies()[k]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -184,6 +201,109 @@ func (m *Principal) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetClientCertificate()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetClientCertificate()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetClientCertificate(), target.GetClientCertificate()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetSourceIp()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSourceIp()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSourceIp(), target.GetSourceIp()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHeader()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHeader()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHeader(), target.GetHeader()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ClientCertificatePrincipal) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ClientCertificatePrincipal)
+	if !ok {
+		that2, ok := that.(ClientCertificatePrincipal)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetPrincipalName(), target.GetPrincipalName()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *SourceIpPrincipal) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*SourceIpPrincipal)
+	if !ok {
+		that2, ok := that.(SourceIpPrincipal)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetCidrRanges()) != len(target.GetCidrRanges()) {
+		return false
+	}
+	for idx, v := range m.GetCidrRanges() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCidrRanges()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetCidrRanges()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetDirectRemoteIp() != target.GetDirectRemoteIp() {
+		return false
+	}
+
 	return true
 }
 
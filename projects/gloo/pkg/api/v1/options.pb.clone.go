@@ -45,12 +45,20 @@ import (
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_waf "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/waf"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_adaptive_concurrency "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_admission_control "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/admission_control"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_als "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/als"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_aws "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/aws"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_azure "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/azure"
 
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_client_cert "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/client_cert"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_connection_limit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/connection_limit"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_cors "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
@@ -281,6 +289,24 @@ func (m *HttpListenerOptions) Clone() proto.Message {
 		target.Gzip = proto.Clone(m.GetGzip()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_filter_http_gzip_v2.Gzip)
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(clone.Cloner); ok {
+		target.Compression = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.Compression)
+	} else {
+		target.Compression = proto.Clone(m.GetCompression()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.Compression)
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(clone.Cloner); ok {
+		target.AdaptiveConcurrency = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_adaptive_concurrency.AdaptiveConcurrency)
+	} else {
+		target.AdaptiveConcurrency = proto.Clone(m.GetAdaptiveConcurrency()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_adaptive_concurrency.AdaptiveConcurrency)
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(clone.Cloner); ok {
+		target.AdmissionControl = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_admission_control.AdmissionControl)
+	} else {
+		target.AdmissionControl = proto.Clone(m.GetAdmissionControl()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_admission_control.AdmissionControl)
+	}
+
 	if h, ok := interface{}(m.GetProxyLatency()).(clone.Cloner); ok {
 		target.ProxyLatency = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_extensions_proxylatency.ProxyLatency)
 	} else {
@@ -523,6 +549,30 @@ func (m *VirtualHostOptions) Clone() proto.Message {
 		target.ExtProc = proto.Clone(m.GetExtProc()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_extproc.RouteSettings)
 	}
 
+	if h, ok := interface{}(m.GetFaults()).(clone.Cloner); ok {
+		target.Faults = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_faultinjection.RouteFaults)
+	} else {
+		target.Faults = proto.Clone(m.GetFaults()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_faultinjection.RouteFaults)
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(clone.Cloner); ok {
+		target.Compression = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	} else {
+		target.Compression = proto.Clone(m.GetCompression()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(clone.Cloner); ok {
+		target.AdaptiveConcurrency = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_adaptive_concurrency.AdaptiveConcurrencyPerRoute)
+	} else {
+		target.AdaptiveConcurrency = proto.Clone(m.GetAdaptiveConcurrency()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_adaptive_concurrency.AdaptiveConcurrencyPerRoute)
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(clone.Cloner); ok {
+		target.AdmissionControl = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_admission_control.AdmissionControlPerRoute)
+	} else {
+		target.AdmissionControl = proto.Clone(m.GetAdmissionControl()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_admission_control.AdmissionControlPerRoute)
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -816,6 +866,36 @@ func (m *RouteOptions) Clone() proto.Message {
 		target.ExtProc = proto.Clone(m.GetExtProc()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_extproc.RouteSettings)
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(clone.Cloner); ok {
+		target.Compression = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	} else {
+		target.Compression = proto.Clone(m.GetCompression()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_compression.CompressionPerRoute)
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(clone.Cloner); ok {
+		target.AdaptiveConcurrency = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_adaptive_concurrency.AdaptiveConcurrencyPerRoute)
+	} else {
+		target.AdaptiveConcurrency = proto.Clone(m.GetAdaptiveConcurrency()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_adaptive_concurrency.AdaptiveConcurrencyPerRoute)
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(clone.Cloner); ok {
+		target.AdmissionControl = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_admission_control.AdmissionControlPerRoute)
+	} else {
+		target.AdmissionControl = proto.Clone(m.GetAdmissionControl()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_admission_control.AdmissionControlPerRoute)
+	}
+
+	if h, ok := interface{}(m.GetCachePolicy()).(clone.Cloner); ok {
+		target.CachePolicy = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_caching.CachePolicy)
+	} else {
+		target.CachePolicy = proto.Clone(m.GetCachePolicy()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_enterprise_options_caching.CachePolicy)
+	}
+
+	if h, ok := interface{}(m.GetClientCertIdentity()).(clone.Cloner); ok {
+		target.ClientCertIdentity = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_client_cert.ClientCertIdentity)
+	} else {
+		target.ClientCertIdentity = proto.Clone(m.GetClientCertIdentity()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options_client_cert.ClientCertIdentity)
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
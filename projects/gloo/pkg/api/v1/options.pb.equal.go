@@ -325,6 +325,36 @@ func (m *HttpListenerOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompression(), target.GetCompression()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAdaptiveConcurrency()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAdaptiveConcurrency(), target.GetAdaptiveConcurrency()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAdmissionControl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAdmissionControl(), target.GetAdmissionControl()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetProxyLatency()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetProxyLatency()) {
 			return false
@@ -732,6 +762,46 @@ func (m *VirtualHostOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFaults()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFaults()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFaults(), target.GetFaults()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompression(), target.GetCompression()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAdaptiveConcurrency()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAdaptiveConcurrency(), target.GetAdaptiveConcurrency()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAdmissionControl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAdmissionControl(), target.GetAdmissionControl()) {
+			return false
+		}
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -1186,6 +1256,56 @@ func (m *RouteOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompression(), target.GetCompression()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAdaptiveConcurrency()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAdaptiveConcurrency(), target.GetAdaptiveConcurrency()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAdmissionControl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAdmissionControl(), target.GetAdmissionControl()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCachePolicy()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCachePolicy()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCachePolicy(), target.GetCachePolicy()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetClientCertIdentity()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetClientCertIdentity()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetClientCertIdentity(), target.GetClientCertIdentity()) {
+			return false
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
@@ -490,6 +490,66 @@ func (m *HttpListenerOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Compression")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCompression(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Compression")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AdaptiveConcurrency")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAdaptiveConcurrency(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AdaptiveConcurrency")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AdmissionControl")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAdmissionControl(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AdmissionControl")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	if h, ok := interface{}(m.GetProxyLatency()).(safe_hasher.SafeHasher); ok {
 		if _, err = hasher.Write([]byte("ProxyLatency")); err != nil {
 			return 0, err
@@ -1210,6 +1270,86 @@ func (m *VirtualHostOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetFaults()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Faults")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetFaults(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Faults")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetCompression()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Compression")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCompression(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Compression")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AdaptiveConcurrency")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAdaptiveConcurrency(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AdaptiveConcurrency")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AdmissionControl")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAdmissionControl(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AdmissionControl")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	switch m.RateLimitEarlyConfigType.(type) {
 
 	case *VirtualHostOptions_RatelimitEarly:
@@ -1961,6 +2101,106 @@ func (m *RouteOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetCompression()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Compression")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCompression(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Compression")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdaptiveConcurrency()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AdaptiveConcurrency")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAdaptiveConcurrency(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AdaptiveConcurrency")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetAdmissionControl()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("AdmissionControl")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAdmissionControl(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("AdmissionControl")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetCachePolicy()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("CachePolicy")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCachePolicy(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("CachePolicy")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetClientCertIdentity()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ClientCertIdentity")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetClientCertIdentity(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ClientCertIdentity")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	switch m.HostRewriteType.(type) {
 
 	case *RouteOptions_HostRewrite:
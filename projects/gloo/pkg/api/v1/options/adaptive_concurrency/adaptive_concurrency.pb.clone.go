@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/adaptive_concurrency/adaptive_concurrency.proto
+
+package adaptive_concurrency
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *AdaptiveConcurrency) Clone() proto.Message {
+	var target *AdaptiveConcurrency
+	if m == nil {
+		return target
+	}
+	target = &AdaptiveConcurrency{}
+
+	if h, ok := interface{}(m.GetGradientController()).(clone.Cloner); ok {
+		target.GradientController = h.Clone().(*GradientController)
+	} else {
+		target.GradientController = proto.Clone(m.GetGradientController()).(*GradientController)
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyLimitExceededStatus()).(clone.Cloner); ok {
+		target.ConcurrencyLimitExceededStatus = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.ConcurrencyLimitExceededStatus = proto.Clone(m.GetConcurrencyLimitExceededStatus()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *GradientController) Clone() proto.Message {
+	var target *GradientController
+	if m == nil {
+		return target
+	}
+	target = &GradientController{}
+
+	if h, ok := interface{}(m.GetSampleAggregatePercentile()).(clone.Cloner); ok {
+		target.SampleAggregatePercentile = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.SampleAggregatePercentile = proto.Clone(m.GetSampleAggregatePercentile()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyLimit()).(clone.Cloner); ok {
+		target.ConcurrencyLimit = h.Clone().(*GradientController_ConcurrencyLimit)
+	} else {
+		target.ConcurrencyLimit = proto.Clone(m.GetConcurrencyLimit()).(*GradientController_ConcurrencyLimit)
+	}
+
+	if h, ok := interface{}(m.GetMinRttCalculation()).(clone.Cloner); ok {
+		target.MinRttCalculation = h.Clone().(*GradientController_MinRttCalculation)
+	} else {
+		target.MinRttCalculation = proto.Clone(m.GetMinRttCalculation()).(*GradientController_MinRttCalculation)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *AdaptiveConcurrencyPerRoute) Clone() proto.Message {
+	var target *AdaptiveConcurrencyPerRoute
+	if m == nil {
+		return target
+	}
+	target = &AdaptiveConcurrencyPerRoute{}
+
+	target.Disabled = m.GetDisabled()
+
+	return target
+}
+
+// Clone function
+func (m *GradientController_ConcurrencyLimit) Clone() proto.Message {
+	var target *GradientController_ConcurrencyLimit
+	if m == nil {
+		return target
+	}
+	target = &GradientController_ConcurrencyLimit{}
+
+	if h, ok := interface{}(m.GetMaxConcurrencyLimit()).(clone.Cloner); ok {
+		target.MaxConcurrencyLimit = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MaxConcurrencyLimit = proto.Clone(m.GetMaxConcurrencyLimit()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyUpdateInterval()).(clone.Cloner); ok {
+		target.ConcurrencyUpdateInterval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.ConcurrencyUpdateInterval = proto.Clone(m.GetConcurrencyUpdateInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *GradientController_MinRttCalculation) Clone() proto.Message {
+	var target *GradientController_MinRttCalculation
+	if m == nil {
+		return target
+	}
+	target = &GradientController_MinRttCalculation{}
+
+	if h, ok := interface{}(m.GetInterval()).(clone.Cloner); ok {
+		target.Interval = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.Interval = proto.Clone(m.GetInterval()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetRequestCount()).(clone.Cloner); ok {
+		target.RequestCount = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.RequestCount = proto.Clone(m.GetRequestCount()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetJitter()).(clone.Cloner); ok {
+		target.Jitter = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.Jitter = proto.Clone(m.GetJitter()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetMinConcurrency()).(clone.Cloner); ok {
+		target.MinConcurrency = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MinConcurrency = proto.Clone(m.GetMinConcurrency()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetBuffer()).(clone.Cloner); ok {
+		target.Buffer = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.Buffer = proto.Clone(m.GetBuffer()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	return target
+}
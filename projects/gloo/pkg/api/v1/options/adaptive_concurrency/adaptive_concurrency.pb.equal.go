@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/adaptive_concurrency/adaptive_concurrency.proto
+
+package adaptive_concurrency
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *AdaptiveConcurrency) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AdaptiveConcurrency)
+	if !ok {
+		that2, ok := that.(AdaptiveConcurrency)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetGradientController()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGradientController()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGradientController(), target.GetGradientController()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyLimitExceededStatus()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConcurrencyLimitExceededStatus()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConcurrencyLimitExceededStatus(), target.GetConcurrencyLimitExceededStatus()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GradientController) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GradientController)
+	if !ok {
+		that2, ok := that.(GradientController)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSampleAggregatePercentile()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSampleAggregatePercentile()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSampleAggregatePercentile(), target.GetSampleAggregatePercentile()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyLimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConcurrencyLimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConcurrencyLimit(), target.GetConcurrencyLimit()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinRttCalculation()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinRttCalculation()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinRttCalculation(), target.GetMinRttCalculation()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AdaptiveConcurrencyPerRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AdaptiveConcurrencyPerRoute)
+	if !ok {
+		that2, ok := that.(AdaptiveConcurrencyPerRoute)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetDisabled() != target.GetDisabled() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GradientController_ConcurrencyLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GradientController_ConcurrencyLimit)
+	if !ok {
+		that2, ok := that.(GradientController_ConcurrencyLimit)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMaxConcurrencyLimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxConcurrencyLimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxConcurrencyLimit(), target.GetMaxConcurrencyLimit()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyUpdateInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConcurrencyUpdateInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConcurrencyUpdateInterval(), target.GetConcurrencyUpdateInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *GradientController_MinRttCalculation) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GradientController_MinRttCalculation)
+	if !ok {
+		that2, ok := that.(GradientController_MinRttCalculation)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetInterval(), target.GetInterval()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRequestCount()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRequestCount()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRequestCount(), target.GetRequestCount()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetJitter()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetJitter()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetJitter(), target.GetJitter()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinConcurrency()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinConcurrency()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinConcurrency(), target.GetMinConcurrency()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetBuffer()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBuffer()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBuffer(), target.GetBuffer()) {
+			return false
+		}
+	}
+
+	return true
+}
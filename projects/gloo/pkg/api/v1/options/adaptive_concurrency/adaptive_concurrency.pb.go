@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/adaptive_concurrency/adaptive_concurrency.proto
+
+package adaptive_concurrency
+
+import (
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdaptiveConcurrency configures Envoy's
+// [adaptive concurrency filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/adaptive_concurrency_filter),
+// which dynamically limits the number of outstanding requests to the upstreams, based on the latency of their responses.
+// Requests which exceed the concurrency limit are rejected.
+// The filter emits stats under the `http.<stat_prefix>.adaptive_concurrency.gradient_controller.` prefix.
+type AdaptiveConcurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The gradient controller, which calculates the concurrency limit from the sampled request latencies. Required.
+	GradientController *GradientController `protobuf:"bytes,1,opt,name=gradient_controller,json=gradientController,proto3" json:"gradient_controller,omitempty"`
+	// The HTTP status code of the responses to the rejected requests. Defaults to 503.
+	ConcurrencyLimitExceededStatus *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=concurrency_limit_exceeded_status,json=concurrencyLimitExceededStatus,proto3" json:"concurrency_limit_exceeded_status,omitempty"`
+}
+
+func (x *AdaptiveConcurrency) Reset() {
+	*x = AdaptiveConcurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveConcurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveConcurrency) ProtoMessage() {}
+
+func (x *AdaptiveConcurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveConcurrency.ProtoReflect.Descriptor instead.
+func (*AdaptiveConcurrency) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdaptiveConcurrency) GetGradientController() *GradientController {
+	if x != nil {
+		return x.GradientController
+	}
+	return nil
+}
+
+func (x *AdaptiveConcurrency) GetConcurrencyLimitExceededStatus() *wrappers.UInt32Value {
+	if x != nil {
+		return x.ConcurrencyLimitExceededStatus
+	}
+	return nil
+}
+
+type GradientController struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentile of the sampled latencies which is compared to the minimum round-trip time,
+	// from 0 to 100. Defaults to 50.
+	SampleAggregatePercentile *wrappers.DoubleValue `protobuf:"bytes,1,opt,name=sample_aggregate_percentile,json=sampleAggregatePercentile,proto3" json:"sample_aggregate_percentile,omitempty"`
+	// Parameters of the periodic recalculation of the concurrency limit. Required.
+	ConcurrencyLimit *GradientController_ConcurrencyLimit `protobuf:"bytes,2,opt,name=concurrency_limit,json=concurrencyLimit,proto3" json:"concurrency_limit,omitempty"`
+	// Parameters of the periodic measurement of the minimum round-trip time, during which the concurrency
+	// limit is lowered. Required.
+	MinRttCalculation *GradientController_MinRttCalculation `protobuf:"bytes,3,opt,name=min_rtt_calculation,json=minRttCalculation,proto3" json:"min_rtt_calculation,omitempty"`
+}
+
+func (x *GradientController) Reset() {
+	*x = GradientController{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradientController) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradientController) ProtoMessage() {}
+
+func (x *GradientController) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradientController.ProtoReflect.Descriptor instead.
+func (*GradientController) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GradientController) GetSampleAggregatePercentile() *wrappers.DoubleValue {
+	if x != nil {
+		return x.SampleAggregatePercentile
+	}
+	return nil
+}
+
+func (x *GradientController) GetConcurrencyLimit() *GradientController_ConcurrencyLimit {
+	if x != nil {
+		return x.ConcurrencyLimit
+	}
+	return nil
+}
+
+func (x *GradientController) GetMinRttCalculation() *GradientController_MinRttCalculation {
+	if x != nil {
+		return x.MinRttCalculation
+	}
+	return nil
+}
+
+// Adaptive concurrency settings of a virtual host or a route.
+type AdaptiveConcurrencyPerRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disable the adaptive concurrency filter for the requests of the virtual host or route.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *AdaptiveConcurrencyPerRoute) Reset() {
+	*x = AdaptiveConcurrencyPerRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptiveConcurrencyPerRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptiveConcurrencyPerRoute) ProtoMessage() {}
+
+func (x *AdaptiveConcurrencyPerRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptiveConcurrencyPerRoute.ProtoReflect.Descriptor instead.
+func (*AdaptiveConcurrencyPerRoute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdaptiveConcurrencyPerRoute) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type GradientController_ConcurrencyLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum concurrency limit. Defaults to 1000.
+	MaxConcurrencyLimit *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=max_concurrency_limit,json=maxConcurrencyLimit,proto3" json:"max_concurrency_limit,omitempty"`
+	// The period of the recalculation of the concurrency limit. Required.
+	ConcurrencyUpdateInterval *duration.Duration `protobuf:"bytes,2,opt,name=concurrency_update_interval,json=concurrencyUpdateInterval,proto3" json:"concurrency_update_interval,omitempty"`
+}
+
+func (x *GradientController_ConcurrencyLimit) Reset() {
+	*x = GradientController_ConcurrencyLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradientController_ConcurrencyLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradientController_ConcurrencyLimit) ProtoMessage() {}
+
+func (x *GradientController_ConcurrencyLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradientController_ConcurrencyLimit.ProtoReflect.Descriptor instead.
+func (*GradientController_ConcurrencyLimit) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GradientController_ConcurrencyLimit) GetMaxConcurrencyLimit() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MaxConcurrencyLimit
+	}
+	return nil
+}
+
+func (x *GradientController_ConcurrencyLimit) GetConcurrencyUpdateInterval() *duration.Duration {
+	if x != nil {
+		return x.ConcurrencyUpdateInterval
+	}
+	return nil
+}
+
+type GradientController_MinRttCalculation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The period of the measurement of the minimum round-trip time. Required.
+	Interval *duration.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The number of requests to sample to measure the minimum round-trip time. Defaults to 50.
+	RequestCount *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// The random delay added to the interval, as a percentage of the interval from 0 to 100. Defaults to 15.
+	Jitter *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// The concurrency limit during the measurement of the minimum round-trip time. Defaults to 3.
+	MinConcurrency *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=min_concurrency,json=minConcurrency,proto3" json:"min_concurrency,omitempty"`
+	// The amount added to the measured minimum round-trip time, as a percentage from 0 to 100,
+	// to tolerate the normal variance of the latencies. Defaults to 25.
+	Buffer *wrappers.DoubleValue `protobuf:"bytes,5,opt,name=buffer,proto3" json:"buffer,omitempty"`
+}
+
+func (x *GradientController_MinRttCalculation) Reset() {
+	*x = GradientController_MinRttCalculation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradientController_MinRttCalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradientController_MinRttCalculation) ProtoMessage() {}
+
+func (x *GradientController_MinRttCalculation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradientController_MinRttCalculation.ProtoReflect.Descriptor instead.
+func (*GradientController_MinRttCalculation) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GradientController_MinRttCalculation) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *GradientController_MinRttCalculation) GetRequestCount() *wrappers.UInt32Value {
+	if x != nil {
+		return x.RequestCount
+	}
+	return nil
+}
+
+func (x *GradientController_MinRttCalculation) GetJitter() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *GradientController_MinRttCalculation) GetMinConcurrency() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MinConcurrency
+	}
+	return nil
+}
+
+func (x *GradientController_MinRttCalculation) GetBuffer() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Buffer
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDesc = []byte{
+	0x0a, 0x64, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x29, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x6e, 0x0a,
+	0x13, 0x67, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x61, 0x64, 0x61,
+	0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x12, 0x67, 0x72, 0x61, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x67, 0x0a,
+	0x21, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf5, 0x06, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5c, 0x0a,
+	0x1b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x19, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x7b, 0x0a, 0x11, 0x63,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x7f, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f,
+	0x72, 0x74, 0x74, 0x5f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x52, 0x74, 0x74, 0x43, 0x61, 0x6c, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x74, 0x74, 0x43, 0x61,
+	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xbf, 0x01, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x50,
+	0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x59, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x19, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0xc0, 0x02, 0x0a, 0x11,
+	0x4d, 0x69, 0x6e, 0x52, 0x74, 0x74, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x45, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x22, 0x39,
+	0x0a, 0x1b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x5b, 0xb8, 0xf5, 0x04, 0x01, 0xc0,
+	0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_goTypes = []interface{}{
+	(*AdaptiveConcurrency)(nil),                  // 0: adaptive_concurrency.options.gloo.solo.io.AdaptiveConcurrency
+	(*GradientController)(nil),                   // 1: adaptive_concurrency.options.gloo.solo.io.GradientController
+	(*AdaptiveConcurrencyPerRoute)(nil),          // 2: adaptive_concurrency.options.gloo.solo.io.AdaptiveConcurrencyPerRoute
+	(*GradientController_ConcurrencyLimit)(nil),  // 3: adaptive_concurrency.options.gloo.solo.io.GradientController.ConcurrencyLimit
+	(*GradientController_MinRttCalculation)(nil), // 4: adaptive_concurrency.options.gloo.solo.io.GradientController.MinRttCalculation
+	(*wrappers.UInt32Value)(nil),                 // 5: google.protobuf.UInt32Value
+	(*wrappers.DoubleValue)(nil),                 // 6: google.protobuf.DoubleValue
+	(*duration.Duration)(nil),                    // 7: google.protobuf.Duration
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_depIdxs = []int32{
+	1,  // 0: adaptive_concurrency.options.gloo.solo.io.AdaptiveConcurrency.gradient_controller:type_name -> adaptive_concurrency.options.gloo.solo.io.GradientController
+	5,  // 1: adaptive_concurrency.options.gloo.solo.io.AdaptiveConcurrency.concurrency_limit_exceeded_status:type_name -> google.protobuf.UInt32Value
+	6,  // 2: adaptive_concurrency.options.gloo.solo.io.GradientController.sample_aggregate_percentile:type_name -> google.protobuf.DoubleValue
+	3,  // 3: adaptive_concurrency.options.gloo.solo.io.GradientController.concurrency_limit:type_name -> adaptive_concurrency.options.gloo.solo.io.GradientController.ConcurrencyLimit
+	4,  // 4: adaptive_concurrency.options.gloo.solo.io.GradientController.min_rtt_calculation:type_name -> adaptive_concurrency.options.gloo.solo.io.GradientController.MinRttCalculation
+	5,  // 5: adaptive_concurrency.options.gloo.solo.io.GradientController.ConcurrencyLimit.max_concurrency_limit:type_name -> google.protobuf.UInt32Value
+	7,  // 6: adaptive_concurrency.options.gloo.solo.io.GradientController.ConcurrencyLimit.concurrency_update_interval:type_name -> google.protobuf.Duration
+	7,  // 7: adaptive_concurrency.options.gloo.solo.io.GradientController.MinRttCalculation.interval:type_name -> google.protobuf.Duration
+	5,  // 8: adaptive_concurrency.options.gloo.solo.io.GradientController.MinRttCalculation.request_count:type_name -> google.protobuf.UInt32Value
+	6,  // 9: adaptive_concurrency.options.gloo.solo.io.GradientController.MinRttCalculation.jitter:type_name -> google.protobuf.DoubleValue
+	5,  // 10: adaptive_concurrency.options.gloo.solo.io.GradientController.MinRttCalculation.min_concurrency:type_name -> google.protobuf.UInt32Value
+	6,  // 11: adaptive_concurrency.options.gloo.solo.io.GradientController.MinRttCalculation.buffer:type_name -> google.protobuf.DoubleValue
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_init()
+}
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveConcurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradientController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdaptiveConcurrencyPerRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradientController_ConcurrencyLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradientController_MinRttCalculation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_adaptive_concurrency_adaptive_concurrency_proto_depIdxs = nil
+}
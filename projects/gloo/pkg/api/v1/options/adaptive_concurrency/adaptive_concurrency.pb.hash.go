@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/adaptive_concurrency/adaptive_concurrency.proto
+
+package adaptive_concurrency
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+
+	safe_hasher "github.com/solo-io/protoc-gen-ext/pkg/hasher"
+	"github.com/solo-io/protoc-gen-ext/pkg/hasher/hashstructure"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = new(hash.Hash64)
+	_ = fnv.New64
+	_ = hashstructure.Hash
+	_ = new(safe_hasher.SafeHasher)
+)
+
+// Hash function
+func (m *AdaptiveConcurrency) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("adaptive_concurrency.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency.AdaptiveConcurrency")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetGradientController()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("GradientController")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetGradientController(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("GradientController")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyLimitExceededStatus()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ConcurrencyLimitExceededStatus")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetConcurrencyLimitExceededStatus(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ConcurrencyLimitExceededStatus")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *GradientController) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("adaptive_concurrency.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency.GradientController")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetSampleAggregatePercentile()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("SampleAggregatePercentile")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSampleAggregatePercentile(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("SampleAggregatePercentile")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyLimit()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ConcurrencyLimit")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetConcurrencyLimit(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ConcurrencyLimit")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinRttCalculation()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MinRttCalculation")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMinRttCalculation(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MinRttCalculation")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *AdaptiveConcurrencyPerRoute) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("adaptive_concurrency.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency.AdaptiveConcurrencyPerRoute")); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetDisabled())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *GradientController_ConcurrencyLimit) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("adaptive_concurrency.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency.GradientController_ConcurrencyLimit")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetMaxConcurrencyLimit()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MaxConcurrencyLimit")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMaxConcurrencyLimit(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MaxConcurrencyLimit")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetConcurrencyUpdateInterval()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ConcurrencyUpdateInterval")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetConcurrencyUpdateInterval(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ConcurrencyUpdateInterval")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *GradientController_MinRttCalculation) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("adaptive_concurrency.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency.GradientController_MinRttCalculation")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetInterval()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Interval")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetInterval(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Interval")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetRequestCount()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("RequestCount")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRequestCount(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("RequestCount")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetJitter()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Jitter")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetJitter(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Jitter")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetMinConcurrency()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MinConcurrency")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMinConcurrency(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MinConcurrency")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetBuffer()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Buffer")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetBuffer(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Buffer")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
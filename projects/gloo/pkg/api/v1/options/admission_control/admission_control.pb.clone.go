@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/admission_control/admission_control.proto
+
+package admission_control
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *AdmissionControl) Clone() proto.Message {
+	var target *AdmissionControl
+	if m == nil {
+		return target
+	}
+	target = &AdmissionControl{}
+
+	if h, ok := interface{}(m.GetSuccessCriteria()).(clone.Cloner); ok {
+		target.SuccessCriteria = h.Clone().(*AdmissionControl_SuccessCriteria)
+	} else {
+		target.SuccessCriteria = proto.Clone(m.GetSuccessCriteria()).(*AdmissionControl_SuccessCriteria)
+	}
+
+	if h, ok := interface{}(m.GetSamplingWindow()).(clone.Cloner); ok {
+		target.SamplingWindow = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.SamplingWindow = proto.Clone(m.GetSamplingWindow()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	if h, ok := interface{}(m.GetAggression()).(clone.Cloner); ok {
+		target.Aggression = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.Aggression = proto.Clone(m.GetAggression()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetSuccessRateThreshold()).(clone.Cloner); ok {
+		target.SuccessRateThreshold = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.SuccessRateThreshold = proto.Clone(m.GetSuccessRateThreshold()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	if h, ok := interface{}(m.GetRpsThreshold()).(clone.Cloner); ok {
+		target.RpsThreshold = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.RpsThreshold = proto.Clone(m.GetRpsThreshold()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetMaxRejectionProbability()).(clone.Cloner); ok {
+		target.MaxRejectionProbability = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	} else {
+		target.MaxRejectionProbability = proto.Clone(m.GetMaxRejectionProbability()).(*github_com_golang_protobuf_ptypes_wrappers.DoubleValue)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *AdmissionControlPerRoute) Clone() proto.Message {
+	var target *AdmissionControlPerRoute
+	if m == nil {
+		return target
+	}
+	target = &AdmissionControlPerRoute{}
+
+	target.Disabled = m.GetDisabled()
+
+	return target
+}
+
+// Clone function
+func (m *AdmissionControl_SuccessCriteria) Clone() proto.Message {
+	var target *AdmissionControl_SuccessCriteria
+	if m == nil {
+		return target
+	}
+	target = &AdmissionControl_SuccessCriteria{}
+
+	if m.GetHttpSuccessStatus() != nil {
+		target.HttpSuccessStatus = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_v3.Int32Range, len(m.GetHttpSuccessStatus()))
+		for idx, v := range m.GetHttpSuccessStatus() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.HttpSuccessStatus[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_v3.Int32Range)
+			} else {
+				target.HttpSuccessStatus[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_type_v3.Int32Range)
+			}
+
+		}
+	}
+
+	if m.GetGrpcSuccessStatus() != nil {
+		target.GrpcSuccessStatus = make([]uint32, len(m.GetGrpcSuccessStatus()))
+		for idx, v := range m.GetGrpcSuccessStatus() {
+
+			target.GrpcSuccessStatus[idx] = v
+
+		}
+	}
+
+	return target
+}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/admission_control/admission_control.proto
+
+package admission_control
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *AdmissionControl) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AdmissionControl)
+	if !ok {
+		that2, ok := that.(AdmissionControl)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSuccessCriteria()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSuccessCriteria()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSuccessCriteria(), target.GetSuccessCriteria()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetSamplingWindow()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSamplingWindow()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSamplingWindow(), target.GetSamplingWindow()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetAggression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAggression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAggression(), target.GetAggression()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetSuccessRateThreshold()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSuccessRateThreshold()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSuccessRateThreshold(), target.GetSuccessRateThreshold()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRpsThreshold()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRpsThreshold()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRpsThreshold(), target.GetRpsThreshold()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxRejectionProbability()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxRejectionProbability()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxRejectionProbability(), target.GetMaxRejectionProbability()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AdmissionControlPerRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AdmissionControlPerRoute)
+	if !ok {
+		that2, ok := that.(AdmissionControlPerRoute)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetDisabled() != target.GetDisabled() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AdmissionControl_SuccessCriteria) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AdmissionControl_SuccessCriteria)
+	if !ok {
+		that2, ok := that.(AdmissionControl_SuccessCriteria)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetHttpSuccessStatus()) != len(target.GetHttpSuccessStatus()) {
+		return false
+	}
+	for idx, v := range m.GetHttpSuccessStatus() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHttpSuccessStatus()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHttpSuccessStatus()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetGrpcSuccessStatus()) != len(target.GetGrpcSuccessStatus()) {
+		return false
+	}
+	for idx, v := range m.GetGrpcSuccessStatus() {
+
+		if v != target.GetGrpcSuccessStatus()[idx] {
+			return false
+		}
+
+	}
+
+	return true
+}
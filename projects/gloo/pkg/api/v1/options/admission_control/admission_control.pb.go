@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/admission_control/admission_control.proto
+
+package admission_control
+
+import (
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdmissionControl configures Envoy's
+// [admission control filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/admission_control_filter),
+// which probabilistically rejects requests when the success rate of the recent requests drops below a threshold.
+// The filter emits stats under the `http.<stat_prefix>.admission_control.` prefix.
+type AdmissionControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defines which requests are successful. Defaults to the responses with a status code
+	// below 500 and the gRPC status codes which do not indicate an upstream failure.
+	SuccessCriteria *AdmissionControl_SuccessCriteria `protobuf:"bytes,1,opt,name=success_criteria,json=successCriteria,proto3" json:"success_criteria,omitempty"`
+	// The time window over which the success rate is calculated. Defaults to 30 seconds.
+	SamplingWindow *duration.Duration `protobuf:"bytes,2,opt,name=sampling_window,json=samplingWindow,proto3" json:"sampling_window,omitempty"`
+	// Controls how fast the rejection probability grows as the success rate drops. Values above 1 reject
+	// requests more aggressively. Defaults to 1.
+	Aggression *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=aggression,proto3" json:"aggression,omitempty"`
+	// The success rate, as a percentage from 0 to 100, below which requests are rejected. Defaults to 95.
+	SuccessRateThreshold *wrappers.DoubleValue `protobuf:"bytes,4,opt,name=success_rate_threshold,json=successRateThreshold,proto3" json:"success_rate_threshold,omitempty"`
+	// The rate of requests per second below which no request is rejected. Defaults to 0.
+	RpsThreshold *wrappers.UInt32Value `protobuf:"bytes,5,opt,name=rps_threshold,json=rpsThreshold,proto3" json:"rps_threshold,omitempty"`
+	// The maximum probability to reject a request, as a percentage from 0 to 100. Defaults to 80.
+	MaxRejectionProbability *wrappers.DoubleValue `protobuf:"bytes,6,opt,name=max_rejection_probability,json=maxRejectionProbability,proto3" json:"max_rejection_probability,omitempty"`
+}
+
+func (x *AdmissionControl) Reset() {
+	*x = AdmissionControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdmissionControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmissionControl) ProtoMessage() {}
+
+func (x *AdmissionControl) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmissionControl.ProtoReflect.Descriptor instead.
+func (*AdmissionControl) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdmissionControl) GetSuccessCriteria() *AdmissionControl_SuccessCriteria {
+	if x != nil {
+		return x.SuccessCriteria
+	}
+	return nil
+}
+
+func (x *AdmissionControl) GetSamplingWindow() *duration.Duration {
+	if x != nil {
+		return x.SamplingWindow
+	}
+	return nil
+}
+
+func (x *AdmissionControl) GetAggression() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Aggression
+	}
+	return nil
+}
+
+func (x *AdmissionControl) GetSuccessRateThreshold() *wrappers.DoubleValue {
+	if x != nil {
+		return x.SuccessRateThreshold
+	}
+	return nil
+}
+
+func (x *AdmissionControl) GetRpsThreshold() *wrappers.UInt32Value {
+	if x != nil {
+		return x.RpsThreshold
+	}
+	return nil
+}
+
+func (x *AdmissionControl) GetMaxRejectionProbability() *wrappers.DoubleValue {
+	if x != nil {
+		return x.MaxRejectionProbability
+	}
+	return nil
+}
+
+// Admission control settings of a virtual host or a route.
+type AdmissionControlPerRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disable the admission control filter for the requests of the virtual host or route.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *AdmissionControlPerRoute) Reset() {
+	*x = AdmissionControlPerRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdmissionControlPerRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmissionControlPerRoute) ProtoMessage() {}
+
+func (x *AdmissionControlPerRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmissionControlPerRoute.ProtoReflect.Descriptor instead.
+func (*AdmissionControlPerRoute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdmissionControlPerRoute) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type AdmissionControl_SuccessCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ranges of HTTP status codes of successful responses. The start of a range is inclusive and its end is exclusive.
+	HttpSuccessStatus []*v3.Int32Range `protobuf:"bytes,1,rep,name=http_success_status,json=httpSuccessStatus,proto3" json:"http_success_status,omitempty"`
+	// The gRPC status codes of successful responses.
+	GrpcSuccessStatus []uint32 `protobuf:"varint,2,rep,packed,name=grpc_success_status,json=grpcSuccessStatus,proto3" json:"grpc_success_status,omitempty"`
+}
+
+func (x *AdmissionControl_SuccessCriteria) Reset() {
+	*x = AdmissionControl_SuccessCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdmissionControl_SuccessCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmissionControl_SuccessCriteria) ProtoMessage() {}
+
+func (x *AdmissionControl_SuccessCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmissionControl_SuccessCriteria.ProtoReflect.Descriptor instead.
+func (*AdmissionControl_SuccessCriteria) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AdmissionControl_SuccessCriteria) GetHttpSuccessStatus() []*v3.Int32Range {
+	if x != nil {
+		return x.HttpSuccessStatus
+	}
+	return nil
+}
+
+func (x *AdmissionControl_SuccessCriteria) GetGrpcSuccessStatus() []uint32 {
+	if x != nil {
+		return x.GrpcSuccessStatus
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDesc = []byte{
+	0x0a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x26, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x4c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f,
+	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x05, 0x0a, 0x10, 0x41,
+	0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x73, 0x0a, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x52, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3c, 0x0a, 0x0a, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x16, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x41, 0x0a, 0x0d, 0x72, 0x70,
+	0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0c, 0x72, 0x70, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x58, 0x0a,
+	0x19, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x17,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0x94, 0x01, 0x0a, 0x0f, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x51, 0x0a, 0x13, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x76, 0x33,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x68, 0x74, 0x74,
+	0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x72, 0x70,
+	0x63, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x36,
+	0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x58, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01,
+	0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_goTypes = []interface{}{
+	(*AdmissionControl)(nil),                 // 0: admission_control.options.gloo.solo.io.AdmissionControl
+	(*AdmissionControlPerRoute)(nil),         // 1: admission_control.options.gloo.solo.io.AdmissionControlPerRoute
+	(*AdmissionControl_SuccessCriteria)(nil), // 2: admission_control.options.gloo.solo.io.AdmissionControl.SuccessCriteria
+	(*duration.Duration)(nil),                // 3: google.protobuf.Duration
+	(*wrappers.DoubleValue)(nil),             // 4: google.protobuf.DoubleValue
+	(*wrappers.UInt32Value)(nil),             // 5: google.protobuf.UInt32Value
+	(*v3.Int32Range)(nil),                    // 6: solo.io.envoy.type.v3.Int32Range
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_depIdxs = []int32{
+	2, // 0: admission_control.options.gloo.solo.io.AdmissionControl.success_criteria:type_name -> admission_control.options.gloo.solo.io.AdmissionControl.SuccessCriteria
+	3, // 1: admission_control.options.gloo.solo.io.AdmissionControl.sampling_window:type_name -> google.protobuf.Duration
+	4, // 2: admission_control.options.gloo.solo.io.AdmissionControl.aggression:type_name -> google.protobuf.DoubleValue
+	4, // 3: admission_control.options.gloo.solo.io.AdmissionControl.success_rate_threshold:type_name -> google.protobuf.DoubleValue
+	5, // 4: admission_control.options.gloo.solo.io.AdmissionControl.rps_threshold:type_name -> google.protobuf.UInt32Value
+	4, // 5: admission_control.options.gloo.solo.io.AdmissionControl.max_rejection_probability:type_name -> google.protobuf.DoubleValue
+	6, // 6: admission_control.options.gloo.solo.io.AdmissionControl.SuccessCriteria.http_success_status:type_name -> solo.io.envoy.type.v3.Int32Range
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_init()
+}
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdmissionControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdmissionControlPerRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdmissionControl_SuccessCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_admission_control_admission_control_proto_depIdxs = nil
+}
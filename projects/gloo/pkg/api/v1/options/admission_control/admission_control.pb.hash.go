@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/admission_control/admission_control.proto
+
+package admission_control
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+
+	safe_hasher "github.com/solo-io/protoc-gen-ext/pkg/hasher"
+	"github.com/solo-io/protoc-gen-ext/pkg/hasher/hashstructure"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = new(hash.Hash64)
+	_ = fnv.New64
+	_ = hashstructure.Hash
+	_ = new(safe_hasher.SafeHasher)
+)
+
+// Hash function
+func (m *AdmissionControl) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("admission_control.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/admission_control.AdmissionControl")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetSuccessCriteria()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("SuccessCriteria")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSuccessCriteria(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("SuccessCriteria")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetSamplingWindow()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("SamplingWindow")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSamplingWindow(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("SamplingWindow")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetAggression()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Aggression")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetAggression(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Aggression")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetSuccessRateThreshold()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("SuccessRateThreshold")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetSuccessRateThreshold(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("SuccessRateThreshold")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetRpsThreshold()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("RpsThreshold")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRpsThreshold(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("RpsThreshold")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxRejectionProbability()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MaxRejectionProbability")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMaxRejectionProbability(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MaxRejectionProbability")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *AdmissionControlPerRoute) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("admission_control.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/admission_control.AdmissionControlPerRoute")); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetDisabled())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *AdmissionControl_SuccessCriteria) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("admission_control.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/admission_control.AdmissionControl_SuccessCriteria")); err != nil {
+		return 0, err
+	}
+
+	for _, v := range m.GetHttpSuccessStatus() {
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetGrpcSuccessStatus())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
@@ -0,0 +1,100 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/client_cert/client_cert.proto
+
+package client_cert
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/solo-io/protoc-gen-ext/pkg/clone"
+	"google.golang.org/protobuf/proto"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = clone.Cloner(nil)
+	_ = proto.Message(nil)
+)
+
+// Clone function
+func (m *ClientCertIdentity) Clone() proto.Message {
+	var target *ClientCertIdentity
+	if m == nil {
+		return target
+	}
+	target = &ClientCertIdentity{}
+
+	if m.GetMappings() != nil {
+		target.Mappings = make([]*ClientCertIdentity_Mapping, len(m.GetMappings()))
+		for idx, v := range m.GetMappings() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Mappings[idx] = h.Clone().(*ClientCertIdentity_Mapping)
+			} else {
+				target.Mappings[idx] = proto.Clone(v).(*ClientCertIdentity_Mapping)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ClientCertIdentity_Mapping) Clone() proto.Message {
+	var target *ClientCertIdentity_Mapping
+	if m == nil {
+		return target
+	}
+	target = &ClientCertIdentity_Mapping{}
+
+	target.Field = m.GetField()
+
+	switch m.Destination.(type) {
+
+	case *ClientCertIdentity_Mapping_Header:
+
+		target.Destination = &ClientCertIdentity_Mapping_Header{
+			Header: m.GetHeader(),
+		}
+
+	case *ClientCertIdentity_Mapping_DynamicMetadata:
+
+		if h, ok := interface{}(m.GetDynamicMetadata()).(clone.Cloner); ok {
+			target.Destination = &ClientCertIdentity_Mapping_DynamicMetadata{
+				DynamicMetadata: h.Clone().(*ClientCertIdentity_DynamicMetadata),
+			}
+		} else {
+			target.Destination = &ClientCertIdentity_Mapping_DynamicMetadata{
+				DynamicMetadata: proto.Clone(m.GetDynamicMetadata()).(*ClientCertIdentity_DynamicMetadata),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ClientCertIdentity_DynamicMetadata) Clone() proto.Message {
+	var target *ClientCertIdentity_DynamicMetadata
+	if m == nil {
+		return target
+	}
+	target = &ClientCertIdentity_DynamicMetadata{}
+
+	target.MetadataNamespace = m.GetMetadataNamespace()
+
+	target.Key = m.GetKey()
+
+	return target
+}
@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/client_cert/client_cert.proto
+
+package client_cert
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *ClientCertIdentity) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ClientCertIdentity)
+	if !ok {
+		that2, ok := that.(ClientCertIdentity)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetMappings()) != len(target.GetMappings()) {
+		return false
+	}
+	for idx, v := range m.GetMappings() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMappings()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMappings()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ClientCertIdentity_Mapping) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ClientCertIdentity_Mapping)
+	if !ok {
+		that2, ok := that.(ClientCertIdentity_Mapping)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetField() != target.GetField() {
+		return false
+	}
+
+	switch m.Destination.(type) {
+
+	case *ClientCertIdentity_Mapping_Header:
+		if _, ok := target.Destination.(*ClientCertIdentity_Mapping_Header); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetHeader(), target.GetHeader()) != 0 {
+			return false
+		}
+
+	case *ClientCertIdentity_Mapping_DynamicMetadata:
+		if _, ok := target.Destination.(*ClientCertIdentity_Mapping_DynamicMetadata); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetDynamicMetadata()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDynamicMetadata()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetDynamicMetadata(), target.GetDynamicMetadata()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Destination != target.Destination {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ClientCertIdentity_DynamicMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ClientCertIdentity_DynamicMetadata)
+	if !ok {
+		that2, ok := that.(ClientCertIdentity_DynamicMetadata)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetMetadataNamespace(), target.GetMetadataNamespace()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetKey(), target.GetKey()) != 0 {
+		return false
+	}
+
+	return true
+}
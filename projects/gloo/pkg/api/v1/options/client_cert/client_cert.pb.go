@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/client_cert/client_cert.proto
+
+package client_cert
+
+import (
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The fields of a client certificate.
+type ClientCertIdentity_Field int32
+
+const (
+	// The SPIFFE ID of the certificate, which is its first URI SAN with the `spiffe://` scheme.
+	ClientCertIdentity_SPIFFE_ID ClientCertIdentity_Field = 0
+	// The URI SANs of the certificate, separated by commas.
+	ClientCertIdentity_URI_SAN ClientCertIdentity_Field = 1
+	// The DNS SANs of the certificate, separated by commas.
+	ClientCertIdentity_DNS_SAN ClientCertIdentity_Field = 2
+	// The subject of the certificate, in RFC 2253 format.
+	ClientCertIdentity_SUBJECT ClientCertIdentity_Field = 3
+	// The hex-encoded SHA256 digest of the certificate.
+	ClientCertIdentity_SHA256_DIGEST ClientCertIdentity_Field = 4
+)
+
+// Enum value maps for ClientCertIdentity_Field.
+var (
+	ClientCertIdentity_Field_name = map[int32]string{
+		0: "SPIFFE_ID",
+		1: "URI_SAN",
+		2: "DNS_SAN",
+		3: "SUBJECT",
+		4: "SHA256_DIGEST",
+	}
+	ClientCertIdentity_Field_value = map[string]int32{
+		"SPIFFE_ID":     0,
+		"URI_SAN":       1,
+		"DNS_SAN":       2,
+		"SUBJECT":       3,
+		"SHA256_DIGEST": 4,
+	}
+)
+
+func (x ClientCertIdentity_Field) Enum() *ClientCertIdentity_Field {
+	p := new(ClientCertIdentity_Field)
+	*p = x
+	return p
+}
+
+func (x ClientCertIdentity_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClientCertIdentity_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_enumTypes[0].Descriptor()
+}
+
+func (ClientCertIdentity_Field) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_enumTypes[0]
+}
+
+func (x ClientCertIdentity_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClientCertIdentity_Field.Descriptor instead.
+func (ClientCertIdentity_Field) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// ClientCertIdentity maps the identity of the client certificate of a mutual TLS connection to request headers or
+// dynamic metadata, so that the upstream services and the filters which run after it, such as ext auth and rate limiting,
+// can act on it. The identity is extracted by an Envoy [Lua filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/lua_filter),
+// which runs before the authentication filters.
+// The mapped headers are always removed from the requests first, so that clients cannot set them,
+// even when they do not present a certificate.
+// To forward the whole certificate in the `x-forwarded-client-cert` header instead, use the `forwardClientCertDetails`
+// and `setCurrentClientCertDetails` settings of the HTTP connection manager.
+type ClientCertIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fields of the client certificate to map. At least one mapping is required.
+	Mappings []*ClientCertIdentity_Mapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+}
+
+func (x *ClientCertIdentity) Reset() {
+	*x = ClientCertIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientCertIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientCertIdentity) ProtoMessage() {}
+
+func (x *ClientCertIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientCertIdentity.ProtoReflect.Descriptor instead.
+func (*ClientCertIdentity) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientCertIdentity) GetMappings() []*ClientCertIdentity_Mapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// Maps a field of the client certificate to a request header or to dynamic metadata.
+// Fields which the certificate does not have, such as a SPIFFE ID, are not mapped.
+type ClientCertIdentity_Mapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The field of the client certificate to map.
+	Field ClientCertIdentity_Field `protobuf:"varint,1,opt,name=field,proto3,enum=client_cert.options.gloo.solo.io.ClientCertIdentity_Field" json:"field,omitempty"`
+	// Types that are assignable to Destination:
+	//
+	//	*ClientCertIdentity_Mapping_Header
+	//	*ClientCertIdentity_Mapping_DynamicMetadata
+	Destination isClientCertIdentity_Mapping_Destination `protobuf_oneof:"destination"`
+}
+
+func (x *ClientCertIdentity_Mapping) Reset() {
+	*x = ClientCertIdentity_Mapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientCertIdentity_Mapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientCertIdentity_Mapping) ProtoMessage() {}
+
+func (x *ClientCertIdentity_Mapping) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientCertIdentity_Mapping.ProtoReflect.Descriptor instead.
+func (*ClientCertIdentity_Mapping) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ClientCertIdentity_Mapping) GetField() ClientCertIdentity_Field {
+	if x != nil {
+		return x.Field
+	}
+	return ClientCertIdentity_SPIFFE_ID
+}
+
+func (m *ClientCertIdentity_Mapping) GetDestination() isClientCertIdentity_Mapping_Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (x *ClientCertIdentity_Mapping) GetHeader() string {
+	if x, ok := x.GetDestination().(*ClientCertIdentity_Mapping_Header); ok {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *ClientCertIdentity_Mapping) GetDynamicMetadata() *ClientCertIdentity_DynamicMetadata {
+	if x, ok := x.GetDestination().(*ClientCertIdentity_Mapping_DynamicMetadata); ok {
+		return x.DynamicMetadata
+	}
+	return nil
+}
+
+type isClientCertIdentity_Mapping_Destination interface {
+	isClientCertIdentity_Mapping_Destination()
+}
+
+type ClientCertIdentity_Mapping_Header struct {
+	// The name of the request header to set to the field.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3,oneof"`
+}
+
+type ClientCertIdentity_Mapping_DynamicMetadata struct {
+	// The dynamic metadata entry to set to the field.
+	DynamicMetadata *ClientCertIdentity_DynamicMetadata `protobuf:"bytes,3,opt,name=dynamic_metadata,json=dynamicMetadata,proto3,oneof"`
+}
+
+func (*ClientCertIdentity_Mapping_Header) isClientCertIdentity_Mapping_Destination() {}
+
+func (*ClientCertIdentity_Mapping_DynamicMetadata) isClientCertIdentity_Mapping_Destination() {}
+
+// A dynamic metadata entry, which can be matched by the filters and the access logs which run after the Lua filter.
+type ClientCertIdentity_DynamicMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The metadata namespace of the entry. Defaults to `io.solo.client_cert`.
+	MetadataNamespace string `protobuf:"bytes,1,opt,name=metadata_namespace,json=metadataNamespace,proto3" json:"metadata_namespace,omitempty"`
+	// The key of the entry in the metadata namespace. Required.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ClientCertIdentity_DynamicMetadata) Reset() {
+	*x = ClientCertIdentity_DynamicMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientCertIdentity_DynamicMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientCertIdentity_DynamicMetadata) ProtoMessage() {}
+
+func (x *ClientCertIdentity_DynamicMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientCertIdentity_DynamicMetadata.ProtoReflect.Descriptor instead.
+func (*ClientCertIdentity_DynamicMetadata) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ClientCertIdentity_DynamicMetadata) GetMetadataNamespace() string {
+	if x != nil {
+		return x.MetadataNamespace
+	}
+	return ""
+}
+
+func (x *ClientCertIdentity_DynamicMetadata) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDesc = []byte{
+	0x0a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x04, 0x0a, 0x12, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x58, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0xf7, 0x01, 0x0a, 0x07,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x65, 0x72, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x10, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x52, 0x0a, 0x0f, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x50, 0x0a, 0x05, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x5f, 0x49, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x52, 0x49, 0x5f, 0x53, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x4e, 0x53, 0x5f, 0x53, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x55, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x48, 0x41, 0x32,
+	0x35, 0x36, 0x5f, 0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x10, 0x04, 0x42, 0x52, 0xb8, 0xf5, 0x04,
+	0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_goTypes = []interface{}{
+	(ClientCertIdentity_Field)(0),              // 0: client_cert.options.gloo.solo.io.ClientCertIdentity.Field
+	(*ClientCertIdentity)(nil),                 // 1: client_cert.options.gloo.solo.io.ClientCertIdentity
+	(*ClientCertIdentity_Mapping)(nil),         // 2: client_cert.options.gloo.solo.io.ClientCertIdentity.Mapping
+	(*ClientCertIdentity_DynamicMetadata)(nil), // 3: client_cert.options.gloo.solo.io.ClientCertIdentity.DynamicMetadata
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_depIdxs = []int32{
+	2, // 0: client_cert.options.gloo.solo.io.ClientCertIdentity.mappings:type_name -> client_cert.options.gloo.solo.io.ClientCertIdentity.Mapping
+	0, // 1: client_cert.options.gloo.solo.io.ClientCertIdentity.Mapping.field:type_name -> client_cert.options.gloo.solo.io.ClientCertIdentity.Field
+	3, // 2: client_cert.options.gloo.solo.io.ClientCertIdentity.Mapping.dynamic_metadata:type_name -> client_cert.options.gloo.solo.io.ClientCertIdentity.DynamicMetadata
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_init()
+}
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientCertIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientCertIdentity_Mapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientCertIdentity_DynamicMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*ClientCertIdentity_Mapping_Header)(nil),
+		(*ClientCertIdentity_Mapping_DynamicMetadata)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_client_cert_client_cert_proto_depIdxs = nil
+}
@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/client_cert/client_cert.proto
+
+package client_cert
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+
+	safe_hasher "github.com/solo-io/protoc-gen-ext/pkg/hasher"
+	"github.com/solo-io/protoc-gen-ext/pkg/hasher/hashstructure"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = new(hash.Hash64)
+	_ = fnv.New64
+	_ = hashstructure.Hash
+	_ = new(safe_hasher.SafeHasher)
+)
+
+// Hash function
+func (m *ClientCertIdentity) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("client_cert.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/client_cert.ClientCertIdentity")); err != nil {
+		return 0, err
+	}
+
+	for _, v := range m.GetMappings() {
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *ClientCertIdentity_Mapping) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("client_cert.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/client_cert.ClientCertIdentity_Mapping")); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetField())
+	if err != nil {
+		return 0, err
+	}
+
+	switch m.Destination.(type) {
+
+	case *ClientCertIdentity_Mapping_Header:
+
+		if _, err = hasher.Write([]byte(m.GetHeader())); err != nil {
+			return 0, err
+		}
+
+	case *ClientCertIdentity_Mapping_DynamicMetadata:
+
+		if h, ok := interface{}(m.GetDynamicMetadata()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("DynamicMetadata")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetDynamicMetadata(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("DynamicMetadata")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *ClientCertIdentity_DynamicMetadata) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("client_cert.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/client_cert.ClientCertIdentity_DynamicMetadata")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetMetadataNamespace())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetKey())); err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
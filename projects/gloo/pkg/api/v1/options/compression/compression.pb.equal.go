@@ -0,0 +1,391 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto
+
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *Compression) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Compression)
+	if !ok {
+		that2, ok := that.(Compression)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetContentType()) != len(target.GetContentType()) {
+		return false
+	}
+	for idx, v := range m.GetContentType() {
+
+		if strings.Compare(v, target.GetContentType()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetMinContentLength()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMinContentLength()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMinContentLength(), target.GetMinContentLength()) {
+			return false
+		}
+	}
+
+	if m.GetDisableOnEtagHeader() != target.GetDisableOnEtagHeader() {
+		return false
+	}
+
+	if m.GetRemoveAcceptEncodingHeader() != target.GetRemoveAcceptEncodingHeader() {
+		return false
+	}
+
+	if len(m.GetCompressors()) != len(target.GetCompressors()) {
+		return false
+	}
+	for idx, v := range m.GetCompressors() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCompressors()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetCompressors()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetDecompression()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDecompression()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDecompression(), target.GetDecompression()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Compressor) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Compressor)
+	if !ok {
+		that2, ok := that.(Compressor)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.CompressorType.(type) {
+
+	case *Compressor_Gzip:
+		if _, ok := target.CompressorType.(*Compressor_Gzip); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetGzip()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetGzip()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetGzip(), target.GetGzip()) {
+				return false
+			}
+		}
+
+	case *Compressor_Brotli:
+		if _, ok := target.CompressorType.(*Compressor_Brotli); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetBrotli()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetBrotli()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetBrotli(), target.GetBrotli()) {
+				return false
+			}
+		}
+
+	case *Compressor_Zstd:
+		if _, ok := target.CompressorType.(*Compressor_Zstd); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetZstd()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetZstd()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetZstd(), target.GetZstd()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.CompressorType != target.CompressorType {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Gzip) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Gzip)
+	if !ok {
+		that2, ok := that.(Gzip)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMemoryLevel()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMemoryLevel()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMemoryLevel(), target.GetMemoryLevel()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCompressionLevel()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompressionLevel()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompressionLevel(), target.GetCompressionLevel()) {
+			return false
+		}
+	}
+
+	if m.GetCompressionStrategy() != target.GetCompressionStrategy() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetWindowBits()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetWindowBits()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetWindowBits(), target.GetWindowBits()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Brotli) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Brotli)
+	if !ok {
+		that2, ok := that.(Brotli)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetQuality()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetQuality()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetQuality(), target.GetQuality()) {
+			return false
+		}
+	}
+
+	if m.GetEncoderMode() != target.GetEncoderMode() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetWindowBits()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetWindowBits()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetWindowBits(), target.GetWindowBits()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Zstd) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Zstd)
+	if !ok {
+		that2, ok := that.(Zstd)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetCompressionLevel()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCompressionLevel()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCompressionLevel(), target.GetCompressionLevel()) {
+			return false
+		}
+	}
+
+	if m.GetEnableChecksum() != target.GetEnableChecksum() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Decompression) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Decompression)
+	if !ok {
+		that2, ok := that.(Decompression)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetAlgorithms()) != len(target.GetAlgorithms()) {
+		return false
+	}
+	for idx, v := range m.GetAlgorithms() {
+
+		if v != target.GetAlgorithms()[idx] {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CompressionPerRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CompressionPerRoute)
+	if !ok {
+		that2, ok := that.(CompressionPerRoute)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetDisabled() != target.GetDisabled() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,1001 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto
+
+package compression
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Gzip_CompressionStrategy int32
+
+const (
+	Gzip_DEFAULT_STRATEGY Gzip_CompressionStrategy = 0
+	Gzip_FILTERED         Gzip_CompressionStrategy = 1
+	Gzip_HUFFMAN_ONLY     Gzip_CompressionStrategy = 2
+	Gzip_RLE              Gzip_CompressionStrategy = 3
+	Gzip_FIXED            Gzip_CompressionStrategy = 4
+)
+
+// Enum value maps for Gzip_CompressionStrategy.
+var (
+	Gzip_CompressionStrategy_name = map[int32]string{
+		0: "DEFAULT_STRATEGY",
+		1: "FILTERED",
+		2: "HUFFMAN_ONLY",
+		3: "RLE",
+		4: "FIXED",
+	}
+	Gzip_CompressionStrategy_value = map[string]int32{
+		"DEFAULT_STRATEGY": 0,
+		"FILTERED":         1,
+		"HUFFMAN_ONLY":     2,
+		"RLE":              3,
+		"FIXED":            4,
+	}
+)
+
+func (x Gzip_CompressionStrategy) Enum() *Gzip_CompressionStrategy {
+	p := new(Gzip_CompressionStrategy)
+	*p = x
+	return p
+}
+
+func (x Gzip_CompressionStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Gzip_CompressionStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[0].Descriptor()
+}
+
+func (Gzip_CompressionStrategy) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[0]
+}
+
+func (x Gzip_CompressionStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Gzip_CompressionStrategy.Descriptor instead.
+func (Gzip_CompressionStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type Brotli_EncoderMode int32
+
+const (
+	Brotli_DEFAULT Brotli_EncoderMode = 0
+	Brotli_GENERIC Brotli_EncoderMode = 1
+	Brotli_TEXT    Brotli_EncoderMode = 2
+	Brotli_FONT    Brotli_EncoderMode = 3
+)
+
+// Enum value maps for Brotli_EncoderMode.
+var (
+	Brotli_EncoderMode_name = map[int32]string{
+		0: "DEFAULT",
+		1: "GENERIC",
+		2: "TEXT",
+		3: "FONT",
+	}
+	Brotli_EncoderMode_value = map[string]int32{
+		"DEFAULT": 0,
+		"GENERIC": 1,
+		"TEXT":    2,
+		"FONT":    3,
+	}
+)
+
+func (x Brotli_EncoderMode) Enum() *Brotli_EncoderMode {
+	p := new(Brotli_EncoderMode)
+	*p = x
+	return p
+}
+
+func (x Brotli_EncoderMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Brotli_EncoderMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[1].Descriptor()
+}
+
+func (Brotli_EncoderMode) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[1]
+}
+
+func (x Brotli_EncoderMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Brotli_EncoderMode.Descriptor instead.
+func (Brotli_EncoderMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type Decompression_Algorithm int32
+
+const (
+	Decompression_GZIP   Decompression_Algorithm = 0
+	Decompression_BROTLI Decompression_Algorithm = 1
+	Decompression_ZSTD   Decompression_Algorithm = 2
+)
+
+// Enum value maps for Decompression_Algorithm.
+var (
+	Decompression_Algorithm_name = map[int32]string{
+		0: "GZIP",
+		1: "BROTLI",
+		2: "ZSTD",
+	}
+	Decompression_Algorithm_value = map[string]int32{
+		"GZIP":   0,
+		"BROTLI": 1,
+		"ZSTD":   2,
+	}
+)
+
+func (x Decompression_Algorithm) Enum() *Decompression_Algorithm {
+	p := new(Decompression_Algorithm)
+	*p = x
+	return p
+}
+
+func (x Decompression_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Decompression_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[2].Descriptor()
+}
+
+func (Decompression_Algorithm) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes[2]
+}
+
+func (x Decompression_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Decompression_Algorithm.Descriptor instead.
+func (Decompression_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// Compression configures Envoy to compress the responses of upstream services with the algorithms accepted by the
+// client, and to decompress the requests sent by the client.
+// Each compressor is configured as a separate
+// [compressor filter](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/compressor_filter),
+// Envoy uses the one with the encoding the client prefers according to its `Accept-Encoding` header.
+// Example:
+// ```
+// compression:
+//
+//	contentType:
+//	- "application/json"
+//	compressors:
+//	- brotli: {}
+//	- zstd: {}
+//	- gzip: {}
+//	decompression:
+//	  algorithms:
+//	  - GZIP
+//
+// ```
+type Compression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Set of strings that allows specifying which mime-types yield compression; e.g.,
+	// application/json, text/html, etc. When this field is not defined, compression will be applied
+	// to the following mime-types: "application/javascript", "application/json",
+	// "application/xhtml+xml", "image/svg+xml", "text/css", "text/html", "text/plain", "text/xml".
+	ContentType []string `protobuf:"bytes,1,rep,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Minimum response length, in bytes, which will trigger compression. The default value is 30.
+	MinContentLength *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=min_content_length,json=minContentLength,proto3" json:"min_content_length,omitempty"`
+	// If true, disables compression when the response contains an etag header. When it is false, the
+	// filter will preserve weak etags and remove the ones that require strong validation.
+	DisableOnEtagHeader bool `protobuf:"varint,3,opt,name=disable_on_etag_header,json=disableOnEtagHeader,proto3" json:"disable_on_etag_header,omitempty"`
+	// If true, removes accept-encoding from the request headers before dispatching it to the upstream
+	// so that responses do not get compressed before reaching the filter.
+	RemoveAcceptEncodingHeader bool `protobuf:"varint,4,opt,name=remove_accept_encoding_header,json=removeAcceptEncodingHeader,proto3" json:"remove_accept_encoding_header,omitempty"`
+	// The compressors to use for the responses. Each algorithm can be configured at most once.
+	Compressors []*Compressor `protobuf:"bytes,5,rep,name=compressors,proto3" json:"compressors,omitempty"`
+	// Decompress the requests with a supported content encoding before they are sent to the upstream.
+	Decompression *Decompression `protobuf:"bytes,6,opt,name=decompression,proto3" json:"decompression,omitempty"`
+}
+
+func (x *Compression) Reset() {
+	*x = Compression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compression) ProtoMessage() {}
+
+func (x *Compression) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compression.ProtoReflect.Descriptor instead.
+func (*Compression) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Compression) GetContentType() []string {
+	if x != nil {
+		return x.ContentType
+	}
+	return nil
+}
+
+func (x *Compression) GetMinContentLength() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MinContentLength
+	}
+	return nil
+}
+
+func (x *Compression) GetDisableOnEtagHeader() bool {
+	if x != nil {
+		return x.DisableOnEtagHeader
+	}
+	return false
+}
+
+func (x *Compression) GetRemoveAcceptEncodingHeader() bool {
+	if x != nil {
+		return x.RemoveAcceptEncodingHeader
+	}
+	return false
+}
+
+func (x *Compression) GetCompressors() []*Compressor {
+	if x != nil {
+		return x.Compressors
+	}
+	return nil
+}
+
+func (x *Compression) GetDecompression() *Decompression {
+	if x != nil {
+		return x.Decompression
+	}
+	return nil
+}
+
+type Compressor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to CompressorType:
+	//
+	//	*Compressor_Gzip
+	//	*Compressor_Brotli
+	//	*Compressor_Zstd
+	CompressorType isCompressor_CompressorType `protobuf_oneof:"compressor_type"`
+}
+
+func (x *Compressor) Reset() {
+	*x = Compressor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compressor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compressor) ProtoMessage() {}
+
+func (x *Compressor) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compressor.ProtoReflect.Descriptor instead.
+func (*Compressor) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *Compressor) GetCompressorType() isCompressor_CompressorType {
+	if m != nil {
+		return m.CompressorType
+	}
+	return nil
+}
+
+func (x *Compressor) GetGzip() *Gzip {
+	if x, ok := x.GetCompressorType().(*Compressor_Gzip); ok {
+		return x.Gzip
+	}
+	return nil
+}
+
+func (x *Compressor) GetBrotli() *Brotli {
+	if x, ok := x.GetCompressorType().(*Compressor_Brotli); ok {
+		return x.Brotli
+	}
+	return nil
+}
+
+func (x *Compressor) GetZstd() *Zstd {
+	if x, ok := x.GetCompressorType().(*Compressor_Zstd); ok {
+		return x.Zstd
+	}
+	return nil
+}
+
+type isCompressor_CompressorType interface {
+	isCompressor_CompressorType()
+}
+
+type Compressor_Gzip struct {
+	// Compress with gzip, using the `gzip` content encoding.
+	Gzip *Gzip `protobuf:"bytes,1,opt,name=gzip,proto3,oneof"`
+}
+
+type Compressor_Brotli struct {
+	// Compress with brotli, using the `br` content encoding.
+	Brotli *Brotli `protobuf:"bytes,2,opt,name=brotli,proto3,oneof"`
+}
+
+type Compressor_Zstd struct {
+	// Compress with zstd, using the `zstd` content encoding.
+	Zstd *Zstd `protobuf:"bytes,3,opt,name=zstd,proto3,oneof"`
+}
+
+func (*Compressor_Gzip) isCompressor_CompressorType() {}
+
+func (*Compressor_Brotli) isCompressor_CompressorType() {}
+
+func (*Compressor_Zstd) isCompressor_CompressorType() {}
+
+type Gzip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value from 1 to 9 that controls the amount of internal memory used by zlib. Higher values
+	// use more memory, but are faster and produce better compression results. The default value is 5.
+	MemoryLevel *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=memory_level,json=memoryLevel,proto3" json:"memory_level,omitempty"`
+	// Value from 1 to 9 that selects the zlib compression level, where 1 is the fastest and 9 provides the best
+	// compression. Defaults to the zlib default compression level.
+	CompressionLevel *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// The zlib compression strategy, which is directly related to the characteristics of the content.
+	CompressionStrategy Gzip_CompressionStrategy `protobuf:"varint,3,opt,name=compression_strategy,json=compressionStrategy,proto3,enum=compression.options.gloo.solo.io.Gzip_CompressionStrategy" json:"compression_strategy,omitempty"`
+	// Value from 9 to 15 that represents the base two logarithmic of the compressor's window size.
+	// Larger window results in better compression at the expense of memory usage. The default is 12
+	// which will produce a 4096 bytes window.
+	WindowBits *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=window_bits,json=windowBits,proto3" json:"window_bits,omitempty"`
+}
+
+func (x *Gzip) Reset() {
+	*x = Gzip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gzip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gzip) ProtoMessage() {}
+
+func (x *Gzip) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gzip.ProtoReflect.Descriptor instead.
+func (*Gzip) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Gzip) GetMemoryLevel() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MemoryLevel
+	}
+	return nil
+}
+
+func (x *Gzip) GetCompressionLevel() *wrappers.UInt32Value {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return nil
+}
+
+func (x *Gzip) GetCompressionStrategy() Gzip_CompressionStrategy {
+	if x != nil {
+		return x.CompressionStrategy
+	}
+	return Gzip_DEFAULT_STRATEGY
+}
+
+func (x *Gzip) GetWindowBits() *wrappers.UInt32Value {
+	if x != nil {
+		return x.WindowBits
+	}
+	return nil
+}
+
+type Brotli struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value from 0 to 11 that controls the compression quality. Higher values provide better compression at the
+	// expense of speed. The default value is 3.
+	Quality *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=quality,proto3" json:"quality,omitempty"`
+	// Tunes the encoder for the type of the content.
+	EncoderMode Brotli_EncoderMode `protobuf:"varint,2,opt,name=encoder_mode,json=encoderMode,proto3,enum=compression.options.gloo.solo.io.Brotli_EncoderMode" json:"encoder_mode,omitempty"`
+	// Value from 10 to 24 that represents the base two logarithmic of the compressor's window size.
+	// Larger window results in better compression at the expense of memory usage. The default is 18.
+	WindowBits *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=window_bits,json=windowBits,proto3" json:"window_bits,omitempty"`
+}
+
+func (x *Brotli) Reset() {
+	*x = Brotli{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Brotli) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Brotli) ProtoMessage() {}
+
+func (x *Brotli) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Brotli.ProtoReflect.Descriptor instead.
+func (*Brotli) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Brotli) GetQuality() *wrappers.UInt32Value {
+	if x != nil {
+		return x.Quality
+	}
+	return nil
+}
+
+func (x *Brotli) GetEncoderMode() Brotli_EncoderMode {
+	if x != nil {
+		return x.EncoderMode
+	}
+	return Brotli_DEFAULT
+}
+
+func (x *Brotli) GetWindowBits() *wrappers.UInt32Value {
+	if x != nil {
+		return x.WindowBits
+	}
+	return nil
+}
+
+type Zstd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The zstd compression level. Higher values provide better compression at the expense of speed.
+	// The default value is 3.
+	CompressionLevel *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=compression_level,json=compressionLevel,proto3" json:"compression_level,omitempty"`
+	// If true, a 32-bit checksum of the content is written at the end of each frame.
+	EnableChecksum bool `protobuf:"varint,2,opt,name=enable_checksum,json=enableChecksum,proto3" json:"enable_checksum,omitempty"`
+}
+
+func (x *Zstd) Reset() {
+	*x = Zstd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Zstd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zstd) ProtoMessage() {}
+
+func (x *Zstd) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zstd.ProtoReflect.Descriptor instead.
+func (*Zstd) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Zstd) GetCompressionLevel() *wrappers.UInt32Value {
+	if x != nil {
+		return x.CompressionLevel
+	}
+	return nil
+}
+
+func (x *Zstd) GetEnableChecksum() bool {
+	if x != nil {
+		return x.EnableChecksum
+	}
+	return false
+}
+
+type Decompression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The content encodings of the requests to decompress. Each algorithm can be configured at most once.
+	Algorithms []Decompression_Algorithm `protobuf:"varint,1,rep,packed,name=algorithms,proto3,enum=compression.options.gloo.solo.io.Decompression_Algorithm" json:"algorithms,omitempty"`
+}
+
+func (x *Decompression) Reset() {
+	*x = Decompression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decompression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decompression) ProtoMessage() {}
+
+func (x *Decompression) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decompression.ProtoReflect.Descriptor instead.
+func (*Decompression) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Decompression) GetAlgorithms() []Decompression_Algorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+// Compression settings of a virtual host or a route.
+type CompressionPerRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disable the compression of the responses of the virtual host or route.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *CompressionPerRoute) Reset() {
+	*x = CompressionPerRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompressionPerRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressionPerRoute) ProtoMessage() {}
+
+func (x *CompressionPerRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressionPerRoute.ProtoReflect.Descriptor instead.
+func (*CompressionPerRoute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CompressionPerRoute) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc = []byte{
+	0x0a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01,
+	0x02, 0x10, 0x32, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x4a, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x16,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x65, 0x74, 0x61, 0x67, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x45, 0x74, 0x61, 0x67, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdf, 0x01, 0x0a, 0x0a,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x04, 0x67, 0x7a,
+	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x7a, 0x69, 0x70,
+	0x48, 0x00, 0x52, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x12, 0x42, 0x0a, 0x06, 0x62, 0x72, 0x6f, 0x74,
+	0x6c, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x42, 0x72, 0x6f, 0x74,
+	0x6c, 0x69, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x74, 0x6c, 0x69, 0x12, 0x3c, 0x0a, 0x04,
+	0x7a, 0x73, 0x74, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x5a, 0x73,
+	0x74, 0x64, 0x48, 0x00, 0x52, 0x04, 0x7a, 0x73, 0x74, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xcc, 0x03,
+	0x0a, 0x04, 0x47, 0x7a, 0x69, 0x70, 0x12, 0x4a, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a,
+	0x04, 0x18, 0x09, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x54, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06,
+	0x2a, 0x04, 0x18, 0x09, 0x28, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x77, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x7a, 0x69, 0x70, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x13, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x48, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x69, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x0f, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x69, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4c, 0x54,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x55, 0x46, 0x46, 0x4d, 0x41,
+	0x4e, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x4c, 0x45, 0x10,
+	0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x04, 0x22, 0xb3, 0x02, 0x0a,
+	0x06, 0x42, 0x72, 0x6f, 0x74, 0x6c, 0x69, 0x12, 0x3f, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x0b, 0x52,
+	0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x61, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x42, 0x72, 0x6f, 0x74, 0x6c, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x18, 0x28, 0x0a, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x42, 0x69, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x49, 0x43, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x4f, 0x4e, 0x54,
+	0x10, 0x03, 0x22, 0x7a, 0x0a, 0x04, 0x5a, 0x73, 0x74, 0x64, 0x12, 0x49, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0xa6,
+	0x01, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x68, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42,
+	0x0d, 0xfa, 0x42, 0x0a, 0x92, 0x01, 0x07, 0x22, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x22, 0x2b, 0x0a, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x5a, 0x49, 0x50, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x4f, 0x54, 0x4c, 0x49, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x5a, 0x53, 0x54, 0x44, 0x10, 0x02, 0x22, 0x31, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x52, 0xb8, 0xf5, 0x04, 0x01,
+	0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData = file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes = []interface{}{
+	(Gzip_CompressionStrategy)(0), // 0: compression.options.gloo.solo.io.Gzip.CompressionStrategy
+	(Brotli_EncoderMode)(0),       // 1: compression.options.gloo.solo.io.Brotli.EncoderMode
+	(Decompression_Algorithm)(0),  // 2: compression.options.gloo.solo.io.Decompression.Algorithm
+	(*Compression)(nil),           // 3: compression.options.gloo.solo.io.Compression
+	(*Compressor)(nil),            // 4: compression.options.gloo.solo.io.Compressor
+	(*Gzip)(nil),                  // 5: compression.options.gloo.solo.io.Gzip
+	(*Brotli)(nil),                // 6: compression.options.gloo.solo.io.Brotli
+	(*Zstd)(nil),                  // 7: compression.options.gloo.solo.io.Zstd
+	(*Decompression)(nil),         // 8: compression.options.gloo.solo.io.Decompression
+	(*CompressionPerRoute)(nil),   // 9: compression.options.gloo.solo.io.CompressionPerRoute
+	(*wrappers.UInt32Value)(nil),  // 10: google.protobuf.UInt32Value
+}
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs = []int32{
+	10, // 0: compression.options.gloo.solo.io.Compression.min_content_length:type_name -> google.protobuf.UInt32Value
+	4,  // 1: compression.options.gloo.solo.io.Compression.compressors:type_name -> compression.options.gloo.solo.io.Compressor
+	8,  // 2: compression.options.gloo.solo.io.Compression.decompression:type_name -> compression.options.gloo.solo.io.Decompression
+	5,  // 3: compression.options.gloo.solo.io.Compressor.gzip:type_name -> compression.options.gloo.solo.io.Gzip
+	6,  // 4: compression.options.gloo.solo.io.Compressor.brotli:type_name -> compression.options.gloo.solo.io.Brotli
+	7,  // 5: compression.options.gloo.solo.io.Compressor.zstd:type_name -> compression.options.gloo.solo.io.Zstd
+	10, // 6: compression.options.gloo.solo.io.Gzip.memory_level:type_name -> google.protobuf.UInt32Value
+	10, // 7: compression.options.gloo.solo.io.Gzip.compression_level:type_name -> google.protobuf.UInt32Value
+	0,  // 8: compression.options.gloo.solo.io.Gzip.compression_strategy:type_name -> compression.options.gloo.solo.io.Gzip.CompressionStrategy
+	10, // 9: compression.options.gloo.solo.io.Gzip.window_bits:type_name -> google.protobuf.UInt32Value
+	10, // 10: compression.options.gloo.solo.io.Brotli.quality:type_name -> google.protobuf.UInt32Value
+	1,  // 11: compression.options.gloo.solo.io.Brotli.encoder_mode:type_name -> compression.options.gloo.solo.io.Brotli.EncoderMode
+	10, // 12: compression.options.gloo.solo.io.Brotli.window_bits:type_name -> google.protobuf.UInt32Value
+	10, // 13: compression.options.gloo.solo.io.Zstd.compression_level:type_name -> google.protobuf.UInt32Value
+	2,  // 14: compression.options.gloo.solo.io.Decompression.algorithms:type_name -> compression.options.gloo.solo.io.Decompression.Algorithm
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_init()
+}
+func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_init() {
+	if File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compressor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gzip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Brotli); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Zstd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Decompression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompressionPerRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Compressor_Gzip)(nil),
+		(*Compressor_Brotli)(nil),
+		(*Compressor_Zstd)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto = out.File
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_goTypes = nil
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_compression_compression_proto_depIdxs = nil
+}
@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo/projects/gloo/api/v1/options/compression/compression.proto
+
+package compression
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+
+	safe_hasher "github.com/solo-io/protoc-gen-ext/pkg/hasher"
+	"github.com/solo-io/protoc-gen-ext/pkg/hasher/hashstructure"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = new(hash.Hash64)
+	_ = fnv.New64
+	_ = hashstructure.Hash
+	_ = new(safe_hasher.SafeHasher)
+)
+
+// Hash function
+func (m *Compression) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("compression.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression.Compression")); err != nil {
+		return 0, err
+	}
+
+	for _, v := range m.GetContentType() {
+
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetMinContentLength()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MinContentLength")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMinContentLength(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MinContentLength")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetDisableOnEtagHeader())
+	if err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetRemoveAcceptEncodingHeader())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, v := range m.GetCompressors() {
+
+		if h, ok := interface{}(v).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(v, nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetDecompression()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Decompression")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetDecompression(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Decompression")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *Compressor) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("compression.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression.Compressor")); err != nil {
+		return 0, err
+	}
+
+	switch m.CompressorType.(type) {
+
+	case *Compressor_Gzip:
+
+		if h, ok := interface{}(m.GetGzip()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Gzip")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetGzip(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Gzip")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *Compressor_Brotli:
+
+		if h, ok := interface{}(m.GetBrotli()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Brotli")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetBrotli(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Brotli")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *Compressor_Zstd:
+
+		if h, ok := interface{}(m.GetZstd()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Zstd")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetZstd(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Zstd")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *Gzip) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("compression.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression.Gzip")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetMemoryLevel()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MemoryLevel")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMemoryLevel(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MemoryLevel")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetCompressionLevel()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("CompressionLevel")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCompressionLevel(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("CompressionLevel")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetCompressionStrategy())
+	if err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetWindowBits()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("WindowBits")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetWindowBits(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("WindowBits")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *Brotli) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("compression.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression.Brotli")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetQuality()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Quality")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetQuality(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Quality")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetEncoderMode())
+	if err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetWindowBits()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("WindowBits")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetWindowBits(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("WindowBits")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *Zstd) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("compression.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression.Zstd")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetCompressionLevel()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("CompressionLevel")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetCompressionLevel(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("CompressionLevel")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetEnableChecksum())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *Decompression) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("compression.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression.Decompression")); err != nil {
+		return 0, err
+	}
+
+	for _, v := range m.GetAlgorithms() {
+
+		err = binary.Write(hasher, binary.LittleEndian, v)
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *CompressionPerRoute) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("compression.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression.CompressionPerRoute")); err != nil {
+		return 0, err
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetDisabled())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
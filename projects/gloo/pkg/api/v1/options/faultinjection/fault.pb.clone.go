@@ -14,6 +14,10 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 )
 
 // ensure the imports are used
@@ -39,6 +43,14 @@ func (m *RouteAbort) Clone() proto.Message {
 
 	target.HttpStatus = m.GetHttpStatus()
 
+	if h, ok := interface{}(m.GetGrpcStatus()).(clone.Cloner); ok {
+		target.GrpcStatus = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.GrpcStatus = proto.Clone(m.GetGrpcStatus()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	target.HeaderAbort = m.GetHeaderAbort()
+
 	return target
 }
 
@@ -58,6 +70,25 @@ func (m *RouteDelay) Clone() proto.Message {
 		target.FixedDelay = proto.Clone(m.GetFixedDelay()).(*github_com_golang_protobuf_ptypes_duration.Duration)
 	}
 
+	target.HeaderDelay = m.GetHeaderDelay()
+
+	return target
+}
+
+// Clone function
+func (m *ResponseRateLimit) Clone() proto.Message {
+	var target *ResponseRateLimit
+	if m == nil {
+		return target
+	}
+	target = &ResponseRateLimit{}
+
+	target.Percentage = m.GetPercentage()
+
+	target.FixedLimitKbps = m.GetFixedLimitKbps()
+
+	target.HeaderLimit = m.GetHeaderLimit()
+
 	return target
 }
 
@@ -81,5 +112,39 @@ func (m *RouteFaults) Clone() proto.Message {
 		target.Delay = proto.Clone(m.GetDelay()).(*RouteDelay)
 	}
 
+	if h, ok := interface{}(m.GetResponseRateLimit()).(clone.Cloner); ok {
+		target.ResponseRateLimit = h.Clone().(*ResponseRateLimit)
+	} else {
+		target.ResponseRateLimit = proto.Clone(m.GetResponseRateLimit()).(*ResponseRateLimit)
+	}
+
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	if m.GetDownstreamNodes() != nil {
+		target.DownstreamNodes = make([]string, len(m.GetDownstreamNodes()))
+		for idx, v := range m.GetDownstreamNodes() {
+
+			target.DownstreamNodes[idx] = v
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxActiveFaults()).(clone.Cloner); ok {
+		target.MaxActiveFaults = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MaxActiveFaults = proto.Clone(m.GetMaxActiveFaults()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
 	return target
 }
@@ -54,6 +54,20 @@ func (m *RouteAbort) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetGrpcStatus()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGrpcStatus()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGrpcStatus(), target.GetGrpcStatus()) {
+			return false
+		}
+	}
+
+	if m.GetHeaderAbort() != target.GetHeaderAbort() {
+		return false
+	}
+
 	return true
 }
 
@@ -92,6 +106,46 @@ func (m *RouteDelay) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetHeaderDelay() != target.GetHeaderDelay() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ResponseRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ResponseRateLimit)
+	if !ok {
+		that2, ok := that.(ResponseRateLimit)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetPercentage() != target.GetPercentage() {
+		return false
+	}
+
+	if m.GetFixedLimitKbps() != target.GetFixedLimitKbps() {
+		return false
+	}
+
+	if m.GetHeaderLimit() != target.GetHeaderLimit() {
+		return false
+	}
+
 	return true
 }
 
@@ -136,5 +190,53 @@ func (m *RouteFaults) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetResponseRateLimit()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetResponseRateLimit()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetResponseRateLimit(), target.GetResponseRateLimit()) {
+			return false
+		}
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetDownstreamNodes()) != len(target.GetDownstreamNodes()) {
+		return false
+	}
+	for idx, v := range m.GetDownstreamNodes() {
+
+		if strings.Compare(v, target.GetDownstreamNodes()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetMaxActiveFaults()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxActiveFaults()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxActiveFaults(), target.GetMaxActiveFaults()) {
+			return false
+		}
+	}
+
 	return true
 }
@@ -12,6 +12,8 @@ import (
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -33,7 +35,17 @@ type RouteAbort struct {
 	// This should be a value between 0.0 and 100.0, with up to 6 significant digits.
 	Percentage float32 `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	// This should be a standard HTTP status in the range [200, 600), e.g. 503. Defaults to 0.
+	// Only one of `http_status`, `grpc_status` or `header_abort` can be set.
 	HttpStatus uint32 `protobuf:"varint,2,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// gRPC status code to abort gRPC requests with, e.g. 14 for UNAVAILABLE.
+	// Only one of `http_status`, `grpc_status` or `header_abort` can be set.
+	GrpcStatus *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=grpc_status,json=grpcStatus,proto3" json:"grpc_status,omitempty"`
+	// If set to true, requests are aborted with the HTTP status or the gRPC status in the
+	// `x-envoy-fault-abort-request` or `x-envoy-fault-abort-grpc-request` request header.
+	// Requests without either header are not aborted. If `percentage` is not set, it defaults to 100.
+	// The percentage of aborted requests can be lowered with the `x-envoy-fault-abort-request-percentage` header.
+	// Only one of `http_status`, `grpc_status` or `header_abort` can be set.
+	HeaderAbort bool `protobuf:"varint,4,opt,name=header_abort,json=headerAbort,proto3" json:"header_abort,omitempty"`
 }
 
 func (x *RouteAbort) Reset() {
@@ -82,6 +94,20 @@ func (x *RouteAbort) GetHttpStatus() uint32 {
 	return 0
 }
 
+func (x *RouteAbort) GetGrpcStatus() *wrappers.UInt32Value {
+	if x != nil {
+		return x.GrpcStatus
+	}
+	return nil
+}
+
+func (x *RouteAbort) GetHeaderAbort() bool {
+	if x != nil {
+		return x.HeaderAbort
+	}
+	return false
+}
+
 type RouteDelay struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -91,7 +117,13 @@ type RouteDelay struct {
 	// This should be a value between 0.0 and 100.0, with up to 6 significant digits.
 	Percentage float32 `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	// Fixed delay, defaulting to 0. Will be rejected by the control plane if the delay is specified and less than 1 second.
+	// Only one of `fixed_delay` or `header_delay` can be set.
 	FixedDelay *duration.Duration `protobuf:"bytes,2,opt,name=fixed_delay,json=fixedDelay,proto3" json:"fixed_delay,omitempty"`
+	// If set to true, requests are delayed by the number of milliseconds in the `x-envoy-fault-delay-request`
+	// request header. Requests without the header are not delayed. If `percentage` is not set, it defaults to 100.
+	// The percentage of delayed requests can be lowered with the `x-envoy-fault-delay-request-percentage` header.
+	// Only one of `fixed_delay` or `header_delay` can be set.
+	HeaderDelay bool `protobuf:"varint,3,opt,name=header_delay,json=headerDelay,proto3" json:"header_delay,omitempty"`
 }
 
 func (x *RouteDelay) Reset() {
@@ -140,6 +172,87 @@ func (x *RouteDelay) GetFixedDelay() *duration.Duration {
 	return nil
 }
 
+func (x *RouteDelay) GetHeaderDelay() bool {
+	if x != nil {
+		return x.HeaderDelay
+	}
+	return false
+}
+
+// Limits the bandwidth of the response body.
+type ResponseRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage of responses that should be rate limited, defaulting to 0.
+	// This should be a value between 0.0 and 100.0, with up to 6 significant digits.
+	Percentage float32 `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Fixed rate limit, in KiB per second. Must be greater than 0.
+	// Only one of `fixed_limit_kbps` or `header_limit` can be set.
+	FixedLimitKbps uint64 `protobuf:"varint,2,opt,name=fixed_limit_kbps,json=fixedLimitKbps,proto3" json:"fixed_limit_kbps,omitempty"`
+	// If set to true, responses are rate limited to the KiB per second in the `x-envoy-fault-throughput-response`
+	// request header. Responses to requests without the header are not rate limited.
+	// If `percentage` is not set, it defaults to 100.
+	// The percentage of rate limited responses can be lowered with the
+	// `x-envoy-fault-throughput-response-percentage` header.
+	// Only one of `fixed_limit_kbps` or `header_limit` can be set.
+	HeaderLimit bool `protobuf:"varint,3,opt,name=header_limit,json=headerLimit,proto3" json:"header_limit,omitempty"`
+}
+
+func (x *ResponseRateLimit) Reset() {
+	*x = ResponseRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseRateLimit) ProtoMessage() {}
+
+func (x *ResponseRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseRateLimit.ProtoReflect.Descriptor instead.
+func (*ResponseRateLimit) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResponseRateLimit) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *ResponseRateLimit) GetFixedLimitKbps() uint64 {
+	if x != nil {
+		return x.FixedLimitKbps
+	}
+	return 0
+}
+
+func (x *ResponseRateLimit) GetHeaderLimit() bool {
+	if x != nil {
+		return x.HeaderLimit
+	}
+	return false
+}
+
 type RouteFaults struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -147,12 +260,23 @@ type RouteFaults struct {
 
 	Abort *RouteAbort `protobuf:"bytes,1,opt,name=abort,proto3" json:"abort,omitempty"`
 	Delay *RouteDelay `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	// Limits the bandwidth of the responses.
+	ResponseRateLimit *ResponseRateLimit `protobuf:"bytes,3,opt,name=response_rate_limit,json=responseRateLimit,proto3" json:"response_rate_limit,omitempty"`
+	// If set, faults are only injected into requests that match all of these headers.
+	Headers []*matchers.HeaderMatcher `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	// If set, faults are only injected into requests whose `x-envoy-downstream-service-node` header
+	// matches one of these downstream nodes. Requests without the header are not faulted.
+	// This allows faults to target the traffic of specific downstream clusters.
+	DownstreamNodes []string `protobuf:"bytes,5,rep,name=downstream_nodes,json=downstreamNodes,proto3" json:"downstream_nodes,omitempty"`
+	// The maximum number of faults that can be active at the same time. If not set, the limit is set by the
+	// `fault.http.max_active_faults` runtime key, and is unlimited by default.
+	MaxActiveFaults *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=max_active_faults,json=maxActiveFaults,proto3" json:"max_active_faults,omitempty"`
 }
 
 func (x *RouteFaults) Reset() {
 	*x = RouteFaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[2]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -165,7 +289,7 @@ func (x *RouteFaults) String() string {
 func (*RouteFaults) ProtoMessage() {}
 
 func (x *RouteFaults) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +302,7 @@ func (x *RouteFaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteFaults.ProtoReflect.Descriptor instead.
 func (*RouteFaults) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_rawDescGZIP(), []int{2}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RouteFaults) GetAbort() *RouteAbort {
@@ -195,6 +319,34 @@ func (x *RouteFaults) GetDelay() *RouteDelay {
 	return nil
 }
 
+func (x *RouteFaults) GetResponseRateLimit() *ResponseRateLimit {
+	if x != nil {
+		return x.ResponseRateLimit
+	}
+	return nil
+}
+
+func (x *RouteFaults) GetHeaders() []*matchers.HeaderMatcher {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RouteFaults) GetDownstreamNodes() []string {
+	if x != nil {
+		return x.DownstreamNodes
+	}
+	return nil
+}
+
+func (x *RouteFaults) GetMaxActiveFaults() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MaxActiveFaults
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_rawDesc = []byte{
@@ -206,38 +358,79 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fau
 	0x6f, 0x12, 0x1a, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x1e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5a, 0x0a, 0x0a, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xfa,
-	0x42, 0x08, 0x2a, 0x06, 0x10, 0xd8, 0x04, 0x28, 0xc8, 0x01, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x72, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44,
-	0x65, 0x6c, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x49, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69,
+	0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
 	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x0a,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x61, 0x62,
-	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x62, 0x6f, 0x72,
-	0x74, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52,
-	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x55, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01,
-	0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x2a, 0x06,
+	0x10, 0xd8, 0x04, 0x28, 0xc8, 0x01, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x62, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x0a, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x80, 0x01, 0x0a,
+	0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4b, 0x62, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xa2, 0x03, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x3c, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x5d, 0x0a, 0x13, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x42, 0x55, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5,
+	0x04, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -252,22 +445,29 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fa
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_goTypes = []interface{}{
-	(*RouteAbort)(nil),        // 0: fault.options.gloo.solo.io.RouteAbort
-	(*RouteDelay)(nil),        // 1: fault.options.gloo.solo.io.RouteDelay
-	(*RouteFaults)(nil),       // 2: fault.options.gloo.solo.io.RouteFaults
-	(*duration.Duration)(nil), // 3: google.protobuf.Duration
+	(*RouteAbort)(nil),             // 0: fault.options.gloo.solo.io.RouteAbort
+	(*RouteDelay)(nil),             // 1: fault.options.gloo.solo.io.RouteDelay
+	(*ResponseRateLimit)(nil),      // 2: fault.options.gloo.solo.io.ResponseRateLimit
+	(*RouteFaults)(nil),            // 3: fault.options.gloo.solo.io.RouteFaults
+	(*wrappers.UInt32Value)(nil),   // 4: google.protobuf.UInt32Value
+	(*duration.Duration)(nil),      // 5: google.protobuf.Duration
+	(*matchers.HeaderMatcher)(nil), // 6: matchers.core.gloo.solo.io.HeaderMatcher
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_depIdxs = []int32{
-	3, // 0: fault.options.gloo.solo.io.RouteDelay.fixed_delay:type_name -> google.protobuf.Duration
-	0, // 1: fault.options.gloo.solo.io.RouteFaults.abort:type_name -> fault.options.gloo.solo.io.RouteAbort
-	1, // 2: fault.options.gloo.solo.io.RouteFaults.delay:type_name -> fault.options.gloo.solo.io.RouteDelay
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 0: fault.options.gloo.solo.io.RouteAbort.grpc_status:type_name -> google.protobuf.UInt32Value
+	5, // 1: fault.options.gloo.solo.io.RouteDelay.fixed_delay:type_name -> google.protobuf.Duration
+	0, // 2: fault.options.gloo.solo.io.RouteFaults.abort:type_name -> fault.options.gloo.solo.io.RouteAbort
+	1, // 3: fault.options.gloo.solo.io.RouteFaults.delay:type_name -> fault.options.gloo.solo.io.RouteDelay
+	2, // 4: fault.options.gloo.solo.io.RouteFaults.response_rate_limit:type_name -> fault.options.gloo.solo.io.ResponseRateLimit
+	6, // 5: fault.options.gloo.solo.io.RouteFaults.headers:type_name -> matchers.core.gloo.solo.io.HeaderMatcher
+	4, // 6: fault.options.gloo.solo.io.RouteFaults.max_active_faults:type_name -> google.protobuf.UInt32Value
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() {
@@ -303,6 +503,18 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fa
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RouteFaults); i {
 			case 0:
 				return &v.state
@@ -321,7 +533,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fa
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_faultinjection_fault_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -101,6 +101,18 @@ func (m *GrpcJsonTranscoder) Clone() proto.Message {
 			}
 		}
 
+	case *GrpcJsonTranscoder_ProtoSourcesConfigMap:
+
+		if h, ok := interface{}(m.GetProtoSourcesConfigMap()).(clone.Cloner); ok {
+			target.DescriptorSet = &GrpcJsonTranscoder_ProtoSourcesConfigMap{
+				ProtoSourcesConfigMap: h.Clone().(*GrpcJsonTranscoder_ProtoSources),
+			}
+		} else {
+			target.DescriptorSet = &GrpcJsonTranscoder_ProtoSourcesConfigMap{
+				ProtoSourcesConfigMap: proto.Clone(m.GetProtoSourcesConfigMap()).(*GrpcJsonTranscoder_ProtoSources),
+			}
+		}
+
 	}
 
 	return target
@@ -143,3 +155,20 @@ func (m *GrpcJsonTranscoder_DescriptorConfigMap) Clone() proto.Message {
 
 	return target
 }
+
+// Clone function
+func (m *GrpcJsonTranscoder_ProtoSources) Clone() proto.Message {
+	var target *GrpcJsonTranscoder_ProtoSources
+	if m == nil {
+		return target
+	}
+	target = &GrpcJsonTranscoder_ProtoSources{}
+
+	if h, ok := interface{}(m.GetConfigMapRef()).(clone.Cloner); ok {
+		target.ConfigMapRef = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.ConfigMapRef = proto.Clone(m.GetConfigMapRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	return target
+}
@@ -129,6 +129,21 @@ func (m *GrpcJsonTranscoder) Equal(that interface{}) bool {
 			}
 		}
 
+	case *GrpcJsonTranscoder_ProtoSourcesConfigMap:
+		if _, ok := target.DescriptorSet.(*GrpcJsonTranscoder_ProtoSourcesConfigMap); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetProtoSourcesConfigMap()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetProtoSourcesConfigMap()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetProtoSourcesConfigMap(), target.GetProtoSourcesConfigMap()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.DescriptorSet != target.DescriptorSet {
@@ -216,3 +231,37 @@ func (m *GrpcJsonTranscoder_DescriptorConfigMap) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *GrpcJsonTranscoder_ProtoSources) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*GrpcJsonTranscoder_ProtoSources)
+	if !ok {
+		that2, ok := that.(GrpcJsonTranscoder_ProtoSources)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetConfigMapRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConfigMapRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConfigMapRef(), target.GetConfigMapRef()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -130,6 +130,28 @@ func (m *GrpcJsonTranscoder) Hash(hasher hash.Hash64) (uint64, error) {
 			}
 		}
 
+	case *GrpcJsonTranscoder_ProtoSourcesConfigMap:
+
+		if h, ok := interface{}(m.GetProtoSourcesConfigMap()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("ProtoSourcesConfigMap")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetProtoSourcesConfigMap(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("ProtoSourcesConfigMap")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
 	}
 
 	return hasher.Sum64(), nil
@@ -210,3 +232,39 @@ func (m *GrpcJsonTranscoder_DescriptorConfigMap) Hash(hasher hash.Hash64) (uint6
 
 	return hasher.Sum64(), nil
 }
+
+// Hash function
+func (m *GrpcJsonTranscoder_ProtoSources) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("grpc_json.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json.GrpcJsonTranscoder_ProtoSources")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetConfigMapRef()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ConfigMapRef")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetConfigMapRef(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ConfigMapRef")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
+
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
 	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 )
 
@@ -62,5 +66,87 @@ func (m *UpstreamSpec) Clone() proto.Message {
 		target.SubsetSpec = proto.Clone(m.GetSubsetSpec()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_options.SubsetSpec)
 	}
 
+	if h, ok := interface{}(m.GetColdStart()).(clone.Cloner); ok {
+		target.ColdStart = h.Clone().(*ColdStart)
+	} else {
+		target.ColdStart = proto.Clone(m.GetColdStart()).(*ColdStart)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ColdStart) Clone() proto.Message {
+	var target *ColdStart
+	if m == nil {
+		return target
+	}
+	target = &ColdStart{}
+
+	if h, ok := interface{}(m.GetMaxPendingRequests()).(clone.Cloner); ok {
+		target.MaxPendingRequests = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.MaxPendingRequests = proto.Clone(m.GetMaxPendingRequests()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
+	if h, ok := interface{}(m.GetTimeout()).(clone.Cloner); ok {
+		target.Timeout = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.Timeout = proto.Clone(m.GetTimeout()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	switch m.ScaleUp.(type) {
+
+	case *ColdStart_WebhookUrl:
+
+		target.ScaleUp = &ColdStart_WebhookUrl{
+			WebhookUrl: m.GetWebhookUrl(),
+		}
+
+	case *ColdStart_Deployment:
+
+		if h, ok := interface{}(m.GetDeployment()).(clone.Cloner); ok {
+			target.ScaleUp = &ColdStart_Deployment{
+				Deployment: h.Clone().(*ColdStart_ScaleTarget),
+			}
+		} else {
+			target.ScaleUp = &ColdStart_Deployment{
+				Deployment: proto.Clone(m.GetDeployment()).(*ColdStart_ScaleTarget),
+			}
+		}
+
+	case *ColdStart_HorizontalPodAutoscaler:
+
+		if h, ok := interface{}(m.GetHorizontalPodAutoscaler()).(clone.Cloner); ok {
+			target.ScaleUp = &ColdStart_HorizontalPodAutoscaler{
+				HorizontalPodAutoscaler: h.Clone().(*ColdStart_ScaleTarget),
+			}
+		} else {
+			target.ScaleUp = &ColdStart_HorizontalPodAutoscaler{
+				HorizontalPodAutoscaler: proto.Clone(m.GetHorizontalPodAutoscaler()).(*ColdStart_ScaleTarget),
+			}
+		}
+
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ColdStart_ScaleTarget) Clone() proto.Message {
+	var target *ColdStart_ScaleTarget
+	if m == nil {
+		return target
+	}
+	target = &ColdStart_ScaleTarget{}
+
+	target.Name = m.GetName()
+
+	if h, ok := interface{}(m.GetReplicas()).(clone.Cloner); ok {
+		target.Replicas = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	} else {
+		target.Replicas = proto.Clone(m.GetReplicas()).(*github_com_golang_protobuf_ptypes_wrappers.UInt32Value)
+	}
+
 	return target
 }
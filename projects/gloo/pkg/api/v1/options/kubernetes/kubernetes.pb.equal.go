@@ -89,5 +89,145 @@ func (m *UpstreamSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetColdStart()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetColdStart()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetColdStart(), target.GetColdStart()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ColdStart) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ColdStart)
+	if !ok {
+		that2, ok := that.(ColdStart)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMaxPendingRequests()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxPendingRequests()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxPendingRequests(), target.GetMaxPendingRequests()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTimeout(), target.GetTimeout()) {
+			return false
+		}
+	}
+
+	switch m.ScaleUp.(type) {
+
+	case *ColdStart_WebhookUrl:
+		if _, ok := target.ScaleUp.(*ColdStart_WebhookUrl); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetWebhookUrl(), target.GetWebhookUrl()) != 0 {
+			return false
+		}
+
+	case *ColdStart_Deployment:
+		if _, ok := target.ScaleUp.(*ColdStart_Deployment); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetDeployment()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDeployment()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetDeployment(), target.GetDeployment()) {
+				return false
+			}
+		}
+
+	case *ColdStart_HorizontalPodAutoscaler:
+		if _, ok := target.ScaleUp.(*ColdStart_HorizontalPodAutoscaler); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHorizontalPodAutoscaler()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHorizontalPodAutoscaler()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHorizontalPodAutoscaler(), target.GetHorizontalPodAutoscaler()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.ScaleUp != target.ScaleUp {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ColdStart_ScaleTarget) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ColdStart_ScaleTarget)
+	if !ok {
+		that2, ok := that.(ColdStart_ScaleTarget)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetReplicas()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetReplicas()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetReplicas(), target.GetReplicas()) {
+			return false
+		}
+	}
+
 	return true
 }
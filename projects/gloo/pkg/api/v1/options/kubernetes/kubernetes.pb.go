@@ -10,6 +10,8 @@ import (
 	reflect "reflect"
 	sync "sync"
 
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	options "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -49,6 +51,9 @@ type UpstreamSpec struct {
 	// configuration allows you to partition the upstream to a set of subsets.
 	// for each unique set of keys and values, a subset will be created.
 	SubsetSpec *options.SubsetSpec `protobuf:"bytes,6,opt,name=subset_spec,json=subsetSpec,proto3" json:"subset_spec,omitempty"`
+	// Holds the requests to the upstream while it has no ready endpoints, e.g. when its pods are scaled to zero,
+	// scales it up and hands the requests back to Envoy to retry them once it is ready. Disabled if unset.
+	ColdStart *ColdStart `protobuf:"bytes,7,opt,name=cold_start,json=coldStart,proto3" json:"cold_start,omitempty"`
 }
 
 func (x *UpstreamSpec) Reset() {
@@ -125,6 +130,201 @@ func (x *UpstreamSpec) GetSubsetSpec() *options.SubsetSpec {
 	return nil
 }
 
+func (x *UpstreamSpec) GetColdStart() *ColdStart {
+	if x != nil {
+		return x.ColdStart
+	}
+	return nil
+}
+
+// The requests to an upstream with cold start which has no ready endpoints are sent to the cold start activator
+// of Gloo, which holds them until Gloo discovers the endpoints of the upstream. It then hands them back to Envoy,
+// which retries them against the endpoints with the retry policy of their routes. The routes without a retry
+// policy retry them up to 3 times.
+// The first request held triggers the scale up of the upstream.
+type ColdStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of requests held for the upstream. Further requests are rejected with a 503.
+	// Defaults to 100.
+	MaxPendingRequests *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=max_pending_requests,json=maxPendingRequests,proto3" json:"max_pending_requests,omitempty"`
+	// How long the requests are held at most. The requests still held after the timeout are rejected with a 504.
+	// Defaults to 30s.
+	Timeout *duration.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// How the upstream is scaled up. If unset, Gloo only holds the requests, e.g. while an external autoscaler
+	// scales the upstream up.
+	//
+	// Types that are assignable to ScaleUp:
+	//
+	//	*ColdStart_WebhookUrl
+	//	*ColdStart_Deployment
+	//	*ColdStart_HorizontalPodAutoscaler
+	ScaleUp isColdStart_ScaleUp `protobuf_oneof:"scale_up"`
+}
+
+func (x *ColdStart) Reset() {
+	*x = ColdStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColdStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColdStart) ProtoMessage() {}
+
+func (x *ColdStart) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColdStart.ProtoReflect.Descriptor instead.
+func (*ColdStart) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ColdStart) GetMaxPendingRequests() *wrappers.UInt32Value {
+	if x != nil {
+		return x.MaxPendingRequests
+	}
+	return nil
+}
+
+func (x *ColdStart) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (m *ColdStart) GetScaleUp() isColdStart_ScaleUp {
+	if m != nil {
+		return m.ScaleUp
+	}
+	return nil
+}
+
+func (x *ColdStart) GetWebhookUrl() string {
+	if x, ok := x.GetScaleUp().(*ColdStart_WebhookUrl); ok {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ColdStart) GetDeployment() *ColdStart_ScaleTarget {
+	if x, ok := x.GetScaleUp().(*ColdStart_Deployment); ok {
+		return x.Deployment
+	}
+	return nil
+}
+
+func (x *ColdStart) GetHorizontalPodAutoscaler() *ColdStart_ScaleTarget {
+	if x, ok := x.GetScaleUp().(*ColdStart_HorizontalPodAutoscaler); ok {
+		return x.HorizontalPodAutoscaler
+	}
+	return nil
+}
+
+type isColdStart_ScaleUp interface {
+	isColdStart_ScaleUp()
+}
+
+type ColdStart_WebhookUrl struct {
+	// POSTs the upstream to the URL of the webhook, which is expected to scale it up.
+	// The body of the request is a JSON object with the `name` and `namespace` of the upstream, and the
+	// `serviceName`, `serviceNamespace` and `servicePort` of its service.
+	WebhookUrl string `protobuf:"bytes,3,opt,name=webhook_url,json=webhookUrl,proto3,oneof"`
+}
+
+type ColdStart_Deployment struct {
+	// Scales the Deployment up to the replicas of the target, if it has fewer.
+	Deployment *ColdStart_ScaleTarget `protobuf:"bytes,4,opt,name=deployment,proto3,oneof"`
+}
+
+type ColdStart_HorizontalPodAutoscaler struct {
+	// Raises the `minReplicas` of the HorizontalPodAutoscaler to the replicas of the target, if it is lower,
+	// and restores it once the upstream is ready. The original value is kept meanwhile in the
+	// `gloo.solo.io/cold-start-original-min-replicas` annotation of the HorizontalPodAutoscaler.
+	HorizontalPodAutoscaler *ColdStart_ScaleTarget `protobuf:"bytes,5,opt,name=horizontal_pod_autoscaler,json=horizontalPodAutoscaler,proto3,oneof"`
+}
+
+func (*ColdStart_WebhookUrl) isColdStart_ScaleUp() {}
+
+func (*ColdStart_Deployment) isColdStart_ScaleUp() {}
+
+func (*ColdStart_HorizontalPodAutoscaler) isColdStart_ScaleUp() {}
+
+// A workload of the namespace of the service of the upstream, and the number of replicas to scale it up to
+type ColdStart_ScaleTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the workload
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of replicas to scale the workload up to. Defaults to 1.
+	Replicas *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+}
+
+func (x *ColdStart_ScaleTarget) Reset() {
+	*x = ColdStart_ScaleTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColdStart_ScaleTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColdStart_ScaleTarget) ProtoMessage() {}
+
+func (x *ColdStart_ScaleTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColdStart_ScaleTarget.ProtoReflect.Descriptor instead.
+func (*ColdStart_ScaleTarget) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ColdStart_ScaleTarget) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColdStart_ScaleTarget) GetReplicas() *wrappers.UInt32Value {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_rawDesc = []byte{
@@ -136,7 +336,11 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kuberne
 	0x74, 0x6f, 0x12, 0x1f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x6f,
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
 	0x2e, 0x69, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
 	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x65,
@@ -145,7 +349,7 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kuberne
 	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
 	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70,
-	0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x03, 0x0a, 0x0c, 0x55, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb, 0x03, 0x0a, 0x0c, 0x55, 0x70, 0x73,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
@@ -167,17 +371,52 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kuberne
 	0x75, 0x62, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x20, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
 	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x53, 0x70,
-	0x65, 0x63, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x1a, 0x3b,
-	0x0a, 0x0d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x51, 0xb8, 0xf5, 0x04,
-	0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x63, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x49,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x09,
+	0x63, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xec, 0x03, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x58, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x74, 0x0a, 0x19, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f,
+	0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x48, 0x00, 0x52, 0x17, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x74, 0x61, 0x6c, 0x50,
+	0x6f, 0x64, 0x41, 0x75, 0x74, 0x6f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x1a, 0x5b, 0x0a, 0x0b,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x38, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x75, 0x70, 0x42, 0x51, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0,
+	0xf5, 0x04, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -192,22 +431,32 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubern
 	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_goTypes = []interface{}{
-	(*UpstreamSpec)(nil),        // 0: kubernetes.options.gloo.solo.io.UpstreamSpec
-	nil,                         // 1: kubernetes.options.gloo.solo.io.UpstreamSpec.SelectorEntry
-	(*options.ServiceSpec)(nil), // 2: options.gloo.solo.io.ServiceSpec
-	(*options.SubsetSpec)(nil),  // 3: options.gloo.solo.io.SubsetSpec
+	(*UpstreamSpec)(nil),          // 0: kubernetes.options.gloo.solo.io.UpstreamSpec
+	(*ColdStart)(nil),             // 1: kubernetes.options.gloo.solo.io.ColdStart
+	nil,                           // 2: kubernetes.options.gloo.solo.io.UpstreamSpec.SelectorEntry
+	(*ColdStart_ScaleTarget)(nil), // 3: kubernetes.options.gloo.solo.io.ColdStart.ScaleTarget
+	(*options.ServiceSpec)(nil),   // 4: options.gloo.solo.io.ServiceSpec
+	(*options.SubsetSpec)(nil),    // 5: options.gloo.solo.io.SubsetSpec
+	(*wrappers.UInt32Value)(nil),  // 6: google.protobuf.UInt32Value
+	(*duration.Duration)(nil),     // 7: google.protobuf.Duration
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_depIdxs = []int32{
-	1, // 0: kubernetes.options.gloo.solo.io.UpstreamSpec.selector:type_name -> kubernetes.options.gloo.solo.io.UpstreamSpec.SelectorEntry
-	2, // 1: kubernetes.options.gloo.solo.io.UpstreamSpec.service_spec:type_name -> options.gloo.solo.io.ServiceSpec
-	3, // 2: kubernetes.options.gloo.solo.io.UpstreamSpec.subset_spec:type_name -> options.gloo.solo.io.SubsetSpec
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 0: kubernetes.options.gloo.solo.io.UpstreamSpec.selector:type_name -> kubernetes.options.gloo.solo.io.UpstreamSpec.SelectorEntry
+	4, // 1: kubernetes.options.gloo.solo.io.UpstreamSpec.service_spec:type_name -> options.gloo.solo.io.ServiceSpec
+	5, // 2: kubernetes.options.gloo.solo.io.UpstreamSpec.subset_spec:type_name -> options.gloo.solo.io.SubsetSpec
+	1, // 3: kubernetes.options.gloo.solo.io.UpstreamSpec.cold_start:type_name -> kubernetes.options.gloo.solo.io.ColdStart
+	6, // 4: kubernetes.options.gloo.solo.io.ColdStart.max_pending_requests:type_name -> google.protobuf.UInt32Value
+	7, // 5: kubernetes.options.gloo.solo.io.ColdStart.timeout:type_name -> google.protobuf.Duration
+	3, // 6: kubernetes.options.gloo.solo.io.ColdStart.deployment:type_name -> kubernetes.options.gloo.solo.io.ColdStart.ScaleTarget
+	3, // 7: kubernetes.options.gloo.solo.io.ColdStart.horizontal_pod_autoscaler:type_name -> kubernetes.options.gloo.solo.io.ColdStart.ScaleTarget
+	6, // 8: kubernetes.options.gloo.solo.io.ColdStart.ScaleTarget.replicas:type_name -> google.protobuf.UInt32Value
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() {
@@ -230,6 +479,35 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubern
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColdStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColdStart_ScaleTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*ColdStart_WebhookUrl)(nil),
+		(*ColdStart_Deployment)(nil),
+		(*ColdStart_HorizontalPodAutoscaler)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -237,7 +515,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubern
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_kubernetes_kubernetes_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -114,5 +114,175 @@ func (m *UpstreamSpec) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if h, ok := interface{}(m.GetColdStart()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ColdStart")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetColdStart(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ColdStart")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *ColdStart) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("kubernetes.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes.ColdStart")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetMaxPendingRequests()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("MaxPendingRequests")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetMaxPendingRequests(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("MaxPendingRequests")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetTimeout()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Timeout")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetTimeout(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Timeout")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	switch m.ScaleUp.(type) {
+
+	case *ColdStart_WebhookUrl:
+
+		if _, err = hasher.Write([]byte(m.GetWebhookUrl())); err != nil {
+			return 0, err
+		}
+
+	case *ColdStart_Deployment:
+
+		if h, ok := interface{}(m.GetDeployment()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Deployment")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetDeployment(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Deployment")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *ColdStart_HorizontalPodAutoscaler:
+
+		if h, ok := interface{}(m.GetHorizontalPodAutoscaler()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("HorizontalPodAutoscaler")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetHorizontalPodAutoscaler(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("HorizontalPodAutoscaler")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *ColdStart_ScaleTarget) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("kubernetes.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes.ColdStart_ScaleTarget")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetName())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetReplicas()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Replicas")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetReplicas(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Replicas")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return hasher.Sum64(), nil
 }
@@ -16,6 +16,8 @@ import (
 	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
 
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_solo_apis_pkg_api_ratelimit_solo_io_v1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 )
 
 // ensure the imports are used
@@ -80,5 +82,93 @@ func (m *Settings) Clone() proto.Message {
 		target.EnableXRatelimitHeaders = proto.Clone(m.GetEnableXRatelimitHeaders()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	if h, ok := interface{}(m.GetRateLimitAfterAuthn()).(clone.Cloner); ok {
+		target.RateLimitAfterAuthn = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.RateLimitAfterAuthn = proto.Clone(m.GetRateLimitAfterAuthn()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *DescriptorLimits) Clone() proto.Message {
+	var target *DescriptorLimits
+	if m == nil {
+		return target
+	}
+	target = &DescriptorLimits{}
+
+	if m.GetRateLimits() != nil {
+		target.RateLimits = make([]*github_com_solo_io_solo_apis_pkg_api_ratelimit_solo_io_v1alpha1.RateLimitActions, len(m.GetRateLimits()))
+		for idx, v := range m.GetRateLimits() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.RateLimits[idx] = h.Clone().(*github_com_solo_io_solo_apis_pkg_api_ratelimit_solo_io_v1alpha1.RateLimitActions)
+			} else {
+				target.RateLimits[idx] = proto.Clone(v).(*github_com_solo_io_solo_apis_pkg_api_ratelimit_solo_io_v1alpha1.RateLimitActions)
+			}
+
+		}
+	}
+
+	if m.GetDescriptors() != nil {
+		target.Descriptors = make([]*Descriptor, len(m.GetDescriptors()))
+		for idx, v := range m.GetDescriptors() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Descriptors[idx] = h.Clone().(*Descriptor)
+			} else {
+				target.Descriptors[idx] = proto.Clone(v).(*Descriptor)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Descriptor) Clone() proto.Message {
+	var target *Descriptor
+	if m == nil {
+		return target
+	}
+	target = &Descriptor{}
+
+	if m.GetEntries() != nil {
+		target.Entries = make([]*Descriptor_Entry, len(m.GetEntries()))
+		for idx, v := range m.GetEntries() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Entries[idx] = h.Clone().(*Descriptor_Entry)
+			} else {
+				target.Entries[idx] = proto.Clone(v).(*Descriptor_Entry)
+			}
+
+		}
+	}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(clone.Cloner); ok {
+		target.TokenBucket = h.Clone().(*TokenBucket)
+	} else {
+		target.TokenBucket = proto.Clone(m.GetTokenBucket()).(*TokenBucket)
+	}
+
+	return target
+}
+
+// Clone function
+func (m *Descriptor_Entry) Clone() proto.Message {
+	var target *Descriptor_Entry
+	if m == nil {
+		return target
+	}
+	target = &Descriptor_Entry{}
+
+	target.Key = m.GetKey()
+
+	target.Value = m.GetValue()
+
 	return target
 }
@@ -124,5 +124,156 @@ func (m *Settings) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetRateLimitAfterAuthn()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRateLimitAfterAuthn()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRateLimitAfterAuthn(), target.GetRateLimitAfterAuthn()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *DescriptorLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DescriptorLimits)
+	if !ok {
+		that2, ok := that.(DescriptorLimits)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetRateLimits()) != len(target.GetRateLimits()) {
+		return false
+	}
+	for idx, v := range m.GetRateLimits() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRateLimits()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRateLimits()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetDescriptors()) != len(target.GetDescriptors()) {
+		return false
+	}
+	for idx, v := range m.GetDescriptors() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDescriptors()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDescriptors()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Descriptor) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Descriptor)
+	if !ok {
+		that2, ok := that.(Descriptor)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetEntries()) != len(target.GetEntries()) {
+		return false
+	}
+	for idx, v := range m.GetEntries() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetEntries()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetEntries()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTokenBucket()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTokenBucket()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTokenBucket(), target.GetTokenBucket()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *Descriptor_Entry) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*Descriptor_Entry)
+	if !ok {
+		that2, ok := that.(Descriptor_Entry)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetKey(), target.GetKey()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetValue(), target.GetValue()) != 0 {
+		return false
+	}
+
 	return true
 }
@@ -13,6 +13,10 @@ import (
 	"github.com/solo-io/protoc-gen-ext/pkg/clone"
 	"google.golang.org/protobuf/proto"
 
+	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+
 	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
@@ -43,5 +47,60 @@ func (m *RouteShadowing) Clone() proto.Message {
 
 	target.Percentage = m.GetPercentage()
 
+	if m.GetTargets() != nil {
+		target.Targets = make([]*ShadowTarget, len(m.GetTargets()))
+		for idx, v := range m.GetTargets() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Targets[idx] = h.Clone().(*ShadowTarget)
+			} else {
+				target.Targets[idx] = proto.Clone(v).(*ShadowTarget)
+			}
+
+		}
+	}
+
+	return target
+}
+
+// Clone function
+func (m *ShadowTarget) Clone() proto.Message {
+	var target *ShadowTarget
+	if m == nil {
+		return target
+	}
+	target = &ShadowTarget{}
+
+	if h, ok := interface{}(m.GetUpstream()).(clone.Cloner); ok {
+		target.Upstream = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.Upstream = proto.Clone(m.GetUpstream()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if m.GetHeaders() != nil {
+		target.Headers = make([]*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher, len(m.GetHeaders()))
+		for idx, v := range m.GetHeaders() {
+
+			if h, ok := interface{}(v).(clone.Cloner); ok {
+				target.Headers[idx] = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			} else {
+				target.Headers[idx] = proto.Clone(v).(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_core_matchers.HeaderMatcher)
+			}
+
+		}
+	}
+
+	target.Percentage = m.GetPercentage()
+
+	target.RuntimeKey = m.GetRuntimeKey()
+
+	if h, ok := interface{}(m.GetTraceSampled()).(clone.Cloner); ok {
+		target.TraceSampled = h.Clone().(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	} else {
+		target.TraceSampled = proto.Clone(m.GetTraceSampled()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
+	}
+
+	target.HostRewrite = m.GetHostRewrite()
+
 	return target
 }
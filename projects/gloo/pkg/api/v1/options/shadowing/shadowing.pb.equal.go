@@ -60,5 +60,95 @@ func (m *RouteShadowing) Equal(that interface{}) bool {
 		return false
 	}
 
+	if len(m.GetTargets()) != len(target.GetTargets()) {
+		return false
+	}
+	for idx, v := range m.GetTargets() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTargets()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetTargets()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ShadowTarget) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ShadowTarget)
+	if !ok {
+		that2, ok := that.(ShadowTarget)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetUpstream()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUpstream()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUpstream(), target.GetUpstream()) {
+			return false
+		}
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetPercentage() != target.GetPercentage() {
+		return false
+	}
+
+	if strings.Compare(m.GetRuntimeKey(), target.GetRuntimeKey()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTraceSampled()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTraceSampled()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTraceSampled(), target.GetTraceSampled()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetHostRewrite(), target.GetHostRewrite()) != 0 {
+		return false
+	}
+
 	return true
 }
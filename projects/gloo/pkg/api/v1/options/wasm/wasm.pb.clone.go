@@ -14,6 +14,10 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	github_com_golang_protobuf_ptypes_any "github.com/golang/protobuf/ptypes/any"
+
+	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
 
 // ensure the imports are used
@@ -93,11 +97,54 @@ func (m *WasmFilter) Clone() proto.Message {
 			FilePath: m.GetFilePath(),
 		}
 
+	case *WasmFilter_DataSource:
+
+		if h, ok := interface{}(m.GetDataSource()).(clone.Cloner); ok {
+			target.Src = &WasmFilter_DataSource{
+				DataSource: h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.DataSource),
+			}
+		} else {
+			target.Src = &WasmFilter_DataSource{
+				DataSource: proto.Clone(m.GetDataSource()).(*github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_config_core_v3.DataSource),
+			}
+		}
+
+	case *WasmFilter_Artifact:
+
+		if h, ok := interface{}(m.GetArtifact()).(clone.Cloner); ok {
+			target.Src = &WasmFilter_Artifact{
+				Artifact: h.Clone().(*ArtifactSource),
+			}
+		} else {
+			target.Src = &WasmFilter_Artifact{
+				Artifact: proto.Clone(m.GetArtifact()).(*ArtifactSource),
+			}
+		}
+
 	}
 
 	return target
 }
 
+// Clone function
+func (m *ArtifactSource) Clone() proto.Message {
+	var target *ArtifactSource
+	if m == nil {
+		return target
+	}
+	target = &ArtifactSource{}
+
+	if h, ok := interface{}(m.GetArtifactRef()).(clone.Cloner); ok {
+		target.ArtifactRef = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.ArtifactRef = proto.Clone(m.GetArtifactRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	target.Key = m.GetKey()
+
+	return target
+}
+
 // Clone function
 func (m *FilterStage) Clone() proto.Message {
 	var target *FilterStage
@@ -143,6 +143,36 @@ func (m *WasmFilter) Equal(that interface{}) bool {
 			return false
 		}
 
+	case *WasmFilter_DataSource:
+		if _, ok := target.Src.(*WasmFilter_DataSource); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetDataSource()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDataSource()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetDataSource(), target.GetDataSource()) {
+				return false
+			}
+		}
+
+	case *WasmFilter_Artifact:
+		if _, ok := target.Src.(*WasmFilter_Artifact); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetArtifact()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetArtifact()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetArtifact(), target.GetArtifact()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.Src != target.Src {
@@ -153,6 +183,44 @@ func (m *WasmFilter) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *ArtifactSource) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ArtifactSource)
+	if !ok {
+		that2, ok := that.(ArtifactSource)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetArtifactRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetArtifactRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetArtifactRef(), target.GetArtifactRef()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetKey(), target.GetKey()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *FilterStage) Equal(that interface{}) bool {
 	if that == nil {
@@ -11,7 +11,9 @@ import (
 	sync "sync"
 
 	any1 "github.com/golang/protobuf/ptypes/any"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	core "github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -136,7 +138,7 @@ func (x FilterStage_Stage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FilterStage_Stage.Descriptor instead.
 func (FilterStage_Stage) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDescGZIP(), []int{2, 0}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDescGZIP(), []int{3, 0}
 }
 
 // During is the 0th member so that it is the default, even though
@@ -187,7 +189,7 @@ func (x FilterStage_Predicate) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FilterStage_Predicate.Descriptor instead.
 func (FilterStage_Predicate) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDescGZIP(), []int{2, 1}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDescGZIP(), []int{3, 1}
 }
 
 // Options config for WASM filters
@@ -249,6 +251,8 @@ type WasmFilter struct {
 	//
 	//	*WasmFilter_Image
 	//	*WasmFilter_FilePath
+	//	*WasmFilter_DataSource
+	//	*WasmFilter_Artifact
 	Src isWasmFilter_Src `protobuf_oneof:"src"`
 	// Filter/service configuration used to configure or reconfigure a plugin
 	// (proxy_on_configuration).
@@ -322,6 +326,20 @@ func (x *WasmFilter) GetFilePath() string {
 	return ""
 }
 
+func (x *WasmFilter) GetDataSource() *v3.DataSource {
+	if x, ok := x.GetSrc().(*WasmFilter_DataSource); ok {
+		return x.DataSource
+	}
+	return nil
+}
+
+func (x *WasmFilter) GetArtifact() *ArtifactSource {
+	if x, ok := x.GetSrc().(*WasmFilter_Artifact); ok {
+		return x.Artifact
+	}
+	return nil
+}
+
 func (x *WasmFilter) GetConfig() *any1.Any {
 	if x != nil {
 		return x.Config
@@ -369,7 +387,9 @@ type isWasmFilter_Src interface {
 }
 
 type WasmFilter_Image struct {
-	// name of image which houses the compiled wasm filter
+	// name of the OCI image which houses the compiled wasm filter, e.g. `webassemblyhub.io/user/filter:v1`.
+	// Gloo pulls the image, verifies its sha256 digest and serves the module to Envoy
+	// from the `wasm-cache` cluster.
 	Image string `protobuf:"bytes,2,opt,name=image,proto3,oneof"`
 }
 
@@ -378,10 +398,86 @@ type WasmFilter_FilePath struct {
 	FilePath string `protobuf:"bytes,8,opt,name=file_path,json=filePath,proto3,oneof"`
 }
 
+type WasmFilter_DataSource struct {
+	// the compiled wasm filter, either inlined in the configuration or loaded from the Envoy filesystem
+	DataSource *v3.DataSource `protobuf:"bytes,10,opt,name=data_source,json=dataSource,proto3,oneof"`
+}
+
+type WasmFilter_Artifact struct {
+	// the artifact which contains the compiled wasm filter.
+	// Artifacts are read from the `artifact_source` configured in the Gloo Settings, for example Kubernetes ConfigMaps
+	// or a directory.
+	Artifact *ArtifactSource `protobuf:"bytes,11,opt,name=artifact,proto3,oneof"`
+}
+
 func (*WasmFilter_Image) isWasmFilter_Src() {}
 
 func (*WasmFilter_FilePath) isWasmFilter_Src() {}
 
+func (*WasmFilter_DataSource) isWasmFilter_Src() {}
+
+func (*WasmFilter_Artifact) isWasmFilter_Src() {}
+
+// Reference to a wasm filter stored in an artifact, such as a Kubernetes ConfigMap
+type ArtifactSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reference to the artifact which contains the wasm filter
+	ArtifactRef *core.ResourceRef `protobuf:"bytes,1,opt,name=artifact_ref,json=artifactRef,proto3" json:"artifact_ref,omitempty"`
+	// The artifact data key whose value contains the base64-encoded wasm filter.
+	// If the artifact contains multiple key-value pairs, this field is required.
+	// If the artifact contains exactly one key-value pair, this field is optional.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ArtifactSource) Reset() {
+	*x = ArtifactSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactSource) ProtoMessage() {}
+
+func (x *ArtifactSource) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactSource.ProtoReflect.Descriptor instead.
+func (*ArtifactSource) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArtifactSource) GetArtifactRef() *core.ResourceRef {
+	if x != nil {
+		return x.ArtifactRef
+	}
+	return nil
+}
+
+func (x *ArtifactSource) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 type FilterStage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -396,7 +492,7 @@ type FilterStage struct {
 func (x *FilterStage) Reset() {
 	*x = FilterStage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[2]
+		mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -409,7 +505,7 @@ func (x *FilterStage) String() string {
 func (*FilterStage) ProtoMessage() {}
 
 func (x *FilterStage) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +518,7 @@ func (x *FilterStage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterStage.ProtoReflect.Descriptor instead.
 func (*FilterStage) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDescGZIP(), []int{2}
+	return file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *FilterStage) GetStage() FilterStage_Stage {
@@ -451,63 +547,87 @@ var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_ra
 	0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x4f, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x3f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x61,
-	0x73, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x22, 0xf0, 0x02, 0x0a, 0x0a, 0x57, 0x61, 0x73, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
-	0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x67, 0x65, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a,
-	0x07, 0x76, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
-	0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x76, 0x6d,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x70, 0x65,
-	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65,
-	0x6e, 0x22, 0x1a, 0x0a, 0x06, 0x56, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x56,
-	0x38, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x56, 0x4d, 0x10, 0x01, 0x42, 0x05, 0x0a,
-	0x03, 0x73, 0x72, 0x63, 0x22, 0xf1, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x1a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x52,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d,
+	0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x4f, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x57, 0x61, 0x73, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x86, 0x04, 0x0a, 0x0a, 0x57, 0x61, 0x73, 0x6d, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12,
+	0x2c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a,
+	0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70,
-	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61,
-	0x67, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65,
-	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x72, 0x73, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10,
-	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x61, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x02, 0x12,
-	0x0e, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x4e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x03, 0x12,
-	0x0e, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x5a, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x04, 0x12,
-	0x12, 0x0a, 0x0e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x67, 0x65, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x41, 0x75, 0x74,
-	0x68, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x08, 0x22, 0x2e, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x10, 0x01, 0x12, 0x09, 0x0a,
-	0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x10, 0x02, 0x42, 0x4b, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5,
-	0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x77, 0x61, 0x73, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x76, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x76, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x61, 0x69, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x22, 0x1a, 0x0a, 0x06, 0x56, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x38, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57,
+	0x41, 0x56, 0x4d, 0x10, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x22, 0x60, 0x0a, 0x0e,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c,
+	0x0a, 0x0c, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52,
+	0x0b, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x66, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xf1,
+	0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x42,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x0a,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x6f, 0x72, 0x73, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x57,
+	0x61, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x75, 0x74,
+	0x68, 0x4e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x75, 0x74,
+	0x68, 0x5a, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x05, 0x12, 0x11, 0x0a,
+	0x0d, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x67, 0x65, 0x10, 0x06,
+	0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x10, 0x08, 0x22, 0x2e, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x44, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x10, 0x02, 0x42, 0x4b, 0xb8, 0xf5, 0x04, 0x01, 0xc0, 0xf5, 0x04, 0x01, 0xd0, 0xf5, 0x04, 0x01,
+	0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x77, 0x61, 0x73, 0x6d, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -523,28 +643,34 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_r
 }
 
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_goTypes = []interface{}{
 	(WasmFilter_VmType)(0),     // 0: wasm.options.gloo.solo.io.WasmFilter.VmType
 	(FilterStage_Stage)(0),     // 1: wasm.options.gloo.solo.io.FilterStage.Stage
 	(FilterStage_Predicate)(0), // 2: wasm.options.gloo.solo.io.FilterStage.Predicate
 	(*PluginSource)(nil),       // 3: wasm.options.gloo.solo.io.PluginSource
 	(*WasmFilter)(nil),         // 4: wasm.options.gloo.solo.io.WasmFilter
-	(*FilterStage)(nil),        // 5: wasm.options.gloo.solo.io.FilterStage
-	(*any1.Any)(nil),           // 6: google.protobuf.Any
+	(*ArtifactSource)(nil),     // 5: wasm.options.gloo.solo.io.ArtifactSource
+	(*FilterStage)(nil),        // 6: wasm.options.gloo.solo.io.FilterStage
+	(*v3.DataSource)(nil),      // 7: solo.io.envoy.config.core.v3.DataSource
+	(*any1.Any)(nil),           // 8: google.protobuf.Any
+	(*core.ResourceRef)(nil),   // 9: core.solo.io.ResourceRef
 }
 var file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_depIdxs = []int32{
 	4, // 0: wasm.options.gloo.solo.io.PluginSource.filters:type_name -> wasm.options.gloo.solo.io.WasmFilter
-	6, // 1: wasm.options.gloo.solo.io.WasmFilter.config:type_name -> google.protobuf.Any
-	5, // 2: wasm.options.gloo.solo.io.WasmFilter.filter_stage:type_name -> wasm.options.gloo.solo.io.FilterStage
-	0, // 3: wasm.options.gloo.solo.io.WasmFilter.vm_type:type_name -> wasm.options.gloo.solo.io.WasmFilter.VmType
-	1, // 4: wasm.options.gloo.solo.io.FilterStage.stage:type_name -> wasm.options.gloo.solo.io.FilterStage.Stage
-	2, // 5: wasm.options.gloo.solo.io.FilterStage.predicate:type_name -> wasm.options.gloo.solo.io.FilterStage.Predicate
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7, // 1: wasm.options.gloo.solo.io.WasmFilter.data_source:type_name -> solo.io.envoy.config.core.v3.DataSource
+	5, // 2: wasm.options.gloo.solo.io.WasmFilter.artifact:type_name -> wasm.options.gloo.solo.io.ArtifactSource
+	8, // 3: wasm.options.gloo.solo.io.WasmFilter.config:type_name -> google.protobuf.Any
+	6, // 4: wasm.options.gloo.solo.io.WasmFilter.filter_stage:type_name -> wasm.options.gloo.solo.io.FilterStage
+	0, // 5: wasm.options.gloo.solo.io.WasmFilter.vm_type:type_name -> wasm.options.gloo.solo.io.WasmFilter.VmType
+	9, // 6: wasm.options.gloo.solo.io.ArtifactSource.artifact_ref:type_name -> core.solo.io.ResourceRef
+	1, // 7: wasm.options.gloo.solo.io.FilterStage.stage:type_name -> wasm.options.gloo.solo.io.FilterStage.Stage
+	2, // 8: wasm.options.gloo.solo.io.FilterStage.predicate:type_name -> wasm.options.gloo.solo.io.FilterStage.Predicate
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_init() }
@@ -578,6 +704,18 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_i
 			}
 		}
 		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FilterStage); i {
 			case 0:
 				return &v.state
@@ -593,6 +731,8 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_i
 	file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*WasmFilter_Image)(nil),
 		(*WasmFilter_FilePath)(nil),
+		(*WasmFilter_DataSource)(nil),
+		(*WasmFilter_Artifact)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -600,7 +740,7 @@ func file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_i
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_projects_gloo_api_v1_options_wasm_wasm_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -150,6 +150,90 @@ func (m *WasmFilter) Hash(hasher hash.Hash64) (uint64, error) {
 			return 0, err
 		}
 
+	case *WasmFilter_DataSource:
+
+		if h, ok := interface{}(m.GetDataSource()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("DataSource")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetDataSource(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("DataSource")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	case *WasmFilter_Artifact:
+
+		if h, ok := interface{}(m.GetArtifact()).(safe_hasher.SafeHasher); ok {
+			if _, err = hasher.Write([]byte("Artifact")); err != nil {
+				return 0, err
+			}
+			if _, err = h.Hash(hasher); err != nil {
+				return 0, err
+			}
+		} else {
+			if fieldValue, err := hashstructure.Hash(m.GetArtifact(), nil); err != nil {
+				return 0, err
+			} else {
+				if _, err = hasher.Write([]byte("Artifact")); err != nil {
+					return 0, err
+				}
+				if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	}
+
+	return hasher.Sum64(), nil
+}
+
+// Hash function
+func (m *ArtifactSource) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("wasm.options.gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm.ArtifactSource")); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetArtifactRef()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("ArtifactRef")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetArtifactRef(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("ArtifactRef")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if _, err = hasher.Write([]byte(m.GetKey())); err != nil {
+		return 0, err
 	}
 
 	return hasher.Sum64(), nil
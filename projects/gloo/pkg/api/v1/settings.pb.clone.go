@@ -454,6 +454,8 @@ func (m *GlooOptions) Clone() proto.Message {
 		target.TransformationEscapeCharacters = proto.Clone(m.GetTransformationEscapeCharacters()).(*github_com_golang_protobuf_ptypes_wrappers.BoolValue)
 	}
 
+	target.ColdStartActivatorAddr = m.GetColdStartActivatorAddr()
+
 	return target
 }
 
@@ -681,6 +681,10 @@ func (m *GlooOptions) Equal(that interface{}) bool {
 		}
 	}
 
+	if strings.Compare(m.GetColdStartActivatorAddr(), target.GetColdStartActivatorAddr()) != 0 {
+		return false
+	}
+
 	return true
 }
 
@@ -1069,6 +1069,10 @@ func (m *GlooOptions) Hash(hasher hash.Hash64) (uint64, error) {
 		}
 	}
 
+	if _, err = hasher.Write([]byte(m.GetColdStartActivatorAddr())); err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
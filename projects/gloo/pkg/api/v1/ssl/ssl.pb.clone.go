@@ -130,11 +130,52 @@ func (m *SslConfig) Clone() proto.Message {
 			}
 		}
 
+	case *SslConfig_Acme:
+
+		if h, ok := interface{}(m.GetAcme()).(clone.Cloner); ok {
+			target.SslSecrets = &SslConfig_Acme{
+				Acme: h.Clone().(*AcmeConfig),
+			}
+		} else {
+			target.SslSecrets = &SslConfig_Acme{
+				Acme: proto.Clone(m.GetAcme()).(*AcmeConfig),
+			}
+		}
+
 	}
 
 	return target
 }
 
+// Clone function
+func (m *AcmeConfig) Clone() proto.Message {
+	var target *AcmeConfig
+	if m == nil {
+		return target
+	}
+	target = &AcmeConfig{}
+
+	target.DirectoryUrl = m.GetDirectoryUrl()
+
+	target.Email = m.GetEmail()
+
+	if h, ok := interface{}(m.GetSecretRef()).(clone.Cloner); ok {
+		target.SecretRef = h.Clone().(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	} else {
+		target.SecretRef = proto.Clone(m.GetSecretRef()).(*github_com_solo_io_solo_kit_pkg_api_v1_resources_core.ResourceRef)
+	}
+
+	if h, ok := interface{}(m.GetRenewBefore()).(clone.Cloner); ok {
+		target.RenewBefore = h.Clone().(*github_com_golang_protobuf_ptypes_duration.Duration)
+	} else {
+		target.RenewBefore = proto.Clone(m.GetRenewBefore()).(*github_com_golang_protobuf_ptypes_duration.Duration)
+	}
+
+	target.DirectoryRootCa = m.GetDirectoryRootCa()
+
+	return target
+}
+
 // Clone function
 func (m *SSLFiles) Clone() proto.Message {
 	var target *SSLFiles
@@ -170,6 +170,21 @@ func (m *SslConfig) Equal(that interface{}) bool {
 			}
 		}
 
+	case *SslConfig_Acme:
+		if _, ok := target.SslSecrets.(*SslConfig_Acme); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetAcme()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAcme()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetAcme(), target.GetAcme()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.SslSecrets != target.SslSecrets {
@@ -180,6 +195,62 @@ func (m *SslConfig) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *AcmeConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AcmeConfig)
+	if !ok {
+		that2, ok := that.(AcmeConfig)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetDirectoryUrl(), target.GetDirectoryUrl()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetEmail(), target.GetEmail()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSecretRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSecretRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSecretRef(), target.GetSecretRef()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRenewBefore()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRenewBefore()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRenewBefore(), target.GetRenewBefore()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetDirectoryRootCa(), target.GetDirectoryRootCa()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *SSLFiles) Equal(that interface{}) bool {
 	if that == nil {
@@ -15,6 +15,8 @@ import (
 
 	github_com_golang_protobuf_ptypes_duration "github.com/golang/protobuf/ptypes/duration"
 
+	github_com_golang_protobuf_ptypes_timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
 	github_com_golang_protobuf_ptypes_wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
 	github_com_solo_io_gloo_projects_gloo_pkg_api_external_envoy_api_v2_cluster "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
@@ -77,6 +79,12 @@ func (m *Upstream) Clone() proto.Message {
 		target.DiscoveryMetadata = proto.Clone(m.GetDiscoveryMetadata()).(*DiscoveryMetadata)
 	}
 
+	if h, ok := interface{}(m.GetDiscoveryStatus()).(clone.Cloner); ok {
+		target.DiscoveryStatus = h.Clone().(*DiscoveryStatus)
+	} else {
+		target.DiscoveryStatus = proto.Clone(m.GetDiscoveryStatus()).(*DiscoveryStatus)
+	}
+
 	if h, ok := interface{}(m.GetSslConfig()).(clone.Cloner); ok {
 		target.SslConfig = h.Clone().(*github_com_solo_io_gloo_projects_gloo_pkg_api_v1_ssl.UpstreamSslConfig)
 	} else {
@@ -324,6 +332,37 @@ func (m *DiscoveryMetadata) Clone() proto.Message {
 	return target
 }
 
+// Clone function
+func (m *DiscoveryStatus) Clone() proto.Message {
+	var target *DiscoveryStatus
+	if m == nil {
+		return target
+	}
+	target = &DiscoveryStatus{}
+
+	target.Error = m.GetError()
+
+	target.DetectedType = m.GetDetectedType()
+
+	target.DiscoveryPlugin = m.GetDiscoveryPlugin()
+
+	if h, ok := interface{}(m.GetLastTransitionTime()).(clone.Cloner); ok {
+		target.LastTransitionTime = h.Clone().(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	} else {
+		target.LastTransitionTime = proto.Clone(m.GetLastTransitionTime()).(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	}
+
+	if h, ok := interface{}(m.GetLastSuccessTime()).(clone.Cloner); ok {
+		target.LastSuccessTime = h.Clone().(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	} else {
+		target.LastSuccessTime = proto.Clone(m.GetLastSuccessTime()).(*github_com_golang_protobuf_ptypes_timestamp.Timestamp)
+	}
+
+	target.FunctionCount = m.GetFunctionCount()
+
+	return target
+}
+
 // Clone function
 func (m *HeaderValue) Clone() proto.Message {
 	var target *HeaderValue
@@ -76,6 +76,16 @@ func (m *Upstream) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetDiscoveryStatus()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDiscoveryStatus()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDiscoveryStatus(), target.GetDiscoveryStatus()) {
+			return false
+		}
+	}
+
 	if h, ok := interface{}(m.GetSslConfig()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetSslConfig()) {
 			return false
@@ -446,6 +456,66 @@ func (m *DiscoveryMetadata) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *DiscoveryStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DiscoveryStatus)
+	if !ok {
+		that2, ok := that.(DiscoveryStatus)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetError(), target.GetError()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetDetectedType(), target.GetDetectedType()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetDiscoveryPlugin(), target.GetDiscoveryPlugin()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetLastTransitionTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLastTransitionTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLastTransitionTime(), target.GetLastTransitionTime()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetLastSuccessTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLastSuccessTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLastSuccessTime(), target.GetLastSuccessTime()) {
+			return false
+		}
+	}
+
+	if m.GetFunctionCount() != target.GetFunctionCount() {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *HeaderValue) Equal(that interface{}) bool {
 	if that == nil {
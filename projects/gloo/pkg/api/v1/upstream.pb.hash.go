@@ -691,6 +691,79 @@ func (m *DiscoveryMetadata) Hash(hasher hash.Hash64) (uint64, error) {
 	return hasher.Sum64(), nil
 }
 
+// Hash function
+func (m *DiscoveryStatus) Hash(hasher hash.Hash64) (uint64, error) {
+	if m == nil {
+		return 0, nil
+	}
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	var err error
+	if _, err = hasher.Write([]byte("gloo.solo.io.github.com/solo-io/gloo/projects/gloo/pkg/api/v1.DiscoveryStatus")); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetError())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetDetectedType())); err != nil {
+		return 0, err
+	}
+
+	if _, err = hasher.Write([]byte(m.GetDiscoveryPlugin())); err != nil {
+		return 0, err
+	}
+
+	if h, ok := interface{}(m.GetLastTransitionTime()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("LastTransitionTime")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetLastTransitionTime(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("LastTransitionTime")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if h, ok := interface{}(m.GetLastSuccessTime()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("LastSuccessTime")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetLastSuccessTime(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("LastSuccessTime")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	err = binary.Write(hasher, binary.LittleEndian, m.GetFunctionCount())
+	if err != nil {
+		return 0, err
+	}
+
+	return hasher.Sum64(), nil
+}
+
 // Hash function
 func (m *HeaderValue) Hash(hasher hash.Hash64) (uint64, error) {
 	if m == nil {
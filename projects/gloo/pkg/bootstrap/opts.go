@@ -7,6 +7,7 @@ import (
 	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector"
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/debug"
+	wasmcache "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm/cache"
 
 	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
 
@@ -53,6 +54,7 @@ type Opts struct {
 	ControlPlane                 ControlPlane
 	ValidationServer             ValidationServer
 	ProxyDebugServer             ProxyDebugServer
+	WasmImageCache               wasmcache.Cache
 	Settings                     *v1.Settings
 	KubeCoreCache                corecache.KubeCoreCache
 	ValidationOpts               *gwtranslator.ValidationOpts
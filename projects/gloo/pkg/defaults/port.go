@@ -14,6 +14,8 @@ var GlooProxyDebugPort = 9966
 var GlooRestXdsPort = 9976
 var GlooXdsPort = 9977
 var GlooValidationPort = 9988
+var GlooWasmCachePort = 9979
+var GlooColdStartPort = 9955
 var GlooMtlsModeRestXdsPort = 9998
 var GlooMtlsModeXdsPort = 9999
 var DefaultRefreshRate = time.Minute
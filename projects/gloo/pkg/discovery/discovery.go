@@ -143,10 +143,12 @@ func (d *UpstreamDiscovery) Resync(ctx context.Context) error {
 			selector[k] = v
 		}
 		logger.Debugw("reconciling upstream details", zap.Any("upstreams", desiredUpstreams))
-		if err := d.upstreamReconciler.Reconcile(d.writeNamespace, desiredUpstreams, uds.UpdateUpstream, clients.ListOpts{
+		err := d.upstreamReconciler.Reconcile(d.writeNamespace, desiredUpstreams, uds.UpdateUpstream, clients.ListOpts{
 			Ctx:      ctx,
 			Selector: selector,
-		}); err != nil {
+		})
+		recordReconcile(ctx, udsName, len(desiredUpstreams), err)
+		if err != nil {
 			logger.Errorw("failed reconciling upstreams",
 				zap.Any("discovered_by", udsName), zap.Int("upstreams", len(desiredUpstreams)), zap.Error(err))
 			return err
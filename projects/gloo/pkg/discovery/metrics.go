@@ -0,0 +1,51 @@
+package discovery
+
+import (
+	"context"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+const (
+	reconcileSucceeded = "success"
+	reconcileFailed    = "failure"
+)
+
+var (
+	mReconciles          = stats.Int64("discovery.gloo.solo.io/uds/reconciles", "The number of upstream discovery reconciles", "1")
+	mDiscoveredUpstreams = stats.Int64("discovery.gloo.solo.io/uds/upstreams", "The number of upstreams discovered", "1")
+	pluginKey, _         = tag.NewKey("plugin")
+	resultKey, _         = tag.NewKey("result")
+
+	reconcilesView = &view.View{
+		Name:        "discovery.gloo.solo.io/uds/reconciles",
+		Measure:     mReconciles,
+		Description: "The number of upstream discovery reconciles, by discovery plugin and result",
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{pluginKey, resultKey},
+	}
+	discoveredUpstreamsView = &view.View{
+		Name:        "discovery.gloo.solo.io/uds/upstreams",
+		Measure:     mDiscoveredUpstreams,
+		Description: "The number of upstreams discovered by each discovery plugin",
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{pluginKey},
+	}
+)
+
+func init() {
+	_ = view.Register(reconcilesView, discoveredUpstreamsView)
+}
+
+func recordReconcile(ctx context.Context, udsName string, upstreams int, err error) {
+	result := reconcileSucceeded
+	if err != nil {
+		result = reconcileFailed
+	}
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(pluginKey, udsName), tag.Upsert(resultKey, result)}, mReconciles.M(1))
+	if err == nil {
+		_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(pluginKey, udsName)}, mDiscoveredUpstreams.M(int64(upstreams)))
+	}
+}
@@ -0,0 +1,115 @@
+package adaptive_concurrency
+
+import (
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyadaptiveconcurrency "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "adaptive_concurrency"
+	FilterName    = "envoy.filters.http.adaptive_concurrency"
+)
+
+// the filter sheds load before any work is done for the request, but after faults are injected, so that
+// injected delays are not measured as upstream latency
+var pluginStage = plugins.AfterStage(plugins.FaultStage)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	adaptiveConcurrency := listener.GetOptions().GetAdaptiveConcurrency()
+	if adaptiveConcurrency == nil {
+		return nil, nil
+	}
+
+	config, err := translateAdaptiveConcurrency(adaptiveConcurrency)
+	if err != nil {
+		return nil, eris.Wrapf(err, "invalid adaptive concurrency config")
+	}
+	filter, err := plugins.NewStagedFilter(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	_ plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	if !in.GetOptions().GetAdaptiveConcurrency().GetDisabled() {
+		return nil
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, pluginutils.DisabledFilterConfig())
+}
+
+func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if !in.GetOptions().GetAdaptiveConcurrency().GetDisabled() {
+		return nil
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, pluginutils.DisabledFilterConfig())
+}
+
+func translateAdaptiveConcurrency(in *adaptive_concurrency.AdaptiveConcurrency) (*envoyadaptiveconcurrency.AdaptiveConcurrency, error) {
+	gradientController := in.GetGradientController()
+	concurrencyLimit := gradientController.GetConcurrencyLimit()
+	minRttCalculation := gradientController.GetMinRttCalculation()
+
+	out := &envoyadaptiveconcurrency.AdaptiveConcurrency{
+		ConcurrencyControllerConfig: &envoyadaptiveconcurrency.AdaptiveConcurrency_GradientControllerConfig{
+			GradientControllerConfig: &envoyadaptiveconcurrency.GradientControllerConfig{
+				SampleAggregatePercentile: percent(gradientController.GetSampleAggregatePercentile()),
+				ConcurrencyLimitParams: &envoyadaptiveconcurrency.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+					MaxConcurrencyLimit:       concurrencyLimit.GetMaxConcurrencyLimit(),
+					ConcurrencyUpdateInterval: concurrencyLimit.GetConcurrencyUpdateInterval(),
+				},
+				MinRttCalcParams: &envoyadaptiveconcurrency.GradientControllerConfig_MinimumRTTCalculationParams{
+					Interval:       minRttCalculation.GetInterval(),
+					RequestCount:   minRttCalculation.GetRequestCount(),
+					Jitter:         percent(minRttCalculation.GetJitter()),
+					MinConcurrency: minRttCalculation.GetMinConcurrency(),
+					Buffer:         percent(minRttCalculation.GetBuffer()),
+				},
+			},
+		},
+	}
+	if in.GetConcurrencyLimitExceededStatus() != nil {
+		out.ConcurrencyLimitExceededStatus = &envoy_type_v3.HttpStatus{
+			Code: envoy_type_v3.StatusCode(in.GetConcurrencyLimitExceededStatus().GetValue()),
+		}
+	}
+	return out, out.Validate()
+}
+
+func percent(in *wrappers.DoubleValue) *envoy_type_v3.Percent {
+	if in == nil {
+		return nil
+	}
+	return &envoy_type_v3.Percent{Value: in.GetValue()}
+}
@@ -0,0 +1,101 @@
+package adaptive_concurrency_test
+
+import (
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyadaptiveconcurrency "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/adaptive_concurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/adaptive_concurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var config *adaptive_concurrency.AdaptiveConcurrency
+
+	BeforeEach(func() {
+		config = &adaptive_concurrency.AdaptiveConcurrency{
+			GradientController: &adaptive_concurrency.GradientController{
+				SampleAggregatePercentile: &wrapperspb.DoubleValue{Value: 90},
+				ConcurrencyLimit: &adaptive_concurrency.GradientController_ConcurrencyLimit{
+					MaxConcurrencyLimit:       &wrapperspb.UInt32Value{Value: 100},
+					ConcurrencyUpdateInterval: durationpb.New(100 * time.Millisecond),
+				},
+				MinRttCalculation: &adaptive_concurrency.GradientController_MinRttCalculation{
+					Interval: durationpb.New(time.Minute),
+					Jitter:   &wrapperspb.DoubleValue{Value: 10},
+				},
+			},
+			ConcurrencyLimitExceededStatus: &wrapperspb.UInt32Value{Value: 429},
+		}
+	})
+
+	httpFilters := func() ([]plugins.StagedHttpFilter, error) {
+		return NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{AdaptiveConcurrency: config},
+		})
+	}
+
+	It("does not add a filter when adaptive concurrency is not configured", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("configures the gradient controller", func() {
+		filters, err := httpFilters()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.FaultStage)))
+
+		msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg).To(matchers.MatchProto(&envoyadaptiveconcurrency.AdaptiveConcurrency{
+			ConcurrencyControllerConfig: &envoyadaptiveconcurrency.AdaptiveConcurrency_GradientControllerConfig{
+				GradientControllerConfig: &envoyadaptiveconcurrency.GradientControllerConfig{
+					SampleAggregatePercentile: &envoy_type_v3.Percent{Value: 90},
+					ConcurrencyLimitParams: &envoyadaptiveconcurrency.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+						MaxConcurrencyLimit:       &wrapperspb.UInt32Value{Value: 100},
+						ConcurrencyUpdateInterval: durationpb.New(100 * time.Millisecond),
+					},
+					MinRttCalcParams: &envoyadaptiveconcurrency.GradientControllerConfig_MinimumRTTCalculationParams{
+						Interval: durationpb.New(time.Minute),
+						Jitter:   &envoy_type_v3.Percent{Value: 10},
+					},
+				},
+			},
+			ConcurrencyLimitExceededStatus: &envoy_type_v3.HttpStatus{Code: envoy_type_v3.StatusCode_TooManyRequests},
+		}))
+	})
+
+	It("errors when the concurrency update interval is missing", func() {
+		config.GetGradientController().GetConcurrencyLimit().ConcurrencyUpdateInterval = nil
+
+		_, err := httpFilters()
+		Expect(err).To(MatchError(ContainSubstring("invalid adaptive concurrency config")))
+	})
+
+	It("disables the filter on routes", func() {
+		out := &envoy_config_route_v3.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				AdaptiveConcurrency: &adaptive_concurrency.AdaptiveConcurrencyPerRoute{Disabled: true},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		disabled, err := utils.MessageToAny(&envoy_config_route_v3.FilterConfig{Disabled: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(HaveKeyWithValue(FilterName, matchers.MatchProto(disabled)))
+	})
+})
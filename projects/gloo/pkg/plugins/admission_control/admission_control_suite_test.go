@@ -0,0 +1,13 @@
+package admission_control_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAdmissionControl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Admission Control Suite")
+}
@@ -0,0 +1,137 @@
+package admission_control
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyadmissioncontrol "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/admission_control"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "admission_control"
+	FilterName    = "envoy.filters.http.admission_control"
+
+	// the runtime keys which can be used to override the configured values
+	AggressionRuntimeKey              = "admission_control.aggression"
+	SuccessRateThresholdRuntimeKey    = "admission_control.sr_threshold"
+	RpsThresholdRuntimeKey            = "admission_control.rps_threshold"
+	MaxRejectionProbabilityRuntimeKey = "admission_control.max_rejection_probability"
+)
+
+// the filter sheds load before any work is done for the request, but after faults are injected, so that
+// injected aborts are not counted as upstream failures
+var pluginStage = plugins.AfterStage(plugins.FaultStage)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	admissionControl := listener.GetOptions().GetAdmissionControl()
+	if admissionControl == nil {
+		return nil, nil
+	}
+
+	config, err := translateAdmissionControl(admissionControl)
+	if err != nil {
+		return nil, eris.Wrapf(err, "invalid admission control config")
+	}
+	filter, err := plugins.NewStagedFilter(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	_ plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	if !in.GetOptions().GetAdmissionControl().GetDisabled() {
+		return nil
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, pluginutils.DisabledFilterConfig())
+}
+
+func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if !in.GetOptions().GetAdmissionControl().GetDisabled() {
+		return nil
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, pluginutils.DisabledFilterConfig())
+}
+
+func translateAdmissionControl(in *admission_control.AdmissionControl) (*envoyadmissioncontrol.AdmissionControl, error) {
+	out := &envoyadmissioncontrol.AdmissionControl{
+		EvaluationCriteria: &envoyadmissioncontrol.AdmissionControl_SuccessCriteria_{
+			SuccessCriteria: translateSuccessCriteria(in.GetSuccessCriteria()),
+		},
+		SamplingWindow: in.GetSamplingWindow(),
+	}
+	if in.GetAggression() != nil {
+		out.Aggression = &envoy_config_core_v3.RuntimeDouble{
+			DefaultValue: in.GetAggression().GetValue(),
+			RuntimeKey:   AggressionRuntimeKey,
+		}
+	}
+	if in.GetSuccessRateThreshold() != nil {
+		out.SrThreshold = &envoy_config_core_v3.RuntimePercent{
+			DefaultValue: &envoy_type_v3.Percent{Value: in.GetSuccessRateThreshold().GetValue()},
+			RuntimeKey:   SuccessRateThresholdRuntimeKey,
+		}
+	}
+	if in.GetRpsThreshold() != nil {
+		out.RpsThreshold = &envoy_config_core_v3.RuntimeUInt32{
+			DefaultValue: in.GetRpsThreshold().GetValue(),
+			RuntimeKey:   RpsThresholdRuntimeKey,
+		}
+	}
+	if in.GetMaxRejectionProbability() != nil {
+		out.MaxRejectionProbability = &envoy_config_core_v3.RuntimePercent{
+			DefaultValue: &envoy_type_v3.Percent{Value: in.GetMaxRejectionProbability().GetValue()},
+			RuntimeKey:   MaxRejectionProbabilityRuntimeKey,
+		}
+	}
+	return out, out.Validate()
+}
+
+// translateSuccessCriteria returns the success criteria, which Envoy requires even if it uses the default criteria
+func translateSuccessCriteria(in *admission_control.AdmissionControl_SuccessCriteria) *envoyadmissioncontrol.AdmissionControl_SuccessCriteria {
+	out := &envoyadmissioncontrol.AdmissionControl_SuccessCriteria{}
+	if len(in.GetHttpSuccessStatus()) > 0 {
+		out.HttpCriteria = &envoyadmissioncontrol.AdmissionControl_SuccessCriteria_HttpCriteria{}
+		for _, status := range in.GetHttpSuccessStatus() {
+			out.GetHttpCriteria().HttpSuccessStatus = append(out.GetHttpCriteria().GetHttpSuccessStatus(), &envoy_type_v3.Int32Range{
+				Start: status.GetStart(),
+				End:   status.GetEnd(),
+			})
+		}
+	}
+	if len(in.GetGrpcSuccessStatus()) > 0 {
+		out.GrpcCriteria = &envoyadmissioncontrol.AdmissionControl_SuccessCriteria_GrpcCriteria{
+			GrpcSuccessStatus: in.GetGrpcSuccessStatus(),
+		}
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+package admission_control_test
+
+import (
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyadmissioncontrol "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	solo_type_v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/admission_control"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/admission_control"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	filterConfig := func(config *admission_control.AdmissionControl) *envoyadmissioncontrol.AdmissionControl {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{AdmissionControl: config},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.FaultStage)))
+
+		msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		return msg.(*envoyadmissioncontrol.AdmissionControl)
+	}
+
+	It("does not add a filter when admission control is not configured", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("uses the default success criteria", func() {
+		Expect(filterConfig(&admission_control.AdmissionControl{})).To(matchers.MatchProto(&envoyadmissioncontrol.AdmissionControl{
+			EvaluationCriteria: &envoyadmissioncontrol.AdmissionControl_SuccessCriteria_{
+				SuccessCriteria: &envoyadmissioncontrol.AdmissionControl_SuccessCriteria{},
+			},
+		}))
+	})
+
+	It("translates the thresholds to runtime values", func() {
+		Expect(filterConfig(&admission_control.AdmissionControl{
+			SuccessCriteria: &admission_control.AdmissionControl_SuccessCriteria{
+				HttpSuccessStatus: []*solo_type_v3.Int32Range{{Start: 200, End: 300}},
+				GrpcSuccessStatus: []uint32{0},
+			},
+			SamplingWindow:          durationpb.New(10 * time.Second),
+			Aggression:              &wrapperspb.DoubleValue{Value: 1.5},
+			SuccessRateThreshold:    &wrapperspb.DoubleValue{Value: 90},
+			RpsThreshold:            &wrapperspb.UInt32Value{Value: 5},
+			MaxRejectionProbability: &wrapperspb.DoubleValue{Value: 50},
+		})).To(matchers.MatchProto(&envoyadmissioncontrol.AdmissionControl{
+			EvaluationCriteria: &envoyadmissioncontrol.AdmissionControl_SuccessCriteria_{
+				SuccessCriteria: &envoyadmissioncontrol.AdmissionControl_SuccessCriteria{
+					HttpCriteria: &envoyadmissioncontrol.AdmissionControl_SuccessCriteria_HttpCriteria{
+						HttpSuccessStatus: []*envoy_type_v3.Int32Range{{Start: 200, End: 300}},
+					},
+					GrpcCriteria: &envoyadmissioncontrol.AdmissionControl_SuccessCriteria_GrpcCriteria{
+						GrpcSuccessStatus: []uint32{0},
+					},
+				},
+			},
+			SamplingWindow: durationpb.New(10 * time.Second),
+			Aggression: &envoy_config_core_v3.RuntimeDouble{
+				DefaultValue: 1.5,
+				RuntimeKey:   AggressionRuntimeKey,
+			},
+			SrThreshold: &envoy_config_core_v3.RuntimePercent{
+				DefaultValue: &envoy_type_v3.Percent{Value: 90},
+				RuntimeKey:   SuccessRateThresholdRuntimeKey,
+			},
+			RpsThreshold: &envoy_config_core_v3.RuntimeUInt32{
+				DefaultValue: 5,
+				RuntimeKey:   RpsThresholdRuntimeKey,
+			},
+			MaxRejectionProbability: &envoy_config_core_v3.RuntimePercent{
+				DefaultValue: &envoy_type_v3.Percent{Value: 50},
+				RuntimeKey:   MaxRejectionProbabilityRuntimeKey,
+			},
+		}))
+	})
+
+	It("errors on an invalid success rate threshold", func() {
+		_, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{AdmissionControl: &admission_control.AdmissionControl{
+				SuccessRateThreshold: &wrapperspb.DoubleValue{Value: 150},
+			}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("invalid admission control config")))
+	})
+
+	It("disables the filter on virtual hosts", func() {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				AdmissionControl: &admission_control.AdmissionControlPerRoute{Disabled: true},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		disabled, err := utils.MessageToAny(&envoy_config_route_v3.FilterConfig{Disabled: true})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(HaveKeyWithValue(FilterName, matchers.MatchProto(disabled)))
+	})
+})
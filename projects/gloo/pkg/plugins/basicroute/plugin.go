@@ -3,8 +3,10 @@ package basicroute
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/solo-io/gloo/pkg/utils/regexutils"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
@@ -12,6 +14,8 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils/upgradeconfig"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 )
 
@@ -22,6 +26,11 @@ var (
 
 const (
 	ExtensionName = "basic_route"
+
+	PreviousHostsPredicateName = "envoy.retry_host_predicates.previous_hosts"
+
+	retriableStatusCodesRetryOn = "retriable-status-codes"
+	retriableHeadersRetryOn     = "retriable-headers"
 )
 
 // Handles a RoutePlugin APIs which map directly to basic Envoy config
@@ -46,7 +55,7 @@ func (p *plugin) ProcessVirtualHost(
 	if in.GetOptions() == nil {
 		return nil
 	}
-	return applyRetriesVhost(in, out)
+	return applyRetriesVhost(params.Ctx, in, out)
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -74,7 +83,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 	if err := applyMaxStreamDuration(in, out); err != nil {
 		return err
 	}
-	if err := applyRetries(in, out); err != nil {
+	if err := applyRetries(params.Ctx, in, out); err != nil {
 		return err
 	}
 	if err := applyHostRewrite(params.Ctx, in, out); err != nil {
@@ -178,7 +187,7 @@ func applyMaxStreamDuration(in *v1.Route, out *envoy_config_route_v3.Route) erro
 	return nil
 }
 
-func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
+func applyRetries(ctx context.Context, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	policy := in.GetOptions().GetRetries()
 	if policy == nil {
 		return nil
@@ -193,10 +202,11 @@ func applyRetries(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	}
 
 	var err error
-	routeAction.Route.RetryPolicy, err = convertPolicy(policy)
+	routeAction.Route.RetryPolicy, err = convertPolicy(ctx, policy)
 	if err != nil {
 		return err
 	}
+	routeAction.Route.HedgePolicy = convertHedgePolicy(policy)
 	return nil
 }
 
@@ -285,16 +295,17 @@ func applyUpgrades(in *v1.Route, out *envoy_config_route_v3.Route) error {
 	return upgradeconfig.ValidateRouteUpgradeConfigs(routeAction.Route.GetUpgradeConfigs())
 }
 
-func applyRetriesVhost(in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
+func applyRetriesVhost(ctx context.Context, in *v1.VirtualHost, out *envoy_config_route_v3.VirtualHost) error {
 	var err error
-	out.RetryPolicy, err = convertPolicy(in.GetOptions().GetRetries())
+	out.RetryPolicy, err = convertPolicy(ctx, in.GetOptions().GetRetries())
 	if err != nil {
 		return err
 	}
+	out.HedgePolicy = convertHedgePolicy(in.GetOptions().GetRetries())
 	return nil
 }
 
-func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
+func convertPolicy(ctx context.Context, policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
 	if policy == nil {
 		return nil, nil
 	}
@@ -352,17 +363,103 @@ func convertPolicy(policy *retries.RetryPolicy) (*envoy_config_route_v3.RetryPol
 		}
 
 		// If max and/or/both base intervals are defined, return a RetryPolicy object that contains them
-		return &envoy_config_route_v3.RetryPolicy{
+		return applyRetryPolicyOptions(ctx, policy, &envoy_config_route_v3.RetryPolicy{
 			RetryOn:       policy.GetRetryOn(),
 			NumRetries:    &wrappers.UInt32Value{Value: numRetries},
 			PerTryTimeout: policy.GetPerTryTimeout(),
 			RetryBackOff:  v3RetryPolicyBackOff,
-		}, nil
+		})
 	}
 
-	return &envoy_config_route_v3.RetryPolicy{
+	return applyRetryPolicyOptions(ctx, policy, &envoy_config_route_v3.RetryPolicy{
 		RetryOn:       policy.GetRetryOn(),
 		NumRetries:    &wrappers.UInt32Value{Value: numRetries},
 		PerTryTimeout: policy.GetPerTryTimeout(),
-	}, nil
+	})
+}
+
+// applyRetryPolicyOptions converts the retry options that are not related to the number, timeout and interval of retries
+func applyRetryPolicyOptions(ctx context.Context, policy *retries.RetryPolicy, out *envoy_config_route_v3.RetryPolicy) (*envoy_config_route_v3.RetryPolicy, error) {
+	if statusCodes := policy.GetRetriableStatusCodes(); len(statusCodes) > 0 {
+		if !retryOnContains(policy.GetRetryOn(), retriableStatusCodesRetryOn) {
+			return nil, errors.Errorf("retriable status codes were specified, but retry on does not contain %s", retriableStatusCodesRetryOn)
+		}
+		for _, statusCode := range statusCodes {
+			if statusCode < 100 || statusCode > 599 {
+				return nil, errors.Errorf("retriable status code must be between 100 and 599, received %d", statusCode)
+			}
+		}
+		out.RetriableStatusCodes = statusCodes
+	}
+
+	if headers := policy.GetRetriableHeaders(); len(headers) > 0 {
+		if !retryOnContains(policy.GetRetryOn(), retriableHeadersRetryOn) {
+			return nil, errors.Errorf("retriable headers were specified, but retry on does not contain %s", retriableHeadersRetryOn)
+		}
+		out.RetriableHeaders = translator.EnvoyHeaderMatchers(ctx, headers)
+	}
+
+	if rateLimitedBackOff := policy.GetRateLimitedRetryBackOff(); rateLimitedBackOff != nil {
+		if len(rateLimitedBackOff.GetResetHeaders()) == 0 {
+			return nil, errors.Errorf("rate limited retry back off must specify at least one reset header")
+		}
+		if maxInterval := rateLimitedBackOff.GetMaxInterval(); maxInterval != nil && maxInterval.AsDuration() <= 0 {
+			return nil, errors.Errorf("max interval for rate limited retry backoff must be > 0 | you provided: %v", maxInterval.AsDuration())
+		}
+		out.RateLimitedRetryBackOff = &envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+			MaxInterval: rateLimitedBackOff.GetMaxInterval(),
+		}
+		for _, header := range rateLimitedBackOff.GetResetHeaders() {
+			if header.GetName() == "" {
+				return nil, errors.Errorf("rate limited retry back off reset header must specify a name")
+			}
+			out.GetRateLimitedRetryBackOff().ResetHeaders = append(out.GetRateLimitedRetryBackOff().GetResetHeaders(), &envoy_config_route_v3.RetryPolicy_ResetHeader{
+				Name:   header.GetName(),
+				Format: envoy_config_route_v3.RetryPolicy_ResetHeaderFormat(header.GetFormat()),
+			})
+		}
+	}
+
+	if policy.GetHedgeOnPerTryTimeout() && policy.GetPerTryTimeout() == nil {
+		return nil, errors.Errorf("hedging on per try timeout requires a per try timeout")
+	}
+
+	if previousHosts := policy.GetPreviousHosts(); previousHosts != nil {
+		if previousHosts.GetHostSelectionRetryMaxAttempts() < 0 {
+			return nil, errors.Errorf("host selection retry max attempts must be >= 0 | you provided: %d", previousHosts.GetHostSelectionRetryMaxAttempts())
+		}
+		predicate, err := utils.MessageToAny(&envoy_previous_hosts_v3.PreviousHostsPredicate{})
+		if err != nil {
+			return nil, err
+		}
+		out.RetryHostPredicate = []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+			Name: PreviousHostsPredicateName,
+			ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: predicate,
+			},
+		}}
+		out.HostSelectionRetryMaxAttempts = previousHosts.GetHostSelectionRetryMaxAttempts()
+	}
+
+	return out, nil
+}
+
+// retryOnContains returns true if the comma-separated list of retry conditions contains the given condition
+func retryOnContains(retryOn string, condition string) bool {
+	for _, c := range strings.Split(retryOn, ",") {
+		if strings.TrimSpace(c) == condition {
+			return true
+		}
+	}
+	return false
+}
+
+// convertHedgePolicy returns the hedge policy for the retry policy, if hedging is enabled
+func convertHedgePolicy(policy *retries.RetryPolicy) *envoy_config_route_v3.HedgePolicy {
+	if !policy.GetHedgeOnPerTryTimeout() {
+		return nil
+	}
+	return &envoy_config_route_v3.HedgePolicy{
+		HedgeOnPerTryTimeout: true,
+	}
 }
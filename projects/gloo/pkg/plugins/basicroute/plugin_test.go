@@ -12,17 +12,21 @@ import (
 	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_previous_hosts_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v32 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol_upgrade"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/retries"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	matchers2 "github.com/solo-io/solo-kit/test/matchers"
 )
 
 var _ = Describe("prefix rewrite", func() {
@@ -490,6 +494,132 @@ var _ = Describe("retries with both intervals", func() {
 		Expect(out.RetryPolicy).To(Equal(expectedRetryPolicy))
 	})
 })
+
+var _ = Describe("retries with additional options", func() {
+	var (
+		retryPolicy         *retries.RetryPolicy
+		expectedRetryPolicy *envoy_config_route_v3.RetryPolicy
+	)
+
+	BeforeEach(func() {
+		previousHostsPredicate, err := utils.MessageToAny(&envoy_previous_hosts_v3.PreviousHostsPredicate{})
+		Expect(err).NotTo(HaveOccurred())
+
+		t := prototime.DurationToProto(time.Second)
+		retryPolicy = &retries.RetryPolicy{
+			RetryOn:              "5xx, retriable-status-codes,retriable-headers",
+			NumRetries:           3,
+			PerTryTimeout:        t,
+			RetriableStatusCodes: []uint32{409, 429},
+			RetriableHeaders: []*matchers.HeaderMatcher{
+				{Name: "x-retry-me"},
+				{Name: "x-upstream-status", Value: "overloaded"},
+			},
+			RateLimitedRetryBackOff: &retries.RateLimitedRetryBackOff{
+				ResetHeaders: []*retries.ResetHeader{
+					{Name: "Retry-After"},
+					{Name: "X-RateLimit-Reset", Format: retries.ResetHeader_UNIX_TIMESTAMP},
+				},
+				MaxInterval: durationpb.New(time.Minute),
+			},
+			PreviousHosts: &retries.PreviousHostsPredicate{
+				HostSelectionRetryMaxAttempts: 5,
+			},
+			HedgeOnPerTryTimeout: true,
+		}
+		expectedRetryPolicy = &envoy_config_route_v3.RetryPolicy{
+			RetryOn:              "5xx, retriable-status-codes,retriable-headers",
+			NumRetries:           &wrappers.UInt32Value{Value: 3},
+			PerTryTimeout:        t,
+			RetriableStatusCodes: []uint32{409, 429},
+			RetriableHeaders: []*envoy_config_route_v3.HeaderMatcher{
+				{
+					Name:                 "x-retry-me",
+					HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+				},
+				{
+					Name:                 "x-upstream-status",
+					HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "overloaded"},
+				},
+			},
+			RateLimitedRetryBackOff: &envoy_config_route_v3.RetryPolicy_RateLimitedRetryBackOff{
+				ResetHeaders: []*envoy_config_route_v3.RetryPolicy_ResetHeader{
+					{Name: "Retry-After", Format: envoy_config_route_v3.RetryPolicy_SECONDS},
+					{Name: "X-RateLimit-Reset", Format: envoy_config_route_v3.RetryPolicy_UNIX_TIMESTAMP},
+				},
+				MaxInterval: durationpb.New(time.Minute),
+			},
+			RetryHostPredicate: []*envoy_config_route_v3.RetryPolicy_RetryHostPredicate{{
+				Name: PreviousHostsPredicateName,
+				ConfigType: &envoy_config_route_v3.RetryPolicy_RetryHostPredicate_TypedConfig{
+					TypedConfig: previousHostsPredicate,
+				},
+			}},
+			HostSelectionRetryMaxAttempts: 5,
+		}
+	})
+
+	It("works", func() {
+		plugin := NewPlugin()
+		routeAction := &envoy_config_route_v3.RouteAction{}
+		out := &envoy_config_route_v3.Route{
+			Action: &envoy_config_route_v3.Route_Route{
+				Route: routeAction,
+			},
+		}
+		err := plugin.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Retries: retryPolicy,
+			},
+			Action: &v1.Route_RouteAction{},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routeAction.GetRetryPolicy()).To(matchers2.MatchProto(expectedRetryPolicy))
+		Expect(routeAction.GetHedgePolicy().GetHedgeOnPerTryTimeout()).To(BeTrue())
+	})
+
+	It("works on vhost", func() {
+		plugin := NewPlugin()
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Retries: retryPolicy,
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetRetryPolicy()).To(matchers2.MatchProto(expectedRetryPolicy))
+		Expect(out.GetHedgePolicy().GetHedgeOnPerTryTimeout()).To(BeTrue())
+	})
+
+	DescribeTable("rejects invalid policies",
+		func(mutate func(policy *retries.RetryPolicy), expectedErr string) {
+			mutate(retryPolicy)
+			plugin := NewPlugin()
+			err := plugin.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					Retries: retryPolicy,
+				},
+			}, &envoy_config_route_v3.VirtualHost{})
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("status codes without retry on condition", func(policy *retries.RetryPolicy) {
+			policy.RetryOn = "5xx,retriable-headers"
+		}, "retry on does not contain retriable-status-codes"),
+		Entry("headers without retry on condition", func(policy *retries.RetryPolicy) {
+			policy.RetryOn = "5xx,retriable-status-codes"
+		}, "retry on does not contain retriable-headers"),
+		Entry("invalid status code", func(policy *retries.RetryPolicy) {
+			policy.RetriableStatusCodes = []uint32{600}
+		}, "retriable status code must be between 100 and 599"),
+		Entry("rate limited back off without reset headers", func(policy *retries.RetryPolicy) {
+			policy.GetRateLimitedRetryBackOff().ResetHeaders = nil
+		}, "must specify at least one reset header"),
+		Entry("hedging without per try timeout", func(policy *retries.RetryPolicy) {
+			policy.PerTryTimeout = nil
+		}, "hedging on per try timeout requires a per try timeout"),
+	)
+})
+
 var _ = Describe("host rewrite", func() {
 	It("rewrites using provided string", func() {
 
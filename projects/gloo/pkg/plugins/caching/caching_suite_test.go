@@ -0,0 +1,13 @@
+package caching_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCaching(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Caching Suite")
+}
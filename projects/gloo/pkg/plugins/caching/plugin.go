@@ -0,0 +1,205 @@
+package caching
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycache "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	envoysimplecache "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	gloo_type_matcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+)
+
+const (
+	ExtensionName = "caching"
+	FilterName    = "envoy.filters.http.cache"
+)
+
+// the filter serves cached responses only to the requests which passed authentication, authorization and rate limiting
+var pluginStage = plugins.DuringStage(plugins.AcceptedStage)
+
+// the methods whose responses Envoy may cache
+var cacheableMethods = []string{"GET", "HEAD"}
+
+var (
+	UnsupportedMethodError = func(method string) error {
+		return eris.Errorf("method %s is not cacheable, only GET and HEAD responses can be cached", method)
+	}
+	UncachedMethodMatchedError = func(method string) error {
+		return eris.Errorf("the route matches %s requests, which are not in the cacheable methods of its cache policy; "+
+			"restrict the methods of the route matchers", method)
+	}
+	InvalidTtlError = eris.New("cache policy ttl must be at least one second")
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	settings := listener.GetOptions().GetCaching()
+	// the caching server is enterprise-only, and reported by the enterprise_warning plugin
+	if settings == nil || settings.GetCachingServiceRef() != nil {
+		return nil, nil
+	}
+
+	simpleCache, err := utils.MessageToAny(&envoysimplecache.SimpleHttpCacheConfig{})
+	if err != nil {
+		return nil, err
+	}
+	config := &envoycache.CacheConfig{
+		TypedConfig:        simpleCache,
+		AllowedVaryHeaders: translateStringMatchers(params.Ctx, settings.GetAllowedVaryHeaders()),
+		MaxBodyBytes:       settings.GetMaxPayloadSize().GetValue(),
+	}
+	filter, err := plugins.NewStagedFilter(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrapf(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	policy := in.GetOptions().GetCachePolicy()
+	if policy == nil {
+		return nil
+	}
+
+	disabled := policy.GetDisabled()
+	if !disabled && len(policy.GetCacheableMethods()) > 0 {
+		var err error
+		disabled, err = matchesNoCacheableMethod(in, policy.GetCacheableMethods())
+		if err != nil {
+			return err
+		}
+	}
+	if disabled {
+		return pluginutils.SetRoutePerFilterConfig(out, FilterName, pluginutils.DisabledFilterConfig())
+	}
+
+	if len(policy.GetVaryHeaders()) > 0 {
+		out.ResponseHeadersToAdd = append(out.GetResponseHeadersToAdd(), &envoy_config_core_v3.HeaderValueOption{
+			Header: &envoy_config_core_v3.HeaderValue{
+				Key:   "vary",
+				Value: strings.Join(policy.GetVaryHeaders(), ", "),
+			},
+			AppendAction: envoy_config_core_v3.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD,
+		})
+	}
+	if ttl := policy.GetTtl(); ttl != nil {
+		if ttl.GetSeconds() < 1 {
+			return InvalidTtlError
+		}
+		// the ttl applies only to the responses without cache-control, the directives of the upstream such as
+		// private or no-store are never overridden
+		out.ResponseHeadersToAdd = append(out.GetResponseHeadersToAdd(), &envoy_config_core_v3.HeaderValueOption{
+			Header: &envoy_config_core_v3.HeaderValue{
+				Key:   "cache-control",
+				Value: fmt.Sprintf("public, max-age=%d", ttl.GetSeconds()),
+			},
+			AppendAction: envoy_config_core_v3.HeaderValueOption_ADD_IF_ABSENT,
+		})
+	}
+	return nil
+}
+
+// matchesNoCacheableMethod returns whether the route only matches methods which must not be cached.
+// Envoy cannot restrict caching to some methods of a route, so the route must not match
+// the methods which Envoy may cache but which are not listed.
+func matchesNoCacheableMethod(in *v1.Route, listed []string) (bool, error) {
+	listedMethods := map[string]bool{}
+	for _, method := range listed {
+		method = strings.ToUpper(method)
+		if !isCacheableMethod(method) {
+			return false, UnsupportedMethodError(method)
+		}
+		listedMethods[method] = true
+	}
+
+	// a route without matchers, or with a matcher without methods, matches all methods
+	matchedMethods := map[string]bool{}
+	matchesAllMethods := len(in.GetMatchers()) == 0
+	for _, matcher := range in.GetMatchers() {
+		if len(matcher.GetMethods()) == 0 {
+			matchesAllMethods = true
+		}
+		for _, method := range matcher.GetMethods() {
+			matchedMethods[strings.ToUpper(method)] = true
+		}
+	}
+
+	matchesListedMethod := false
+	for _, method := range cacheableMethods {
+		if !matchesAllMethods && !matchedMethods[method] {
+			continue
+		}
+		if !listedMethods[method] {
+			return false, UncachedMethodMatchedError(method)
+		}
+		matchesListedMethod = true
+	}
+	return !matchesListedMethod, nil
+}
+
+func isCacheableMethod(method string) bool {
+	for _, cacheable := range cacheableMethods {
+		if method == cacheable {
+			return true
+		}
+	}
+	return false
+}
+
+func translateStringMatchers(ctx context.Context, in []*gloo_type_matcher.StringMatcher) []*envoy_type_matcher_v3.StringMatcher {
+	var out []*envoy_type_matcher_v3.StringMatcher
+	for _, matcher := range in {
+		switch typed := matcher.GetMatchPattern().(type) {
+		case *gloo_type_matcher.StringMatcher_Exact:
+			out = append(out, &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: typed.Exact},
+				IgnoreCase:   matcher.GetIgnoreCase(),
+			})
+		case *gloo_type_matcher.StringMatcher_Prefix:
+			out = append(out, &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: typed.Prefix},
+				IgnoreCase:   matcher.GetIgnoreCase(),
+			})
+		case *gloo_type_matcher.StringMatcher_Suffix:
+			out = append(out, &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: typed.Suffix},
+				IgnoreCase:   matcher.GetIgnoreCase(),
+			})
+		case *gloo_type_matcher.StringMatcher_SafeRegex:
+			out = append(out, &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+					SafeRegex: regexutils.NewRegex(ctx, typed.SafeRegex.GetRegex()),
+				},
+			})
+		}
+	}
+	return out
+}
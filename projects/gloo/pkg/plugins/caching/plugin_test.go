@@ -0,0 +1,175 @@
+package caching_test
+
+import (
+	"context"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycache "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	envoysimplecache "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gloo_type_matcher "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/caching"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/caching"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	solomatchers "github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	Context("http filters", func() {
+
+		httpFilters := func(settings *caching.Settings) ([]plugins.StagedHttpFilter, error) {
+			return NewPlugin().HttpFilters(plugins.Params{Ctx: context.Background()}, &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{Caching: settings},
+			})
+		}
+
+		It("does not add a filter when caching is not configured", func() {
+			filters, err := httpFilters(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("does not add a filter when a caching server is referenced", func() {
+			filters, err := httpFilters(&caching.Settings{
+				CachingServiceRef: &core.ResourceRef{Name: "caching-service", Namespace: "gloo-system"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("configures the in-memory cache", func() {
+			filters, err := httpFilters(&caching.Settings{
+				AllowedVaryHeaders: []*gloo_type_matcher.StringMatcher{{
+					MatchPattern: &gloo_type_matcher.StringMatcher_Exact{Exact: "accept-language"},
+					IgnoreCase:   true,
+				}},
+				MaxPayloadSize: &wrapperspb.UInt32Value{Value: 1024},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+			Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.AcceptedStage)))
+
+			simpleCache, err := utils.MessageToAny(&envoysimplecache.SimpleHttpCacheConfig{})
+			Expect(err).NotTo(HaveOccurred())
+			msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(solomatchers.MatchProto(&envoycache.CacheConfig{
+				TypedConfig: simpleCache,
+				AllowedVaryHeaders: []*envoy_type_matcher_v3.StringMatcher{{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "accept-language"},
+					IgnoreCase:   true,
+				}},
+				MaxBodyBytes: 1024,
+			}))
+		})
+	})
+
+	Context("routes", func() {
+
+		var disabled *envoy_config_route_v3.FilterConfig
+
+		BeforeEach(func() {
+			disabled = &envoy_config_route_v3.FilterConfig{Disabled: true}
+		})
+
+		processRoute := func(route *v1.Route) (*envoy_config_route_v3.Route, error) {
+			out := &envoy_config_route_v3.Route{}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{}, route, out)
+			return out, err
+		}
+
+		routeWithPolicy := func(policy *caching.CachePolicy, methods ...string) *v1.Route {
+			return &v1.Route{
+				Matchers: []*matchers.Matcher{{Methods: methods}},
+				Options:  &v1.RouteOptions{CachePolicy: policy},
+			}
+		}
+
+		expectDisabled := func(out *envoy_config_route_v3.Route) {
+			disabledAny, err := utils.MessageToAny(disabled)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, out.GetTypedPerFilterConfig()).To(HaveKeyWithValue(FilterName, solomatchers.MatchProto(disabledAny)))
+		}
+
+		It("disables the filter", func() {
+			out, err := processRoute(routeWithPolicy(&caching.CachePolicy{Disabled: true}))
+			Expect(err).NotTo(HaveOccurred())
+			expectDisabled(out)
+		})
+
+		It("sets the default ttl and adds vary headers", func() {
+			out, err := processRoute(routeWithPolicy(&caching.CachePolicy{
+				VaryHeaders: []string{"accept-language", "x-tenant"},
+				Ttl:         durationpb.New(5 * time.Minute),
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+			Expect(out.GetResponseHeadersToAdd()).To(ConsistOf(
+				solomatchers.MatchProto(&envoy_config_core_v3.HeaderValueOption{
+					Header:       &envoy_config_core_v3.HeaderValue{Key: "vary", Value: "accept-language, x-tenant"},
+					AppendAction: envoy_config_core_v3.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD,
+				}),
+				solomatchers.MatchProto(&envoy_config_core_v3.HeaderValueOption{
+					Header:       &envoy_config_core_v3.HeaderValue{Key: "cache-control", Value: "public, max-age=300"},
+					AppendAction: envoy_config_core_v3.HeaderValueOption_ADD_IF_ABSENT,
+				}),
+			))
+		})
+
+		It("never overrides the cache-control header of the upstream", func() {
+			out, err := processRoute(routeWithPolicy(&caching.CachePolicy{Ttl: durationpb.New(time.Minute)}))
+			Expect(err).NotTo(HaveOccurred())
+			// responses which are private or no-store keep their cache-control header, and are not cached
+			var cacheControl []*envoy_config_core_v3.HeaderValueOption
+			for _, header := range out.GetResponseHeadersToAdd() {
+				if header.GetHeader().GetKey() == "cache-control" {
+					cacheControl = append(cacheControl, header)
+				}
+			}
+			Expect(cacheControl).To(HaveLen(1))
+			Expect(cacheControl[0].GetAppendAction()).To(Equal(envoy_config_core_v3.HeaderValueOption_ADD_IF_ABSENT))
+		})
+
+		It("errors on a ttl below one second", func() {
+			_, err := processRoute(routeWithPolicy(&caching.CachePolicy{Ttl: durationpb.New(time.Millisecond)}))
+			Expect(err).To(MatchError(InvalidTtlError))
+		})
+
+		Context("cacheable methods", func() {
+
+			It("keeps the filter when the route only matches cacheable methods", func() {
+				out, err := processRoute(routeWithPolicy(&caching.CachePolicy{CacheableMethods: []string{"get"}}, "GET", "POST"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+			})
+
+			It("disables the filter when the route matches no cacheable method", func() {
+				out, err := processRoute(routeWithPolicy(&caching.CachePolicy{CacheableMethods: []string{"GET"}}, "POST"))
+				Expect(err).NotTo(HaveOccurred())
+				expectDisabled(out)
+			})
+
+			It("errors when the route matches a method which is not listed", func() {
+				_, err := processRoute(routeWithPolicy(&caching.CachePolicy{CacheableMethods: []string{"GET"}}))
+				Expect(err).To(MatchError(UncachedMethodMatchedError("HEAD")))
+			})
+
+			It("errors on methods which cannot be cached", func() {
+				_, err := processRoute(routeWithPolicy(&caching.CachePolicy{CacheableMethods: []string{"POST"}}, "POST"))
+				Expect(err).To(MatchError(UnsupportedMethodError("POST")))
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package client_cert_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestClientCert(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Client Cert Suite")
+}
@@ -0,0 +1,191 @@
+package client_cert
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoylua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/client_cert"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+	_ plugins.RoutePlugin      = new(plugin)
+)
+
+const (
+	ExtensionName = "client_cert"
+	FilterName    = "envoy.filters.http.lua"
+
+	// DefaultMetadataNamespace is the namespace of the dynamic metadata entries which do not set one
+	DefaultMetadataNamespace = "io.solo.client_cert"
+)
+
+// the identity is mapped before the authentication, authorization and rate limiting filters, so that they can act on it
+var filterStage = plugins.AfterStage(plugins.WafStage)
+
+var (
+	NoMappingsError = func() error {
+		return eris.New("client cert identity must have at least one mapping")
+	}
+	NoDestinationError = func(field client_cert.ClientCertIdentity_Field) error {
+		return eris.Errorf("the mapping of client cert field %s must set a header or a dynamic metadata entry", field.String())
+	}
+	InvalidHeaderError = func(header string) error {
+		return eris.Errorf("client cert identity cannot be mapped to header %q", header)
+	}
+	NoMetadataKeyError = func(field client_cert.ClientCertIdentity_Field) error {
+		return eris.Errorf("the dynamic metadata entry of client cert field %s must have a key", field.String())
+	}
+
+	// the token characters of RFC 7230
+	headerNameRegex = regexp.MustCompile("^[a-zA-Z0-9!#$%&'*+.^_`|~-]+$")
+)
+
+// the Lua expressions of the fields, which evaluate to nil or to a string given the ssl connection
+var fieldExpressions = map[client_cert.ClientCertIdentity_Field]string{
+	client_cert.ClientCertIdentity_SPIFFE_ID:     "spiffe_id(ssl)",
+	client_cert.ClientCertIdentity_URI_SAN:       `table.concat(ssl:uriSanPeerCertificate(), ",")`,
+	client_cert.ClientCertIdentity_DNS_SAN:       `table.concat(ssl:dnsSansPeerCertificate(), ",")`,
+	client_cert.ClientCertIdentity_SUBJECT:       "ssl:subjectPeerCertificate()",
+	client_cert.ClientCertIdentity_SHA256_DIGEST: "ssl:sha256PeerCertificateDigest()",
+}
+
+const spiffeIdFunction = `local function spiffe_id(ssl)
+  for _, uri in ipairs(ssl:uriSanPeerCertificate()) do
+    if string.sub(uri, 1, 9) == "spiffe://" then
+      return uri
+    end
+  end
+  return nil
+end
+`
+
+type plugin struct {
+	filterRequiredForListener map[*v1.HttpListener]struct{}
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+	p.filterRequiredForListener = make(map[*v1.HttpListener]struct{})
+}
+
+// HttpFilters adds the Lua filter to the listeners which have routes that map the client cert identity.
+// The filter has no default code, so it only runs for these routes.
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	if _, ok := p.filterRequiredForListener[listener]; !ok {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+	filter, err := plugins.NewStagedFilter(FilterName, &envoylua.Lua{}, filterStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	identity := in.GetOptions().GetClientCertIdentity()
+	if identity == nil {
+		return nil
+	}
+
+	sourceCode, err := GenerateSourceCode(identity)
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, &envoylua.LuaPerRoute{
+		Override: &envoylua.LuaPerRoute_SourceCode{
+			SourceCode: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{InlineString: sourceCode},
+			},
+		},
+	})
+}
+
+// GenerateSourceCode generates the Lua code which maps the identity of the client certificate
+func GenerateSourceCode(identity *client_cert.ClientCertIdentity) (string, error) {
+	if len(identity.GetMappings()) == 0 {
+		return "", NoMappingsError()
+	}
+
+	var removeHeaders, setValues strings.Builder
+	for _, mapping := range identity.GetMappings() {
+		expression, ok := fieldExpressions[mapping.GetField()]
+		if !ok {
+			return "", eris.Errorf("unknown client cert field %s", mapping.GetField().String())
+		}
+		fmt.Fprintf(&setValues, "  value = %s\n", expression)
+
+		switch destination := mapping.GetDestination().(type) {
+		case *client_cert.ClientCertIdentity_Mapping_Header:
+			if !headerNameRegex.MatchString(destination.Header) || strings.EqualFold(destination.Header, "host") {
+				return "", InvalidHeaderError(destination.Header)
+			}
+			header := luaString(strings.ToLower(destination.Header))
+			fmt.Fprintf(&removeHeaders, "  headers:remove(%s)\n", header)
+			fmt.Fprintf(&setValues, "  if value ~= nil and value ~= \"\" then\n    headers:replace(%s, value)\n  end\n", header)
+		case *client_cert.ClientCertIdentity_Mapping_DynamicMetadata:
+			if destination.DynamicMetadata.GetKey() == "" {
+				return "", NoMetadataKeyError(mapping.GetField())
+			}
+			metadataNamespace := destination.DynamicMetadata.GetMetadataNamespace()
+			if metadataNamespace == "" {
+				metadataNamespace = DefaultMetadataNamespace
+			}
+			fmt.Fprintf(&setValues, "  if value ~= nil and value ~= \"\" then\n    metadata:set(%s, %s, value)\n  end\n",
+				luaString(metadataNamespace), luaString(destination.DynamicMetadata.GetKey()))
+		default:
+			return "", NoDestinationError(mapping.GetField())
+		}
+	}
+
+	var code strings.Builder
+	code.WriteString(spiffeIdFunction)
+	code.WriteString("\nfunction envoy_on_request(request_handle)\n")
+	code.WriteString("  local headers = request_handle:headers()\n")
+	code.WriteString(removeHeaders.String())
+	code.WriteString("  local ssl = request_handle:streamInfo():downstreamSslConnection()\n")
+	code.WriteString("  if ssl == nil or not ssl:peerCertificatePresented() then\n    return\n  end\n")
+	code.WriteString("  local metadata = request_handle:streamInfo():dynamicMetadata()\n")
+	code.WriteString("  local value\n")
+	code.WriteString(setValues.String())
+	code.WriteString("end\n")
+	return code.String(), nil
+}
+
+// luaString quotes the string as a Lua string literal, escaping the quotes, the backslashes and the non-printable bytes
+func luaString(s string) string {
+	var quoted strings.Builder
+	quoted.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			quoted.WriteByte('\\')
+			quoted.WriteByte(c)
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&quoted, "\\%03d", c)
+		default:
+			quoted.WriteByte(c)
+		}
+	}
+	quoted.WriteByte('"')
+	return quoted.String()
+}
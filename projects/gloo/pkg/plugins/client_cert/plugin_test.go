@@ -0,0 +1,134 @@
+package client_cert_test
+
+import (
+	"strings"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoylua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/client_cert"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/client_cert"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		p        plugins.Plugin
+		listener *v1.HttpListener
+		identity *client_cert.ClientCertIdentity
+	)
+
+	BeforeEach(func() {
+		p = NewPlugin()
+		p.Init(plugins.InitParams{})
+		listener = &v1.HttpListener{}
+		identity = &client_cert.ClientCertIdentity{
+			Mappings: []*client_cert.ClientCertIdentity_Mapping{
+				{
+					Field:       client_cert.ClientCertIdentity_SPIFFE_ID,
+					Destination: &client_cert.ClientCertIdentity_Mapping_Header{Header: "X-Spiffe-Id"},
+				},
+				{
+					Field: client_cert.ClientCertIdentity_DNS_SAN,
+					Destination: &client_cert.ClientCertIdentity_Mapping_DynamicMetadata{
+						DynamicMetadata: &client_cert.ClientCertIdentity_DynamicMetadata{Key: "dns"},
+					},
+				},
+			},
+		}
+	})
+
+	processRoute := func() (*envoy_config_route_v3.Route, error) {
+		out := &envoy_config_route_v3.Route{}
+		err := p.(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{
+			VirtualHostParams: plugins.VirtualHostParams{HttpListener: listener},
+		}, &v1.Route{Options: &v1.RouteOptions{ClientCertIdentity: identity}}, out)
+		return out, err
+	}
+
+	httpFilters := func() []plugins.StagedHttpFilter {
+		filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+		Expect(err).NotTo(HaveOccurred())
+		return filters
+	}
+
+	It("does not add the filter if no route maps the client cert identity", func() {
+		identity = nil
+		out, err := processRoute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		Expect(httpFilters()).To(BeEmpty())
+	})
+
+	It("adds the filter without default code before the authentication filters", func() {
+		_, err := processRoute()
+		Expect(err).NotTo(HaveOccurred())
+
+		filters := httpFilters()
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+		Expect(plugins.FilterStageComparison(filters[0].Stage, plugins.BeforeStage(plugins.AuthNStage))).To(Equal(-1))
+		msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.(*envoylua.Lua).GetDefaultSourceCode()).To(BeNil())
+	})
+
+	It("sets the generated code on the route", func() {
+		out, err := processRoute()
+		Expect(err).NotTo(HaveOccurred())
+
+		sourceCode, err := GenerateSourceCode(identity)
+		Expect(err).NotTo(HaveOccurred())
+		msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.(*envoylua.LuaPerRoute).GetSourceCode().GetInlineString()).To(Equal(sourceCode))
+	})
+
+	It("removes the headers before checking the client certificate", func() {
+		sourceCode, err := GenerateSourceCode(identity)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(sourceCode).To(ContainSubstring(`headers:remove("x-spiffe-id")`))
+		Expect(sourceCode).To(ContainSubstring(`headers:replace("x-spiffe-id", value)`))
+		Expect(sourceCode).To(ContainSubstring(`metadata:set("io.solo.client_cert", "dns", value)`))
+		Expect(sourceCode).To(ContainSubstring(`table.concat(ssl:dnsSansPeerCertificate(), ",")`))
+		Expect(strings.Index(sourceCode, "headers:remove")).To(BeNumerically("<", strings.Index(sourceCode, "peerCertificatePresented")))
+	})
+
+	It("escapes the metadata namespace and key", func() {
+		identity.GetMappings()[1].GetDynamicMetadata().MetadataNamespace = "my\"namespace"
+		identity.GetMappings()[1].GetDynamicMetadata().Key = "key\\\n"
+
+		sourceCode, err := GenerateSourceCode(identity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sourceCode).To(ContainSubstring(`metadata:set("my\"namespace", "key\\\010", value)`))
+	})
+
+	DescribeTable("invalid mappings",
+		func(mutate func(), expectedErr error) {
+			mutate()
+			_, err := processRoute()
+			Expect(err).To(MatchError(expectedErr.Error()))
+			Expect(httpFilters()).To(BeEmpty())
+		},
+		Entry("no mappings", func() {
+			identity.Mappings = nil
+		}, NoMappingsError()),
+		Entry("no destination", func() {
+			identity.GetMappings()[0].Destination = nil
+		}, NoDestinationError(client_cert.ClientCertIdentity_SPIFFE_ID)),
+		Entry("invalid header", func() {
+			identity.GetMappings()[0].Destination = &client_cert.ClientCertIdentity_Mapping_Header{Header: ":authority"}
+		}, InvalidHeaderError(":authority")),
+		Entry("host header", func() {
+			identity.GetMappings()[0].Destination = &client_cert.ClientCertIdentity_Mapping_Header{Header: "Host"}
+		}, InvalidHeaderError("Host")),
+		Entry("no metadata key", func() {
+			identity.GetMappings()[1].GetDynamicMetadata().Key = ""
+		}, NoMetadataKeyError(client_cert.ClientCertIdentity_DNS_SAN)),
+	)
+})
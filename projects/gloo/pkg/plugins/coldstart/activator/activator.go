@@ -0,0 +1,270 @@
+package activator
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+const (
+	// ClusterHeader is the header of the requests sent to the activator, which names the cluster of their upstream
+	ClusterHeader = "x-gloo-cold-start-cluster"
+	// RetryHeader is set on the responses of the activator to the requests it held until their upstream had
+	// endpoints, for Envoy to retry them against the upstream
+	RetryHeader = "x-gloo-cold-start-retry"
+)
+
+const (
+	DefaultMaxPendingRequests = 100
+	DefaultTimeout            = 30 * time.Second
+
+	// the delay before the requests to an upstream which already has endpoints are handed back to Envoy, which has
+	// not received the endpoints yet
+	readyDelay = time.Second
+)
+
+var (
+	UnknownClusterError = func(cluster string) error {
+		return errors.Errorf("no upstream with cold start for the cluster %q", cluster)
+	}
+	TooManyPendingRequestsError = func(cluster string) error {
+		return errors.Errorf("too many requests pending for the cluster %q", cluster)
+	}
+	TimeoutError = func(cluster string) error {
+		return errors.Errorf("timed out waiting for the endpoints of the cluster %q", cluster)
+	}
+)
+
+// Activator holds the requests to the upstreams with cold start which have no ready endpoints and scales them up.
+// Once their endpoints are discovered, it responds to the requests with a 503 and the RetryHeader, for Envoy to retry
+// them against the upstreams. It syncs the upstreams and their endpoints from the api snapshots.
+type Activator interface {
+	http.Handler
+	v1snap.ApiSyncer
+}
+
+type activator struct {
+	ctx    context.Context
+	scaler Scaler
+
+	lock      sync.Mutex
+	upstreams map[string]*coldUpstream
+}
+
+// the state of an upstream with cold start, keyed by the name of its cluster
+type coldUpstream struct {
+	upstream     *v1.Upstream
+	hasEndpoints bool
+	// closed once the upstream has endpoints, or is removed
+	ready   chan struct{}
+	pending int
+	scaling bool
+}
+
+// NewActivator returns an activator which scales the upstreams up with the given scaler. The scale ups run with
+// the given context.
+func NewActivator(ctx context.Context, scaler Scaler) Activator {
+	return &activator{
+		ctx:       ctx,
+		scaler:    scaler,
+		upstreams: map[string]*coldUpstream{},
+	}
+}
+
+// MaxPendingRequests returns the maximum number of requests held for an upstream with the given cold start
+func MaxPendingRequests(coldStart *kubernetes.ColdStart) int {
+	if maxPending := coldStart.GetMaxPendingRequests(); maxPending != nil {
+		return int(maxPending.GetValue())
+	}
+	return DefaultMaxPendingRequests
+}
+
+// Timeout returns how long the requests to an upstream with the given cold start are held at most
+func Timeout(coldStart *kubernetes.ColdStart) time.Duration {
+	if timeout := coldStart.GetTimeout(); timeout != nil {
+		return prototime.DurationFromProto(timeout)
+	}
+	return DefaultTimeout
+}
+
+// upstreamsWithEndpoints returns the keys of the refs of the upstreams which have endpoints in the snapshot
+func upstreamsWithEndpoints(snap *v1snap.ApiSnapshot) map[string]bool {
+	hasEndpoints := map[string]bool{}
+	for _, ep := range snap.Endpoints {
+		for _, ref := range ep.GetUpstreams() {
+			hasEndpoints[ref.Key()] = true
+		}
+	}
+	return hasEndpoints
+}
+
+func (a *activator) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) error {
+	hasEndpoints := upstreamsWithEndpoints(snap)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	synced := map[string]bool{}
+	for _, us := range snap.Upstreams {
+		if us.GetKube().GetColdStart() == nil {
+			continue
+		}
+		ref := us.GetMetadata().Ref()
+		cluster := translator.UpstreamToClusterName(ref)
+		synced[cluster] = true
+
+		cold, ok := a.upstreams[cluster]
+		if !ok {
+			cold = &coldUpstream{ready: make(chan struct{})}
+			a.upstreams[cluster] = cold
+		}
+		cold.upstream = us
+		cold.hasEndpoints = hasEndpoints[ref.Key()]
+		if !cold.hasEndpoints {
+			if cold.isReady() {
+				// the upstream scaled back to zero, the next request scales it up again
+				cold.ready = make(chan struct{})
+			}
+			continue
+		}
+		if !cold.isReady() {
+			contextutils.LoggerFrom(ctx).Infof("releasing %v requests held for the upstream %v", cold.pending, ref.Key())
+			close(cold.ready)
+			go a.restore(us)
+		}
+		cold.scaling = false
+	}
+
+	for cluster, cold := range a.upstreams {
+		if synced[cluster] {
+			continue
+		}
+		// release the requests held for the removed upstreams, which fail as the upstreams have no endpoints
+		cold.hasEndpoints = false
+		if !cold.isReady() {
+			close(cold.ready)
+		}
+		delete(a.upstreams, cluster)
+	}
+	return nil
+}
+
+func (c *coldUpstream) isReady() bool {
+	select {
+	case <-c.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+func (a *activator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cluster := r.Header.Get(ClusterHeader)
+
+	status, err := a.activate(r.Context(), cluster)
+	if err != nil {
+		contextutils.LoggerFrom(a.ctx).Warnf("cold start activator: %v", err)
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	// the activator does not forward the requests itself, so that they keep the routing, tls and retries of Envoy
+	w.Header().Set(RetryHeader, "true")
+	http.Error(w, "the upstream is ready", http.StatusServiceUnavailable)
+}
+
+// activate waits for the upstream of the cluster to have endpoints, and returns the status to reject the request with
+// if it does not get any
+func (a *activator) activate(ctx context.Context, cluster string) (int, error) {
+	a.lock.Lock()
+	cold, ok := a.upstreams[cluster]
+	if !ok {
+		a.lock.Unlock()
+		return http.StatusNotFound, UnknownClusterError(cluster)
+	}
+	if cold.hasEndpoints {
+		a.lock.Unlock()
+		// Envoy sent the request here before it received the endpoints, give them time to reach it
+		return wait(ctx, readyDelay)
+	}
+	coldStart := cold.upstream.GetKube().GetColdStart()
+	if cold.pending >= MaxPendingRequests(coldStart) {
+		a.lock.Unlock()
+		return http.StatusServiceUnavailable, TooManyPendingRequestsError(cluster)
+	}
+	cold.pending++
+	if !cold.scaling {
+		cold.scaling = true
+		go a.scaleUp(cluster, cold.upstream)
+	}
+	ready := cold.ready
+	a.lock.Unlock()
+
+	defer func() {
+		a.lock.Lock()
+		cold.pending--
+		if cold.pending == 0 && !cold.isReady() {
+			// the upstream did not scale up in time for any of the requests, let the next request retry
+			cold.scaling = false
+		}
+		a.lock.Unlock()
+	}()
+
+	timer := time.NewTimer(Timeout(coldStart))
+	defer timer.Stop()
+	select {
+	case <-ready:
+	case <-timer.C:
+		return http.StatusGatewayTimeout, TimeoutError(cluster)
+	case <-ctx.Done():
+		return http.StatusServiceUnavailable, ctx.Err()
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if !cold.hasEndpoints {
+		return http.StatusServiceUnavailable, UnknownClusterError(cluster)
+	}
+	return 0, nil
+}
+
+func wait(ctx context.Context, delay time.Duration) (int, error) {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return 0, nil
+	case <-ctx.Done():
+		return http.StatusServiceUnavailable, ctx.Err()
+	}
+}
+
+func (a *activator) scaleUp(cluster string, upstream *v1.Upstream) {
+	logger := contextutils.LoggerFrom(a.ctx)
+	logger.Infof("scaling up the upstream %v", upstream.GetMetadata().Ref().Key())
+	if err := a.scaler.ScaleUp(a.ctx, upstream); err != nil {
+		logger.Errorf("failed to scale up the upstream %v: %v", upstream.GetMetadata().Ref().Key(), err)
+		// let the next request retry
+		a.lock.Lock()
+		if cold, ok := a.upstreams[cluster]; ok {
+			cold.scaling = false
+		}
+		a.lock.Unlock()
+	}
+}
+
+// restore undoes the changes of the scale up of the upstream once it is ready
+func (a *activator) restore(upstream *v1.Upstream) {
+	if err := a.scaler.Restore(a.ctx, upstream); err != nil {
+		contextutils.LoggerFrom(a.ctx).Errorf("failed to restore the upstream %v after its scale up: %v", upstream.GetMetadata().Ref().Key(), err)
+	}
+}
@@ -0,0 +1,204 @@
+package activator_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/coldstart/activator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+type fakeScaler struct {
+	lock     sync.Mutex
+	scaledUp []string
+	restored []string
+}
+
+func (s *fakeScaler) ScaleUp(_ context.Context, upstream *v1.Upstream) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.scaledUp = append(s.scaledUp, upstream.GetMetadata().GetName())
+	return nil
+}
+
+func (s *fakeScaler) Restore(_ context.Context, upstream *v1.Upstream) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.restored = append(s.restored, upstream.GetMetadata().GetName())
+	return nil
+}
+
+func (s *fakeScaler) ScaledUp() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string{}, s.scaledUp...)
+}
+
+func (s *fakeScaler) Restored() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string{}, s.restored...)
+}
+
+var _ = Describe("Activator", func() {
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		scaler     *fakeScaler
+		act        activator.Activator
+		activatorS *httptest.Server
+		upstream   *v1.Upstream
+		cluster    string
+	)
+
+	const endpoint = "10.0.0.1:8080"
+
+	snapshot := func(endpointAddrs ...string) *v1snap.ApiSnapshot {
+		snap := &v1snap.ApiSnapshot{Upstreams: v1.UpstreamList{upstream}}
+		for _, addr := range endpointAddrs {
+			host, portStr, err := net.SplitHostPort(addr)
+			Expect(err).NotTo(HaveOccurred())
+			port, err := strconv.Atoi(portStr)
+			Expect(err).NotTo(HaveOccurred())
+			snap.Endpoints = append(snap.Endpoints, &v1.Endpoint{
+				Metadata:  &core.Metadata{Name: "ep", Namespace: "gloo-system"},
+				Upstreams: []*core.ResourceRef{upstream.GetMetadata().Ref()},
+				Address:   host,
+				Port:      uint32(port),
+			})
+		}
+		return snap
+	}
+
+	type response struct {
+		Status int
+		Retry  bool
+	}
+
+	// the response of the activator to the requests it hands back to Envoy
+	retry := response{Status: http.StatusServiceUnavailable, Retry: true}
+
+	send := func(cluster string) <-chan response {
+		responses := make(chan response, 1)
+		go func() {
+			defer GinkgoRecover()
+			req, err := http.NewRequest(http.MethodGet, activatorS.URL+"/pets", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set(activator.ClusterHeader, cluster)
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+			_, err = io.Copy(io.Discard, resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+			responses <- response{Status: resp.StatusCode, Retry: resp.Header.Get(activator.RetryHeader) == "true"}
+		}()
+		return responses
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		scaler = &fakeScaler{}
+		act = activator.NewActivator(ctx, scaler)
+		activatorS = httptest.NewServer(act)
+		upstream = &v1.Upstream{
+			Metadata: &core.Metadata{Name: "us", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Kube{
+				Kube: &kubernetes.UpstreamSpec{
+					ServiceName:      "svc",
+					ServiceNamespace: "default",
+					ServicePort:      8080,
+					ColdStart: &kubernetes.ColdStart{
+						MaxPendingRequests: &wrappers.UInt32Value{Value: 2},
+						Timeout:            prototime.DurationToProto(time.Second),
+					},
+				},
+			},
+		}
+		cluster = translator.UpstreamToClusterName(upstream.GetMetadata().Ref())
+	})
+
+	AfterEach(func() {
+		activatorS.Close()
+		cancel()
+	})
+
+	It("holds the requests until the upstream has endpoints", func() {
+		Expect(act.Sync(ctx, snapshot())).To(Succeed())
+
+		responses := send(cluster)
+		Eventually(scaler.ScaledUp).Should(Equal([]string{"us"}))
+		Consistently(responses, 100*time.Millisecond).ShouldNot(Receive())
+		Expect(scaler.Restored()).To(BeEmpty())
+
+		Expect(act.Sync(ctx, snapshot(endpoint))).To(Succeed())
+		Eventually(responses).Should(Receive(Equal(retry)))
+		Eventually(scaler.Restored).Should(Equal([]string{"us"}))
+
+		// Envoy has not received the endpoints of the warm upstream yet, the requests are handed back after a delay
+		responses = send(cluster)
+		Consistently(responses, 500*time.Millisecond).ShouldNot(Receive())
+		Eventually(responses, 2*time.Second).Should(Receive(Equal(retry)))
+		Expect(scaler.ScaledUp()).To(HaveLen(1))
+	})
+
+	It("scales the upstream up again once it scaled back to zero", func() {
+		Expect(act.Sync(ctx, snapshot(endpoint))).To(Succeed())
+		Expect(act.Sync(ctx, snapshot())).To(Succeed())
+
+		responses := send(cluster)
+		Eventually(scaler.ScaledUp).Should(Equal([]string{"us"}))
+		Expect(act.Sync(ctx, snapshot(endpoint))).To(Succeed())
+		Eventually(responses).Should(Receive(Equal(retry)))
+	})
+
+	It("rejects the requests held past the timeout", func() {
+		Expect(act.Sync(ctx, snapshot())).To(Succeed())
+
+		Eventually(send(cluster), 2*time.Second).Should(Receive(Equal(response{Status: http.StatusGatewayTimeout})))
+	})
+
+	It("rejects the requests beyond the max pending requests", func() {
+		Expect(act.Sync(ctx, snapshot())).To(Succeed())
+
+		first, second := send(cluster), send(cluster)
+		Eventually(scaler.ScaledUp).Should(HaveLen(1))
+		// wait for the first requests to be held
+		time.Sleep(100 * time.Millisecond)
+		Eventually(send(cluster)).Should(Receive(Equal(response{Status: http.StatusServiceUnavailable})))
+
+		Expect(act.Sync(ctx, snapshot(endpoint))).To(Succeed())
+		Eventually(first).Should(Receive(Equal(retry)))
+		Eventually(second).Should(Receive(Equal(retry)))
+	})
+
+	It("rejects the requests to unknown clusters", func() {
+		Expect(act.Sync(ctx, snapshot())).To(Succeed())
+
+		Eventually(send("unknown_gloo-system")).Should(Receive(Equal(response{Status: http.StatusNotFound})))
+		Expect(scaler.ScaledUp()).To(BeEmpty())
+	})
+
+	It("releases the requests held for removed upstreams", func() {
+		Expect(act.Sync(ctx, snapshot())).To(Succeed())
+
+		responses := send(cluster)
+		Eventually(scaler.ScaledUp).Should(HaveLen(1))
+		Expect(act.Sync(ctx, &v1snap.ApiSnapshot{})).To(Succeed())
+		Eventually(responses).Should(Receive(Equal(response{Status: http.StatusServiceUnavailable})))
+	})
+})
@@ -0,0 +1,192 @@
+package activator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclient "k8s.io/client-go/kubernetes"
+)
+
+const (
+	DefaultReplicas = 1
+	// DefaultWebhookTimeout is how long the webhooks which scale the upstreams up may take to respond
+	DefaultWebhookTimeout = 10 * time.Second
+
+	// OriginalMinReplicasAnnotation keeps the min replicas of the horizontal pod autoscalers while they are raised,
+	// so that they are restored once the upstreams are ready
+	OriginalMinReplicasAnnotation = "gloo.solo.io/cold-start-original-min-replicas"
+)
+
+// Scaler scales up the upstreams with cold start
+type Scaler interface {
+	ScaleUp(ctx context.Context, upstream *v1.Upstream) error
+	// Restore undoes the changes of ScaleUp which are only needed until the upstream is ready
+	Restore(ctx context.Context, upstream *v1.Upstream) error
+}
+
+// WebhookRequest is the body of the requests to the webhooks which scale the upstreams up
+type WebhookRequest struct {
+	Name             string `json:"name"`
+	Namespace        string `json:"namespace"`
+	ServiceName      string `json:"serviceName"`
+	ServiceNamespace string `json:"serviceNamespace"`
+	ServicePort      uint32 `json:"servicePort"`
+}
+
+type scaler struct {
+	kube       kubeclient.Interface
+	httpClient *http.Client
+}
+
+// NewScaler returns a scaler which scales the workloads up with the kube client, and fails the calls to the webhooks
+// which take longer than the webhook timeout. The kube client may be nil, if gloo does not run in kubernetes.
+func NewScaler(kube kubeclient.Interface, webhookTimeout time.Duration) Scaler {
+	return &scaler{
+		kube:       kube,
+		httpClient: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+func (s *scaler) ScaleUp(ctx context.Context, upstream *v1.Upstream) error {
+	spec := upstream.GetKube()
+	coldStart := spec.GetColdStart()
+	switch coldStart.GetScaleUp().(type) {
+	case *kubernetes.ColdStart_WebhookUrl:
+		return s.callWebhook(ctx, upstream, coldStart.GetWebhookUrl())
+	case *kubernetes.ColdStart_Deployment:
+		return s.scaleDeployment(ctx, spec.GetServiceNamespace(), coldStart.GetDeployment())
+	case *kubernetes.ColdStart_HorizontalPodAutoscaler:
+		return s.raiseMinReplicas(ctx, spec.GetServiceNamespace(), coldStart.GetHorizontalPodAutoscaler())
+	}
+	// the upstream is scaled up by someone else
+	return nil
+}
+
+func (s *scaler) Restore(ctx context.Context, upstream *v1.Upstream) error {
+	spec := upstream.GetKube()
+	if hpa := spec.GetColdStart().GetHorizontalPodAutoscaler(); hpa != nil {
+		return s.restoreMinReplicas(ctx, spec.GetServiceNamespace(), hpa)
+	}
+	return nil
+}
+
+func (s *scaler) callWebhook(ctx context.Context, upstream *v1.Upstream, url string) error {
+	body, err := json.Marshal(WebhookRequest{
+		Name:             upstream.GetMetadata().GetName(),
+		Namespace:        upstream.GetMetadata().GetNamespace(),
+		ServiceName:      upstream.GetKube().GetServiceName(),
+		ServiceNamespace: upstream.GetKube().GetServiceNamespace(),
+		ServicePort:      upstream.GetKube().GetServicePort(),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook url %q", url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "calling the webhook %q", url)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("the webhook %q responded with the status %v", url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *scaler) scaleDeployment(ctx context.Context, namespace string, target *kubernetes.ColdStart_ScaleTarget) error {
+	if s.kube == nil {
+		return errors.Errorf("cannot scale the deployment %v.%v outside of kubernetes", namespace, target.GetName())
+	}
+	deployments := s.kube.AppsV1().Deployments(namespace)
+	scale, err := deployments.GetScale(ctx, target.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "getting the scale of the deployment %v.%v", namespace, target.GetName())
+	}
+	replicas := targetReplicas(target)
+	if scale.Spec.Replicas >= replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	if _, err := deployments.UpdateScale(ctx, target.GetName(), scale, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "scaling the deployment %v.%v", namespace, target.GetName())
+	}
+	return nil
+}
+
+func (s *scaler) raiseMinReplicas(ctx context.Context, namespace string, target *kubernetes.ColdStart_ScaleTarget) error {
+	if s.kube == nil {
+		return errors.Errorf("cannot scale the horizontal pod autoscaler %v.%v outside of kubernetes", namespace, target.GetName())
+	}
+	hpas := s.kube.AutoscalingV2().HorizontalPodAutoscalers(namespace)
+	hpa, err := hpas.Get(ctx, target.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "getting the horizontal pod autoscaler %v.%v", namespace, target.GetName())
+	}
+	// the min replicas of the horizontal pod autoscalers default to 1
+	minReplicas := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	replicas := targetReplicas(target)
+	if minReplicas >= replicas {
+		return nil
+	}
+	patch := []byte(fmt.Sprintf(`{"spec":{"minReplicas":%d}}`, replicas))
+	if _, raised := hpa.GetAnnotations()[OriginalMinReplicasAnnotation]; !raised {
+		patch = []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}},"spec":{"minReplicas":%d}}`,
+			OriginalMinReplicasAnnotation, strconv.Itoa(int(minReplicas)), replicas))
+	}
+	if _, err := hpas.Patch(ctx, target.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "raising the min replicas of the horizontal pod autoscaler %v.%v", namespace, target.GetName())
+	}
+	return nil
+}
+
+// restoreMinReplicas restores the min replicas of the horizontal pod autoscaler which were raised to scale it up
+func (s *scaler) restoreMinReplicas(ctx context.Context, namespace string, target *kubernetes.ColdStart_ScaleTarget) error {
+	if s.kube == nil {
+		return errors.Errorf("cannot restore the horizontal pod autoscaler %v.%v outside of kubernetes", namespace, target.GetName())
+	}
+	hpas := s.kube.AutoscalingV2().HorizontalPodAutoscalers(namespace)
+	hpa, err := hpas.Get(ctx, target.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "getting the horizontal pod autoscaler %v.%v", namespace, target.GetName())
+	}
+	original, ok := hpa.GetAnnotations()[OriginalMinReplicasAnnotation]
+	if !ok {
+		return nil
+	}
+	minReplicas, err := strconv.Atoi(original)
+	if err != nil {
+		return errors.Wrapf(err, "invalid %v annotation of the horizontal pod autoscaler %v.%v", OriginalMinReplicasAnnotation, namespace, target.GetName())
+	}
+	patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:null}},"spec":{"minReplicas":%d}}`,
+		OriginalMinReplicasAnnotation, minReplicas))
+	if _, err := hpas.Patch(ctx, target.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "restoring the min replicas of the horizontal pod autoscaler %v.%v", namespace, target.GetName())
+	}
+	return nil
+}
+
+func targetReplicas(target *kubernetes.ColdStart_ScaleTarget) int32 {
+	if replicas := target.GetReplicas(); replicas != nil {
+		return int32(replicas.GetValue())
+	}
+	return DefaultReplicas
+}
@@ -0,0 +1,189 @@
+package activator_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/coldstart/activator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Scaler", func() {
+
+	var (
+		ctx  context.Context
+		kube *fake.Clientset
+	)
+
+	upstream := func(coldStart *kubernetes.ColdStart) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: &core.Metadata{Name: "us", Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Kube{
+				Kube: &kubernetes.UpstreamSpec{
+					ServiceName:      "svc",
+					ServiceNamespace: "default",
+					ServicePort:      8080,
+					ColdStart:        coldStart,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kube = fake.NewSimpleClientset()
+	})
+
+	It("calls the webhook", func() {
+		requests := make(chan activator.WebhookRequest, 1)
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			var req activator.WebhookRequest
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			requests <- req
+		}))
+		defer webhook.Close()
+
+		err := activator.NewScaler(kube, activator.DefaultWebhookTimeout).ScaleUp(ctx, upstream(&kubernetes.ColdStart{
+			ScaleUp: &kubernetes.ColdStart_WebhookUrl{WebhookUrl: webhook.URL},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(Receive(Equal(activator.WebhookRequest{
+			Name:             "us",
+			Namespace:        "gloo-system",
+			ServiceName:      "svc",
+			ServiceNamespace: "default",
+			ServicePort:      8080,
+		})))
+	})
+
+	It("fails if the webhook fails", func() {
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer webhook.Close()
+
+		err := activator.NewScaler(kube, activator.DefaultWebhookTimeout).ScaleUp(ctx, upstream(&kubernetes.ColdStart{
+			ScaleUp: &kubernetes.ColdStart_WebhookUrl{WebhookUrl: webhook.URL},
+		}))
+		Expect(err).To(MatchError(ContainSubstring("responded with the status 500")))
+	})
+
+	It("fails if the webhook does not respond in time", func() {
+		release := make(chan struct{})
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer webhook.Close()
+		defer close(release)
+
+		err := activator.NewScaler(kube, 100*time.Millisecond).ScaleUp(ctx, upstream(&kubernetes.ColdStart{
+			ScaleUp: &kubernetes.ColdStart_WebhookUrl{WebhookUrl: webhook.URL},
+		}))
+		Expect(err).To(MatchError(ContainSubstring("Client.Timeout exceeded")))
+	})
+
+	It("scales the deployment up", func() {
+		var updated *autoscalingv1.Scale
+		kube.PrependReactor("get", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "scale" {
+				return false, nil, nil
+			}
+			return true, &autoscalingv1.Scale{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+				Spec:       autoscalingv1.ScaleSpec{Replicas: 0},
+			}, nil
+		})
+		kube.PrependReactor("update", "deployments", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "scale" {
+				return false, nil, nil
+			}
+			updated = action.(kubetesting.UpdateAction).GetObject().(*autoscalingv1.Scale)
+			return true, updated, nil
+		})
+
+		err := activator.NewScaler(kube, activator.DefaultWebhookTimeout).ScaleUp(ctx, upstream(&kubernetes.ColdStart{
+			ScaleUp: &kubernetes.ColdStart_Deployment{Deployment: &kubernetes.ColdStart_ScaleTarget{
+				Name:     "app",
+				Replicas: &wrappers.UInt32Value{Value: 2},
+			}},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).NotTo(BeNil())
+		Expect(updated.Spec.Replicas).To(BeEquivalentTo(2))
+	})
+
+	It("raises the min replicas of the horizontal pod autoscaler", func() {
+		minReplicas := int32(0)
+		_, err := kube.AutoscalingV2().HorizontalPodAutoscalers("default").Create(ctx, &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				MinReplicas: &minReplicas,
+				MaxReplicas: 5,
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = activator.NewScaler(kube, activator.DefaultWebhookTimeout).ScaleUp(ctx, upstream(&kubernetes.ColdStart{
+			ScaleUp: &kubernetes.ColdStart_HorizontalPodAutoscaler{HorizontalPodAutoscaler: &kubernetes.ColdStart_ScaleTarget{
+				Name: "app",
+			}},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+
+		hpa, err := kube.AutoscalingV2().HorizontalPodAutoscalers("default").Get(ctx, "app", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*hpa.Spec.MinReplicas).To(BeEquivalentTo(activator.DefaultReplicas))
+	})
+
+	It("restores the min replicas of the horizontal pod autoscaler once the upstream is ready", func() {
+		minReplicas := int32(0)
+		_, err := kube.AutoscalingV2().HorizontalPodAutoscalers("default").Create(ctx, &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				MinReplicas: &minReplicas,
+				MaxReplicas: 5,
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		us := upstream(&kubernetes.ColdStart{
+			ScaleUp: &kubernetes.ColdStart_HorizontalPodAutoscaler{HorizontalPodAutoscaler: &kubernetes.ColdStart_ScaleTarget{
+				Name:     "app",
+				Replicas: &wrappers.UInt32Value{Value: 2},
+			}},
+		})
+		scaler := activator.NewScaler(kube, activator.DefaultWebhookTimeout)
+
+		Expect(scaler.ScaleUp(ctx, us)).To(Succeed())
+		hpa, err := kube.AutoscalingV2().HorizontalPodAutoscalers("default").Get(ctx, "app", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*hpa.Spec.MinReplicas).To(BeEquivalentTo(2))
+		Expect(hpa.GetAnnotations()).To(HaveKeyWithValue(activator.OriginalMinReplicasAnnotation, "0"))
+
+		Expect(scaler.Restore(ctx, us)).To(Succeed())
+		hpa, err = kube.AutoscalingV2().HorizontalPodAutoscalers("default").Get(ctx, "app", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*hpa.Spec.MinReplicas).To(BeEquivalentTo(0))
+		Expect(hpa.GetAnnotations()).NotTo(HaveKey(activator.OriginalMinReplicasAnnotation))
+
+		// the horizontal pod autoscalers which were not raised are left alone
+		Expect(scaler.Restore(ctx, us)).To(Succeed())
+		hpa, err = kube.AutoscalingV2().HorizontalPodAutoscalers("default").Get(ctx, "app", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*hpa.Spec.MinReplicas).To(BeEquivalentTo(0))
+	})
+})
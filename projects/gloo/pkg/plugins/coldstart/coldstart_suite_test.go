@@ -0,0 +1,13 @@
+package coldstart_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestColdStart(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cold Start Suite")
+}
@@ -0,0 +1,251 @@
+package coldstart
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_clusters_aggregate_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/aggregate/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/constants"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/coldstart/activator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+)
+
+var (
+	_ plugins.Plugin                  = new(plugin)
+	_ plugins.ResourceGeneratorPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "cold_start"
+	// ActivatorCluster is the generated cluster of the cold start activator of gloo
+	ActivatorCluster = constants.SoloGeneratedClusterPrefix + "cold_start_activator"
+
+	// the timeout of the routes which Envoy applies when they do not set one
+	envoyDefaultRouteTimeout = 15 * time.Second
+	// the number of retries of the routes to the upstreams with cold start which have no retry policy, so that the
+	// requests handed back by the activator reach the upstreams even if Envoy receives their endpoints a little late
+	heldRequestRetries      = 3
+	retriableHeadersRetryOn = "retriable-headers"
+)
+
+type plugin struct {
+	settings *v1.Settings
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.settings = params.Settings
+}
+
+// AggregateClusterName returns the name of the generated cluster which sends the requests to the cluster of an
+// upstream with cold start while it has healthy endpoints, and to the activator otherwise
+func AggregateClusterName(cluster string) string {
+	return constants.SoloGeneratedClusterPrefix + "cold_start_" + cluster
+}
+
+// GeneratedResources routes the requests to the upstreams with cold start through aggregate clusters, which fall back
+// to the cold start activator while the upstreams have no endpoints. The activator holds the requests until the
+// upstreams scale up, and then hands them back to Envoy, which retries them against the upstreams.
+func (p *plugin) GeneratedResources(params plugins.Params,
+	inClusters []*envoy_config_cluster_v3.Cluster,
+	inEndpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	inRouteConfigurations []*envoy_config_route_v3.RouteConfiguration,
+	inListeners []*envoy_config_listener_v3.Listener,
+) ([]*envoy_config_cluster_v3.Cluster, []*envoy_config_endpoint_v3.ClusterLoadAssignment, []*envoy_config_route_v3.RouteConfiguration, []*envoy_config_listener_v3.Listener, error) {
+
+	// the upstreams with cold start, by the name of their cluster. The routes to them do not depend on whether they
+	// have endpoints, so that they are not translated again each time the upstreams scale up or down.
+	coldUpstreams := map[string]*v1.Upstream{}
+	for _, us := range params.Snapshot.Upstreams {
+		if us.GetKube().GetColdStart() == nil {
+			continue
+		}
+		coldUpstreams[translator.UpstreamToClusterName(us.GetMetadata().Ref())] = us
+	}
+	if len(coldUpstreams) == 0 {
+		return nil, nil, nil, nil, nil
+	}
+
+	routedClusters := map[string]bool{}
+	for _, rtConfig := range inRouteConfigurations {
+		for _, vh := range rtConfig.GetVirtualHosts() {
+			for _, rt := range vh.GetRoutes() {
+				// the longest time the requests of the route may be held by the activator
+				var holdTimeout time.Duration
+				switch specifier := rt.GetRoute().GetClusterSpecifier().(type) {
+				case *envoy_config_route_v3.RouteAction_Cluster:
+					us, ok := coldUpstreams[specifier.Cluster]
+					if !ok {
+						continue
+					}
+					routedClusters[specifier.Cluster] = true
+					rt.RequestHeadersToAdd = append(rt.GetRequestHeadersToAdd(), clusterHeader(specifier.Cluster))
+					specifier.Cluster = AggregateClusterName(specifier.Cluster)
+					holdTimeout = activator.Timeout(us.GetKube().GetColdStart())
+				case *envoy_config_route_v3.RouteAction_WeightedClusters:
+					for _, weightedCluster := range specifier.WeightedClusters.GetClusters() {
+						us, ok := coldUpstreams[weightedCluster.GetName()]
+						if !ok {
+							continue
+						}
+						routedClusters[weightedCluster.GetName()] = true
+						weightedCluster.RequestHeadersToAdd = append(weightedCluster.GetRequestHeadersToAdd(), clusterHeader(weightedCluster.GetName()))
+						weightedCluster.Name = AggregateClusterName(weightedCluster.GetName())
+						if timeout := activator.Timeout(us.GetKube().GetColdStart()); timeout > holdTimeout {
+							holdTimeout = timeout
+						}
+					}
+				}
+				if holdTimeout == 0 {
+					continue
+				}
+				retryHeldRequests(rt, vh)
+				// the requests are held by the activator before they are retried, so the timeout of the route must
+				// leave room for the hold, unless the route sets its own timeout
+				if rt.GetRoute().GetTimeout() == nil {
+					rt.GetRoute().Timeout = prototime.DurationToProto(holdTimeout + envoyDefaultRouteTimeout)
+				}
+			}
+		}
+	}
+	if len(routedClusters) == 0 {
+		return nil, nil, nil, nil, nil
+	}
+
+	activatorCluster, err := p.activatorCluster()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	clusters := []*envoy_config_cluster_v3.Cluster{activatorCluster}
+	for _, cluster := range sortedKeys(routedClusters) {
+		aggregateCluster, err := aggregateCluster(cluster)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		clusters = append(clusters, aggregateCluster)
+	}
+	return clusters, nil, nil, nil, nil
+}
+
+// retryHeldRequests makes Envoy retry the requests of the route which the activator hands back once their upstream
+// has endpoints. The retry policy of the route keeps its number of retries, and only the routes without any retry
+// policy get their own.
+func retryHeldRequests(rt *envoy_config_route_v3.Route, vh *envoy_config_route_v3.VirtualHost) {
+	policy := rt.GetRoute().GetRetryPolicy()
+	if policy == nil {
+		// the retry policy of the route replaces the one of its virtual host, so start from it
+		if vhPolicy := vh.GetRetryPolicy(); vhPolicy != nil {
+			policy = proto.Clone(vhPolicy).(*envoy_config_route_v3.RetryPolicy)
+		} else {
+			policy = &envoy_config_route_v3.RetryPolicy{
+				NumRetries: &wrappers.UInt32Value{Value: heldRequestRetries},
+			}
+		}
+	}
+	if policy.GetRetryOn() == "" {
+		policy.RetryOn = retriableHeadersRetryOn
+	} else if !strings.Contains(policy.GetRetryOn(), retriableHeadersRetryOn) {
+		policy.RetryOn = policy.GetRetryOn() + "," + retriableHeadersRetryOn
+	}
+	policy.RetriableHeaders = append(policy.GetRetriableHeaders(), &envoy_config_route_v3.HeaderMatcher{
+		Name:                 activator.RetryHeader,
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+	})
+	rt.GetRoute().RetryPolicy = policy
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func clusterHeader(cluster string) *envoy_config_core_v3.HeaderValueOption {
+	return &envoy_config_core_v3.HeaderValueOption{
+		Header: &envoy_config_core_v3.HeaderValue{
+			Key:   activator.ClusterHeader,
+			Value: cluster,
+		},
+		Append: &wrappers.BoolValue{Value: false},
+	}
+}
+
+// aggregateCluster sends the requests to the cluster while it has healthy endpoints, and to the activator otherwise
+func aggregateCluster(cluster string) (*envoy_config_cluster_v3.Cluster, error) {
+	typedConfig, err := utils.MessageToAny(&envoy_extensions_clusters_aggregate_v3.ClusterConfig{
+		Clusters: []string{cluster, ActivatorCluster},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_cluster_v3.Cluster{
+		Name:           AggregateClusterName(cluster),
+		ConnectTimeout: &duration.Duration{Seconds: 5},
+		LbPolicy:       envoy_config_cluster_v3.Cluster_CLUSTER_PROVIDED,
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_ClusterType{
+			ClusterType: &envoy_config_cluster_v3.Cluster_CustomClusterType{
+				Name:        "envoy.clusters.aggregate",
+				TypedConfig: typedConfig,
+			},
+		},
+	}, nil
+}
+
+func (p *plugin) activatorCluster() (*envoy_config_cluster_v3.Cluster, error) {
+	addr := p.settings.GetGloo().GetColdStartActivatorAddr()
+	if addr == "" {
+		namespace := p.settings.GetMetadata().GetNamespace()
+		if namespace == "" {
+			namespace = defaults.GlooSystem
+		}
+		addr = fmt.Sprintf("gloo.%v.svc.cluster.local:%v", namespace, defaults.GlooColdStartPort)
+	}
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cold start activator addr %q", addr)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cold start activator addr %q", addr)
+	}
+
+	out := &envoy_config_cluster_v3.Cluster{
+		Name:           ActivatorCluster,
+		ConnectTimeout: &duration.Duration{Seconds: 5},
+		ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{
+			Type: envoy_config_cluster_v3.Cluster_STRICT_DNS,
+		},
+		RespectDnsTtl: true,
+	}
+	pluginutils.EnvoySingleEndpointLoadAssignment(out, host, uint32(port))
+	return out, nil
+}
@@ -0,0 +1,266 @@
+package coldstart_test
+
+import (
+	"time"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_clusters_aggregate_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/aggregate/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	v1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/coldstart"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/coldstart/activator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params       plugins.Params
+		coldUs       *v1.Upstream
+		warmUs       *v1.Upstream
+		coldCluster  string
+		warmCluster  string
+		routeConfigs []*envoy_config_route_v3.RouteConfiguration
+	)
+
+	kubeUpstream := func(name string, coldStart *kubernetes.ColdStart) *v1.Upstream {
+		return &v1.Upstream{
+			Metadata: &core.Metadata{Name: name, Namespace: "gloo-system"},
+			UpstreamType: &v1.Upstream_Kube{
+				Kube: &kubernetes.UpstreamSpec{
+					ServiceName:      name,
+					ServiceNamespace: "default",
+					ServicePort:      8080,
+					ColdStart:        coldStart,
+				},
+			},
+		}
+	}
+
+	route := func(action *envoy_config_route_v3.RouteAction) *envoy_config_route_v3.Route {
+		return &envoy_config_route_v3.Route{
+			Match: &envoy_config_route_v3.RouteMatch{
+				PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{Prefix: "/"},
+			},
+			Action: &envoy_config_route_v3.Route_Route{Route: action},
+		}
+	}
+
+	singleRoute := func(cluster string) *envoy_config_route_v3.Route {
+		return route(&envoy_config_route_v3.RouteAction{
+			ClusterSpecifier: &envoy_config_route_v3.RouteAction_Cluster{Cluster: cluster},
+		})
+	}
+
+	weightedRoute := func(clusters ...string) *envoy_config_route_v3.Route {
+		weightedClusters := &envoy_config_route_v3.WeightedCluster{}
+		for _, cluster := range clusters {
+			weightedClusters.Clusters = append(weightedClusters.GetClusters(), &envoy_config_route_v3.WeightedCluster_ClusterWeight{
+				Name:   cluster,
+				Weight: &wrappers.UInt32Value{Value: 1},
+			})
+		}
+		return route(&envoy_config_route_v3.RouteAction{
+			ClusterSpecifier: &envoy_config_route_v3.RouteAction_WeightedClusters{WeightedClusters: weightedClusters},
+		})
+	}
+
+	generate := func(settings *v1.Settings) []*envoy_config_cluster_v3.Cluster {
+		p := coldstart.NewPlugin()
+		p.Init(plugins.InitParams{Settings: settings})
+		clusters, endpoints, generatedRouteConfigs, listeners, err := p.GeneratedResources(params, nil, nil, routeConfigs, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoints).To(BeEmpty())
+		Expect(generatedRouteConfigs).To(BeEmpty())
+		Expect(listeners).To(BeEmpty())
+		return clusters
+	}
+
+	routes := func() []*envoy_config_route_v3.Route {
+		return routeConfigs[0].GetVirtualHosts()[0].GetRoutes()
+	}
+
+	clusterHeader := func(cluster string) *envoy_config_core_v3.HeaderValueOption {
+		return &envoy_config_core_v3.HeaderValueOption{
+			Header: &envoy_config_core_v3.HeaderValue{Key: activator.ClusterHeader, Value: cluster},
+			Append: &wrappers.BoolValue{Value: false},
+		}
+	}
+
+	retryHeader := &envoy_config_route_v3.HeaderMatcher{
+		Name:                 activator.RetryHeader,
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+	}
+
+	expectAggregateCluster := func(cluster *envoy_config_cluster_v3.Cluster, upstreamCluster string) {
+		Expect(cluster.GetName()).To(Equal(coldstart.AggregateClusterName(upstreamCluster)))
+		Expect(cluster.GetLbPolicy()).To(Equal(envoy_config_cluster_v3.Cluster_CLUSTER_PROVIDED))
+		Expect(cluster.GetClusterType().GetName()).To(Equal("envoy.clusters.aggregate"))
+		msg, err := utils.AnyToMessage(cluster.GetClusterType().GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.(*envoy_extensions_clusters_aggregate_v3.ClusterConfig).GetClusters()).To(Equal([]string{upstreamCluster, coldstart.ActivatorCluster}))
+	}
+
+	BeforeEach(func() {
+		coldUs = kubeUpstream("cold", &kubernetes.ColdStart{Timeout: prototime.DurationToProto(time.Minute)})
+		warmUs = kubeUpstream("warm", &kubernetes.ColdStart{})
+		coldCluster = translator.UpstreamToClusterName(coldUs.GetMetadata().Ref())
+		warmCluster = translator.UpstreamToClusterName(warmUs.GetMetadata().Ref())
+		params = plugins.Params{
+			Snapshot: &v1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{coldUs, warmUs, kubeUpstream("other", nil)},
+				Endpoints: v1.EndpointList{
+					{
+						Metadata:  &core.Metadata{Name: "warm-ep", Namespace: "gloo-system"},
+						Upstreams: []*core.ResourceRef{warmUs.GetMetadata().Ref()},
+						Address:   "10.0.0.1",
+						Port:      8080,
+					},
+				},
+			},
+		}
+		routeConfigs = []*envoy_config_route_v3.RouteConfiguration{{
+			Name: "routes",
+			VirtualHosts: []*envoy_config_route_v3.VirtualHost{{
+				Name:    "vh",
+				Domains: []string{"*"},
+			}},
+		}}
+	})
+
+	It("routes the requests to the upstreams with cold start through the activator", func() {
+		routeConfigs[0].GetVirtualHosts()[0].Routes = []*envoy_config_route_v3.Route{
+			singleRoute(coldCluster),
+			singleRoute(warmCluster),
+			singleRoute("other_gloo-system"),
+		}
+
+		clusters := generate(&v1.Settings{Metadata: &core.Metadata{Namespace: "gloo"}})
+
+		Expect(clusters).To(HaveLen(3))
+		Expect(clusters[0].GetName()).To(Equal(coldstart.ActivatorCluster))
+		Expect(clusters[0].GetType()).To(Equal(envoy_config_cluster_v3.Cluster_STRICT_DNS))
+		socketAddress := clusters[0].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()
+		Expect(socketAddress.GetAddress()).To(Equal("gloo.gloo.svc.cluster.local"))
+		Expect(socketAddress.GetPortValue()).To(BeEquivalentTo(9955))
+		// the upstreams which have endpoints are routed the same way, so the routes do not change when they scale
+		expectAggregateCluster(clusters[1], coldCluster)
+		expectAggregateCluster(clusters[2], warmCluster)
+
+		cold := routes()[0]
+		Expect(cold.GetRoute().GetCluster()).To(Equal(coldstart.AggregateClusterName(coldCluster)))
+		Expect(cold.GetRequestHeadersToAdd()).To(ConsistOf(clusterHeader(coldCluster)))
+		// the timeout of the route leaves room for the hold
+		Expect(cold.GetRoute().GetTimeout()).To(Equal(prototime.DurationToProto(time.Minute + 15*time.Second)))
+		Expect(cold.GetRoute().GetRetryPolicy()).To(matchers.MatchProto(&envoy_config_route_v3.RetryPolicy{
+			RetryOn:          "retriable-headers",
+			RetriableHeaders: []*envoy_config_route_v3.HeaderMatcher{retryHeader},
+			NumRetries:       &wrappers.UInt32Value{Value: 3},
+		}))
+
+		warm := routes()[1]
+		Expect(warm.GetRoute().GetCluster()).To(Equal(coldstart.AggregateClusterName(warmCluster)))
+		Expect(warm.GetRoute().GetTimeout()).To(Equal(prototime.DurationToProto(activator.DefaultTimeout + 15*time.Second)))
+
+		other := routes()[2]
+		Expect(other.GetRoute().GetCluster()).To(Equal("other_gloo-system"))
+		Expect(other.GetRequestHeadersToAdd()).To(BeEmpty())
+		Expect(other.GetRoute().GetTimeout()).To(BeNil())
+		Expect(other.GetRoute().GetRetryPolicy()).To(BeNil())
+	})
+
+	It("routes the weighted clusters of the upstreams with cold start through the activator", func() {
+		routeConfigs[0].GetVirtualHosts()[0].Routes = []*envoy_config_route_v3.Route{
+			weightedRoute(warmCluster, coldCluster, "other_gloo-system"),
+		}
+
+		clusters := generate(&v1.Settings{
+			Gloo: &v1.GlooOptions{ColdStartActivatorAddr: "activator.example.com:8080"},
+		})
+
+		Expect(clusters).To(HaveLen(3))
+		socketAddress := clusters[0].GetLoadAssignment().GetEndpoints()[0].GetLbEndpoints()[0].GetEndpoint().GetAddress().GetSocketAddress()
+		Expect(socketAddress.GetAddress()).To(Equal("activator.example.com"))
+		Expect(socketAddress.GetPortValue()).To(BeEquivalentTo(8080))
+		expectAggregateCluster(clusters[1], coldCluster)
+		expectAggregateCluster(clusters[2], warmCluster)
+
+		weightedClusters := routes()[0].GetRoute().GetWeightedClusters().GetClusters()
+		Expect(weightedClusters[0].GetName()).To(Equal(coldstart.AggregateClusterName(warmCluster)))
+		Expect(weightedClusters[0].GetRequestHeadersToAdd()).To(ConsistOf(clusterHeader(warmCluster)))
+		Expect(weightedClusters[1].GetName()).To(Equal(coldstart.AggregateClusterName(coldCluster)))
+		Expect(weightedClusters[1].GetRequestHeadersToAdd()).To(ConsistOf(clusterHeader(coldCluster)))
+		Expect(weightedClusters[2].GetName()).To(Equal("other_gloo-system"))
+		Expect(weightedClusters[2].GetRequestHeadersToAdd()).To(BeEmpty())
+		// the timeout of the route leaves room for the longest hold of its clusters
+		Expect(routes()[0].GetRoute().GetTimeout()).To(Equal(prototime.DurationToProto(time.Minute + 15*time.Second)))
+		Expect(routes()[0].GetRoute().GetRetryPolicy()).To(matchers.MatchProto(&envoy_config_route_v3.RetryPolicy{
+			RetryOn:          "retriable-headers",
+			RetriableHeaders: []*envoy_config_route_v3.HeaderMatcher{retryHeader},
+			NumRetries:       &wrappers.UInt32Value{Value: 3},
+		}))
+	})
+
+	It("keeps the timeout and the retries of the routes", func() {
+		rt := singleRoute(coldCluster)
+		rt.GetRoute().Timeout = prototime.DurationToProto(5 * time.Second)
+		rt.GetRoute().RetryPolicy = &envoy_config_route_v3.RetryPolicy{
+			RetryOn:    "5xx",
+			NumRetries: &wrappers.UInt32Value{Value: 5},
+		}
+		vh := routeConfigs[0].GetVirtualHosts()[0]
+		vh.RetryPolicy = &envoy_config_route_v3.RetryPolicy{RetryOn: "connect-failure"}
+		vh.Routes = []*envoy_config_route_v3.Route{rt, singleRoute(warmCluster)}
+
+		generate(&v1.Settings{})
+
+		Expect(routes()[0].GetRoute().GetTimeout()).To(Equal(prototime.DurationToProto(5 * time.Second)))
+		Expect(routes()[0].GetRoute().GetRetryPolicy()).To(matchers.MatchProto(&envoy_config_route_v3.RetryPolicy{
+			RetryOn:          "5xx,retriable-headers",
+			RetriableHeaders: []*envoy_config_route_v3.HeaderMatcher{retryHeader},
+			NumRetries:       &wrappers.UInt32Value{Value: 5},
+		}))
+		// the retry policy of the route replaces the one of the virtual host, which is kept
+		Expect(routes()[1].GetRoute().GetRetryPolicy()).To(matchers.MatchProto(&envoy_config_route_v3.RetryPolicy{
+			RetryOn:          "connect-failure,retriable-headers",
+			RetriableHeaders: []*envoy_config_route_v3.HeaderMatcher{retryHeader},
+		}))
+		Expect(vh.GetRetryPolicy()).To(matchers.MatchProto(&envoy_config_route_v3.RetryPolicy{RetryOn: "connect-failure"}))
+	})
+
+	It("does not raise the retries of the routes", func() {
+		rt := singleRoute(coldCluster)
+		rt.GetRoute().RetryPolicy = &envoy_config_route_v3.RetryPolicy{
+			RetryOn:    "5xx",
+			NumRetries: &wrappers.UInt32Value{Value: 1},
+		}
+		routeConfigs[0].GetVirtualHosts()[0].Routes = []*envoy_config_route_v3.Route{rt}
+
+		generate(&v1.Settings{})
+
+		Expect(routes()[0].GetRoute().GetRetryPolicy()).To(matchers.MatchProto(&envoy_config_route_v3.RetryPolicy{
+			RetryOn:          "5xx,retriable-headers",
+			RetriableHeaders: []*envoy_config_route_v3.HeaderMatcher{retryHeader},
+			NumRetries:       &wrappers.UInt32Value{Value: 1},
+		}))
+	})
+
+	It("does not generate the activator cluster if no route needs it", func() {
+		routeConfigs[0].GetVirtualHosts()[0].Routes = []*envoy_config_route_v3.Route{
+			singleRoute("other_gloo-system"),
+		}
+
+		Expect(generate(&v1.Settings{})).To(BeEmpty())
+	})
+})
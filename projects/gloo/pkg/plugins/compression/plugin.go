@@ -0,0 +1,263 @@
+package compression
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoybrotlicompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoybrotlidecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/decompressor/v3"
+	envoygzipcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoygzipdecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/decompressor/v3"
+	envoyzstdcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	envoyzstddecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/decompressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoydecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gzip"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "compression"
+
+	// each algorithm has its own compressor and decompressor filter
+	GzipCompressorFilterName     = "envoy.filters.http.compressor.gzip"
+	BrotliCompressorFilterName   = "envoy.filters.http.compressor.brotli"
+	ZstdCompressorFilterName     = "envoy.filters.http.compressor.zstd"
+	GzipDecompressorFilterName   = "envoy.filters.http.decompressor.gzip"
+	BrotliDecompressorFilterName = "envoy.filters.http.decompressor.brotli"
+	ZstdDecompressorFilterName   = "envoy.filters.http.decompressor.zstd"
+
+	GzipCompressorLibrary     = "envoy.compression.gzip.compressor"
+	BrotliCompressorLibrary   = "envoy.compression.brotli.compressor"
+	ZstdCompressorLibrary     = "envoy.compression.zstd.compressor"
+	GzipDecompressorLibrary   = "envoy.compression.gzip.decompressor"
+	BrotliDecompressorLibrary = "envoy.compression.brotli.decompressor"
+	ZstdDecompressorLibrary   = "envoy.compression.zstd.decompressor"
+)
+
+// like the gzip filter, the filters are placed in the first stage of the request path, so that they are the last
+// filters of the response path and compress the responses after any transformations
+var pluginStage = plugins.DuringStage(plugins.FaultStage)
+
+// compressorFilterNames are the names of all the filters which can compress responses, including the filter of the
+// gzip option, so that the compression of a virtual host or route can be disabled regardless of how it is configured
+var compressorFilterNames = []string{
+	gzip.CompressorFilterName,
+	GzipCompressorFilterName,
+	BrotliCompressorFilterName,
+	ZstdCompressorFilterName,
+}
+
+var (
+	GzipConflictError        = eris.New("gzip compression cannot be configured in both the gzip and the compression options")
+	NoCompressorTypeError    = eris.New("compressor must specify an algorithm")
+	DuplicateCompressorError = func(filterName string) error {
+		return eris.Errorf("compression algorithm of filter %s is configured more than once", filterName)
+	}
+	UnknownDecompressionAlgorithmError = func(algorithm compression.Decompression_Algorithm) error {
+		return eris.Errorf("unknown decompression algorithm %v", algorithm)
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	compressionConfig := listener.GetOptions().GetCompression()
+	if compressionConfig == nil {
+		return nil, nil
+	}
+
+	var filters []plugins.StagedHttpFilter
+	filterNames := map[string]bool{}
+	addFilter := func(name string, config proto.Message) error {
+		if filterNames[name] {
+			return DuplicateCompressorError(name)
+		}
+		filterNames[name] = true
+		filter, err := plugins.NewStagedFilter(name, config, pluginStage)
+		if err != nil {
+			return eris.Wrapf(err, "generating filter config")
+		}
+		filters = append(filters, filter)
+		return nil
+	}
+
+	for _, compressor := range compressionConfig.GetCompressors() {
+		if compressor.GetGzip() != nil && listener.GetOptions().GetGzip() != nil {
+			return nil, GzipConflictError
+		}
+		name, config, err := translateCompressor(compressionConfig, compressor)
+		if err != nil {
+			return nil, err
+		}
+		if err := addFilter(name, config); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, algorithm := range compressionConfig.GetDecompression().GetAlgorithms() {
+		name, config, err := translateDecompressor(algorithm)
+		if err != nil {
+			return nil, err
+		}
+		if err := addFilter(name, config); err != nil {
+			return nil, err
+		}
+	}
+
+	return filters, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	_ plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	if !in.GetOptions().GetCompression().GetDisabled() {
+		return nil
+	}
+	for _, name := range compressorFilterNames {
+		if err := pluginutils.SetVhostPerFilterConfig(out, name, disabledPerRoute()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	if !in.GetOptions().GetCompression().GetDisabled() {
+		return nil
+	}
+	for _, name := range compressorFilterNames {
+		if err := pluginutils.SetRoutePerFilterConfig(out, name, disabledPerRoute()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func disabledPerRoute() *envoycompressor.CompressorPerRoute {
+	return &envoycompressor.CompressorPerRoute{
+		Override: &envoycompressor.CompressorPerRoute_Disabled{Disabled: true},
+	}
+}
+
+// translateCompressor returns the name and the config of the compressor filter
+func translateCompressor(compressionConfig *compression.Compression, compressor *compression.Compressor) (string, *envoycompressor.Compressor, error) {
+	var (
+		filterName  string
+		libraryName string
+		library     proto.Message
+	)
+	switch compressorType := compressor.GetCompressorType().(type) {
+	case *compression.Compressor_Gzip:
+		filterName, libraryName = GzipCompressorFilterName, GzipCompressorLibrary
+		library = &envoygzipcompressor.Gzip{
+			MemoryLevel:         compressorType.Gzip.GetMemoryLevel(),
+			CompressionLevel:    envoygzipcompressor.Gzip_CompressionLevel(compressorType.Gzip.GetCompressionLevel().GetValue()),
+			CompressionStrategy: envoygzipcompressor.Gzip_CompressionStrategy(compressorType.Gzip.GetCompressionStrategy()),
+			WindowBits:          compressorType.Gzip.GetWindowBits(),
+		}
+	case *compression.Compressor_Brotli:
+		filterName, libraryName = BrotliCompressorFilterName, BrotliCompressorLibrary
+		library = &envoybrotlicompressor.Brotli{
+			Quality:     compressorType.Brotli.GetQuality(),
+			EncoderMode: envoybrotlicompressor.Brotli_EncoderMode(compressorType.Brotli.GetEncoderMode()),
+			WindowBits:  compressorType.Brotli.GetWindowBits(),
+		}
+	case *compression.Compressor_Zstd:
+		filterName, libraryName = ZstdCompressorFilterName, ZstdCompressorLibrary
+		library = &envoyzstdcompressor.Zstd{
+			CompressionLevel: compressorType.Zstd.GetCompressionLevel(),
+			EnableChecksum:   compressorType.Zstd.GetEnableChecksum(),
+		}
+	default:
+		return "", nil, NoCompressorTypeError
+	}
+
+	typedConfig, err := utils.MessageToAny(library)
+	if err != nil {
+		return "", nil, err
+	}
+	envoyCompressor := &envoycompressor.Compressor{
+		CompressorLibrary: &envoy_config_core_v3.TypedExtensionConfig{
+			Name:        libraryName,
+			TypedConfig: typedConfig,
+		},
+		ResponseDirectionConfig: &envoycompressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: &envoycompressor.Compressor_CommonDirectionConfig{
+				MinContentLength: compressionConfig.GetMinContentLength(),
+				ContentType:      compressionConfig.GetContentType(),
+			},
+			DisableOnEtagHeader:        compressionConfig.GetDisableOnEtagHeader(),
+			RemoveAcceptEncodingHeader: compressionConfig.GetRemoveAcceptEncodingHeader(),
+		},
+	}
+	return filterName, envoyCompressor, envoyCompressor.Validate()
+}
+
+// translateDecompressor returns the name and the config of the decompressor filter, which only decompresses requests
+func translateDecompressor(algorithm compression.Decompression_Algorithm) (string, *envoydecompressor.Decompressor, error) {
+	var (
+		filterName  string
+		libraryName string
+		library     proto.Message
+	)
+	switch algorithm {
+	case compression.Decompression_GZIP:
+		filterName, libraryName, library = GzipDecompressorFilterName, GzipDecompressorLibrary, &envoygzipdecompressor.Gzip{}
+	case compression.Decompression_BROTLI:
+		filterName, libraryName, library = BrotliDecompressorFilterName, BrotliDecompressorLibrary, &envoybrotlidecompressor.Brotli{}
+	case compression.Decompression_ZSTD:
+		filterName, libraryName, library = ZstdDecompressorFilterName, ZstdDecompressorLibrary, &envoyzstddecompressor.Zstd{}
+	default:
+		return "", nil, UnknownDecompressionAlgorithmError(algorithm)
+	}
+
+	typedConfig, err := utils.MessageToAny(library)
+	if err != nil {
+		return "", nil, err
+	}
+	envoyDecompressor := &envoydecompressor.Decompressor{
+		DecompressorLibrary: &envoy_config_core_v3.TypedExtensionConfig{
+			Name:        libraryName,
+			TypedConfig: typedConfig,
+		},
+		RequestDirectionConfig: &envoydecompressor.Decompressor_RequestDirectionConfig{
+			AdvertiseAcceptEncoding: &wrappers.BoolValue{Value: false},
+		},
+		ResponseDirectionConfig: &envoydecompressor.Decompressor_ResponseDirectionConfig{
+			CommonConfig: &envoydecompressor.Decompressor_CommonDirectionConfig{
+				Enabled: &envoy_config_core_v3.RuntimeFeatureFlag{
+					DefaultValue: &wrappers.BoolValue{Value: false},
+					RuntimeKey:   filterName + ".response_enabled",
+				},
+			},
+		},
+	}
+	return filterName, envoyDecompressor, envoyDecompressor.Validate()
+}
@@ -0,0 +1,182 @@
+package compression_test
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoybrotlicompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	envoygzipcompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	envoyzstddecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/decompressor/v3"
+	envoycompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	envoydecompressor "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v2 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/filter/http/gzip/v2"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/compression"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/gzip"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	typedExtensionConfig := func(name string, msg proto.Message) *envoy_config_core_v3.TypedExtensionConfig {
+		typedConfig, err := utils.MessageToAny(msg)
+		Expect(err).NotTo(HaveOccurred())
+		return &envoy_config_core_v3.TypedExtensionConfig{Name: name, TypedConfig: typedConfig}
+	}
+
+	filterConfig := func(filter plugins.StagedHttpFilter) proto.Message {
+		Expect(filter.Stage).To(Equal(plugins.DuringStage(plugins.FaultStage)))
+		msg, err := utils.AnyToMessage(filter.HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		return msg
+	}
+
+	It("does not add filters when compression is not configured", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("adds a compressor filter for each algorithm", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Compression: &compression.Compression{
+					ContentType:         []string{"application/json"},
+					MinContentLength:    &wrappers.UInt32Value{Value: 100},
+					DisableOnEtagHeader: true,
+					Compressors: []*compression.Compressor{
+						{CompressorType: &compression.Compressor_Brotli{Brotli: &compression.Brotli{
+							Quality:     &wrappers.UInt32Value{Value: 5},
+							EncoderMode: compression.Brotli_TEXT,
+						}}},
+						{CompressorType: &compression.Compressor_Gzip{Gzip: &compression.Gzip{
+							CompressionLevel:    &wrappers.UInt32Value{Value: 9},
+							CompressionStrategy: compression.Gzip_RLE,
+						}}},
+						{CompressorType: &compression.Compressor_Zstd{Zstd: &compression.Zstd{}}},
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(3))
+
+		responseDirectionConfig := &envoycompressor.Compressor_ResponseDirectionConfig{
+			CommonConfig: &envoycompressor.Compressor_CommonDirectionConfig{
+				MinContentLength: &wrappers.UInt32Value{Value: 100},
+				ContentType:      []string{"application/json"},
+			},
+			DisableOnEtagHeader: true,
+		}
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(BrotliCompressorFilterName))
+		Expect(filterConfig(filters[0])).To(matchers.MatchProto(&envoycompressor.Compressor{
+			CompressorLibrary: typedExtensionConfig(BrotliCompressorLibrary, &envoybrotlicompressor.Brotli{
+				Quality:     &wrappers.UInt32Value{Value: 5},
+				EncoderMode: envoybrotlicompressor.Brotli_TEXT,
+			}),
+			ResponseDirectionConfig: responseDirectionConfig,
+		}))
+		Expect(filters[1].HttpFilter.GetName()).To(Equal(GzipCompressorFilterName))
+		Expect(filterConfig(filters[1])).To(matchers.MatchProto(&envoycompressor.Compressor{
+			CompressorLibrary: typedExtensionConfig(GzipCompressorLibrary, &envoygzipcompressor.Gzip{
+				CompressionLevel:    envoygzipcompressor.Gzip_BEST_COMPRESSION,
+				CompressionStrategy: envoygzipcompressor.Gzip_RLE,
+			}),
+			ResponseDirectionConfig: responseDirectionConfig,
+		}))
+		Expect(filters[2].HttpFilter.GetName()).To(Equal(ZstdCompressorFilterName))
+	})
+
+	It("adds a decompressor filter which only decompresses requests", func() {
+		filters, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Compression: &compression.Compression{
+					Decompression: &compression.Decompression{
+						Algorithms: []compression.Decompression_Algorithm{compression.Decompression_ZSTD},
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(ZstdDecompressorFilterName))
+		Expect(filterConfig(filters[0])).To(matchers.MatchProto(&envoydecompressor.Decompressor{
+			DecompressorLibrary: typedExtensionConfig(ZstdDecompressorLibrary, &envoyzstddecompressor.Zstd{}),
+			RequestDirectionConfig: &envoydecompressor.Decompressor_RequestDirectionConfig{
+				AdvertiseAcceptEncoding: &wrappers.BoolValue{Value: false},
+			},
+			ResponseDirectionConfig: &envoydecompressor.Decompressor_ResponseDirectionConfig{
+				CommonConfig: &envoydecompressor.Decompressor_CommonDirectionConfig{
+					Enabled: &envoy_config_core_v3.RuntimeFeatureFlag{
+						DefaultValue: &wrappers.BoolValue{Value: false},
+						RuntimeKey:   ZstdDecompressorFilterName + ".response_enabled",
+					},
+				},
+			},
+		}))
+	})
+
+	It("errors when an algorithm is configured more than once", func() {
+		_, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Compression: &compression.Compression{
+					Compressors: []*compression.Compressor{
+						{CompressorType: &compression.Compressor_Zstd{Zstd: &compression.Zstd{}}},
+						{CompressorType: &compression.Compressor_Zstd{Zstd: &compression.Zstd{EnableChecksum: true}}},
+					},
+				},
+			},
+		})
+		Expect(err).To(MatchError(DuplicateCompressorError(ZstdCompressorFilterName)))
+	})
+
+	It("errors when gzip is also configured by the gzip option", func() {
+		_, err := NewPlugin().HttpFilters(plugins.Params{}, &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Gzip: &v2.Gzip{},
+				Compression: &compression.Compression{
+					Compressors: []*compression.Compressor{
+						{CompressorType: &compression.Compressor_Gzip{Gzip: &compression.Gzip{}}},
+					},
+				},
+			},
+		})
+		Expect(err).To(MatchError(GzipConflictError))
+	})
+
+	It("disables all compressors on routes", func() {
+		out := &envoy_config_route_v3.Route{}
+		err := NewPlugin().ProcessRoute(plugins.RouteParams{}, &v1.Route{
+			Options: &v1.RouteOptions{
+				Compression: &compression.CompressionPerRoute{Disabled: true},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+
+		disabled, err := utils.MessageToAny(&envoycompressor.CompressorPerRoute{
+			Override: &envoycompressor.CompressorPerRoute_Disabled{Disabled: true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(HaveLen(4))
+		for _, name := range []string{gzip.CompressorFilterName, GzipCompressorFilterName, BrotliCompressorFilterName, ZstdCompressorFilterName} {
+			Expect(out.GetTypedPerFilterConfig()).To(HaveKeyWithValue(name, matchers.MatchProto(disabled)))
+		}
+	})
+
+	It("does not configure virtual hosts which do not disable compression", func() {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{
+			Options: &v1.VirtualHostOptions{
+				Compression: &compression.CompressionPerRoute{},
+			},
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+	})
+})
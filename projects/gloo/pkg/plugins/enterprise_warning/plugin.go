@@ -33,16 +33,11 @@ const (
 	CachingExtensionName               = "caching"
 	DlpExtensionName                   = "dlp"
 	FailoverExtensionName              = "failover"
-	JwtExtensionName                   = "jwt"
 	LeftmostXffAddressExtensionName    = "leftmost_xff_address"
 	ProxyLatencyExtensionName          = "proxy_latency"
-	RbacExtensionName                  = "rbac"
 	SanitizeClusterHeaderExtensionName = "sanitize_cluster_header"
 	WafExtensionName                   = "waf"
-	WasmExtensionName                  = "wasm"
 	Aws                                = "aws"
-	ExtProcExtensionName               = "extproc"
-	TapFilterExtensionName             = "tap"
 )
 
 type plugin struct{}
@@ -72,36 +67,16 @@ func (p *plugin) ProcessVirtualHost(
 ) error {
 	var enterpriseExtensions []string
 
-	if isJwtConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, JwtExtensionName)
-	}
-
-	if isRbacConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, RbacExtensionName)
-	}
-
 	if isWafConfiguredOnVirtualHost(in) {
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
 
-	if isExtProcConfiguredOnVirtualHost(in) {
-		enterpriseExtensions = append(enterpriseExtensions, ExtProcExtensionName)
-	}
-
 	return GetErrorForEnterpriseOnlyExtensions(enterpriseExtensions)
 }
 
 func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, _ *envoy_config_route_v3.Route) error {
 	var enterpriseExtensions []string
 
-	if isJwtConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, JwtExtensionName)
-	}
-
-	if isRbacConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, RbacExtensionName)
-	}
-
 	if isWafConfiguredOnRoute(in) {
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
@@ -110,10 +85,6 @@ func (p *plugin) ProcessRoute(_ plugins.RouteParams, in *v1.Route, _ *envoy_conf
 		enterpriseExtensions = append(enterpriseExtensions, Aws)
 	}
 
-	if isExtProcConfiguredOnRoute(in) {
-		enterpriseExtensions = append(enterpriseExtensions, ExtProcExtensionName)
-	}
-
 	return GetErrorForEnterpriseOnlyExtensions(enterpriseExtensions)
 }
 
@@ -158,18 +129,6 @@ func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plu
 		enterpriseExtensions = append(enterpriseExtensions, WafExtensionName)
 	}
 
-	if isWasmConfiguredOnListener(listener) {
-		enterpriseExtensions = append(enterpriseExtensions, WasmExtensionName)
-	}
-
-	if isExtProcConfiguredOnListener(listener) {
-		enterpriseExtensions = append(enterpriseExtensions, ExtProcExtensionName)
-	}
-
-	if isTapConfiguredOnListener(listener) {
-		enterpriseExtensions = append(enterpriseExtensions, TapFilterExtensionName)
-	}
-
 	return nil, GetErrorForEnterpriseOnlyExtensions(enterpriseExtensions)
 }
 
@@ -194,8 +153,9 @@ func isAdvancedHttpConfiguredOnUpstream(in *v1.Upstream) bool {
 }
 
 // caching
+// only the caching server is enterprise-only, Envoy's in-memory cache is configured by the caching plugin
 func isCachingConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetCaching() != nil
+	return in.GetOptions().GetCaching().GetCachingServiceRef() != nil
 }
 
 // dlp
@@ -208,15 +168,6 @@ func isFailoverConfiguredOnUpstream(in *v1.Upstream) bool {
 	return in.GetFailover() != nil
 }
 
-// jwt
-func isJwtConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetJwtConfig() != nil
-}
-
-func isJwtConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetJwtConfig() != nil
-}
-
 // leftmost_xff_address
 func isLeftmostXffAddressConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetLeftmostXffAddress() != nil
@@ -227,25 +178,11 @@ func isProxyLatencyConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetProxyLatency() != nil
 }
 
-// rbac
-func isRbacConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetRbac() != nil
-}
-
-func isRbacConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetRbac() != nil
-}
-
 // sanitize_cluster_header
 func isSanitizeClusterHeaderConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetSanitizeClusterHeader() != nil
 }
 
-// tap
-func isTapConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetTap() != nil
-}
-
 // waf
 func isWafConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
 	return in.GetOptions().GetWaf() != nil
@@ -259,11 +196,6 @@ func isWafConfiguredOnListener(in *v1.HttpListener) bool {
 	return in.GetOptions().GetWaf() != nil
 }
 
-// wasm
-func isWasmConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetWasm() != nil
-}
-
 // aws
 func isEnterpriseAWSConfiguredOnRoute(in *v1.Route) bool {
 	var awsDestinationSpecs []*aws.DestinationSpec
@@ -287,16 +219,3 @@ func isEnterpriseAWSConfiguredOnRoute(in *v1.Route) bool {
 
 	return false
 }
-
-// extproc
-func isExtProcConfiguredOnVirtualHost(in *v1.VirtualHost) bool {
-	return in.GetOptions().GetExtProc() != nil
-}
-
-func isExtProcConfiguredOnRoute(in *v1.Route) bool {
-	return in.GetOptions().GetExtProc() != nil
-}
-
-func isExtProcConfiguredOnListener(in *v1.HttpListener) bool {
-	return in.GetOptions().GetExtProc() != nil || in.GetOptions().GetDisableExtProc() != nil
-}
@@ -8,6 +8,7 @@ import (
 	core1 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/proxylatency"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/caching"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/dlp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
@@ -133,13 +134,8 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	Context("jwt", func() {
 
-		It("should not add filter if jwt config is nil", func() {
-			p := NewPlugin()
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{}, &envoy_config_route.VirtualHost{})
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("will err if jwt is configured", func() {
+		// jwt is supported by the open source jwt plugin
+		It("will not err if jwt is configured on a virtual host", func() {
 			p := NewPlugin()
 			virtualHost := &v1.VirtualHost{
 				Name:    "virt1",
@@ -152,10 +148,10 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("will err if jwt is configured", func() {
+		It("will not err if jwt is configured on a route", func() {
 			p := NewPlugin()
 			route := &v1.Route{
 				Name: "route1",
@@ -167,7 +163,7 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			err := p.ProcessRoute(plugins.RouteParams{}, route, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 	})
@@ -222,13 +218,8 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	Context("rbac", func() {
 
-		It("should not add filter if rbac config is nil", func() {
-			p := NewPlugin()
-			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, &v1.VirtualHost{}, &envoy_config_route.VirtualHost{})
-			Expect(err).NotTo(HaveOccurred())
-		})
-
-		It("will err if rbac is configured on vhost", func() {
+		// rbac is supported by the open source rbac plugin
+		It("will not err if rbac is configured on vhost", func() {
 			p := NewPlugin()
 			virtualHost := &v1.VirtualHost{
 				Name:    "virt1",
@@ -239,10 +230,10 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("will err if rbac is configured on route", func() {
+		It("will not err if rbac is configured on route", func() {
 			p := NewPlugin()
 			virtualHost := &v1.Route{
 				Name: "route1",
@@ -252,7 +243,7 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			err := p.ProcessRoute(plugins.RouteParams{}, virtualHost, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 	})
@@ -280,6 +271,37 @@ var _ = Describe("enterprise_warning plugin", func() {
 		})
 	})
 
+	Context("caching", func() {
+		// the in-memory cache is supported by the open source caching plugin
+		It("will not error if caching is configured without a caching server", func() {
+			p := NewPlugin()
+			hl := &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Caching: &caching.Settings{},
+				},
+			}
+
+			f, err := p.HttpFilters(plugins.Params{}, hl)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(BeNil())
+		})
+
+		It("will error if a caching server is configured", func() {
+			p := NewPlugin()
+			hl := &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					Caching: &caching.Settings{
+						CachingServiceRef: &core.ResourceRef{Name: "caching-service", Namespace: "gloo-system"},
+					},
+				},
+			}
+
+			f, err := p.HttpFilters(plugins.Params{}, hl)
+			ExpectEnterpriseOnlyErr(err)
+			Expect(f).To(BeNil())
+		})
+	})
+
 	Context("tap", func() {
 		It("should not add filter if tap config is nil", func() {
 			p := NewPlugin()
@@ -288,7 +310,8 @@ var _ = Describe("enterprise_warning plugin", func() {
 			Expect(f).To(BeNil())
 		})
 
-		It("will error if tap is configured", func() {
+		// tap is supported by the open source tap plugin
+		It("will not error if tap is configured", func() {
 			p := NewPlugin()
 			hl := &v1.HttpListener{
 				Options: &v1.HttpListenerOptions{
@@ -297,7 +320,7 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(f).To(BeNil())
 		})
 	})
@@ -355,14 +378,8 @@ var _ = Describe("enterprise_warning plugin", func() {
 
 	Context("extproc", func() {
 
-		It("should not add filter if extproc config is nil", func() {
-			p := NewPlugin()
-			f, err := p.HttpFilters(plugins.Params{}, nil)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(f).To(BeNil())
-		})
-
-		It("should error if disable extproc is configured on listener", func() {
+		// extproc is supported by the open source extproc plugin
+		It("should not error if disable extproc is configured on listener", func() {
 			p := NewPlugin()
 			hl := &v1.HttpListener{
 				Options: &v1.HttpListenerOptions{
@@ -371,11 +388,11 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(f).To(BeNil())
 		})
 
-		It("should error if extproc is configured on listener", func() {
+		It("should not error if extproc is configured on listener", func() {
 			p := NewPlugin()
 			hl := &v1.HttpListener{
 				Options: &v1.HttpListenerOptions{
@@ -384,11 +401,11 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(f).To(BeNil())
 		})
 
-		It("should error if extproc is configured on vhost", func() {
+		It("should not error if extproc is configured on vhost", func() {
 			p := NewPlugin()
 			virtualHost := &v1.VirtualHost{
 				Name:    "virt1",
@@ -399,10 +416,10 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			err := p.ProcessVirtualHost(plugins.VirtualHostParams{}, virtualHost, &envoy_config_route.VirtualHost{})
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		It("should error if extproc is configured on route", func() {
+		It("should not error if extproc is configured on route", func() {
 			p := NewPlugin()
 			virtualHost := &v1.Route{
 				Name: "route1",
@@ -412,7 +429,7 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			err := p.ProcessRoute(plugins.RouteParams{}, virtualHost, &envoy_config_route.Route{})
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 	})
@@ -433,7 +450,8 @@ var _ = Describe("enterprise_warning plugin", func() {
 			Expect(f).To(BeNil())
 		})
 
-		It("will err if wasm is configured", func() {
+		// wasm is supported by the open source wasm plugin
+		It("will not err if wasm is configured", func() {
 			image := "hello"
 			hl := &v1.HttpListener{
 				Options: &v1.HttpListenerOptions{
@@ -450,7 +468,7 @@ var _ = Describe("enterprise_warning plugin", func() {
 			}
 
 			f, err := p.HttpFilters(plugins.Params{}, hl)
-			ExpectEnterpriseOnlyErr(err)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(f).To(BeNil())
 		})
 	})
@@ -0,0 +1,147 @@
+package extproc
+
+import (
+	"reflect"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "extproc"
+	FilterName    = "envoy.filters.http.ext_proc"
+)
+
+var (
+	NoGrpcServiceError  = eris.New("ext_proc settings must specify a grpc service with an ext_proc_server_ref")
+	NoFilterStageError  = eris.New("ext_proc settings must specify a filter stage")
+	DisabledFalseError  = eris.New("setting ext_proc disabled to false is not supported")
+	ServerNotFoundError = func(ref *core.ResourceRef) error {
+		return eris.Errorf("ext_proc server upstream %s does not exist", ref.Key())
+	}
+	UnsupportedFieldError = func(field string) error {
+		return eris.Errorf("ext_proc setting %s is not supported", field)
+	}
+)
+
+type plugin struct {
+	globalSettings *extproc.Settings
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.globalSettings = params.Settings.GetExtProc()
+}
+
+// HttpFilters adds the ext_proc filter with the settings of the listener, merged with the global settings
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	settings := p.listenerSettings(listener)
+	if settings == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	if settings.GetFilterStage() == nil {
+		return nil, NoFilterStageError
+	}
+	config, err := translateSettings(params, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := plugins.NewStagedFilter(FilterName, config, *plugins.ConvertFilterStage(settings.GetFilterStage()))
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+// ProcessVirtualHost overrides the settings of the listener for the virtual host.
+// If the filter is disabled on the listener, it is disabled on the virtual hosts that do not override it.
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	if p.listenerSettings(params.HttpListener) == nil {
+		return nil
+	}
+
+	routeSettings := in.GetOptions().GetExtProc()
+	if routeSettings == nil {
+		if !params.HttpListener.GetOptions().GetDisableExtProc().GetValue() {
+			return nil
+		}
+		routeSettings = &extproc.RouteSettings{
+			Override: &extproc.RouteSettings_Disabled{
+				Disabled: &wrappers.BoolValue{Value: true},
+			},
+		}
+	}
+
+	perRouteConfig, err := translateRouteSettings(params.Params, routeSettings)
+	if err != nil || perRouteConfig == nil {
+		return err
+	}
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+// ProcessRoute overrides the settings of the virtual host for the route
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	routeSettings := in.GetOptions().GetExtProc()
+	if routeSettings == nil || p.listenerSettings(params.HttpListener) == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translateRouteSettings(params.Params, routeSettings)
+	if err != nil || perRouteConfig == nil {
+		return err
+	}
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+// listenerSettings returns the settings of the listener, merged with the global settings, or nil if ext_proc is not
+// configured for the listener
+func (p *plugin) listenerSettings(listener *v1.HttpListener) *extproc.Settings {
+	return mergeSettings(listener.GetOptions().GetExtProc(), p.globalSettings)
+}
+
+// mergeSettings merges the fields of src into a copy of dst.
+// The fields in dst that have non-zero values are not overwritten.
+func mergeSettings(dst, src *extproc.Settings) *extproc.Settings {
+	if dst == nil {
+		return src
+	}
+	merged := proto.Clone(dst).(*extproc.Settings)
+	if src == nil {
+		return merged
+	}
+
+	dstValue, srcValue := reflect.ValueOf(merged).Elem(), reflect.ValueOf(src).Elem()
+	for i := 0; i < dstValue.NumField(); i++ {
+		dstField, srcField := dstValue.Field(i), srcValue.Field(i)
+		utils.ShallowMerge(dstField, srcField, false)
+	}
+	return merged
+}
@@ -0,0 +1,263 @@
+package extproc_test
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyextproc "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	solocorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	soloextproc "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/filters/http/ext_proc/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/filters"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params    plugins.Params
+		serverRef *core.ResourceRef
+	)
+
+	BeforeEach(func() {
+		serverRef = &core.ResourceRef{Name: "ext-proc", Namespace: "gloo-system"}
+		params = plugins.Params{
+			Ctx: context.Background(),
+			Snapshot: &gloov1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{{
+					Metadata: &core.Metadata{Name: serverRef.GetName(), Namespace: serverRef.GetNamespace()},
+				}},
+			},
+		}
+	})
+
+	initPlugin := func(globalSettings *extproc.Settings) plugins.Plugin {
+		p := NewPlugin()
+		p.Init(plugins.InitParams{Ctx: params.Ctx, Settings: &v1.Settings{ExtProc: globalSettings}})
+		return p
+	}
+
+	listenerWithSettings := func(settings *extproc.Settings) *v1.HttpListener {
+		return &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				ExtProcConfig: &v1.HttpListenerOptions_ExtProc{ExtProc: settings},
+			},
+		}
+	}
+
+	envoyGrpcService := func() *envoy_config_core_v3.GrpcService {
+		return &envoy_config_core_v3.GrpcService{
+			TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+					ClusterName: translator.UpstreamToClusterName(serverRef),
+				},
+			},
+		}
+	}
+
+	Context("HttpFilters", func() {
+
+		It("does not add the filter when ext_proc is not configured", func() {
+			filters, err := initPlugin(nil).(plugins.HttpFilterPlugin).HttpFilters(params, &v1.HttpListener{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("merges the settings of the listener with the global settings", func() {
+			p := initPlugin(&extproc.Settings{
+				GrpcService: &extproc.GrpcService{
+					ExtProcServerRef: serverRef,
+					Authority:        &wrappers.StringValue{Value: "global"},
+				},
+				FilterStage:      &filters.FilterStage{Stage: filters.FilterStage_AuthZStage, Predicate: filters.FilterStage_After},
+				FailureModeAllow: &wrappers.BoolValue{Value: true},
+			})
+			f, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listenerWithSettings(&extproc.Settings{
+				GrpcService: &extproc.GrpcService{
+					ExtProcServerRef: serverRef,
+					Timeout:          &duration.Duration{Seconds: 1},
+					InitialMetadata: []*solocorev3.HeaderValue{{
+						Key:   "x-team",
+						Value: "gloo",
+					}},
+				},
+				ProcessingMode: &soloextproc.ProcessingMode{
+					RequestBodyMode: soloextproc.ProcessingMode_BUFFERED,
+				},
+				MessageTimeout:            &duration.Duration{Seconds: 2},
+				StatPrefix:                &wrappers.StringValue{Value: "prefix"},
+				MetadataContextNamespaces: []string{"envoy.filters.http.jwt_authn"},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(HaveLen(1))
+			Expect(f[0].HttpFilter.GetName()).To(Equal(FilterName))
+			Expect(f[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthZStage)))
+
+			grpcService := envoyGrpcService()
+			grpcService.Timeout = &duration.Duration{Seconds: 1}
+			grpcService.InitialMetadata = []*envoy_config_core_v3.HeaderValue{{Key: "x-team", Value: "gloo"}}
+			msg, err := utils.AnyToMessage(f[0].HttpFilter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyextproc.ExternalProcessor{
+				GrpcService:      grpcService,
+				FailureModeAllow: true,
+				ProcessingMode: &envoyextproc.ProcessingMode{
+					RequestBodyMode: envoyextproc.ProcessingMode_BUFFERED,
+				},
+				MessageTimeout: &duration.Duration{Seconds: 2},
+				StatPrefix:     "prefix",
+				MetadataOptions: &envoyextproc.MetadataOptions{
+					ForwardingNamespaces: &envoyextproc.MetadataOptions_MetadataNamespaces{
+						Untyped: []string{"envoy.filters.http.jwt_authn"},
+					},
+				},
+			}))
+		})
+
+		DescribeTable("invalid settings",
+			func(settings *extproc.Settings, expectedErr error) {
+				_, err := initPlugin(nil).(plugins.HttpFilterPlugin).HttpFilters(params, listenerWithSettings(settings))
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("without filter stage", &extproc.Settings{
+				GrpcService: &extproc.GrpcService{ExtProcServerRef: serverRef},
+			}, NoFilterStageError),
+			Entry("without grpc service", &extproc.Settings{
+				FilterStage: &filters.FilterStage{},
+			}, NoGrpcServiceError),
+			Entry("with a missing upstream", &extproc.Settings{
+				FilterStage: &filters.FilterStage{},
+				GrpcService: &extproc.GrpcService{
+					ExtProcServerRef: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+				},
+			}, ServerNotFoundError(&core.ResourceRef{Name: "missing", Namespace: "gloo-system"})),
+			Entry("with unsupported settings", &extproc.Settings{
+				FilterStage:       &filters.FilterStage{},
+				GrpcService:       &extproc.GrpcService{ExtProcServerRef: serverRef},
+				AllowModeOverride: &wrappers.BoolValue{Value: true},
+			}, UnsupportedFieldError("allow_mode_override")),
+		)
+	})
+
+	Context("per route config", func() {
+
+		var (
+			listener *v1.HttpListener
+		)
+
+		BeforeEach(func() {
+			listener = listenerWithSettings(&extproc.Settings{
+				GrpcService: &extproc.GrpcService{ExtProcServerRef: serverRef},
+				FilterStage: &filters.FilterStage{},
+			})
+		})
+
+		processVirtualHost := func(p plugins.Plugin, routeSettings *extproc.RouteSettings) (*envoy_config_route_v3.VirtualHost, error) {
+			out := &envoy_config_route_v3.VirtualHost{}
+			err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{
+				Params:       params,
+				HttpListener: listener,
+			}, &v1.VirtualHost{Options: &v1.VirtualHostOptions{ExtProc: routeSettings}}, out)
+			return out, err
+		}
+
+		It("disables the filter on a virtual host", func() {
+			out, err := processVirtualHost(initPlugin(nil), &extproc.RouteSettings{
+				Override: &extproc.RouteSettings_Disabled{Disabled: &wrappers.BoolValue{Value: true}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyextproc.ExtProcPerRoute{
+				Override: &envoyextproc.ExtProcPerRoute_Disabled{Disabled: true},
+			}))
+		})
+
+		It("does not support enabling the filter with disabled", func() {
+			_, err := processVirtualHost(initPlugin(nil), &extproc.RouteSettings{
+				Override: &extproc.RouteSettings_Disabled{Disabled: &wrappers.BoolValue{Value: false}},
+			})
+			Expect(err).To(MatchError(DisabledFalseError))
+		})
+
+		It("disables the filter on virtual hosts when it is disabled on the listener", func() {
+			listener = &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					ExtProcConfig: &v1.HttpListenerOptions_DisableExtProc{DisableExtProc: &wrappers.BoolValue{Value: true}},
+				},
+			}
+			p := initPlugin(&extproc.Settings{
+				GrpcService: &extproc.GrpcService{ExtProcServerRef: serverRef},
+				FilterStage: &filters.FilterStage{},
+			})
+
+			f, err := p.(plugins.HttpFilterPlugin).HttpFilters(params, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(HaveLen(1))
+
+			out, err := processVirtualHost(p, nil)
+			Expect(err).NotTo(HaveOccurred())
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyextproc.ExtProcPerRoute{
+				Override: &envoyextproc.ExtProcPerRoute_Disabled{Disabled: true},
+			}))
+		})
+
+		It("overrides the settings on a route", func() {
+			out := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{Route: &envoy_config_route_v3.RouteAction{}},
+			}
+			err := initPlugin(nil).(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params:       params,
+					HttpListener: listener,
+				},
+			}, &v1.Route{
+				Options: &v1.RouteOptions{
+					ExtProc: &extproc.RouteSettings{
+						Override: &extproc.RouteSettings_Overrides{
+							Overrides: &extproc.Overrides{
+								ProcessingMode: &soloextproc.ProcessingMode{
+									ResponseHeaderMode: soloextproc.ProcessingMode_SKIP,
+								},
+								GrpcService:                    &extproc.GrpcService{ExtProcServerRef: serverRef},
+								TypedMetadataContextNamespaces: []string{"typed"},
+							},
+						},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyextproc.ExtProcPerRoute{
+				Override: &envoyextproc.ExtProcPerRoute_Overrides{
+					Overrides: &envoyextproc.ExtProcOverrides{
+						ProcessingMode: &envoyextproc.ProcessingMode{
+							ResponseHeaderMode: envoyextproc.ProcessingMode_SKIP,
+						},
+						GrpcService: envoyGrpcService(),
+						MetadataOptions: &envoyextproc.MetadataOptions{
+							ForwardingNamespaces: &envoyextproc.MetadataOptions_MetadataNamespaces{
+								Typed: []string{"typed"},
+							},
+						},
+					},
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,172 @@
+package extproc
+
+import (
+	envoy_config_common_mutation_rules_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyextproc "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/extproc"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+func translateSettings(params plugins.Params, settings *extproc.Settings) (*envoyextproc.ExternalProcessor, error) {
+	// these settings are not supported by the version of the ext_proc filter api that gloo is built with
+	switch {
+	case settings.GetDisableClearRouteCache() != nil:
+		return nil, UnsupportedFieldError("disable_clear_route_cache")
+	case settings.GetForwardRules() != nil:
+		return nil, UnsupportedFieldError("forward_rules")
+	case settings.GetFilterMetadata() != nil:
+		return nil, UnsupportedFieldError("filter_metadata")
+	case settings.GetAllowModeOverride() != nil:
+		return nil, UnsupportedFieldError("allow_mode_override")
+	}
+
+	grpcService, err := translateGrpcService(params, settings.GetGrpcService())
+	if err != nil {
+		return nil, err
+	}
+	if grpcService == nil {
+		return nil, NoGrpcServiceError
+	}
+
+	config := &envoyextproc.ExternalProcessor{
+		GrpcService:        grpcService,
+		FailureModeAllow:   settings.GetFailureModeAllow().GetValue(),
+		AsyncMode:          settings.GetAsyncMode().GetValue(),
+		RequestAttributes:  settings.GetRequestAttributes(),
+		ResponseAttributes: settings.GetResponseAttributes(),
+		MessageTimeout:     settings.GetMessageTimeout(),
+		StatPrefix:         settings.GetStatPrefix().GetValue(),
+		MaxMessageTimeout:  settings.GetMaxMessageTimeout(),
+		MetadataOptions: translateMetadataOptions(
+			settings.GetMetadataContextNamespaces(),
+			settings.GetTypedMetadataContextNamespaces(),
+		),
+	}
+	if settings.GetProcessingMode() != nil {
+		config.ProcessingMode = &envoyextproc.ProcessingMode{}
+		if err := convertProto(settings.GetProcessingMode(), config.GetProcessingMode()); err != nil {
+			return nil, err
+		}
+	}
+	if settings.GetMutationRules() != nil {
+		config.MutationRules = &envoy_config_common_mutation_rules_v3.HeaderMutationRules{}
+		if err := convertProto(settings.GetMutationRules(), config.GetMutationRules()); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// translateRouteSettings returns the per route config of a virtual host or a route, or nil if it does not override
+// the settings of its parent
+func translateRouteSettings(params plugins.Params, routeSettings *extproc.RouteSettings) (*envoyextproc.ExtProcPerRoute, error) {
+	switch override := routeSettings.GetOverride().(type) {
+	case *extproc.RouteSettings_Disabled:
+		if !override.Disabled.GetValue() {
+			return nil, DisabledFalseError
+		}
+		return &envoyextproc.ExtProcPerRoute{
+			Override: &envoyextproc.ExtProcPerRoute_Disabled{
+				Disabled: true,
+			},
+		}, nil
+	case *extproc.RouteSettings_Overrides:
+		overrides, err := translateOverrides(params, override.Overrides)
+		if err != nil {
+			return nil, err
+		}
+		return &envoyextproc.ExtProcPerRoute{
+			Override: &envoyextproc.ExtProcPerRoute_Overrides{
+				Overrides: overrides,
+			},
+		}, nil
+	}
+	return nil, nil
+}
+
+func translateOverrides(params plugins.Params, overrides *extproc.Overrides) (*envoyextproc.ExtProcOverrides, error) {
+	grpcService, err := translateGrpcService(params, overrides.GetGrpcService())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &envoyextproc.ExtProcOverrides{
+		AsyncMode:          overrides.GetAsyncMode().GetValue(),
+		RequestAttributes:  overrides.GetRequestAttributes(),
+		ResponseAttributes: overrides.GetResponseAttributes(),
+		GrpcService:        grpcService,
+		MetadataOptions: translateMetadataOptions(
+			overrides.GetMetadataContextNamespaces(),
+			overrides.GetTypedMetadataContextNamespaces(),
+		),
+	}
+	if overrides.GetProcessingMode() != nil {
+		out.ProcessingMode = &envoyextproc.ProcessingMode{}
+		if err := convertProto(overrides.GetProcessingMode(), out.GetProcessingMode()); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// translateGrpcService returns the envoy grpc service that targets the cluster of the ext_proc server upstream,
+// or nil if no grpc service is set
+func translateGrpcService(params plugins.Params, grpcService *extproc.GrpcService) (*envoy_config_core_v3.GrpcService, error) {
+	serverRef := grpcService.GetExtProcServerRef()
+	if serverRef == nil {
+		return nil, nil
+	}
+	if _, err := params.Snapshot.Upstreams.Find(serverRef.GetNamespace(), serverRef.GetName()); err != nil {
+		return nil, ServerNotFoundError(serverRef)
+	}
+
+	envoyGrpc := &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+		ClusterName: translator.UpstreamToClusterName(serverRef),
+		Authority:   grpcService.GetAuthority().GetValue(),
+	}
+	if grpcService.GetRetryPolicy() != nil {
+		envoyGrpc.RetryPolicy = &envoy_config_core_v3.RetryPolicy{}
+		if err := convertProto(grpcService.GetRetryPolicy(), envoyGrpc.GetRetryPolicy()); err != nil {
+			return nil, err
+		}
+	}
+
+	out := &envoy_config_core_v3.GrpcService{
+		TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: envoyGrpc,
+		},
+		Timeout: grpcService.GetTimeout(),
+	}
+	for _, header := range grpcService.GetInitialMetadata() {
+		out.InitialMetadata = append(out.GetInitialMetadata(), &envoy_config_core_v3.HeaderValue{
+			Key:   header.GetKey(),
+			Value: header.GetValue(),
+		})
+	}
+	return out, nil
+}
+
+// translateMetadataOptions returns the metadata namespaces forwarded to the ext_proc server, or nil if there are none
+func translateMetadataOptions(untyped, typed []string) *envoyextproc.MetadataOptions {
+	if len(untyped) == 0 && len(typed) == 0 {
+		return nil
+	}
+	return &envoyextproc.MetadataOptions{
+		ForwardingNamespaces: &envoyextproc.MetadataOptions_MetadataNamespaces{
+			Untyped: untyped,
+			Typed:   typed,
+		},
+	}
+}
+
+// Since we are using the same proto def, marshal out of gloo format and unmarshal into envoy format
+func convertProto(in, out proto.Message) error {
+	bytes, err := proto.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(bytes, out)
+}
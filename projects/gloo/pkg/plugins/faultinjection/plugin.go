@@ -1,9 +1,12 @@
 package faultinjection
 
 import (
+	"context"
+
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoyfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
 	envoyhttpfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -13,12 +16,14 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 )
 
 var (
-	_ plugins.Plugin           = new(plugin)
-	_ plugins.HttpFilterPlugin = new(plugin)
-	_ plugins.RoutePlugin      = new(plugin)
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
 )
 
 // https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/fault_filter
@@ -28,6 +33,9 @@ const (
 
 var pluginStage = plugins.DuringStage(plugins.FaultStage)
 
+// header-controlled faults are injected into all requests that have the fault headers, unless a percentage is set
+const headerControlledPercentage = 100
+
 type plugin struct {
 	removeUnused              bool
 	filterRequiredForListener map[*v1.HttpListener]struct{}
@@ -56,56 +64,107 @@ func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 	return []plugins.StagedHttpFilter{plugins.MustNewStagedFilter(wellknown.Fault, &envoyhttpfault.HTTPFault{}, pluginStage)}, nil
 }
 
+// ProcessVirtualHost will add the desired fault parameters on the virtual host.
+// These are used by the routes of the virtual host that do not configure their own faults.
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	envoyFault, err := toEnvoyFault(params.Ctx, in.GetOptions().GetFaults())
+	if err != nil || envoyFault == nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+	return pluginutils.SetVhostPerFilterConfig(out, wellknown.Fault, envoyFault)
+}
+
 // ProcessRoute will add the desired fault parameters on each given route.
 // There is no higher level configuration of the fault filter so this is where
 // actual functional configuration takes place.
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
 	markFilterConfigFunc := func(spec *v1.Destination) (proto.Message, error) {
-		if in.GetOptions() == nil {
-			return nil, nil
-		}
-		routeFaults := in.GetOptions().GetFaults()
-		if routeFaults == nil {
-			return nil, nil
-		}
-		routeAbort := routeFaults.GetAbort()
-		routeDelay := routeFaults.GetDelay()
-		envoyAbort, err := toEnvoyAbort(routeAbort)
-		if err != nil {
+		envoyFault, err := toEnvoyFault(params.Ctx, in.GetOptions().GetFaults())
+		if err != nil || envoyFault == nil {
 			return nil, err
 		}
-		envoyDelay, err := toEnvoyDelay(routeDelay)
-		if err != nil {
-			return nil, err
-		}
-
-		// neither were configured on the route so return without error
-		if envoyAbort == nil && envoyDelay == nil {
-			return nil, nil
-		}
 
 		// mark configured and return the wrapped envoy configuration
 		p.filterRequiredForListener[params.HttpListener] = struct{}{}
-		return &envoyhttpfault.HTTPFault{
-			Abort: envoyAbort,
-			Delay: envoyDelay,
-		}, nil
+		return envoyFault, nil
 	}
 	return pluginutils.MarkPerFilterConfig(params.Ctx, params.Snapshot, in, out, wellknown.Fault, markFilterConfigFunc)
 }
 
+// toEnvoyFault converts the faults from the gloo api to the envoy per-route configuration of the fault filter.
+// Returns nil if no fault is configured.
+func toEnvoyFault(ctx context.Context, routeFaults *fault.RouteFaults) (*envoyhttpfault.HTTPFault, error) {
+	if routeFaults == nil {
+		return nil, nil
+	}
+	envoyAbort, err := toEnvoyAbort(routeFaults.GetAbort())
+	if err != nil {
+		return nil, err
+	}
+	envoyDelay, err := toEnvoyDelay(routeFaults.GetDelay())
+	if err != nil {
+		return nil, err
+	}
+	envoyRateLimit, err := toEnvoyRateLimit(routeFaults.GetResponseRateLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	// no fault was configured so return without error
+	if envoyAbort == nil && envoyDelay == nil && envoyRateLimit == nil {
+		return nil, nil
+	}
+
+	return &envoyhttpfault.HTTPFault{
+		Abort:             envoyAbort,
+		Delay:             envoyDelay,
+		ResponseRateLimit: envoyRateLimit,
+		Headers:           translator.EnvoyHeaderMatchers(ctx, routeFaults.GetHeaders()),
+		DownstreamNodes:   routeFaults.GetDownstreamNodes(),
+		MaxActiveFaults:   routeFaults.GetMaxActiveFaults(),
+	}, nil
+}
+
 // toEnvoyAbort converts the abort config from the gloo api to the envoy api.
 // Will error if there is config present but it is invalid.
 func toEnvoyAbort(abort *fault.RouteAbort) (*envoyhttpfault.FaultAbort, error) {
 	if abort == nil {
 		return nil, nil
 	}
+	errorTypes := 0
+	for _, set := range []bool{abort.GetHttpStatus() != 0, abort.GetGrpcStatus() != nil, abort.GetHeaderAbort()} {
+		if set {
+			errorTypes++
+		}
+	}
+	if errorTypes > 1 {
+		return nil, errors.Errorf("only one of http_status, grpc_status or header_abort can be set on an abort")
+	}
+
+	percentage := common.ToEnvoyPercentage(abort.GetPercentage())
+	switch {
+	case abort.GetHeaderAbort():
+		return &envoyhttpfault.FaultAbort{
+			Percentage: headerControlledEnvoyPercentage(abort.GetPercentage()),
+			ErrorType:  &envoyhttpfault.FaultAbort_HeaderAbort_{HeaderAbort: &envoyhttpfault.FaultAbort_HeaderAbort{}},
+		}, nil
+	case abort.GetGrpcStatus() != nil:
+		return &envoyhttpfault.FaultAbort{
+			Percentage: percentage,
+			ErrorType:  &envoyhttpfault.FaultAbort_GrpcStatus{GrpcStatus: abort.GetGrpcStatus().GetValue()},
+		}, nil
+	}
+
 	// Validation really should catch this at proto level but sometimes things can sneak by
 	// https://github.com/envoyproxy/envoy/blob/bc8f0cd19f991a56269f1ea30b5b8d8d331da0dc/api/envoy/config/filter/http/fault/v2/fault.proto#L39
 	if abort.GetHttpStatus() >= 600 || abort.GetHttpStatus() < 200 {
 		return nil, errors.Errorf("invalid abort status code '%v', must be in range of [200,600)", abort.GetHttpStatus())
 	}
-	percentage := common.ToEnvoyPercentage(abort.GetPercentage())
 	errorType := &envoyhttpfault.FaultAbort_HttpStatus{
 		HttpStatus: uint32(abort.GetHttpStatus()),
 	}
@@ -121,6 +180,15 @@ func toEnvoyDelay(delay *fault.RouteDelay) (*envoyfault.FaultDelay, error) {
 	if delay == nil {
 		return nil, nil
 	}
+	if delay.GetHeaderDelay() {
+		if delay.GetFixedDelay() != nil {
+			return nil, errors.Errorf("only one of fixed_delay or header_delay can be set on a delay")
+		}
+		return &envoyfault.FaultDelay{
+			Percentage:         headerControlledEnvoyPercentage(delay.GetPercentage()),
+			FaultDelaySecifier: &envoyfault.FaultDelay_HeaderDelay_{HeaderDelay: &envoyfault.FaultDelay_HeaderDelay{}},
+		}, nil
+	}
 	// Validation really should catch this at proto level but sometimes things can sneak by
 	// https://github.com/envoyproxy/envoy/blob/bc8f0cd19f991a56269f1ea30b5b8d8d331da0dc/api/envoy/extensions/filters/common/fault/v3/fault.proto#L53
 	if delay.GetFixedDelay().GetSeconds() <= 0 {
@@ -135,3 +203,38 @@ func toEnvoyDelay(delay *fault.RouteDelay) (*envoyfault.FaultDelay, error) {
 		FaultDelaySecifier: delaySpec,
 	}, nil
 }
+
+// toEnvoyRateLimit converts the response rate limit config from the gloo api to the envoy api.
+// Will error if there is config present but it is invalid.
+func toEnvoyRateLimit(rateLimit *fault.ResponseRateLimit) (*envoyfault.FaultRateLimit, error) {
+	if rateLimit == nil {
+		return nil, nil
+	}
+	if rateLimit.GetHeaderLimit() {
+		if rateLimit.GetFixedLimitKbps() != 0 {
+			return nil, errors.Errorf("only one of fixed_limit_kbps or header_limit can be set on a response rate limit")
+		}
+		return &envoyfault.FaultRateLimit{
+			Percentage: headerControlledEnvoyPercentage(rateLimit.GetPercentage()),
+			LimitType:  &envoyfault.FaultRateLimit_HeaderLimit_{HeaderLimit: &envoyfault.FaultRateLimit_HeaderLimit{}},
+		}, nil
+	}
+	if rateLimit.GetFixedLimitKbps() == 0 {
+		return nil, errors.Errorf("invalid response rate limit '0', must be greater than 0")
+	}
+	return &envoyfault.FaultRateLimit{
+		Percentage: common.ToEnvoyPercentage(rateLimit.GetPercentage()),
+		LimitType: &envoyfault.FaultRateLimit_FixedLimit_{
+			FixedLimit: &envoyfault.FaultRateLimit_FixedLimit{LimitKbps: rateLimit.GetFixedLimitKbps()},
+		},
+	}, nil
+}
+
+// headerControlledEnvoyPercentage converts the percentage of a header-controlled fault, which defaults to 100.
+// The percentage in the fault headers of a request cannot exceed it.
+func headerControlledEnvoyPercentage(percentage float32) *envoytype.FractionalPercent {
+	if percentage == 0 {
+		percentage = headerControlledPercentage
+	}
+	return common.ToEnvoyPercentage(percentage)
+}
@@ -1,15 +1,21 @@
 package faultinjection
 
 import (
+	"context"
 	"reflect"
 	"strings"
 	"testing"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoyhttpfault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
 	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
@@ -97,3 +103,143 @@ func TestProcessRoute(t *testing.T) {
 	}
 
 }
+
+func TestProcessRouteInvalidFaults(t *testing.T) {
+	p := NewPlugin()
+	p.Init(plugins.InitParams{})
+
+	tests := []struct {
+		description string
+		fault       *faultinjection.RouteFaults
+		errContains string
+	}{
+		{"http and grpc abort", &faultinjection.RouteFaults{Abort: &faultinjection.RouteAbort{HttpStatus: 503, GrpcStatus: &wrappers.UInt32Value{Value: 14}}}, "only one of http_status, grpc_status or header_abort"},
+		{"http and header abort", &faultinjection.RouteFaults{Abort: &faultinjection.RouteAbort{HttpStatus: 503, HeaderAbort: true}}, "only one of http_status, grpc_status or header_abort"},
+		{"fixed and header delay", &faultinjection.RouteFaults{Delay: &faultinjection.RouteDelay{FixedDelay: &duration.Duration{Seconds: 1}, HeaderDelay: true}}, "only one of fixed_delay or header_delay"},
+		{"empty rate limit", &faultinjection.RouteFaults{ResponseRateLimit: &faultinjection.ResponseRateLimit{}}, "invalid response rate limit '0'"},
+		{"fixed and header rate limit", &faultinjection.RouteFaults{ResponseRateLimit: &faultinjection.ResponseRateLimit{FixedLimitKbps: 1, HeaderLimit: true}}, "only one of fixed_limit_kbps or header_limit"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.description, func(t *testing.T) {
+			err := p.ProcessRoute(plugins.RouteParams{}, &v1.Route{
+				Options: &v1.RouteOptions{
+					Faults: tc.fault,
+				},
+				Action: &v1.Route_RouteAction{
+					RouteAction: &v1.RouteAction{
+						Destination: &v1.RouteAction_Single{
+							Single: &v1.Destination{},
+						},
+					},
+				},
+			}, &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{},
+				},
+			})
+			if err == nil {
+				t.Fatalf("Test:%v, Expected error but got none", tc.description)
+			}
+			if !strings.Contains(err.Error(), tc.errContains) {
+				t.Fatalf("Test:%v, Expected error to contain %v but got %v.", tc.description, tc.errContains, err)
+			}
+		})
+	}
+}
+
+func TestToEnvoyFault(t *testing.T) {
+	ctx := context.Background()
+
+	envoyFault, err := toEnvoyFault(ctx, &faultinjection.RouteFaults{
+		Abort:             &faultinjection.RouteAbort{Percentage: 50, GrpcStatus: &wrappers.UInt32Value{Value: 14}},
+		Delay:             &faultinjection.RouteDelay{HeaderDelay: true},
+		ResponseRateLimit: &faultinjection.ResponseRateLimit{Percentage: 10, FixedLimitKbps: 64},
+		Headers:           []*matchers.HeaderMatcher{{Name: "x-chaos", Value: "true"}},
+		DownstreamNodes:   []string{"frontend"},
+		MaxActiveFaults:   &wrappers.UInt32Value{Value: 5},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got %v.", err)
+	}
+	expected := &envoyhttpfault.HTTPFault{
+		Abort: &envoyhttpfault.FaultAbort{
+			Percentage: common.ToEnvoyPercentage(50),
+			ErrorType:  &envoyhttpfault.FaultAbort_GrpcStatus{GrpcStatus: 14},
+		},
+		Delay: &envoyfault.FaultDelay{
+			Percentage:         common.ToEnvoyPercentage(100),
+			FaultDelaySecifier: &envoyfault.FaultDelay_HeaderDelay_{HeaderDelay: &envoyfault.FaultDelay_HeaderDelay{}},
+		},
+		ResponseRateLimit: &envoyfault.FaultRateLimit{
+			Percentage: common.ToEnvoyPercentage(10),
+			LimitType:  &envoyfault.FaultRateLimit_FixedLimit_{FixedLimit: &envoyfault.FaultRateLimit_FixedLimit{LimitKbps: 64}},
+		},
+		Headers: []*envoy_config_route_v3.HeaderMatcher{{
+			Name:                 "x-chaos",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+		}},
+		DownstreamNodes: []string{"frontend"},
+		MaxActiveFaults: &wrappers.UInt32Value{Value: 5},
+	}
+	if !proto.Equal(expected, envoyFault) {
+		t.Errorf("Expected %v but got %v.", expected, envoyFault)
+	}
+
+	envoyFault, err = toEnvoyFault(ctx, &faultinjection.RouteFaults{
+		Abort:           &faultinjection.RouteAbort{HeaderAbort: true},
+		DownstreamNodes: []string{"frontend"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got %v.", err)
+	}
+	expectedAbort := &envoyhttpfault.FaultAbort{
+		Percentage: common.ToEnvoyPercentage(100),
+		ErrorType:  &envoyhttpfault.FaultAbort_HeaderAbort_{HeaderAbort: &envoyhttpfault.FaultAbort_HeaderAbort{}},
+	}
+	if !proto.Equal(expectedAbort, envoyFault.GetAbort()) {
+		t.Errorf("Expected %v but got %v.", expectedAbort, envoyFault.GetAbort())
+	}
+
+	// targeting options without a fault do not configure the filter
+	envoyFault, err = toEnvoyFault(ctx, &faultinjection.RouteFaults{DownstreamNodes: []string{"frontend"}})
+	if err != nil || envoyFault != nil {
+		t.Errorf("Expected no fault and no error but got %v and %v.", envoyFault, err)
+	}
+}
+
+func TestProcessVirtualHost(t *testing.T) {
+	p := NewPlugin()
+	p.Init(plugins.InitParams{})
+
+	listener := &v1.HttpListener{}
+	out := &envoy_config_route_v3.VirtualHost{}
+	err := p.ProcessVirtualHost(plugins.VirtualHostParams{
+		Params:       plugins.Params{Ctx: context.Background()},
+		HttpListener: listener,
+	}, &v1.VirtualHost{
+		Options: &v1.VirtualHostOptions{
+			Faults: &faultinjection.RouteFaults{
+				Abort: &faultinjection.RouteAbort{Percentage: 100, HttpStatus: 503},
+			},
+		},
+	}, out)
+	if err != nil {
+		t.Fatalf("Expected no error but got %v.", err)
+	}
+
+	config := out.GetTypedPerFilterConfig()[wellknown.Fault]
+	if config == nil {
+		t.Fatalf("Expected the fault filter to be configured on the virtual host")
+	}
+	var envoyFault envoyhttpfault.HTTPFault
+	if err := config.UnmarshalTo(&envoyFault); err != nil {
+		t.Fatalf("Expected no error but got %v.", err)
+	}
+	if envoyFault.GetAbort().GetHttpStatus() != 503 {
+		t.Errorf("Expected abort status 503 but got %v.", envoyFault.GetAbort().GetHttpStatus())
+	}
+	if _, ok := p.filterRequiredForListener[listener]; !ok {
+		t.Errorf("Expected the fault filter to be required on the listener")
+	}
+}
@@ -1,7 +1,6 @@
 package grpcjson
 
 import (
-	"context"
 	"encoding/base64"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
@@ -12,7 +11,6 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
-	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
 	glooplugins "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
@@ -178,8 +176,8 @@ func translateGlooToEnvoyGrpcJson(params plugins.Params, grpcJsonConf *grpc_json
 
 	// Convert from our descriptor storages to the appropriate type
 	switch typedDescriptorSet := grpcJsonConf.GetDescriptorSet().(type) {
-	case *grpc_json.GrpcJsonTranscoder_ProtoDescriptorConfigMap:
-		protoDesc, err := translateConfigMapToProtoBin(params.Ctx, params.Snapshot, typedDescriptorSet.ProtoDescriptorConfigMap)
+	case *grpc_json.GrpcJsonTranscoder_ProtoDescriptorConfigMap, *grpc_json.GrpcJsonTranscoder_ProtoSourcesConfigMap:
+		protoDesc, err := DescriptorSetFromArtifacts(params.Snapshot.Artifacts, grpcJsonConf)
 		if err != nil {
 			return nil, err
 		}
@@ -206,13 +204,13 @@ func translateGlooToEnvoyPrintOptions(options *grpc_json.GrpcJsonTranscoder_Prin
 }
 
 // get the proto descriptor data from a ConfigMap
-func translateConfigMapToProtoBin(ctx context.Context, snap *gloosnapshot.ApiSnapshot, configRef *grpc_json.GrpcJsonTranscoder_DescriptorConfigMap) ([]byte, error) {
+func configMapToProtoBin(artifacts v1.ArtifactList, configRef *grpc_json.GrpcJsonTranscoder_DescriptorConfigMap) ([]byte, error) {
 	if configRef.GetConfigMapRef() == nil {
 		return nil, NoConfigMapRefError()
 	}
 
 	// make sure the referenced configmap exists in the gloo snapshot
-	configMap, err := snap.Artifacts.Find(configRef.GetConfigMapRef().Strings())
+	configMap, err := artifacts.Find(configRef.GetConfigMapRef().Strings())
 	if err != nil {
 		return nil, ConfigMapNotFoundError(configRef)
 	}
@@ -0,0 +1,196 @@
+package grpcjson
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"k8s.io/utils/lru"
+)
+
+const (
+	protoFileSuffix         = ".proto"
+	wellKnownTypesDirectory = "google/protobuf/"
+	// the number of compiled descriptor sets which are cached, the least recently used are evicted first
+	maxCompiledProtoSources = 100
+)
+
+var (
+	ProtoSourcesConfigMapNotFoundError = func(configRef *grpc_json.GrpcJsonTranscoder_ProtoSources) error {
+		return eris.Errorf("configmap %s:%s cannot be found", configRef.GetConfigMapRef().GetNamespace(), configRef.GetConfigMapRef().GetName())
+	}
+	NoProtoSourcesError = func(configRef *grpc_json.GrpcJsonTranscoder_ProtoSources) error {
+		return eris.Errorf("configmap %s:%s does not contain any %s file", configRef.GetConfigMapRef().GetNamespace(), configRef.GetConfigMapRef().GetName(), protoFileSuffix)
+	}
+	CompilingProtoSourcesError = func(configRef *grpc_json.GrpcJsonTranscoder_ProtoSources, err error) error {
+		return eris.Wrapf(err, "compiling the proto sources of configmap %s:%s", configRef.GetConfigMapRef().GetNamespace(), configRef.GetConfigMapRef().GetName())
+	}
+
+	// (sha256 of the sources) -> compiled descriptor set
+	compiledProtoSources = lru.New(maxCompiledProtoSources)
+)
+
+// DescriptorSetFromArtifacts returns the binary proto descriptor set of a transcoder which stores its descriptor set
+// in a ConfigMap, either as a descriptor set or as proto sources. It returns nil if the transcoder does not use a ConfigMap.
+func DescriptorSetFromArtifacts(artifacts v1.ArtifactList, transcoder *grpc_json.GrpcJsonTranscoder) ([]byte, error) {
+	switch typedDescriptorSet := transcoder.GetDescriptorSet().(type) {
+	case *grpc_json.GrpcJsonTranscoder_ProtoDescriptorConfigMap:
+		return configMapToProtoBin(artifacts, typedDescriptorSet.ProtoDescriptorConfigMap)
+	case *grpc_json.GrpcJsonTranscoder_ProtoSourcesConfigMap:
+		return protoSourcesToProtoBin(artifacts, typedDescriptorSet.ProtoSourcesConfigMap)
+	}
+	return nil, nil
+}
+
+// get the proto descriptor data by compiling the proto sources of a ConfigMap
+func protoSourcesToProtoBin(artifacts v1.ArtifactList, configRef *grpc_json.GrpcJsonTranscoder_ProtoSources) ([]byte, error) {
+	if configRef.GetConfigMapRef() == nil {
+		return nil, NoConfigMapRefError()
+	}
+	configMap, err := artifacts.Find(configRef.GetConfigMapRef().Strings())
+	if err != nil {
+		return nil, ProtoSourcesConfigMapNotFoundError(configRef)
+	}
+
+	sources := map[string]string{}
+	for name, source := range configMap.GetData() {
+		if strings.HasSuffix(name, protoFileSuffix) {
+			sources[name] = source
+		}
+	}
+	if len(sources) == 0 {
+		return nil, NoProtoSourcesError(configRef)
+	}
+
+	descriptorSet, err := CompileProtoSources(sources)
+	if err != nil {
+		return nil, CompilingProtoSourcesError(configRef, err)
+	}
+	return descriptorSet, nil
+}
+
+// CompileProtoSources compiles the .proto sources, keyed by file name, to a binary proto descriptor set.
+// Imports are resolved by their path, or else by their file name, and the well-known types are always available.
+// The compiled descriptor sets are cached, as the sources are compiled on every translation.
+func CompileProtoSources(sources map[string]string) ([]byte, error) {
+	filenames := sortedFilenames(sources)
+	hasher := sha256.New()
+	for _, filename := range filenames {
+		// the lengths delimit the names and the sources
+		fmt.Fprintf(hasher, "%d:%s%d:%s", len(filename), filename, len(sources[filename]), sources[filename])
+	}
+	var hash [sha256.Size]byte
+	copy(hash[:], hasher.Sum(nil))
+
+	if descriptorSet, ok := compiledProtoSources.Get(hash); ok {
+		return descriptorSet.([]byte), nil
+	}
+
+	descriptorSet, err := compileProtoSources(sources, filenames)
+	if err != nil {
+		return nil, err
+	}
+
+	compiledProtoSources.Add(hash, descriptorSet)
+	return descriptorSet, nil
+}
+
+func compileProtoSources(sources map[string]string, filenames []string) ([]byte, error) {
+	parser := protoparse.Parser{
+		Accessor: func(filename string) (io.ReadCloser, error) {
+			source, ok := sources[filename]
+			// ConfigMap keys cannot contain directories, so imports are resolved by file name,
+			// except for the well-known types which the parser provides
+			if !ok && !strings.HasPrefix(filename, wellKnownTypesDirectory) {
+				source, ok = sources[path.Base(filename)]
+			}
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			return io.NopCloser(strings.NewReader(source)), nil
+		},
+	}
+
+	// the files imported by another path would be compiled twice, so only the files which are not imported
+	// by another file are compiled, along with their imports
+	unlinked, err := parser.ParseFilesButDoNotLink(filenames...)
+	if err != nil {
+		return nil, err
+	}
+	imported := map[string]bool{}
+	for _, file := range unlinked {
+		for _, dependency := range file.GetDependency() {
+			if !strings.HasPrefix(dependency, wellKnownTypesDirectory) {
+				imported[path.Base(dependency)] = true
+			}
+		}
+	}
+	var roots []string
+	for _, filename := range filenames {
+		if !imported[filename] {
+			roots = append(roots, filename)
+		}
+	}
+
+	files, err := parser.ParseFiles(roots...)
+	if err != nil {
+		return nil, err
+	}
+	descriptorSet := &descriptorpb.FileDescriptorSet{}
+	added := map[string]bool{}
+	for _, file := range files {
+		descriptorSet.File = appendFileWithDependencies(descriptorSet.GetFile(), file, added)
+	}
+	return proto.Marshal(descriptorSet)
+}
+
+func sortedFilenames(sources map[string]string) []string {
+	var filenames []string
+	for filename := range sources {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	return filenames
+}
+
+// appendFileWithDependencies appends the file after its dependencies, which are only added once
+func appendFileWithDependencies(files []*descriptorpb.FileDescriptorProto, file *desc.FileDescriptor, added map[string]bool) []*descriptorpb.FileDescriptorProto {
+	if added[file.GetName()] {
+		return files
+	}
+	added[file.GetName()] = true
+	for _, dependency := range file.GetDependencies() {
+		files = appendFileWithDependencies(files, dependency, added)
+	}
+	return append(files, file.AsFileDescriptorProto())
+}
+
+// ServicesFromDescriptorSet returns the fully qualified names of the services of a binary proto descriptor set.
+func ServicesFromDescriptorSet(descriptorSet []byte) ([]string, error) {
+	files := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorSet, files); err != nil {
+		return nil, eris.Wrapf(err, "unmarshalling the proto descriptor set")
+	}
+	var services []string
+	for _, file := range files.GetFile() {
+		for _, service := range file.GetService() {
+			if file.GetPackage() == "" {
+				services = append(services, service.GetName())
+				continue
+			}
+			services = append(services, file.GetPackage()+"."+service.GetName())
+		}
+	}
+	return services, nil
+}
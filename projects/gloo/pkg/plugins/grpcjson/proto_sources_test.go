@@ -0,0 +1,176 @@
+package grpcjson_test
+
+import (
+	"fmt"
+
+	envoy_extensions_filters_http_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/grpc_json"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	bookstoreProto = `syntax = "proto3";
+package main;
+
+import "google/protobuf/empty.proto";
+import "bookstore/v1/types.proto";
+
+service Bookstore {
+  rpc ListShelves(google.protobuf.Empty) returns (main.types.ListShelvesResponse);
+}
+`
+	typesProto = `syntax = "proto3";
+package main.types;
+
+message ListShelvesResponse {
+  repeated string shelves = 1;
+}
+`
+)
+
+var _ = Describe("Proto sources", func() {
+
+	var (
+		sources map[string]string
+	)
+
+	BeforeEach(func() {
+		sources = map[string]string{
+			"bookstore.proto": bookstoreProto,
+			"types.proto":     typesProto,
+		}
+	})
+
+	It("compiles the sources with their imports", func() {
+		descriptorSet, err := grpcjson.CompileProtoSources(sources)
+		Expect(err).NotTo(HaveOccurred())
+
+		files := &descriptorpb.FileDescriptorSet{}
+		Expect(proto.Unmarshal(descriptorSet, files)).To(Succeed())
+		var names []string
+		for _, file := range files.GetFile() {
+			names = append(names, file.GetName())
+		}
+		// the imported files are only added once, before the files which import them
+		Expect(names).To(Equal([]string{"google/protobuf/empty.proto", "bookstore/v1/types.proto", "bookstore.proto"}))
+
+		services, err := grpcjson.ServicesFromDescriptorSet(descriptorSet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(ConsistOf("main.Bookstore"))
+	})
+
+	It("returns an error if an import is missing", func() {
+		delete(sources, "types.proto")
+
+		_, err := grpcjson.CompileProtoSources(sources)
+		Expect(err).To(MatchError(ContainSubstring("bookstore/v1/types.proto")))
+	})
+
+	It("keeps the recently used descriptor sets cached when other sources are compiled", func() {
+		descriptorSet, err := grpcjson.CompileProtoSources(sources)
+		Expect(err).NotTo(HaveOccurred())
+
+		// compile more sources than the cache holds, while the bookstore sources stay in use
+		for i := 0; i < 200; i++ {
+			_, err := grpcjson.CompileProtoSources(map[string]string{
+				"other.proto": fmt.Sprintf("syntax = \"proto3\";\npackage other%d;\n", i),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			cached, err := grpcjson.CompileProtoSources(sources)
+			Expect(err).NotTo(HaveOccurred())
+			// a cached descriptor set is returned as is, rather than compiled again
+			Expect(&cached[0]).To(BeIdenticalTo(&descriptorSet[0]))
+		}
+	})
+
+	Context("proto sources configmap", func() {
+
+		var (
+			snap *gloosnapshot.ApiSnapshot
+			hl   *v1.HttpListener
+		)
+
+		BeforeEach(func() {
+			snap = &gloosnapshot.ApiSnapshot{
+				Artifacts: v1.ArtifactList{
+					&v1.Artifact{
+						Metadata: &core.Metadata{
+							Name:      "my-config-map",
+							Namespace: "gloo-system",
+						},
+						Data: map[string]string{
+							"bookstore.proto": bookstoreProto,
+							"types.proto":     typesProto,
+							"README":          "not a proto file",
+						},
+					},
+				},
+			}
+			hl = &v1.HttpListener{
+				Options: &v1.HttpListenerOptions{
+					GrpcJsonTranscoder: &grpc_json.GrpcJsonTranscoder{
+						DescriptorSet: &grpc_json.GrpcJsonTranscoder_ProtoSourcesConfigMap{
+							ProtoSourcesConfigMap: &grpc_json.GrpcJsonTranscoder_ProtoSources{
+								ConfigMapRef: &core.ResourceRef{Name: "my-config-map", Namespace: "gloo-system"},
+							},
+						},
+						Services: []string{"main.Bookstore"},
+					},
+				},
+			}
+		})
+
+		httpFilters := func() ([]plugins.StagedHttpFilter, error) {
+			p := grpcjson.NewPlugin()
+			p.Init(plugins.InitParams{})
+			return p.HttpFilters(plugins.Params{
+				Snapshot: snap,
+			}, hl)
+		}
+
+		It("should use the compiled proto descriptor", func() {
+			descriptorSet, err := grpcjson.CompileProtoSources(sources)
+			Expect(err).NotTo(HaveOccurred())
+
+			f, err := httpFilters()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f).To(HaveLen(1))
+			msg, err := utils.AnyToMessage(f[0].HttpFilter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			envoyGrpcJsonConf := msg.(*envoy_extensions_filters_http_grpc_json_transcoder_v3.GrpcJsonTranscoder)
+			Expect(envoyGrpcJsonConf.GetProtoDescriptorBin()).To(Equal(descriptorSet))
+			Expect(envoyGrpcJsonConf.GetServices()).To(Equal([]string{"main.Bookstore"}))
+		})
+
+		It("should return error if specified configmap does not exist", func() {
+			hl.GetOptions().GetGrpcJsonTranscoder().GetProtoSourcesConfigMap().ConfigMapRef = &core.ResourceRef{Name: "does-not-exist", Namespace: "gloo-system"}
+
+			_, err := httpFilters()
+			Expect(err).To(MatchError(grpcjson.ProtoSourcesConfigMapNotFoundError(hl.GetOptions().GetGrpcJsonTranscoder().GetProtoSourcesConfigMap()).Error()))
+		})
+
+		It("should return error if configmap has no proto file", func() {
+			snap.Artifacts[0].Data = map[string]string{"README": "not a proto file"}
+
+			_, err := httpFilters()
+			Expect(err).To(MatchError(grpcjson.NoProtoSourcesError(hl.GetOptions().GetGrpcJsonTranscoder().GetProtoSourcesConfigMap()).Error()))
+		})
+
+		It("should return error if the sources do not compile", func() {
+			snap.Artifacts[0].Data["types.proto"] = "not a proto file"
+
+			_, err := httpFilters()
+			Expect(err).To(MatchError(ContainSubstring("compiling the proto sources of configmap gloo-system:my-config-map")))
+		})
+	})
+})
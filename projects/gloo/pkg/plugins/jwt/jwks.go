@@ -0,0 +1,89 @@
+package jwt
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"gopkg.in/square/go-jose.v2"
+)
+
+var (
+	InvalidLocalJwksError = eris.New("the local jwks must be a json web key, a json web key set or PEM encoded public keys")
+)
+
+// localJwks converts an inline key to the json web key set expected by the jwt_authn filter.
+// The key can be a json web key, a json web key set, or PEM encoded public keys or certificates.
+func localJwks(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "{") {
+		return jsonJwks(key)
+	}
+	return pemJwks(key)
+}
+
+func jsonJwks(key string) (string, error) {
+	var keySet jose.JSONWebKeySet
+	if err := json.Unmarshal([]byte(key), &keySet); err == nil && len(keySet.Keys) > 0 {
+		return key, nil
+	}
+	var webKey jose.JSONWebKey
+	if err := json.Unmarshal([]byte(key), &webKey); err != nil {
+		return "", eris.Wrap(InvalidLocalJwksError, err.Error())
+	}
+	return marshalJwks([]jose.JSONWebKey{webKey})
+}
+
+func pemJwks(key string) (string, error) {
+	var webKeys []jose.JSONWebKey
+	rest := []byte(key)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		var publicKey interface{}
+		var err error
+		switch block.Type {
+		case "CERTIFICATE":
+			var cert *x509.Certificate
+			if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+				publicKey = cert.PublicKey
+			}
+		case "RSA PUBLIC KEY":
+			publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		default:
+			publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+		}
+		if err != nil {
+			return "", eris.Wrap(InvalidLocalJwksError, err.Error())
+		}
+		webKeys = append(webKeys, jose.JSONWebKey{Key: publicKey, Use: "sig"})
+	}
+	if len(webKeys) == 0 {
+		return "", InvalidLocalJwksError
+	}
+	return marshalJwks(webKeys)
+}
+
+func marshalJwks(webKeys []jose.JSONWebKey) (string, error) {
+	keySet, err := json.Marshal(jose.JSONWebKeySet{Keys: webKeys})
+	if err != nil {
+		return "", eris.Wrap(InvalidLocalJwksError, err.Error())
+	}
+	return string(keySet), nil
+}
+
+func sortedProviderNames(providers map[string]*jwt.Provider) []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,330 @@
+package jwt
+
+import (
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "jwt"
+	// FilterName is the name of the jwt_authn filter that runs before the ext_authz filter
+	FilterName = "envoy.filters.http.jwt_authn"
+	// AfterExtAuthFilterName is the name of the jwt_authn filter that runs after the ext_authz filter
+	AfterExtAuthFilterName = "envoy.filters.http.jwt_authn.after_ext_auth"
+)
+
+var (
+	BeforeExtAuthFilterStage = plugins.BeforeStage(plugins.AuthNStage)
+	AfterExtAuthFilterStage  = plugins.AfterStage(plugins.AuthNStage)
+
+	// the timeout of the requests to remote JWKS servers
+	remoteJwksTimeout = &duration.Duration{Seconds: 5}
+)
+
+var (
+	NoJwksError = func(provider string) error {
+		return eris.Errorf("jwt provider %s must specify a local or a remote jwks", provider)
+	}
+	JwksUpstreamNotFoundError = func(provider string, ref *core.ResourceRef) error {
+		return eris.Errorf("jwt provider %s references upstream %s for its jwks, which does not exist",
+			provider, ref.Key())
+	}
+	ClaimAppendNotSupportedError = func(provider, claim string) error {
+		return eris.Errorf("jwt provider %s copies claim %s to a header with append, which is not supported "+
+			"by the open source jwt filter", provider, claim)
+	}
+)
+
+// jwtStage is one of the jwt_authn filters, which run either before or after the ext_authz filter
+type jwtStage struct {
+	filterName  string
+	filterStage plugins.FilterStage
+	vhostConfig func(options *v1.VirtualHostOptions) *jwt.VhostExtension
+	routeConfig func(options *v1.RouteOptions) *jwt.RouteExtension
+}
+
+var jwtStages = []jwtStage{
+	{
+		filterName:  FilterName,
+		filterStage: BeforeExtAuthFilterStage,
+		vhostConfig: func(options *v1.VirtualHostOptions) *jwt.VhostExtension {
+			return options.GetJwtStaged().GetBeforeExtAuth()
+		},
+		routeConfig: func(options *v1.RouteOptions) *jwt.RouteExtension {
+			return options.GetJwtStaged().GetBeforeExtAuth()
+		},
+	},
+	{
+		filterName:  AfterExtAuthFilterName,
+		filterStage: AfterExtAuthFilterStage,
+		// the deprecated jwt field is equivalent to the after_ext_auth stage
+		vhostConfig: func(options *v1.VirtualHostOptions) *jwt.VhostExtension {
+			if vhostExtension := options.GetJwt(); vhostExtension != nil {
+				return vhostExtension
+			}
+			return options.GetJwtStaged().GetAfterExtAuth()
+		},
+		routeConfig: func(options *v1.RouteOptions) *jwt.RouteExtension {
+			if routeExtension := options.GetJwt(); routeExtension != nil {
+				return routeExtension
+			}
+			return options.GetJwtStaged().GetAfterExtAuth()
+		},
+	},
+}
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+// HttpFilters adds a jwt_authn filter for each stage that is used by a virtual host of the listener.
+// The filter holds the providers of all of these virtual hosts, and a requirement for each virtual host.
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var filters []plugins.StagedHttpFilter
+	for _, stage := range jwtStages {
+		config := &envoyjwt.JwtAuthentication{
+			Providers:      map[string]*envoyjwt.JwtProvider{},
+			RequirementMap: map[string]*envoyjwt.JwtRequirement{},
+		}
+		for _, virtualHost := range listener.GetVirtualHosts() {
+			vhostExtension := stage.vhostConfig(virtualHost.GetOptions())
+			if len(vhostExtension.GetProviders()) == 0 {
+				continue
+			}
+			providers, requirement, err := translateVhostExtension(params, virtualHost.GetName(), vhostExtension)
+			if err != nil {
+				// the error is reported on the virtual host
+				continue
+			}
+			for name, provider := range providers {
+				config.GetProviders()[name] = provider
+			}
+			config.GetRequirementMap()[requirementName(virtualHost)] = requirement
+		}
+		if len(config.GetRequirementMap()) == 0 {
+			continue
+		}
+
+		filter, err := plugins.NewStagedFilter(stage.filterName, config, stage.filterStage)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// ProcessVirtualHost requires the jwt requirement of the virtual host on its routes
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	for _, stage := range jwtStages {
+		vhostExtension := stage.vhostConfig(in.GetOptions())
+		if len(vhostExtension.GetProviders()) == 0 {
+			continue
+		}
+		if _, _, err := translateVhostExtension(params.Params, in.GetName(), vhostExtension); err != nil {
+			return err
+		}
+		perRouteConfig := &envoyjwt.PerRouteConfig{
+			RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{
+				RequirementName: requirementName(in),
+			},
+		}
+		if err := pluginutils.SetVhostPerFilterConfig(out, stage.filterName, perRouteConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessRoute disables the jwt filters on routes that opt out of the requirement of their virtual host
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	for _, stage := range jwtStages {
+		if !stage.routeConfig(in.GetOptions()).GetDisable() || !isStageUsedOnListener(stage, params.HttpListener) {
+			continue
+		}
+		perRouteConfig := &envoyjwt.PerRouteConfig{
+			RequirementSpecifier: &envoyjwt.PerRouteConfig_Disabled{
+				Disabled: true,
+			},
+		}
+		if err := pluginutils.SetRoutePerFilterConfig(out, stage.filterName, perRouteConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isStageUsedOnListener(stage jwtStage, listener *v1.HttpListener) bool {
+	for _, virtualHost := range listener.GetVirtualHosts() {
+		if len(stage.vhostConfig(virtualHost.GetOptions()).GetProviders()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// the requirement of a virtual host is named after the virtual host, which is unique within a listener
+func requirementName(virtualHost *v1.VirtualHost) string {
+	return virtualHost.GetName()
+}
+
+// the providers of all virtual hosts share the configuration of the filter, so their names are prefixed
+// with the name of the virtual host
+func providerName(virtualHostName, provider string) string {
+	return virtualHostName + "_" + provider
+}
+
+// translateVhostExtension returns the providers of a virtual host, by name, and the requirement that
+// a request is verified by any of them
+func translateVhostExtension(
+	params plugins.Params,
+	virtualHostName string,
+	vhostExtension *jwt.VhostExtension,
+) (map[string]*envoyjwt.JwtProvider, *envoyjwt.JwtRequirement, error) {
+	providers := map[string]*envoyjwt.JwtProvider{}
+	var requirements []*envoyjwt.JwtRequirement
+	// sort the providers so that the requirement is stable
+	for _, name := range sortedProviderNames(vhostExtension.GetProviders()) {
+		provider, err := translateProvider(params, name, vhostExtension.GetProviders()[name])
+		if err != nil {
+			return nil, nil, err
+		}
+		envoyName := providerName(virtualHostName, name)
+		providers[envoyName] = provider
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_ProviderName{
+				ProviderName: envoyName,
+			},
+		})
+	}
+
+	if vhostExtension.GetAllowMissingOrFailedJwt() {
+		requirements = append(requirements, &envoyjwt.JwtRequirement{
+			RequiresType: &envoyjwt.JwtRequirement_AllowMissingOrFailed{},
+		})
+	}
+	if len(requirements) == 1 {
+		return providers, requirements[0], nil
+	}
+	return providers, &envoyjwt.JwtRequirement{
+		RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+			RequiresAny: &envoyjwt.JwtRequirementOrList{
+				Requirements: requirements,
+			},
+		},
+	}, nil
+}
+
+func translateProvider(params plugins.Params, name string, provider *jwt.Provider) (*envoyjwt.JwtProvider, error) {
+	out := &envoyjwt.JwtProvider{
+		Issuer:    provider.GetIssuer(),
+		Audiences: provider.GetAudiences(),
+		Forward:   provider.GetKeepToken(),
+		// the payload of verified tokens is available to other filters, such as rbac, under the name of the provider
+		PayloadInMetadata: name,
+		ClockSkewSeconds:  provider.GetClockSkewSeconds().GetValue(),
+	}
+	if provider.GetClockSkewSeconds() == nil {
+		out.ClockSkewSeconds = 60
+	}
+
+	switch jwks := provider.GetJwks().GetJwks().(type) {
+	case *jwt.Jwks_Local:
+		key, err := localJwks(jwks.Local.GetKey())
+		if err != nil {
+			return nil, eris.Wrapf(err, "jwt provider %s", name)
+		}
+		out.JwksSourceSpecifier = &envoyjwt.JwtProvider_LocalJwks{
+			LocalJwks: &envoy_config_core_v3.DataSource{
+				Specifier: &envoy_config_core_v3.DataSource_InlineString{
+					InlineString: key,
+				},
+			},
+		}
+	case *jwt.Jwks_Remote:
+		upstreamRef := jwks.Remote.GetUpstreamRef()
+		if _, err := params.Snapshot.Upstreams.Find(upstreamRef.GetNamespace(), upstreamRef.GetName()); err != nil {
+			return nil, JwksUpstreamNotFoundError(name, upstreamRef)
+		}
+		remoteJwks := &envoyjwt.RemoteJwks{
+			HttpUri: &envoy_config_core_v3.HttpUri{
+				Uri: jwks.Remote.GetUrl(),
+				HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+					Cluster: translator.UpstreamToClusterName(upstreamRef),
+				},
+				Timeout: remoteJwksTimeout,
+			},
+			CacheDuration: jwks.Remote.GetCacheDuration(),
+		}
+		if asyncFetch := jwks.Remote.GetAsyncFetch(); asyncFetch != nil {
+			remoteJwks.AsyncFetch = &envoyjwt.JwksAsyncFetch{
+				FastListener: asyncFetch.GetFastListener(),
+			}
+		}
+		out.JwksSourceSpecifier = &envoyjwt.JwtProvider_RemoteJwks{
+			RemoteJwks: remoteJwks,
+		}
+	default:
+		return nil, NoJwksError(name)
+	}
+
+	for _, header := range provider.GetTokenSource().GetHeaders() {
+		out.FromHeaders = append(out.GetFromHeaders(), &envoyjwt.JwtHeader{
+			Name:        header.GetHeader(),
+			ValuePrefix: header.GetPrefix(),
+		})
+	}
+	out.FromParams = provider.GetTokenSource().GetQueryParams()
+
+	for _, claimToHeader := range provider.GetClaimsToHeaders() {
+		if claimToHeader.GetAppend() {
+			return nil, ClaimAppendNotSupportedError(name, claimToHeader.GetClaim())
+		}
+		out.ClaimToHeaders = append(out.GetClaimToHeaders(), &envoyjwt.JwtClaimToHeader{
+			ClaimName:  claimToHeader.GetClaim(),
+			HeaderName: claimToHeader.GetHeader(),
+		})
+	}
+	return out, nil
+}
+
+// ProviderNames returns the sorted names of the jwt providers of a virtual host, in all stages.
+// The payload of a verified token is stored in the dynamic metadata of the jwt_authn filter under these names.
+func ProviderNames(options *v1.VirtualHostOptions) []string {
+	providers := map[string]*jwt.Provider{}
+	for _, stage := range jwtStages {
+		for name, provider := range stage.vhostConfig(options).GetProviders() {
+			providers[name] = provider
+		}
+	}
+	return sortedProviderNames(providers)
+}
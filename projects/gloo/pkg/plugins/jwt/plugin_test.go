@@ -0,0 +1,337 @@
+package jwt_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyjwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gopkg.in/square/go-jose.v2"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params      plugins.Params
+		upstreamRef *core.ResourceRef
+		pemKey      string
+	)
+
+	BeforeEach(func() {
+		upstreamRef = &core.ResourceRef{Name: "jwks-server", Namespace: "gloo-system"}
+		params = plugins.Params{
+			Ctx: context.Background(),
+			Snapshot: &gloov1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{{
+					Metadata: &core.Metadata{Name: upstreamRef.GetName(), Namespace: upstreamRef.GetNamespace()},
+				}},
+			},
+		}
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		pemKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+	})
+
+	remoteProvider := func() *jwt.Provider {
+		return &jwt.Provider{
+			Issuer:    "https://issuer.example.com",
+			Audiences: []string{"api"},
+			KeepToken: true,
+			Jwks: &jwt.Jwks{
+				Jwks: &jwt.Jwks_Remote{
+					Remote: &jwt.RemoteJwks{
+						Url:           "https://issuer.example.com/jwks",
+						UpstreamRef:   upstreamRef,
+						CacheDuration: durationpb.New(300e9),
+					},
+				},
+			},
+			TokenSource: &jwt.TokenSource{
+				Headers: []*jwt.TokenSource_HeaderSource{{
+					Header: "x-token",
+					Prefix: "Bearer ",
+				}},
+				QueryParams: []string{"token"},
+			},
+			ClaimsToHeaders: []*jwt.ClaimToHeader{{
+				Claim:  "sub",
+				Header: "x-sub",
+			}},
+		}
+	}
+
+	localProvider := func() *jwt.Provider {
+		return &jwt.Provider{
+			ClockSkewSeconds: wrapperspb.UInt32(10),
+			Jwks: &jwt.Jwks{
+				Jwks: &jwt.Jwks_Local{
+					Local: &jwt.LocalJwks{
+						Key: pemKey,
+					},
+				},
+			},
+		}
+	}
+
+	listenerWithVhost := func(vhostOptions *v1.VirtualHostOptions) *v1.HttpListener {
+		return &v1.HttpListener{
+			VirtualHosts: []*v1.VirtualHost{{
+				Name:    "vhost",
+				Options: vhostOptions,
+			}},
+		}
+	}
+
+	jwtConfig := func(filter plugins.StagedHttpFilter) *envoyjwt.JwtAuthentication {
+		msg, err := utils.AnyToMessage(filter.HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		return msg.(*envoyjwt.JwtAuthentication)
+	}
+
+	Context("HttpFilters", func() {
+
+		It("does not add filters when jwt is not configured", func() {
+			filters, err := NewPlugin().HttpFilters(params, listenerWithVhost(&v1.VirtualHostOptions{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("translates a provider with a remote jwks", func() {
+			filters, err := NewPlugin().HttpFilters(params, listenerWithVhost(&v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_Jwt{
+					Jwt: &jwt.VhostExtension{
+						Providers: map[string]*jwt.Provider{"remote": remoteProvider()},
+					},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(AfterExtAuthFilterName))
+			Expect(filters[0].Stage).To(Equal(AfterExtAuthFilterStage))
+
+			config := jwtConfig(filters[0])
+			Expect(config.GetProviders()).To(HaveKey("vhost_remote"))
+			Expect(config.GetProviders()["vhost_remote"]).To(matchers.MatchProto(&envoyjwt.JwtProvider{
+				Issuer:            "https://issuer.example.com",
+				Audiences:         []string{"api"},
+				Forward:           true,
+				PayloadInMetadata: "remote",
+				ClockSkewSeconds:  60,
+				JwksSourceSpecifier: &envoyjwt.JwtProvider_RemoteJwks{
+					RemoteJwks: &envoyjwt.RemoteJwks{
+						HttpUri: &envoy_config_core_v3.HttpUri{
+							Uri: "https://issuer.example.com/jwks",
+							HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+								Cluster: translator.UpstreamToClusterName(upstreamRef),
+							},
+							Timeout: durationpb.New(5e9),
+						},
+						CacheDuration: durationpb.New(300e9),
+					},
+				},
+				FromHeaders: []*envoyjwt.JwtHeader{{
+					Name:        "x-token",
+					ValuePrefix: "Bearer ",
+				}},
+				FromParams: []string{"token"},
+				ClaimToHeaders: []*envoyjwt.JwtClaimToHeader{{
+					ClaimName:  "sub",
+					HeaderName: "x-sub",
+				}},
+			}))
+			Expect(config.GetRequirementMap()).To(HaveKey("vhost"))
+			Expect(config.GetRequirementMap()["vhost"]).To(matchers.MatchProto(&envoyjwt.JwtRequirement{
+				RequiresType: &envoyjwt.JwtRequirement_ProviderName{
+					ProviderName: "vhost_remote",
+				},
+			}))
+		})
+
+		It("converts a local PEM key to a json web key set", func() {
+			filters, err := NewPlugin().HttpFilters(params, listenerWithVhost(&v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedVhostExtension{
+						BeforeExtAuth: &jwt.VhostExtension{
+							Providers: map[string]*jwt.Provider{"local": localProvider()},
+						},
+					},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+			Expect(filters[0].Stage).To(Equal(BeforeExtAuthFilterStage))
+
+			provider := jwtConfig(filters[0]).GetProviders()["vhost_local"]
+			Expect(provider.GetClockSkewSeconds()).To(Equal(uint32(10)))
+			var keySet jose.JSONWebKeySet
+			Expect(json.Unmarshal([]byte(provider.GetLocalJwks().GetInlineString()), &keySet)).To(Succeed())
+			Expect(keySet.Keys).To(HaveLen(1))
+			Expect(keySet.Keys[0].Key).To(BeAssignableToTypeOf(&rsa.PublicKey{}))
+		})
+
+		It("requires any of the providers of a virtual host", func() {
+			filters, err := NewPlugin().HttpFilters(params, listenerWithVhost(&v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_Jwt{
+					Jwt: &jwt.VhostExtension{
+						Providers: map[string]*jwt.Provider{
+							"remote": remoteProvider(),
+							"local":  localProvider(),
+						},
+						AllowMissingOrFailedJwt: true,
+					},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(jwtConfig(filters[0]).GetRequirementMap()["vhost"]).To(matchers.MatchProto(&envoyjwt.JwtRequirement{
+				RequiresType: &envoyjwt.JwtRequirement_RequiresAny{
+					RequiresAny: &envoyjwt.JwtRequirementOrList{
+						Requirements: []*envoyjwt.JwtRequirement{
+							{RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "vhost_local"}},
+							{RequiresType: &envoyjwt.JwtRequirement_ProviderName{ProviderName: "vhost_remote"}},
+							{RequiresType: &envoyjwt.JwtRequirement_AllowMissingOrFailed{}},
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Context("ProcessVirtualHost", func() {
+
+		process := func(provider *jwt.Provider) (*envoy_config_route_v3.VirtualHost, error) {
+			out := &envoy_config_route_v3.VirtualHost{}
+			err := NewPlugin().ProcessVirtualHost(plugins.VirtualHostParams{Params: params}, &v1.VirtualHost{
+				Name: "vhost",
+				Options: &v1.VirtualHostOptions{
+					JwtConfig: &v1.VirtualHostOptions_Jwt{
+						Jwt: &jwt.VhostExtension{
+							Providers: map[string]*jwt.Provider{"provider": provider},
+						},
+					},
+				},
+			}, out)
+			return out, err
+		}
+
+		It("requires the requirement of the virtual host", func() {
+			out, err := process(localProvider())
+			Expect(err).NotTo(HaveOccurred())
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[AfterExtAuthFilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyjwt.PerRouteConfig{
+				RequirementSpecifier: &envoyjwt.PerRouteConfig_RequirementName{
+					RequirementName: "vhost",
+				},
+			}))
+		})
+
+		It("errors when the jwks upstream does not exist", func() {
+			provider := remoteProvider()
+			provider.GetJwks().GetRemote().UpstreamRef = &core.ResourceRef{Name: "missing", Namespace: "gloo-system"}
+			_, err := process(provider)
+			Expect(err).To(MatchError(JwksUpstreamNotFoundError("provider", provider.GetJwks().GetRemote().GetUpstreamRef())))
+		})
+
+		It("errors when a claim is appended to a header", func() {
+			provider := remoteProvider()
+			provider.GetClaimsToHeaders()[0].Append = true
+			_, err := process(provider)
+			Expect(err).To(MatchError(ClaimAppendNotSupportedError("provider", "sub")))
+		})
+
+		It("errors when no jwks is set", func() {
+			_, err := process(&jwt.Provider{})
+			Expect(err).To(MatchError(NoJwksError("provider")))
+		})
+
+		It("errors when the local jwks is invalid", func() {
+			provider := localProvider()
+			provider.GetJwks().GetLocal().Key = "not a key"
+			_, err := process(provider)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(InvalidLocalJwksError.Error()))
+		})
+	})
+
+	Context("ProcessRoute", func() {
+
+		var (
+			listener *v1.HttpListener
+		)
+
+		BeforeEach(func() {
+			listener = listenerWithVhost(&v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_Jwt{
+					Jwt: &jwt.VhostExtension{
+						Providers: map[string]*jwt.Provider{"local": localProvider()},
+					},
+				},
+			})
+		})
+
+		processRoute := func(routeOptions *v1.RouteOptions) *envoy_config_route_v3.Route {
+			out := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{},
+				},
+			}
+			err := NewPlugin().ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params:       params,
+					HttpListener: listener,
+				},
+			}, &v1.Route{Options: routeOptions}, out)
+			Expect(err).NotTo(HaveOccurred())
+			return out
+		}
+
+		It("disables the filter on routes that opt out", func() {
+			out := processRoute(&v1.RouteOptions{
+				JwtConfig: &v1.RouteOptions_Jwt{
+					Jwt: &jwt.RouteExtension{Disable: true},
+				},
+			})
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[AfterExtAuthFilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyjwt.PerRouteConfig{
+				RequirementSpecifier: &envoyjwt.PerRouteConfig_Disabled{
+					Disabled: true,
+				},
+			}))
+		})
+
+		It("does not configure filters that are not on the listener", func() {
+			out := processRoute(&v1.RouteOptions{
+				JwtConfig: &v1.RouteOptions_JwtStaged{
+					JwtStaged: &jwt.JwtStagedRouteExtension{
+						BeforeExtAuth: &jwt.RouteExtension{Disable: true},
+					},
+				},
+			})
+			Expect(out.GetTypedPerFilterConfig()).To(BeEmpty())
+		})
+	})
+})
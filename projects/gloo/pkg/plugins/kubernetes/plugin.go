@@ -13,8 +13,10 @@ import (
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	"github.com/solo-io/gloo/projects/gloo/pkg/xds"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -40,7 +42,7 @@ type plugin struct {
 func NewPlugin(kube kubernetes.Interface, kubeCoreCache corecache.KubeCoreCache) plugins.Plugin {
 	return &plugin{
 		kube:              kube,
-		UpstreamConverter: DefaultUpstreamConverter(),
+		UpstreamConverter: NewUpstreamConverter(kube, kubeCoreCache),
 		kubeCoreCache:     kubeCoreCache,
 	}
 }
@@ -71,7 +73,20 @@ func (p *plugin) ProcessUpstream(params plugins.Params, in *v1.Upstream, out *en
 
 	// configure the cluster to use EDS:ADS and call it a day
 	xds.SetEdsOnCluster(out, p.settings)
-	upstreamRef := in.GetMetadata().Ref()
+
+	if err := p.validateServiceExists(in.GetMetadata().Ref(), kube); err != nil {
+		return err
+	}
+
+	// Upstreams discovered from a service carry its annotations. Report the ones that could not be applied to the upstream.
+	if err := serviceconverter.ValidateServiceAnnotations(in.GetMetadata().GetAnnotations()); err != nil {
+		return plugins.NewUpstreamWarning(err)
+	}
+	return nil
+}
+
+func (p *plugin) validateServiceExists(upstreamRef *core.ResourceRef, kube *v1.Upstream_Kube) error {
+	var ok bool
 
 	// Lister functions obfuscate the typical (val, ok) pair returned values of maps, so we have to do a nil check instead.
 	lister := p.kubeCoreCache.NamespacedServiceLister(kube.Kube.GetServiceNamespace())
@@ -8,7 +8,10 @@ import (
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
+	kubev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
@@ -73,6 +76,23 @@ var _ = Describe("Plugin", func() {
 			Expect(strings.Contains(err.Error(), "invalid ServiceNamespace")).To(BeTrue())
 		})
 
+		It("should warn on upstream with invalid service annotations", func() {
+			_, err := kube.CoreV1().Services("ns").Create(context.Background(), &kubev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "mySvc", Namespace: "ns"},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() error {
+				return plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			}, "5s").ShouldNot(HaveOccurred())
+
+			upstream.GetMetadata().Annotations = map[string]string{
+				serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation: "-1",
+			}
+			err = plugin.(plugins.UpstreamPlugin).ProcessUpstream(params, upstream, out)
+			Expect(plugins.IsUpstreamWarning(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation))
+		})
+
 	})
 
 })
@@ -0,0 +1,193 @@
+package serviceconverter
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	envoycore_sk "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
+	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const GlooHealthCheckFromReadinessProbeAnnotation = "gloo.solo.io/health_checks_from_readiness_probe"
+
+// Kubernetes defaults for unset probe fields
+const (
+	defaultProbePeriodSeconds    = 10
+	defaultProbeTimeoutSeconds   = 1
+	defaultProbeSuccessThreshold = 1
+	defaultProbeFailureThreshold = 3
+)
+
+// PodLister lists the pods in a namespace that match a selector
+type PodLister func(namespace string, selector labels.Selector) ([]*kubev1.Pod, error)
+
+// HealthCheckConverter sets active health checks on the upstream from the readiness probe of the pods selected by the service if:
+// (1) the service has the "health_checks_from_readiness_probe" annotation set to "true"; or
+// (2) the "health_checks_from_readiness_probe" annotation is set to "true" in Settings.UpstreamOptions and the service does not set it to "false"
+//
+// HTTP, TCP and gRPC probes are supported. Envoy sends health checks to the port of the endpoint, so only probes
+// on the port targeted by the service port are converted. The probe of the most recently created pod is used,
+// so the health checks follow the pods of the latest rollout.
+type HealthCheckConverter struct {
+	podLister PodLister
+}
+
+func NewHealthCheckConverter(podLister PodLister) *HealthCheckConverter {
+	return &HealthCheckConverter{podLister: podLister}
+}
+
+func (h *HealthCheckConverter) ConvertService(ctx context.Context, svc *kubev1.Service, port kubev1.ServicePort, us *v1.Upstream) error {
+	if h.podLister == nil || !healthChecksFromReadinessProbe(ctx, svc) || len(svc.Spec.Selector) == 0 {
+		return nil
+	}
+
+	pods, err := h.podLister(svc.Namespace, labels.SelectorFromSet(svc.Spec.Selector))
+	if err != nil {
+		return err
+	}
+
+	// prefer the probes of the newest pods, as they reflect the latest version of the deployment
+	sort.SliceStable(pods, func(i, j int) bool {
+		if !pods[i].CreationTimestamp.Equal(&pods[j].CreationTimestamp) {
+			return pods[j].CreationTimestamp.Before(&pods[i].CreationTimestamp)
+		}
+		return pods[i].Name < pods[j].Name
+	})
+
+	for _, pod := range pods {
+		if healthCheck := healthCheckForPod(pod, port); healthCheck != nil {
+			utils.SetDerivedHealthChecks(us, []*envoycore.HealthCheck{healthCheck})
+			return nil
+		}
+	}
+
+	contextutils.LoggerFrom(ctx).Debugf("no readiness probe found for port %v of service %v.%v", port.Port, svc.Namespace, svc.Name)
+	return nil
+}
+
+func healthChecksFromReadinessProbe(ctx context.Context, svc *kubev1.Service) bool {
+	if value, ok := svc.Annotations[GlooHealthCheckFromReadinessProbeAnnotation]; ok {
+		return value == "true"
+	}
+	globalAnnotations := settingsutil.MaybeFromContext(ctx).GetUpstreamOptions().GetGlobalAnnotations()
+	return globalAnnotations[GlooHealthCheckFromReadinessProbeAnnotation] == "true"
+}
+
+func healthCheckForPod(pod *kubev1.Pod, port kubev1.ServicePort) *envoycore.HealthCheck {
+	for _, container := range pod.Spec.Containers {
+		probe := container.ReadinessProbe
+		if probe == nil {
+			continue
+		}
+		targetPort := resolveContainerPort(container, port.TargetPort, port.Port)
+		if targetPort == 0 {
+			continue
+		}
+
+		healthCheck := &envoycore.HealthCheck{
+			Interval:           secondsOrDefault(probe.PeriodSeconds, defaultProbePeriodSeconds),
+			Timeout:            secondsOrDefault(probe.TimeoutSeconds, defaultProbeTimeoutSeconds),
+			HealthyThreshold:   thresholdOrDefault(probe.SuccessThreshold, defaultProbeSuccessThreshold),
+			UnhealthyThreshold: thresholdOrDefault(probe.FailureThreshold, defaultProbeFailureThreshold),
+		}
+
+		switch {
+		case probe.HTTPGet != nil:
+			if resolveContainerPort(container, probe.HTTPGet.Port, 0) != targetPort {
+				continue
+			}
+			healthCheck.HealthChecker = httpHealthChecker(probe.HTTPGet)
+		case probe.TCPSocket != nil:
+			if resolveContainerPort(container, probe.TCPSocket.Port, 0) != targetPort {
+				continue
+			}
+			healthCheck.HealthChecker = &envoycore.HealthCheck_TcpHealthCheck_{
+				TcpHealthCheck: &envoycore.HealthCheck_TcpHealthCheck{},
+			}
+		case probe.GRPC != nil:
+			if probe.GRPC.Port != targetPort {
+				continue
+			}
+			grpcHealthCheck := &envoycore.HealthCheck_GrpcHealthCheck{}
+			if probe.GRPC.Service != nil {
+				grpcHealthCheck.ServiceName = *probe.GRPC.Service
+			}
+			healthCheck.HealthChecker = &envoycore.HealthCheck_GrpcHealthCheck_{
+				GrpcHealthCheck: grpcHealthCheck,
+			}
+		default:
+			// exec probes cannot be performed by envoy
+			continue
+		}
+		return healthCheck
+	}
+	return nil
+}
+
+func httpHealthChecker(httpGet *kubev1.HTTPGetAction) *envoycore.HealthCheck_HttpHealthCheck_ {
+	httpHealthCheck := &envoycore.HealthCheck_HttpHealthCheck{
+		Path: httpGet.Path,
+	}
+	if httpHealthCheck.GetPath() == "" {
+		httpHealthCheck.Path = "/"
+	}
+	for _, header := range httpGet.HTTPHeaders {
+		// the host header cannot be added to health check requests, envoy sets it from the host field instead
+		if strings.EqualFold(header.Name, "host") {
+			httpHealthCheck.Host = header.Value
+			continue
+		}
+		httpHealthCheck.RequestHeadersToAdd = append(httpHealthCheck.GetRequestHeadersToAdd(), &envoycore_sk.HeaderValueOption{
+			HeaderOption: &envoycore_sk.HeaderValueOption_Header{
+				Header: &envoycore_sk.HeaderValue{
+					Key:   header.Name,
+					Value: header.Value,
+				},
+			},
+		})
+	}
+	return &envoycore.HealthCheck_HttpHealthCheck_{
+		HttpHealthCheck: httpHealthCheck,
+	}
+}
+
+// resolveContainerPort returns the number of a port on the container, which can be referenced by name.
+// defaultPort is used when the port is not set, and 0 is returned if a named port is not found.
+func resolveContainerPort(container kubev1.Container, port intstr.IntOrString, defaultPort int32) int32 {
+	if port.Type == intstr.String {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == port.StrVal {
+				return containerPort.ContainerPort
+			}
+		}
+		return 0
+	}
+	if port.IntVal == 0 {
+		return defaultPort
+	}
+	return port.IntVal
+}
+
+func secondsOrDefault(seconds, defaultSeconds int32) *duration.Duration {
+	if seconds <= 0 {
+		seconds = defaultSeconds
+	}
+	return &duration.Duration{Seconds: int64(seconds)}
+}
+
+func thresholdOrDefault(threshold, defaultThreshold int32) *wrappers.UInt32Value {
+	if threshold <= 0 {
+		threshold = defaultThreshold
+	}
+	return &wrappers.UInt32Value{Value: uint32(threshold)}
+}
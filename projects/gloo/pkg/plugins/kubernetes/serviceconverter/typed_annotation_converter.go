@@ -0,0 +1,412 @@
+package serviceconverter
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/settingsutil"
+	envoycluster "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/cluster"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/protocol"
+	kubev1 "k8s.io/api/core/v1"
+)
+
+/*
+Each of these annotations sets a single upstream option, e.g.
+
+gloo.solo.io/upstream.circuit_breakers.max_connections = 1024
+gloo.solo.io/upstream.connection.connect_timeout = 2s
+gloo.solo.io/upstream.load_balancer.policy = least_request
+
+Durations use the Go duration format (e.g. 500ms, 2s, 1m).
+*/
+
+const GlooUpstreamAnnotationPrefix = "gloo.solo.io/upstream."
+
+const (
+	GlooCircuitBreakersMaxConnectionsAnnotation     = GlooUpstreamAnnotationPrefix + "circuit_breakers.max_connections"
+	GlooCircuitBreakersMaxPendingRequestsAnnotation = GlooUpstreamAnnotationPrefix + "circuit_breakers.max_pending_requests"
+	GlooCircuitBreakersMaxRequestsAnnotation        = GlooUpstreamAnnotationPrefix + "circuit_breakers.max_requests"
+	GlooCircuitBreakersMaxRetriesAnnotation         = GlooUpstreamAnnotationPrefix + "circuit_breakers.max_retries"
+
+	GlooOutlierDetectionConsecutive5xxAnnotation     = GlooUpstreamAnnotationPrefix + "outlier_detection.consecutive_5xx"
+	GlooOutlierDetectionIntervalAnnotation           = GlooUpstreamAnnotationPrefix + "outlier_detection.interval"
+	GlooOutlierDetectionBaseEjectionTimeAnnotation   = GlooUpstreamAnnotationPrefix + "outlier_detection.base_ejection_time"
+	GlooOutlierDetectionMaxEjectionPercentAnnotation = GlooUpstreamAnnotationPrefix + "outlier_detection.max_ejection_percent"
+
+	GlooLoadBalancerPolicyAnnotation                = GlooUpstreamAnnotationPrefix + "load_balancer.policy"
+	GlooLoadBalancerHealthyPanicThresholdAnnotation = GlooUpstreamAnnotationPrefix + "load_balancer.healthy_panic_threshold"
+
+	GlooConnectionConnectTimeoutAnnotation                = GlooUpstreamAnnotationPrefix + "connection.connect_timeout"
+	GlooConnectionIdleTimeoutAnnotation                   = GlooUpstreamAnnotationPrefix + "connection.idle_timeout"
+	GlooConnectionMaxRequestsPerConnectionAnnotation      = GlooUpstreamAnnotationPrefix + "connection.max_requests_per_connection"
+	GlooConnectionPerConnectionBufferLimitBytesAnnotation = GlooUpstreamAnnotationPrefix + "connection.per_connection_buffer_limit_bytes"
+
+	GlooProtocolAnnotation          = GlooUpstreamAnnotationPrefix + "protocol"
+	GlooProtocolSelectionAnnotation = GlooUpstreamAnnotationPrefix + "protocol_selection"
+
+	GlooHealthCheckTypeAnnotation               = GlooUpstreamAnnotationPrefix + "health_check.type"
+	GlooHealthCheckPathAnnotation               = GlooUpstreamAnnotationPrefix + "health_check.path"
+	GlooHealthCheckIntervalAnnotation           = GlooUpstreamAnnotationPrefix + "health_check.interval"
+	GlooHealthCheckTimeoutAnnotation            = GlooUpstreamAnnotationPrefix + "health_check.timeout"
+	GlooHealthCheckHealthyThresholdAnnotation   = GlooUpstreamAnnotationPrefix + "health_check.healthy_threshold"
+	GlooHealthCheckUnhealthyThresholdAnnotation = GlooUpstreamAnnotationPrefix + "health_check.unhealthy_threshold"
+)
+
+var (
+	UnknownAnnotationError = func(key string) error {
+		return eris.Errorf("unknown upstream annotation %s", key)
+	}
+	InvalidAnnotationValueError = func(key, value string, err error) error {
+		return eris.Wrapf(err, "invalid value %q for annotation %s", value, key)
+	}
+)
+
+// GlobalAnnotationsError is returned for the annotations of Settings.UpstreamOptions that cannot be applied.
+// They are the same for every service, so they are reported once rather than with the errors of each service.
+type GlobalAnnotationsError struct {
+	Err error
+}
+
+func (e *GlobalAnnotationsError) Error() string {
+	return "invalid global annotations in settings: " + e.Err.Error()
+}
+
+// TypedAnnotationConverter sets upstream options from the "gloo.solo.io/upstream.*" annotations of the service
+// and the ones defined in Settings.UpstreamOptions. Service annotations override the ones defined in Settings.
+// Invalid annotations are skipped and reported in the returned error, while valid ones are still applied.
+type TypedAnnotationConverter struct{}
+
+func (t *TypedAnnotationConverter) ConvertService(ctx context.Context, svc *kubev1.Service, port kubev1.ServicePort, us *v1.Upstream) error {
+	var errs error
+	if globalAnnotations := settingsutil.MaybeFromContext(ctx).GetUpstreamOptions().GetGlobalAnnotations(); globalAnnotations != nil {
+		if err := applyTypedAnnotations(globalAnnotations, us); err != nil {
+			errs = multierror.Append(errs, &GlobalAnnotationsError{Err: err})
+		}
+	}
+	if err := applyTypedAnnotations(svc.Annotations, us); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	return errs
+}
+
+// ValidateServiceAnnotations returns an error for each upstream annotation of a service that cannot be applied.
+// It is used to report these errors on the Upstreams that are discovered from the service.
+func ValidateServiceAnnotations(annotations map[string]string) error {
+	var errs error
+	if err := applyTypedAnnotations(annotations, &v1.Upstream{}); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := applyAnnotations(annotations, &v1.Upstream{}); err != nil {
+		errs = multierror.Append(errs, eris.Wrapf(err, "invalid value for annotation %s", GlooAnnotationPrefix))
+	}
+	return errs
+}
+
+type annotationSetter func(value string, us *v1.Upstream) error
+
+var typedAnnotationSetters = map[string]annotationSetter{
+	GlooCircuitBreakersMaxConnectionsAnnotation: uint32Setter(func(us *v1.Upstream, val *wrappers.UInt32Value) {
+		circuitBreakers(us).MaxConnections = val
+	}),
+	GlooCircuitBreakersMaxPendingRequestsAnnotation: uint32Setter(func(us *v1.Upstream, val *wrappers.UInt32Value) {
+		circuitBreakers(us).MaxPendingRequests = val
+	}),
+	GlooCircuitBreakersMaxRequestsAnnotation: uint32Setter(func(us *v1.Upstream, val *wrappers.UInt32Value) {
+		circuitBreakers(us).MaxRequests = val
+	}),
+	GlooCircuitBreakersMaxRetriesAnnotation: uint32Setter(func(us *v1.Upstream, val *wrappers.UInt32Value) {
+		circuitBreakers(us).MaxRetries = val
+	}),
+
+	GlooOutlierDetectionConsecutive5xxAnnotation: uint32Setter(func(us *v1.Upstream, val *wrappers.UInt32Value) {
+		outlierDetection(us).Consecutive_5Xx = val
+	}),
+	GlooOutlierDetectionIntervalAnnotation: durationSetter(func(us *v1.Upstream, val *duration.Duration) {
+		outlierDetection(us).Interval = val
+	}),
+	GlooOutlierDetectionBaseEjectionTimeAnnotation: durationSetter(func(us *v1.Upstream, val *duration.Duration) {
+		outlierDetection(us).BaseEjectionTime = val
+	}),
+	GlooOutlierDetectionMaxEjectionPercentAnnotation: func(value string, us *v1.Upstream) error {
+		val, err := parseUInt32(value)
+		if err != nil {
+			return err
+		}
+		if val.GetValue() > 100 {
+			return eris.New("must be between 0 and 100")
+		}
+		outlierDetection(us).MaxEjectionPercent = val
+		return nil
+	},
+
+	GlooLoadBalancerPolicyAnnotation: func(value string, us *v1.Upstream) error {
+		lbConfig := &v1.LoadBalancerConfig{}
+		switch strings.ToLower(value) {
+		case "round_robin":
+			lbConfig.Type = &v1.LoadBalancerConfig_RoundRobin_{RoundRobin: &v1.LoadBalancerConfig_RoundRobin{}}
+		case "least_request":
+			lbConfig.Type = &v1.LoadBalancerConfig_LeastRequest_{LeastRequest: &v1.LoadBalancerConfig_LeastRequest{}}
+		case "random":
+			lbConfig.Type = &v1.LoadBalancerConfig_Random_{Random: &v1.LoadBalancerConfig_Random{}}
+		case "ring_hash":
+			lbConfig.Type = &v1.LoadBalancerConfig_RingHash_{RingHash: &v1.LoadBalancerConfig_RingHash{}}
+		case "maglev":
+			lbConfig.Type = &v1.LoadBalancerConfig_Maglev_{Maglev: &v1.LoadBalancerConfig_Maglev{}}
+		default:
+			return eris.New("must be one of round_robin, least_request, random, ring_hash or maglev")
+		}
+		loadBalancerConfig(us).Type = lbConfig.GetType()
+		return nil
+	},
+	GlooLoadBalancerHealthyPanicThresholdAnnotation: func(value string, us *v1.Upstream) error {
+		val, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		if val < 0 || val > 100 {
+			return eris.New("must be between 0 and 100")
+		}
+		loadBalancerConfig(us).HealthyPanicThreshold = &wrappers.DoubleValue{Value: val}
+		return nil
+	},
+
+	GlooConnectionConnectTimeoutAnnotation: durationSetter(func(us *v1.Upstream, val *duration.Duration) {
+		connectionConfig(us).ConnectTimeout = val
+	}),
+	GlooConnectionIdleTimeoutAnnotation: durationSetter(func(us *v1.Upstream, val *duration.Duration) {
+		config := connectionConfig(us)
+		if config.GetCommonHttpProtocolOptions() == nil {
+			config.CommonHttpProtocolOptions = &protocol.HttpProtocolOptions{}
+		}
+		config.GetCommonHttpProtocolOptions().IdleTimeout = val
+	}),
+	GlooConnectionMaxRequestsPerConnectionAnnotation: uint32Setter(func(us *v1.Upstream, val *wrappers.UInt32Value) {
+		connectionConfig(us).MaxRequestsPerConnection = val.GetValue()
+	}),
+	GlooConnectionPerConnectionBufferLimitBytesAnnotation: uint32Setter(func(us *v1.Upstream, val *wrappers.UInt32Value) {
+		connectionConfig(us).PerConnectionBufferLimitBytes = val
+	}),
+
+	GlooProtocolAnnotation: func(value string, us *v1.Upstream) error {
+		switch strings.ToLower(value) {
+		case "http1":
+			us.UseHttp2 = &wrappers.BoolValue{Value: false}
+		case "http2", "h2", "grpc":
+			us.UseHttp2 = &wrappers.BoolValue{Value: true}
+		default:
+			return eris.New("must be one of http1, http2, h2 or grpc")
+		}
+		return nil
+	},
+	GlooProtocolSelectionAnnotation: func(value string, us *v1.Upstream) error {
+		selection, ok := v1.Upstream_ClusterProtocolSelection_value[strings.ToUpper(value)]
+		if !ok {
+			return eris.New("must be one of USE_CONFIGURED_PROTOCOL or USE_DOWNSTREAM_PROTOCOL")
+		}
+		us.ProtocolSelection = v1.Upstream_ClusterProtocolSelection(selection)
+		return nil
+	},
+}
+
+// the health check annotations are applied together, as they configure a single health check
+var healthCheckAnnotations = map[string]struct{}{
+	GlooHealthCheckTypeAnnotation:               {},
+	GlooHealthCheckPathAnnotation:               {},
+	GlooHealthCheckIntervalAnnotation:           {},
+	GlooHealthCheckTimeoutAnnotation:            {},
+	GlooHealthCheckHealthyThresholdAnnotation:   {},
+	GlooHealthCheckUnhealthyThresholdAnnotation: {},
+}
+
+func applyTypedAnnotations(annotations map[string]string, us *v1.Upstream) error {
+	var errs error
+	hasHealthCheck := false
+	for _, key := range sortedKeys(annotations) {
+		if !strings.HasPrefix(key, GlooUpstreamAnnotationPrefix) {
+			continue
+		}
+		if _, ok := healthCheckAnnotations[key]; ok {
+			hasHealthCheck = true
+			continue
+		}
+		setter, ok := typedAnnotationSetters[key]
+		if !ok {
+			errs = multierror.Append(errs, UnknownAnnotationError(key))
+			continue
+		}
+		if err := setter(annotations[key], us); err != nil {
+			errs = multierror.Append(errs, InvalidAnnotationValueError(key, annotations[key], err))
+		}
+	}
+
+	if hasHealthCheck {
+		healthCheck, err := healthCheckFromAnnotations(annotations)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		} else {
+			us.HealthChecks = []*envoycore.HealthCheck{healthCheck}
+		}
+	}
+	return errs
+}
+
+// healthCheckFromAnnotations builds a health check from the health check annotations.
+// Unset values use the same defaults as Kubernetes probes.
+func healthCheckFromAnnotations(annotations map[string]string) (*envoycore.HealthCheck, error) {
+	healthCheck := &envoycore.HealthCheck{
+		Interval:           &duration.Duration{Seconds: defaultProbePeriodSeconds},
+		Timeout:            &duration.Duration{Seconds: defaultProbeTimeoutSeconds},
+		HealthyThreshold:   &wrappers.UInt32Value{Value: defaultProbeSuccessThreshold},
+		UnhealthyThreshold: &wrappers.UInt32Value{Value: defaultProbeFailureThreshold},
+	}
+
+	var errs error
+	parse := func(key string, parseFunc func(string) error) {
+		if value, ok := annotations[key]; ok {
+			if err := parseFunc(value); err != nil {
+				errs = multierror.Append(errs, InvalidAnnotationValueError(key, value, err))
+			}
+		}
+	}
+	parse(GlooHealthCheckIntervalAnnotation, func(value string) (err error) {
+		healthCheck.Interval, err = parseDuration(value)
+		return err
+	})
+	parse(GlooHealthCheckTimeoutAnnotation, func(value string) (err error) {
+		healthCheck.Timeout, err = parseDuration(value)
+		return err
+	})
+	parse(GlooHealthCheckHealthyThresholdAnnotation, func(value string) (err error) {
+		healthCheck.HealthyThreshold, err = parseUInt32(value)
+		return err
+	})
+	parse(GlooHealthCheckUnhealthyThresholdAnnotation, func(value string) (err error) {
+		healthCheck.UnhealthyThreshold, err = parseUInt32(value)
+		return err
+	})
+
+	path := annotations[GlooHealthCheckPathAnnotation]
+	healthCheckType, ok := annotations[GlooHealthCheckTypeAnnotation]
+	if !ok {
+		healthCheckType = "http"
+	}
+	switch strings.ToLower(healthCheckType) {
+	case "http":
+		if path == "" {
+			path = "/"
+		}
+		healthCheck.HealthChecker = &envoycore.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: &envoycore.HealthCheck_HttpHealthCheck{
+				Path: path,
+			},
+		}
+	case "tcp":
+		healthCheck.HealthChecker = &envoycore.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &envoycore.HealthCheck_TcpHealthCheck{},
+		}
+	case "grpc":
+		healthCheck.HealthChecker = &envoycore.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &envoycore.HealthCheck_GrpcHealthCheck{},
+		}
+	default:
+		errs = multierror.Append(errs, InvalidAnnotationValueError(GlooHealthCheckTypeAnnotation, healthCheckType, eris.New("must be one of http, tcp or grpc")))
+	}
+	if path != "" && healthCheck.GetHttpHealthCheck() == nil {
+		errs = multierror.Append(errs, eris.Errorf("annotation %s is only supported for http health checks", GlooHealthCheckPathAnnotation))
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+	return healthCheck, nil
+}
+
+func uint32Setter(set func(us *v1.Upstream, val *wrappers.UInt32Value)) annotationSetter {
+	return func(value string, us *v1.Upstream) error {
+		val, err := parseUInt32(value)
+		if err != nil {
+			return err
+		}
+		set(us, val)
+		return nil
+	}
+}
+
+func durationSetter(set func(us *v1.Upstream, val *duration.Duration)) annotationSetter {
+	return func(value string, us *v1.Upstream) error {
+		val, err := parseDuration(value)
+		if err != nil {
+			return err
+		}
+		set(us, val)
+		return nil
+	}
+}
+
+func parseUInt32(value string) (*wrappers.UInt32Value, error) {
+	val, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &wrappers.UInt32Value{Value: uint32(val)}, nil
+}
+
+func parseDuration(value string) (*duration.Duration, error) {
+	val, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	if val <= 0 {
+		return nil, eris.New("must be positive")
+	}
+	return &duration.Duration{
+		Seconds: int64(val / time.Second),
+		Nanos:   int32(val % time.Second),
+	}, nil
+}
+
+func circuitBreakers(us *v1.Upstream) *v1.CircuitBreakerConfig {
+	if us.GetCircuitBreakers() == nil {
+		us.CircuitBreakers = &v1.CircuitBreakerConfig{}
+	}
+	return us.GetCircuitBreakers()
+}
+
+func outlierDetection(us *v1.Upstream) *envoycluster.OutlierDetection {
+	if us.GetOutlierDetection() == nil {
+		us.OutlierDetection = &envoycluster.OutlierDetection{}
+	}
+	return us.GetOutlierDetection()
+}
+
+func loadBalancerConfig(us *v1.Upstream) *v1.LoadBalancerConfig {
+	if us.GetLoadBalancerConfig() == nil {
+		us.LoadBalancerConfig = &v1.LoadBalancerConfig{}
+	}
+	return us.GetLoadBalancerConfig()
+}
+
+func connectionConfig(us *v1.Upstream) *v1.ConnectionConfig {
+	if us.GetConnectionConfig() == nil {
+		us.ConnectionConfig = &v1.ConnectionConfig{}
+	}
+	return us.GetConnectionConfig()
+}
+
+func sortedKeys(annotations map[string]string) []string {
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
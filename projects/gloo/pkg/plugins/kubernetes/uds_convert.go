@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
 	"github.com/solo-io/go-utils/contextutils"
 
@@ -15,9 +17,20 @@ import (
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/errors"
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
 	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	EventSourceComponent              = "gloo-discovery"
+	InvalidUpstreamOptionsEventReason = "InvalidUpstreamOptions"
 )
 
 type UpstreamConverter interface {
@@ -30,8 +43,51 @@ func DefaultUpstreamConverter() *KubeUpstreamConverter {
 	return kuc
 }
 
+// NewUpstreamConverter returns the default upstream converter, along with the service converters
+// that need to read other resources from the cluster.
+// Errors applying the options of a service to its upstreams are reported as Events on the service.
+func NewUpstreamConverter(kube kubernetes.Interface, kubeCoreCache corecache.KubeCoreCache) *KubeUpstreamConverter {
+	kuc := DefaultUpstreamConverter()
+	kuc.kube = kube
+	if kubeCoreCache == nil {
+		return kuc
+	}
+	healthCheckConverter := serviceconverter.NewHealthCheckConverter(func(namespace string, selector labels.Selector) ([]*kubev1.Pod, error) {
+		lister := kubeCoreCache.NamespacedPodLister(namespace)
+		if lister == nil {
+			return nil, errors.Errorf("cannot list pods in invalid namespace \"%s\"", namespace)
+		}
+		return lister.List(selector)
+	})
+	// prepended so that the General Service Converter is still applied last
+	kuc.serviceConverters = append([]serviceconverter.ServiceConverter{healthCheckConverter}, kuc.serviceConverters...)
+	return kuc
+}
+
 type KubeUpstreamConverter struct {
 	serviceConverters []serviceconverter.ServiceConverter
+
+	kube              kubernetes.Interface
+	eventRecorderOnce sync.Once
+	eventRecorder     record.EventRecorder
+
+	// the errors last reported for each upstream and for the global annotations of the settings
+	reportedErrorsLock  sync.Mutex
+	reportedErrors      map[string]string
+	reportedGlobalError string
+}
+
+// getEventRecorder lazily starts recording events, as only discovery needs to emit them
+func (uc *KubeUpstreamConverter) getEventRecorder() record.EventRecorder {
+	if uc.kube == nil {
+		return nil
+	}
+	uc.eventRecorderOnce.Do(func() {
+		broadcaster := record.NewBroadcaster()
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: uc.kube.CoreV1().Events("")})
+		uc.eventRecorder = broadcaster.NewRecorder(scheme.Scheme, kubev1.EventSource{Component: EventSourceComponent})
+	})
+	return uc.eventRecorder
 }
 
 func (uc *KubeUpstreamConverter) UpstreamsForService(ctx context.Context, svc *kubev1.Service) v1.UpstreamList {
@@ -65,15 +121,68 @@ func (uc *KubeUpstreamConverter) CreateUpstream(ctx context.Context, svc *kubev1
 		},
 	}
 
+	var errs error
 	for _, sc := range uc.serviceConverters {
 		if err := sc.ConvertService(ctx, svc, port, us); err != nil {
-			contextutils.LoggerFrom(ctx).Errorf("error: failed to process service options with err %v", err)
+			errs = multierror.Append(errs, err)
 		}
 	}
+	uc.reportConversionErrors(ctx, svc, coremeta.GetName(), errs)
 
 	return us
 }
 
+// reportConversionErrors logs the errors of the service converters and records them as an Event on the service.
+// Discovery converts every service on each pass, so the errors are only reported again once they change,
+// and the errors of the global annotations of the settings are reported once rather than for every service.
+func (uc *KubeUpstreamConverter) reportConversionErrors(ctx context.Context, svc *kubev1.Service, upstreamName string, errs error) {
+	var serviceErrs, globalErrs error
+	if merr, ok := errs.(*multierror.Error); ok {
+		for _, err := range merr.Errors {
+			if _, ok := err.(*serviceconverter.GlobalAnnotationsError); ok {
+				globalErrs = multierror.Append(globalErrs, err)
+			} else {
+				serviceErrs = multierror.Append(serviceErrs, err)
+			}
+		}
+	}
+
+	uc.reportedErrorsLock.Lock()
+	defer uc.reportedErrorsLock.Unlock()
+	if uc.reportedErrors == nil {
+		uc.reportedErrors = make(map[string]string)
+	}
+
+	if globalErr := errorString(globalErrs); globalErr != uc.reportedGlobalError {
+		uc.reportedGlobalError = globalErr
+		if globalErrs != nil {
+			contextutils.LoggerFrom(ctx).Errorf("error: failed to process the upstream options of the settings with err %v", globalErrs)
+		}
+	}
+
+	serviceErr := errorString(serviceErrs)
+	if serviceErr == uc.reportedErrors[upstreamName] {
+		return
+	}
+	if serviceErrs == nil {
+		delete(uc.reportedErrors, upstreamName)
+		return
+	}
+	uc.reportedErrors[upstreamName] = serviceErr
+	contextutils.LoggerFrom(ctx).Errorf("error: failed to process service options with err %v", serviceErrs)
+	if recorder := uc.getEventRecorder(); recorder != nil {
+		recorder.Eventf(svc, kubev1.EventTypeWarning, InvalidUpstreamOptionsEventReason,
+			"failed to apply service options to upstream %s: %v", upstreamName, serviceErrs)
+	}
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
 func UpstreamName(serviceNamespace, serviceName string, servicePort int32) string {
 	return sanitizer.SanitizeNameV2(fmt.Sprintf("%s-%s-%v", serviceNamespace, serviceName, servicePort))
 }
@@ -5,21 +5,32 @@ import (
 	"encoding/json"
 	"reflect"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/solo-io/gloo/pkg/utils/settingsutil"
 
+	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-multierror"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options"
 	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	rest "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes/serviceconverter"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/utils"
+	envoycore_sk "github.com/solo-io/solo-kit/pkg/api/external/envoy/api/v2/core"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	"github.com/solo-io/solo-kit/test/matchers"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
 
 	kubev1 "k8s.io/api/core/v1"
 
@@ -649,6 +660,396 @@ var _ = Describe("UdsConvert", func() {
 			)
 		})
 	})
+
+	Context("typed upstream annotations", func() {
+
+		var (
+			svc  *kubev1.Service
+			port kubev1.ServicePort
+		)
+
+		BeforeEach(func() {
+			svc = &kubev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test-ns",
+				},
+			}
+			port = kubev1.ServicePort{
+				Port: 123,
+			}
+		})
+
+		It("should apply typed annotations to the upstream", func() {
+			svc.Annotations = map[string]string{
+				serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation:      "1024",
+				serviceconverter.GlooOutlierDetectionConsecutive5xxAnnotation:     "5",
+				serviceconverter.GlooOutlierDetectionIntervalAnnotation:           "10s",
+				serviceconverter.GlooLoadBalancerPolicyAnnotation:                 "least_request",
+				serviceconverter.GlooLoadBalancerHealthyPanicThresholdAnnotation:  "50",
+				serviceconverter.GlooConnectionConnectTimeoutAnnotation:           "1500ms",
+				serviceconverter.GlooConnectionIdleTimeoutAnnotation:              "1m",
+				serviceconverter.GlooConnectionMaxRequestsPerConnectionAnnotation: "100",
+				serviceconverter.GlooProtocolAnnotation:                           "http2",
+				serviceconverter.GlooProtocolSelectionAnnotation:                  "use_downstream_protocol",
+				serviceconverter.GlooHealthCheckPathAnnotation:                    "/healthz",
+				serviceconverter.GlooHealthCheckIntervalAnnotation:                "5s",
+			}
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+
+			Expect(up.GetCircuitBreakers()).To(matchers.MatchProto(&v1.CircuitBreakerConfig{
+				MaxConnections: &wrappers.UInt32Value{Value: 1024},
+			}))
+			Expect(up.GetOutlierDetection().GetConsecutive_5Xx().GetValue()).To(BeEquivalentTo(5))
+			Expect(up.GetOutlierDetection().GetInterval()).To(matchers.MatchProto(&duration.Duration{Seconds: 10}))
+			Expect(up.GetLoadBalancerConfig().GetLeastRequest()).NotTo(BeNil())
+			Expect(up.GetLoadBalancerConfig().GetHealthyPanicThreshold().GetValue()).To(Equal(50.0))
+			Expect(up.GetConnectionConfig().GetConnectTimeout()).To(matchers.MatchProto(&duration.Duration{Seconds: 1, Nanos: 500000000}))
+			Expect(up.GetConnectionConfig().GetCommonHttpProtocolOptions().GetIdleTimeout()).To(matchers.MatchProto(&duration.Duration{Seconds: 60}))
+			Expect(up.GetConnectionConfig().GetMaxRequestsPerConnection()).To(BeEquivalentTo(100))
+			Expect(up.GetUseHttp2().GetValue()).To(BeTrue())
+			Expect(up.GetProtocolSelection()).To(Equal(v1.Upstream_USE_DOWNSTREAM_PROTOCOL))
+			Expect(up.GetHealthChecks()).To(HaveLen(1))
+			Expect(up.GetHealthChecks()[0]).To(matchers.MatchProto(&envoycore.HealthCheck{
+				Interval:           &duration.Duration{Seconds: 5},
+				Timeout:            &duration.Duration{Seconds: 1},
+				HealthyThreshold:   &wrappers.UInt32Value{Value: 1},
+				UnhealthyThreshold: &wrappers.UInt32Value{Value: 3},
+				HealthChecker: &envoycore.HealthCheck_HttpHealthCheck_{
+					HttpHealthCheck: &envoycore.HealthCheck_HttpHealthCheck{
+						Path: "/healthz",
+					},
+				},
+			}))
+		})
+
+		It("should apply typed annotations from the settings and let the service override them", func() {
+			svc.Annotations = map[string]string{
+				serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation: "10",
+			}
+			ctx := settingsutil.WithSettings(context.TODO(), &v1.Settings{
+				UpstreamOptions: &v1.UpstreamOptions{
+					GlobalAnnotations: map[string]string{
+						serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation: "20",
+						serviceconverter.GlooCircuitBreakersMaxRequestsAnnotation:    "30",
+					},
+				},
+			})
+			up := uc.CreateUpstream(ctx, svc, port)
+			Expect(up.GetCircuitBreakers()).To(matchers.MatchProto(&v1.CircuitBreakerConfig{
+				MaxConnections: &wrappers.UInt32Value{Value: 10},
+				MaxRequests:    &wrappers.UInt32Value{Value: 30},
+			}))
+		})
+
+		It("should skip invalid annotations, apply valid ones and emit an event on the service", func() {
+			recorder := record.NewFakeRecorder(10)
+			uc.kube = fake.NewSimpleClientset()
+			uc.eventRecorderOnce.Do(func() {
+				uc.eventRecorder = recorder
+			})
+			svc.Annotations = map[string]string{
+				serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation: "many",
+				serviceconverter.GlooCircuitBreakersMaxRequestsAnnotation:    "30",
+				serviceconverter.GlooLoadBalancerPolicyAnnotation:            "fastest",
+				serviceconverter.GlooUpstreamAnnotationPrefix + "unknown":    "true",
+			}
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(up.GetCircuitBreakers()).To(matchers.MatchProto(&v1.CircuitBreakerConfig{
+				MaxRequests: &wrappers.UInt32Value{Value: 30},
+			}))
+			Expect(up.GetLoadBalancerConfig()).To(BeNil())
+
+			Expect(recorder.Events).To(HaveLen(1))
+			event := <-recorder.Events
+			Expect(event).To(HavePrefix(kubev1.EventTypeWarning + " " + InvalidUpstreamOptionsEventReason))
+			Expect(event).To(ContainSubstring(serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation))
+			Expect(event).To(ContainSubstring(serviceconverter.GlooLoadBalancerPolicyAnnotation))
+			Expect(event).To(ContainSubstring("unknown upstream annotation " + serviceconverter.GlooUpstreamAnnotationPrefix + "unknown"))
+		})
+
+		It("should only emit an event again once the errors of the service change", func() {
+			recorder := record.NewFakeRecorder(10)
+			uc.kube = fake.NewSimpleClientset()
+			uc.eventRecorderOnce.Do(func() {
+				uc.eventRecorder = recorder
+			})
+			svc.Annotations = map[string]string{
+				serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation: "many",
+			}
+			uc.CreateUpstream(context.TODO(), svc, port)
+			uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(ContainSubstring(serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation))
+
+			svc.Annotations[serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation] = "more"
+			uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(ContainSubstring(`"more"`))
+
+			// fixing the annotation, then breaking it again, reports the error again
+			svc.Annotations[serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation] = "10"
+			uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(recorder.Events).To(BeEmpty())
+			svc.Annotations[serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation] = "more"
+			uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should not emit events on the services for the errors of the global annotations", func() {
+			recorder := record.NewFakeRecorder(10)
+			uc.kube = fake.NewSimpleClientset()
+			uc.eventRecorderOnce.Do(func() {
+				uc.eventRecorder = recorder
+			})
+			ctx := settingsutil.WithSettings(context.TODO(), &v1.Settings{
+				UpstreamOptions: &v1.UpstreamOptions{
+					GlobalAnnotations: map[string]string{
+						serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation: "many",
+						serviceconverter.GlooCircuitBreakersMaxRequestsAnnotation:    "30",
+					},
+				},
+			})
+			err := (&serviceconverter.TypedAnnotationConverter{}).ConvertService(ctx, svc, port, &v1.Upstream{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*multierror.Error).Errors).To(ConsistOf(BeAssignableToTypeOf(&serviceconverter.GlobalAnnotationsError{})))
+
+			up := uc.CreateUpstream(ctx, svc, port)
+			Expect(up.GetCircuitBreakers().GetMaxRequests().GetValue()).To(BeEquivalentTo(30))
+			otherSvc := svc.DeepCopy()
+			otherSvc.Name = "other"
+			uc.CreateUpstream(ctx, otherSvc, port)
+			Expect(recorder.Events).To(BeEmpty())
+			Expect(uc.reportedGlobalError).To(ContainSubstring(serviceconverter.GlooCircuitBreakersMaxConnectionsAnnotation))
+		})
+
+		DescribeTable("should validate typed annotations", func(annotations map[string]string, expectedErr string) {
+			svc.Annotations = annotations
+			err := (&serviceconverter.TypedAnnotationConverter{}).ConvertService(context.TODO(), svc, port, &v1.Upstream{})
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+			Entry("valid annotations", map[string]string{
+				serviceconverter.GlooCircuitBreakersMaxRetriesAnnotation: "3",
+				"unrelated": "annotation",
+			}, ""),
+			Entry("negative number", map[string]string{
+				serviceconverter.GlooCircuitBreakersMaxRetriesAnnotation: "-3",
+			}, serviceconverter.GlooCircuitBreakersMaxRetriesAnnotation),
+			Entry("invalid duration", map[string]string{
+				serviceconverter.GlooOutlierDetectionBaseEjectionTimeAnnotation: "30",
+			}, serviceconverter.GlooOutlierDetectionBaseEjectionTimeAnnotation),
+			Entry("percent out of range", map[string]string{
+				serviceconverter.GlooOutlierDetectionMaxEjectionPercentAnnotation: "101",
+			}, "must be between 0 and 100"),
+			Entry("invalid protocol selection", map[string]string{
+				serviceconverter.GlooProtocolSelectionAnnotation: "http3",
+			}, serviceconverter.GlooProtocolSelectionAnnotation),
+			Entry("invalid health check type", map[string]string{
+				serviceconverter.GlooHealthCheckTypeAnnotation: "exec",
+			}, "must be one of http, tcp or grpc"),
+			Entry("path on tcp health check", map[string]string{
+				serviceconverter.GlooHealthCheckTypeAnnotation: "tcp",
+				serviceconverter.GlooHealthCheckPathAnnotation: "/healthz",
+			}, "only supported for http health checks"),
+		)
+	})
+
+	Context("health checks from readiness probes", func() {
+
+		var (
+			svc  *kubev1.Service
+			port kubev1.ServicePort
+			pods []*kubev1.Pod
+		)
+
+		newPod := func(name string, created time.Time, probe *kubev1.Probe) *kubev1.Pod {
+			return &kubev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         "test-ns",
+					CreationTimestamp: metav1.NewTime(created),
+				},
+				Spec: kubev1.PodSpec{
+					Containers: []kubev1.Container{
+						{
+							Name: "app",
+							Ports: []kubev1.ContainerPort{
+								{Name: "http", ContainerPort: 8080},
+								{Name: "admin", ContainerPort: 9090},
+							},
+							ReadinessProbe: probe,
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			svc = &kubev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test-ns",
+					Annotations: map[string]string{
+						serviceconverter.GlooHealthCheckFromReadinessProbeAnnotation: "true",
+					},
+				},
+				Spec: kubev1.ServiceSpec{
+					Selector: map[string]string{"app": "test"},
+				},
+			}
+			port = kubev1.ServicePort{
+				Port:       80,
+				TargetPort: intstr.FromString("http"),
+			}
+			pods = []*kubev1.Pod{
+				newPod("test-1", time.Unix(100, 0), &kubev1.Probe{
+					ProbeHandler: kubev1.ProbeHandler{
+						HTTPGet: &kubev1.HTTPGetAction{
+							Path: "/healthz",
+							Port: intstr.FromInt(8080),
+							HTTPHeaders: []kubev1.HTTPHeader{
+								{Name: "Host", Value: "test.local"},
+								{Name: "x-probe", Value: "true"},
+							},
+						},
+					},
+					PeriodSeconds:    5,
+					FailureThreshold: 2,
+				}),
+			}
+			uc = &KubeUpstreamConverter{
+				serviceConverters: []serviceconverter.ServiceConverter{
+					serviceconverter.NewHealthCheckConverter(func(namespace string, selector labels.Selector) ([]*kubev1.Pod, error) {
+						Expect(namespace).To(Equal("test-ns"))
+						Expect(selector.String()).To(Equal("app=test"))
+						return pods, nil
+					}),
+				},
+			}
+		})
+
+		It("should create http health checks from the readiness probe", func() {
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(up.GetHealthChecks()).To(HaveLen(1))
+			Expect(up.GetHealthChecks()[0]).To(matchers.MatchProto(&envoycore.HealthCheck{
+				Interval:           &duration.Duration{Seconds: 5},
+				Timeout:            &duration.Duration{Seconds: 1},
+				HealthyThreshold:   &wrappers.UInt32Value{Value: 1},
+				UnhealthyThreshold: &wrappers.UInt32Value{Value: 2},
+				HealthChecker: &envoycore.HealthCheck_HttpHealthCheck_{
+					HttpHealthCheck: &envoycore.HealthCheck_HttpHealthCheck{
+						Host: "test.local",
+						Path: "/healthz",
+						RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{
+							{
+								HeaderOption: &envoycore_sk.HeaderValueOption_Header{
+									Header: &envoycore_sk.HeaderValue{Key: "x-probe", Value: "true"},
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("should use the readiness probe of the newest pod", func() {
+			pods = append(pods, newPod("test-2", time.Unix(200, 0), &kubev1.Probe{
+				ProbeHandler: kubev1.ProbeHandler{
+					TCPSocket: &kubev1.TCPSocketAction{
+						Port: intstr.FromString("http"),
+					},
+				},
+			}))
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(up.GetHealthChecks()).To(HaveLen(1))
+			Expect(up.GetHealthChecks()[0].GetTcpHealthCheck()).NotTo(BeNil())
+			Expect(up.GetHealthChecks()[0].GetInterval()).To(matchers.MatchProto(&duration.Duration{Seconds: 10}))
+			Expect(up.GetHealthChecks()[0].GetUnhealthyThreshold().GetValue()).To(BeEquivalentTo(3))
+		})
+
+		It("should create grpc health checks from the readiness probe", func() {
+			service := "my.Service"
+			pods[0].Spec.Containers[0].ReadinessProbe.ProbeHandler = kubev1.ProbeHandler{
+				GRPC: &kubev1.GRPCAction{
+					Port:    8080,
+					Service: &service,
+				},
+			}
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(up.GetHealthChecks()).To(HaveLen(1))
+			Expect(up.GetHealthChecks()[0].GetGrpcHealthCheck().GetServiceName()).To(Equal(service))
+		})
+
+		It("should not create health checks for probes on another port", func() {
+			pods[0].Spec.Containers[0].ReadinessProbe.HTTPGet.Port = intstr.FromString("admin")
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(up.GetHealthChecks()).To(BeEmpty())
+		})
+
+		It("should not create health checks for exec probes", func() {
+			pods[0].Spec.Containers[0].ReadinessProbe.ProbeHandler = kubev1.ProbeHandler{
+				Exec: &kubev1.ExecAction{Command: []string{"true"}},
+			}
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(up.GetHealthChecks()).To(BeEmpty())
+		})
+
+		It("should not create health checks when the annotation is not set", func() {
+			svc.Annotations = nil
+			up := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(up.GetHealthChecks()).To(BeEmpty())
+		})
+
+		It("should create health checks when the annotation is set in the settings", func() {
+			svc.Annotations = nil
+			ctx := settingsutil.WithSettings(context.TODO(), &v1.Settings{
+				UpstreamOptions: &v1.UpstreamOptions{
+					GlobalAnnotations: map[string]string{
+						serviceconverter.GlooHealthCheckFromReadinessProbeAnnotation: "true",
+					},
+				},
+			})
+			up := uc.CreateUpstream(ctx, svc, port)
+			Expect(up.GetHealthChecks()).To(HaveLen(1))
+		})
+
+		It("should clear the derived health checks once the readiness probe is removed", func() {
+			original := uc.CreateUpstream(context.TODO(), svc, port)
+			Expect(original.GetHealthChecks()).To(HaveLen(1))
+
+			pods[0].Spec.Containers[0].ReadinessProbe = nil
+			desired := uc.CreateUpstream(context.TODO(), svc, port)
+			changed, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(desired.GetHealthChecks()).To(BeEmpty())
+		})
+
+		It("should keep the health checks written by the user", func() {
+			original := uc.CreateUpstream(context.TODO(), svc, port)
+			userHealthChecks := []*envoycore.HealthCheck{{Interval: &duration.Duration{Seconds: 30}}}
+			original.HealthChecks = userHealthChecks
+
+			// over the derived health checks
+			desired := uc.CreateUpstream(context.TODO(), svc, port)
+			_, err := UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desired.GetHealthChecks()).To(Equal(userHealthChecks))
+			Expect(desired.GetMetadata().GetAnnotations()).NotTo(HaveKey(utils.DerivedHealthChecksAnnotation))
+
+			// and once the readiness probe is removed
+			pods[0].Spec.Containers[0].ReadinessProbe = nil
+			desired = uc.CreateUpstream(context.TODO(), svc, port)
+			_, err = UpdateUpstream(original, desired)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desired.GetHealthChecks()).To(Equal(userHealthChecks))
+		})
+	})
 })
 
 func testSetUseHttp2Converter() {
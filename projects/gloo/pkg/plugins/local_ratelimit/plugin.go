@@ -22,7 +22,14 @@ const (
 	HTTPFilterStatPrefix    = "http_local_ratelimit"
 	NetworkFilterName       = "envoy.filters.network.local_ratelimit"
 	HTTPFilterName          = "envoy.filters.http.local_ratelimit"
-	CustomStageBeforeAuth   = uint32(3)
+
+	// CustomStage is the stage of the http local rate limit filter and of the rate limit actions of its descriptors.
+	// The actions share the routes with the ones of the global rate limit filter, so it must differ from
+	// ratelimit.CustomStage and ratelimit.CustomStageBeforeAuth.
+	CustomStage = uint32(4)
+	// Deprecated: the http local rate limit filter uses CustomStage. It used this stage, which is also the one of the
+	// actions of the global rate limit filter before auth, up to 1.17.0-beta3.
+	CustomStageBeforeAuth = uint32(3)
 )
 
 var (
@@ -31,7 +38,13 @@ var (
 	// For the HTTP filter, this is designed to rate limit early, and consequently kick in before auth
 	httpFilterPluginStage = plugins.BeforeStage(plugins.AuthNStage)
 
-	ErrConfigurationExists = errors.New("configuration already exists")
+	// When configured, it runs after auth instead so that descriptors can be generated from the metadata set by auth filters
+	httpFilterAfterAuthNPluginStage = plugins.AfterStage(plugins.AuthNStage)
+
+	ErrConfigurationExists              = errors.New("configuration already exists")
+	ErrMissingTokenBucketForDescriptors = errors.New("a token bucket is required on the vHost, route or gateway to configure local rate limit descriptors")
+	ErrUnsupportedDescriptorActions     = errors.New("setActions and limit are not supported for local rate limit descriptors")
+	ErrDescriptorsWithoutRouteAction    = errors.New("cannot apply local rate limit descriptors without a route action")
 )
 
 type plugin struct {
@@ -65,8 +78,17 @@ func (p *plugin) ProcessVirtualHost(
 	in *v1.VirtualHost,
 	out *envoy_config_route_v3.VirtualHost,
 ) error {
+	settings := params.HttpListener.GetOptions().GetHttpLocalRatelimit()
+	if descriptorLimits := in.GetOptions().GetRatelimit().GetLocalRatelimitDescriptors(); descriptorLimits != nil {
+		err := configureVirtualHostDescriptors(params.Ctx, settings, in.GetOptions().GetRatelimit().GetLocalRatelimit(), descriptorLimits, CustomStage, out)
+		if err != nil {
+			return err
+		}
+		p.filterRequiredForListener[params.HttpListener] = struct{}{}
+		return nil
+	}
 	if limits := in.GetOptions().GetRatelimit().GetLocalRatelimit(); limits != nil {
-		err := ConfigureVirtualHostFilter(params.HttpListener.GetOptions().GetHttpLocalRatelimit(), limits, CustomStageBeforeAuth, out)
+		err := ConfigureVirtualHostFilter(settings, limits, CustomStage, out)
 		if err != nil {
 			return err
 		}
@@ -77,8 +99,17 @@ func (p *plugin) ProcessVirtualHost(
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	settings := params.HttpListener.GetOptions().GetHttpLocalRatelimit()
+	if descriptorLimits := in.GetOptions().GetRatelimit().GetLocalRatelimitDescriptors(); descriptorLimits != nil {
+		err := configureRouteDescriptors(params.Ctx, settings, in.GetOptions().GetRatelimit().GetLocalRatelimit(), descriptorLimits, CustomStage, out)
+		if err != nil {
+			return err
+		}
+		p.filterRequiredForListener[params.HttpListener] = struct{}{}
+		return nil
+	}
 	if limits := in.GetOptions().GetRatelimit().GetLocalRatelimit(); limits != nil {
-		err := ConfigureRouteFilter(params.HttpListener.GetOptions().GetHttpLocalRatelimit(), limits, CustomStageBeforeAuth, out)
+		err := ConfigureRouteFilter(settings, limits, CustomStage, out)
 		if err != nil {
 			return err
 		}
@@ -89,7 +120,7 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 
 func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
 	settings := listener.GetOptions().GetHttpLocalRatelimit()
-	filter, err := GenerateHTTPFilter(settings, settings.GetDefaultLimit(), CustomStageBeforeAuth)
+	filter, err := GenerateHTTPFilter(settings, settings.GetDefaultLimit(), CustomStage)
 	if err != nil {
 		return nil, err
 	}
@@ -103,10 +134,14 @@ func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) (
 		return []plugins.StagedHttpFilter{}, nil
 	}
 
+	stage := httpFilterPluginStage
+	if settings.GetRateLimitAfterAuthn().GetValue() {
+		stage = httpFilterAfterAuthNPluginStage
+	}
 	stagedRateLimitFilter, err := plugins.NewStagedFilter(
 		HTTPFilterName,
 		filter,
-		httpFilterPluginStage,
+		stage,
 	)
 	if err != nil {
 		return nil, err
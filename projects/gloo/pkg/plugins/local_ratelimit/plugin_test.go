@@ -1,6 +1,8 @@
 package local_ratelimit
 
 import (
+	"context"
+
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
@@ -15,7 +17,9 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/ratelimit"
 	local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	globalratelimit "github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -63,7 +67,7 @@ var _ = Describe("Local Rate Limit Plugin", func() {
 			StatPrefix:                            HTTPFilterStatPrefix,
 			LocalRateLimitPerDownstreamConnection: true,
 			EnableXRatelimitHeaders:               envoyratelimit.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03,
-			Stage:                                 CustomStageBeforeAuth,
+			Stage:                                 CustomStage,
 			FilterEnabled: &corev3.RuntimeFractionalPercent{
 				DefaultValue: &envoy_type_v3.FractionalPercent{
 					Numerator:   100,
@@ -186,4 +190,299 @@ var _ = Describe("Local Rate Limit Plugin", func() {
 			},
 		}))
 	})
+
+	Context("descriptors", func() {
+
+		var (
+			descriptorLimits   *local_ratelimit.DescriptorLimits
+			expectedRateLimits []*envoy_config_route_v3.RateLimit
+			vhostParams        plugins.VirtualHostParams
+		)
+
+		BeforeEach(func() {
+			descriptorLimits = &local_ratelimit.DescriptorLimits{
+				RateLimits: []*solo_rl.RateLimitActions{
+					{
+						Actions: []*solo_rl.Action{
+							{
+								ActionSpecifier: &solo_rl.Action_RequestHeaders_{
+									RequestHeaders: &solo_rl.Action_RequestHeaders{
+										HeaderName:    "x-client-id",
+										DescriptorKey: "client",
+									},
+								},
+							},
+						},
+					},
+					{
+						Actions: []*solo_rl.Action{
+							{
+								ActionSpecifier: &solo_rl.Action_RemoteAddress_{
+									RemoteAddress: &solo_rl.Action_RemoteAddress{},
+								},
+							},
+						},
+					},
+				},
+				Descriptors: []*local_ratelimit.Descriptor{
+					{
+						Entries: []*local_ratelimit.Descriptor_Entry{
+							{Key: "client", Value: "foo"},
+						},
+						TokenBucket: &local_ratelimit.TokenBucket{
+							MaxTokens: 5,
+							FillInterval: &durationpb.Duration{
+								Seconds: 20,
+							},
+						},
+					},
+				},
+			}
+
+			expectedRateLimits = []*envoy_config_route_v3.RateLimit{
+				{
+					Stage: &wrapperspb.UInt32Value{Value: CustomStage},
+					Actions: []*envoy_config_route_v3.RateLimit_Action{
+						{
+							ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+								RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+									HeaderName:    "x-client-id",
+									DescriptorKey: "client",
+								},
+							},
+						},
+					},
+				},
+				{
+					Stage: &wrapperspb.UInt32Value{Value: CustomStage},
+					Actions: []*envoy_config_route_v3.RateLimit_Action{
+						{
+							ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+								RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+							},
+						},
+					},
+				},
+			}
+
+			expectedFilter.Descriptors = []*envoyratelimit.LocalRateLimitDescriptor{
+				{
+					Entries: []*envoyratelimit.RateLimitDescriptor_Entry{
+						{Key: "client", Value: "foo"},
+					},
+					TokenBucket: &envoy_type_v3.TokenBucket{
+						MaxTokens: 5,
+						FillInterval: &durationpb.Duration{
+							Seconds: 20,
+						},
+					},
+				},
+			}
+
+			vhostParams = plugins.VirtualHostParams{
+				Params:       plugins.Params{Ctx: context.Background()},
+				HttpListener: httpListener,
+			}
+		})
+
+		It("Should configure descriptors and rate limit actions on the virtual host", func() {
+			out := &envoy_config_route_v3.VirtualHost{}
+			err := p.ProcessVirtualHost(vhostParams, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					RateLimitConfigType: &v1.VirtualHostOptions_Ratelimit{
+						Ratelimit: &ratelimit.RateLimitVhostExtension{
+							LocalRatelimit:            tokenBucket,
+							LocalRatelimitDescriptors: descriptorLimits,
+						},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			typedConfig, err := utils.MessageToAny(expectedFilter)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(&envoy_config_route_v3.VirtualHost{
+				RateLimits: expectedRateLimits,
+				TypedPerFilterConfig: map[string]*anypb.Any{
+					HTTPFilterName: typedConfig,
+				},
+			}))
+		})
+
+		It("Should configure descriptors and append rate limit actions on the route", func() {
+			existingRateLimit := &envoy_config_route_v3.RateLimit{
+				Stage: &wrapperspb.UInt32Value{Value: 1},
+			}
+			out := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{
+						RateLimits: []*envoy_config_route_v3.RateLimit{existingRateLimit},
+					},
+				},
+			}
+			err := p.ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: vhostParams,
+			}, &v1.Route{
+				Options: &v1.RouteOptions{
+					RateLimitConfigType: &v1.RouteOptions_Ratelimit{
+						Ratelimit: &ratelimit.RateLimitRouteExtension{
+							LocalRatelimit:            tokenBucket,
+							LocalRatelimitDescriptors: descriptorLimits,
+						},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			typedConfig, err := utils.MessageToAny(expectedFilter)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetRoute().GetRateLimits()).To(Equal(append([]*envoy_config_route_v3.RateLimit{existingRateLimit}, expectedRateLimits...)))
+			Expect(out.GetTypedPerFilterConfig()).To(Equal(map[string]*anypb.Any{
+				HTTPFilterName: typedConfig,
+			}))
+		})
+
+		It("Should keep the descriptor actions apart from the global rate limit actions on the same route", func() {
+			globalPlugin := globalratelimit.NewPlugin()
+			globalPlugin.Init(plugins.InitParams{
+				Settings: &v1.Settings{
+					RatelimitServer: &ratelimit.Settings{
+						RateLimitBeforeAuth: true,
+					},
+				},
+			})
+			globalActions := []*solo_rl.RateLimitActions{
+				{
+					Actions: []*solo_rl.Action{
+						{
+							ActionSpecifier: &solo_rl.Action_GenericKey_{
+								GenericKey: &solo_rl.Action_GenericKey{
+									DescriptorValue: "global",
+								},
+							},
+						},
+					},
+				},
+			}
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					RateLimitConfigType: &v1.RouteOptions_Ratelimit{
+						Ratelimit: &ratelimit.RateLimitRouteExtension{
+							RateLimits:                globalActions,
+							LocalRatelimit:            tokenBucket,
+							LocalRatelimitDescriptors: descriptorLimits,
+						},
+					},
+				},
+			}
+			out := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{},
+				},
+			}
+			params := plugins.RouteParams{
+				VirtualHostParams: vhostParams,
+			}
+			Expect(globalPlugin.ProcessRoute(params, in, out)).To(Succeed())
+			Expect(p.ProcessRoute(params, in, out)).To(Succeed())
+
+			Expect(CustomStage).NotTo(Equal(globalratelimit.CustomStage))
+			Expect(CustomStage).NotTo(Equal(globalratelimit.CustomStageBeforeAuth))
+			rateLimits := out.GetRoute().GetRateLimits()
+			Expect(rateLimits).To(HaveLen(3))
+			Expect(rateLimits[0].GetStage().GetValue()).To(Equal(globalratelimit.CustomStageBeforeAuth))
+			Expect(rateLimits[1:]).To(Equal(expectedRateLimits))
+		})
+
+		It("Should use the default limit of the listener when no token bucket is set on the virtual host", func() {
+			out := &envoy_config_route_v3.VirtualHost{}
+			err := p.ProcessVirtualHost(vhostParams, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					RateLimitConfigType: &v1.VirtualHostOptions_Ratelimit{
+						Ratelimit: &ratelimit.RateLimitVhostExtension{
+							LocalRatelimitDescriptors: descriptorLimits,
+						},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+			typedConfig, err := utils.MessageToAny(expectedFilter)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GetTypedPerFilterConfig()).To(Equal(map[string]*anypb.Any{
+				HTTPFilterName: typedConfig,
+			}))
+		})
+
+		It("Should error when no token bucket is configured", func() {
+			httpListener.GetOptions().GetHttpLocalRatelimit().DefaultLimit = nil
+			err := p.ProcessVirtualHost(vhostParams, &v1.VirtualHost{
+				Options: &v1.VirtualHostOptions{
+					RateLimitConfigType: &v1.VirtualHostOptions_Ratelimit{
+						Ratelimit: &ratelimit.RateLimitVhostExtension{
+							LocalRatelimitDescriptors: descriptorLimits,
+						},
+					},
+				},
+			}, &envoy_config_route_v3.VirtualHost{})
+			Expect(err).To(MatchError(ErrMissingTokenBucketForDescriptors))
+		})
+
+		It("Should error when the route has no route action", func() {
+			err := p.ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: vhostParams,
+			}, &v1.Route{
+				Options: &v1.RouteOptions{
+					RateLimitConfigType: &v1.RouteOptions_Ratelimit{
+						Ratelimit: &ratelimit.RateLimitRouteExtension{
+							LocalRatelimit:            tokenBucket,
+							LocalRatelimitDescriptors: descriptorLimits,
+						},
+					},
+				},
+			}, &envoy_config_route_v3.Route{})
+			Expect(err).To(MatchError(ErrDescriptorsWithoutRouteAction))
+		})
+
+		DescribeTable("Should error on invalid descriptors",
+			func(mutate func(), errSubstring string) {
+				mutate()
+				err := p.ProcessVirtualHost(vhostParams, &v1.VirtualHost{
+					Options: &v1.VirtualHostOptions{
+						RateLimitConfigType: &v1.VirtualHostOptions_Ratelimit{
+							Ratelimit: &ratelimit.RateLimitVhostExtension{
+								LocalRatelimit:            tokenBucket,
+								LocalRatelimitDescriptors: descriptorLimits,
+							},
+						},
+					},
+				}, &envoy_config_route_v3.VirtualHost{})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(errSubstring))
+			},
+			Entry("no entries", func() {
+				descriptorLimits.GetDescriptors()[0].Entries = nil
+			}, "must have at least one entry"),
+			Entry("empty value", func() {
+				descriptorLimits.GetDescriptors()[0].GetEntries()[0].Value = ""
+			}, "must have a key and a value"),
+			Entry("duplicate descriptor", func() {
+				descriptorLimits.Descriptors = append(descriptorLimits.GetDescriptors(), descriptorLimits.GetDescriptors()[0])
+			}, "Duplicate descriptor"),
+			Entry("missing token bucket", func() {
+				descriptorLimits.GetDescriptors()[0].TokenBucket = nil
+			}, "must have a token bucket"),
+			Entry("fill interval not a multiple of the default", func() {
+				descriptorLimits.GetDescriptors()[0].GetTokenBucket().FillInterval = &durationpb.Duration{Seconds: 15}
+			}, "must be a multiple of the default FillInterval"),
+			Entry("set actions", func() {
+				descriptorLimits.GetRateLimits()[0].SetActions = descriptorLimits.GetRateLimits()[0].GetActions()
+			}, ErrUnsupportedDescriptorActions.Error()),
+		)
+	})
+
+	It("Should run the http local rate limit filter after authn when configured", func() {
+		httpListener.GetOptions().GetHttpLocalRatelimit().RateLimitAfterAuthn = &wrapperspb.BoolValue{Value: true}
+		filters, err := p.HttpFilters(plugins.Params{}, httpListener)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthNStage)))
+	})
 })
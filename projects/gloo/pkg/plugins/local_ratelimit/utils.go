@@ -1,7 +1,9 @@
 package local_ratelimit
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -12,10 +14,14 @@ import (
 	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/go-multierror"
 	local_ratelimit "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/local_ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	solo_rl "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -146,3 +152,146 @@ func ConfigureRouteFilter(settings *local_ratelimit.Settings, localRatelimit *lo
 	// Mark this stage as having user-defined configuration
 	return pluginutils.ModifyRoutePerFilterConfig(out, HTTPFilterName, modIfNoExisting(filter))
 }
+
+// generateHTTPFilterWithDescriptors generates the per-filter config for a vHost or route with descriptor-based limits,
+// along with the rate limit actions that generate the descriptors.
+// If no token bucket is defined on the vHost or route, the default limit of the listener is used.
+func generateHTTPFilterWithDescriptors(
+	ctx context.Context,
+	settings *local_ratelimit.Settings,
+	localRatelimit *local_ratelimit.TokenBucket,
+	descriptorLimits *local_ratelimit.DescriptorLimits,
+	stage uint32,
+) (*envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit, []*envoy_config_route_v3.RateLimit, error) {
+	if descriptorLimits != nil && localRatelimit == nil {
+		localRatelimit = settings.GetDefaultLimit()
+		if localRatelimit == nil {
+			return nil, nil, ErrMissingTokenBucketForDescriptors
+		}
+	}
+	filter, err := GenerateHTTPFilter(settings, localRatelimit, stage)
+	if err != nil {
+		return nil, nil, err
+	}
+	if descriptorLimits == nil {
+		return filter, nil, nil
+	}
+
+	filter.Descriptors, err = toEnvoyDescriptors(descriptorLimits.GetDescriptors(), filter.GetTokenBucket())
+	if err != nil {
+		return nil, nil, err
+	}
+	rateLimits, err := toEnvoyRateLimits(ctx, descriptorLimits.GetRateLimits(), stage)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filter, rateLimits, nil
+}
+
+func toEnvoyDescriptors(descriptors []*local_ratelimit.Descriptor, defaultTokenBucket *envoy_type_v3.TokenBucket) ([]*envoyratelimit.LocalRateLimitDescriptor, error) {
+	var out []*envoyratelimit.LocalRateLimitDescriptor
+	seen := make(map[string]struct{}, len(descriptors))
+	for _, descriptor := range descriptors {
+		if len(descriptor.GetEntries()) == 0 {
+			return nil, fmt.Errorf("Descriptor must have at least one entry")
+		}
+		var entries []*envoyratelimit.RateLimitDescriptor_Entry
+		var keyParts []string
+		for _, entry := range descriptor.GetEntries() {
+			if entry.GetKey() == "" || entry.GetValue() == "" {
+				return nil, fmt.Errorf("Descriptor entries must have a key and a value. Current entry : %v=%v", entry.GetKey(), entry.GetValue())
+			}
+			entries = append(entries, &envoyratelimit.RateLimitDescriptor_Entry{
+				Key:   entry.GetKey(),
+				Value: entry.GetValue(),
+			})
+			keyParts = append(keyParts, entry.GetKey()+"="+entry.GetValue())
+		}
+		descriptorKey := strings.Join(keyParts, ",")
+		if _, ok := seen[descriptorKey]; ok {
+			return nil, fmt.Errorf("Duplicate descriptor : %v", descriptorKey)
+		}
+		seen[descriptorKey] = struct{}{}
+
+		if descriptor.GetTokenBucket() == nil {
+			return nil, fmt.Errorf("Descriptor %v must have a token bucket", descriptorKey)
+		}
+		tokenBucket, err := toEnvoyTokenBucket(descriptor.GetTokenBucket())
+		if err != nil {
+			return nil, err
+		}
+		// Envoy requires the fill interval of a descriptor to be a multiple of the fill interval of the default token bucket
+		fillInterval, defaultFillInterval := tokenBucket.GetFillInterval().AsDuration(), defaultTokenBucket.GetFillInterval().AsDuration()
+		if fillInterval%defaultFillInterval != 0 {
+			return nil, fmt.Errorf("FillInterval of descriptor %v must be a multiple of the default FillInterval. Current value : %v, default : %v", descriptorKey, fillInterval, defaultFillInterval)
+		}
+
+		out = append(out, &envoyratelimit.LocalRateLimitDescriptor{
+			Entries:     entries,
+			TokenBucket: tokenBucket,
+		})
+	}
+	return out, nil
+}
+
+func toEnvoyRateLimits(ctx context.Context, rateLimits []*solo_rl.RateLimitActions, stage uint32) ([]*envoy_config_route_v3.RateLimit, error) {
+	var out []*envoy_config_route_v3.RateLimit
+	var allErrors error
+	for _, rateLimit := range rateLimits {
+		if len(rateLimit.GetSetActions()) != 0 || rateLimit.GetLimit() != nil {
+			allErrors = multierror.Append(allErrors, ErrUnsupportedDescriptorActions)
+			continue
+		}
+		if len(rateLimit.GetActions()) == 0 {
+			continue
+		}
+		actions, err := ratelimit.ConvertActions(ctx, rateLimit.GetActions())
+		if err != nil {
+			allErrors = multierror.Append(allErrors, err)
+			continue
+		}
+		out = append(out, &envoy_config_route_v3.RateLimit{
+			Stage:   &wrappers.UInt32Value{Value: stage},
+			Actions: actions,
+		})
+	}
+	return out, allErrors
+}
+
+func configureVirtualHostDescriptors(
+	ctx context.Context,
+	settings *local_ratelimit.Settings,
+	localRatelimit *local_ratelimit.TokenBucket,
+	descriptorLimits *local_ratelimit.DescriptorLimits,
+	stage uint32,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	filter, rateLimits, err := generateHTTPFilterWithDescriptors(ctx, settings, localRatelimit, descriptorLimits, stage)
+	if err != nil {
+		return err
+	}
+	out.RateLimits = append(out.GetRateLimits(), rateLimits...)
+	return pluginutils.ModifyVhostPerFilterConfig(out, HTTPFilterName, modIfNoExisting(filter))
+}
+
+func configureRouteDescriptors(
+	ctx context.Context,
+	settings *local_ratelimit.Settings,
+	localRatelimit *local_ratelimit.TokenBucket,
+	descriptorLimits *local_ratelimit.DescriptorLimits,
+	stage uint32,
+	out *envoy_config_route_v3.Route,
+) error {
+	filter, rateLimits, err := generateHTTPFilterWithDescriptors(ctx, settings, localRatelimit, descriptorLimits, stage)
+	if err != nil {
+		return err
+	}
+	if len(rateLimits) > 0 {
+		ra := out.GetRoute()
+		if ra == nil {
+			return ErrDescriptorsWithoutRouteAction
+		}
+		ra.RateLimits = append(ra.GetRateLimits(), rateLimits...)
+	}
+	return pluginutils.ModifyRoutePerFilterConfig(out, HTTPFilterName, modIfNoExisting(filter))
+}
@@ -88,6 +88,25 @@ type UpstreamPlugin interface {
 	ProcessUpstream(params Params, in *v1.Upstream, out *envoy_config_cluster_v3.Cluster) error
 }
 
+// UpstreamWarning can be returned by an UpstreamPlugin for configuration issues that should be reported
+// as a warning on the Upstream, instead of rejecting it
+type UpstreamWarning struct {
+	Err error
+}
+
+func NewUpstreamWarning(err error) *UpstreamWarning {
+	return &UpstreamWarning{Err: err}
+}
+
+func (e *UpstreamWarning) Error() string {
+	return e.Err.Error()
+}
+
+func IsUpstreamWarning(err error) bool {
+	_, ok := err.(*UpstreamWarning)
+	return ok
+}
+
 // EndpointPlugin modifies an Envoy ClusterLoadAssignment (formerly known as an Endpoint) which
 // has been created for the input Gloo Upstream.
 // This allows the ClusterLoadAssignments to be edited before being sent to Envoy via EDS.
@@ -38,6 +38,12 @@ func SetWeightedClusterPerFilterConfig(
 	return ModifyWeightedClusterPerFilterConfig(out, filterName, nopMod(protoext))
 }
 
+// DisabledFilterConfig returns the per-filter config which disables a filter for a virtual host or route,
+// for filters which do not have a per-route config of their own
+func DisabledFilterConfig() *envoy_config_route_v3.FilterConfig {
+	return &envoy_config_route_v3.FilterConfig{Disabled: true}
+}
+
 func ModifyRoutePerFilterConfig(out *envoy_config_route_v3.Route, filterName string, mod ModifyFunc) error {
 	if out.GetTypedPerFilterConfig() == nil {
 		out.TypedPerFilterConfig = make(map[string]*any.Any)
@@ -30,6 +30,8 @@ const (
 	CustomDomain  = "custom"
 	RequestType   = "both"
 
+	// the stages of the rate limit actions of the global rate limit filter.
+	// local_ratelimit.CustomStage is reserved for the actions of the local rate limit filter.
 	CustomStage           = uint32(1)
 	CustomStageBeforeAuth = uint32(3)
 )
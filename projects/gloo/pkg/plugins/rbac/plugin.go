@@ -0,0 +1,490 @@
+package rbac
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+)
+
+var (
+	_ plugins.Plugin            = new(plugin)
+	_ plugins.HttpFilterPlugin  = new(plugin)
+	_ plugins.VirtualHostPlugin = new(plugin)
+	_ plugins.RoutePlugin       = new(plugin)
+)
+
+const (
+	ExtensionName = "rbac"
+	FilterName    = "envoy.filters.http.rbac"
+)
+
+// the rbac filter authorizes requests once they are authenticated, so that it can match the claims of verified JWTs
+var filterStage = plugins.DuringStage(plugins.AuthZStage)
+
+var (
+	NoPrincipalsError = func(policy string) error {
+		return eris.Errorf("rbac policy %s must have at least one principal", policy)
+	}
+	EmptyPrincipalError = func(policy string) error {
+		return eris.Errorf("a principal of rbac policy %s does not set any field", policy)
+	}
+	NoCidrRangesError = func(policy string) error {
+		return eris.Errorf("a source ip principal of rbac policy %s must have at least one cidr range", policy)
+	}
+	NoJwtProvidersError = func(policy string) error {
+		return eris.Errorf("a jwt principal of rbac policy %s does not specify a provider, "+
+			"and the virtual host does not have any jwt provider", policy)
+	}
+	InvalidBooleanClaimError = func(policy, claim, value string) error {
+		return eris.Errorf("rbac policy %s matches claim %s with the boolean matcher, but %s is not a boolean",
+			policy, claim, value)
+	}
+)
+
+type plugin struct {
+	requireRbac               bool
+	filterRequiredForListener map[*v1.HttpListener]struct{}
+}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(params plugins.InitParams) {
+	p.requireRbac = params.Settings.GetRbac().GetRequireRbac()
+	p.filterRequiredForListener = make(map[*v1.HttpListener]struct{})
+}
+
+// HttpFilters adds the rbac filter to listeners that use it, or to all listeners if rbac is required
+func (p *plugin) HttpFilters(_ plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	_, ok := p.filterRequiredForListener[listener]
+	if !ok && !p.requireRbac {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	config := &envoyrbac.RBAC{}
+	if p.requireRbac {
+		// an allow policy without any policy denies all requests that are not authorized by a virtual host or a route
+		config.Rules = &envoy_config_rbac_v3.RBAC{
+			Action: envoy_config_rbac_v3.RBAC_ALLOW,
+		}
+	}
+	rbacFilter, err := plugins.NewStagedFilter(FilterName, config, filterStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{rbacFilter}, nil
+}
+
+func (p *plugin) ProcessVirtualHost(
+	params plugins.VirtualHostParams,
+	in *v1.VirtualHost,
+	out *envoy_config_route_v3.VirtualHost,
+) error {
+	rbacSettings := in.GetOptions().GetRbac()
+	if rbacSettings == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translatePerRoute(params.Ctx, rbacSettings, jwt.ProviderNames(in.GetOptions()))
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+
+	return pluginutils.SetVhostPerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+// ProcessRoute overrides the settings of the virtual host with the settings of the route
+func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
+	rbacSettings := in.GetOptions().GetRbac()
+	if rbacSettings == nil {
+		return nil
+	}
+
+	perRouteConfig, err := translatePerRoute(params.Ctx, rbacSettings, jwt.ProviderNames(params.VirtualHost.GetOptions()))
+	if err != nil {
+		return err
+	}
+	p.filterRequiredForListener[params.HttpListener] = struct{}{}
+
+	return pluginutils.SetRoutePerFilterConfig(out, FilterName, perRouteConfig)
+}
+
+// translatePerRoute translates the settings of a virtual host or a route.
+// jwtProviders are the jwt providers of the virtual host, which are matched by jwt principals that do not specify one.
+func translatePerRoute(
+	ctx context.Context,
+	rbacSettings *rbac.ExtensionSettings,
+	jwtProviders []string,
+) (*envoyrbac.RBACPerRoute, error) {
+	if rbacSettings.GetDisable() {
+		// a per route config without rbac config disables the filter
+		return &envoyrbac.RBACPerRoute{}, nil
+	}
+
+	config := &envoyrbac.RBAC{}
+	// when only shadow policies are set, they are dry run and no policy is enforced
+	if len(rbacSettings.GetPolicies()) > 0 || len(rbacSettings.GetShadowPolicies()) == 0 {
+		rules, err := translatePolicies(ctx, rbacSettings.GetPolicies(), jwtProviders)
+		if err != nil {
+			return nil, err
+		}
+		config.Rules = rules
+	}
+	if len(rbacSettings.GetShadowPolicies()) > 0 {
+		shadowRules, err := translatePolicies(ctx, rbacSettings.GetShadowPolicies(), jwtProviders)
+		if err != nil {
+			return nil, err
+		}
+		config.ShadowRules = shadowRules
+	}
+	return &envoyrbac.RBACPerRoute{Rbac: config}, nil
+}
+
+func translatePolicies(
+	ctx context.Context,
+	policies map[string]*rbac.Policy,
+	jwtProviders []string,
+) (*envoy_config_rbac_v3.RBAC, error) {
+	rules := &envoy_config_rbac_v3.RBAC{
+		Action:   envoy_config_rbac_v3.RBAC_ALLOW,
+		Policies: map[string]*envoy_config_rbac_v3.Policy{},
+	}
+	for name, policy := range policies {
+		envoyPolicy, err := translatePolicy(ctx, name, policy, jwtProviders)
+		if err != nil {
+			return nil, err
+		}
+		rules.GetPolicies()[name] = envoyPolicy
+	}
+	return rules, nil
+}
+
+func translatePolicy(
+	ctx context.Context,
+	name string,
+	policy *rbac.Policy,
+	jwtProviders []string,
+) (*envoy_config_rbac_v3.Policy, error) {
+	if len(policy.GetPrincipals()) == 0 {
+		return nil, NoPrincipalsError(name)
+	}
+
+	var principals []*envoy_config_rbac_v3.Principal
+	for _, principal := range policy.GetPrincipals() {
+		envoyPrincipal, err := translatePrincipal(ctx, name, policy.GetNestedClaimDelimiter(), principal, jwtProviders)
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, envoyPrincipal)
+	}
+	return &envoy_config_rbac_v3.Policy{
+		Permissions: []*envoy_config_rbac_v3.Permission{translatePermissions(policy.GetPermissions())},
+		Principals:  principals,
+	}, nil
+}
+
+// translatePermissions returns a permission that matches requests that match all the fields of the permissions
+func translatePermissions(permissions *rbac.Permissions) *envoy_config_rbac_v3.Permission {
+	var rules []*envoy_config_rbac_v3.Permission
+	if pathPrefix := permissions.GetPathPrefix(); pathPrefix != "" {
+		rules = append(rules, &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_UrlPath{
+				UrlPath: &envoy_type_matcher_v3.PathMatcher{
+					Rule: &envoy_type_matcher_v3.PathMatcher_Path{
+						Path: &envoy_type_matcher_v3.StringMatcher{
+							MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{
+								Prefix: pathPrefix,
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	if len(permissions.GetMethods()) > 0 {
+		var methods []*envoy_config_rbac_v3.Permission
+		for _, method := range permissions.GetMethods() {
+			methods = append(methods, &envoy_config_rbac_v3.Permission{
+				Rule: &envoy_config_rbac_v3.Permission_Header{
+					Header: &envoy_config_route_v3.HeaderMatcher{
+						Name: ":method",
+						HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+							ExactMatch: method,
+						},
+					},
+				},
+			})
+		}
+		rules = append(rules, orPermissions(methods))
+	}
+
+	switch len(rules) {
+	case 0:
+		return &envoy_config_rbac_v3.Permission{
+			Rule: &envoy_config_rbac_v3.Permission_Any{
+				Any: true,
+			},
+		}
+	case 1:
+		return rules[0]
+	}
+	return &envoy_config_rbac_v3.Permission{
+		Rule: &envoy_config_rbac_v3.Permission_AndRules{
+			AndRules: &envoy_config_rbac_v3.Permission_Set{
+				Rules: rules,
+			},
+		},
+	}
+}
+
+// translatePrincipal returns a principal that matches requests that match all the fields of the principal
+func translatePrincipal(
+	ctx context.Context,
+	policyName, nestedClaimDelimiter string,
+	principal *rbac.Principal,
+	jwtProviders []string,
+) (*envoy_config_rbac_v3.Principal, error) {
+	var ids []*envoy_config_rbac_v3.Principal
+
+	if jwtPrincipal := principal.GetJwtPrincipal(); jwtPrincipal != nil {
+		id, err := translateJwtPrincipal(policyName, nestedClaimDelimiter, jwtPrincipal, jwtProviders)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if clientCertificate := principal.GetClientCertificate(); clientCertificate != nil {
+		authenticated := &envoy_config_rbac_v3.Principal_Authenticated{}
+		if principalName := clientCertificate.GetPrincipalName(); principalName != "" {
+			authenticated.PrincipalName = &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{
+					Exact: principalName,
+				},
+			}
+		}
+		ids = append(ids, &envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_Authenticated_{
+				Authenticated: authenticated,
+			},
+		})
+	}
+
+	if sourceIp := principal.GetSourceIp(); sourceIp != nil {
+		if len(sourceIp.GetCidrRanges()) == 0 {
+			return nil, NoCidrRangesError(policyName)
+		}
+		var ranges []*envoy_config_rbac_v3.Principal
+		for _, cidrRange := range sourceIp.GetCidrRanges() {
+			envoyCidrRange := &envoy_config_core_v3.CidrRange{
+				AddressPrefix: cidrRange.GetAddressPrefix(),
+				PrefixLen:     cidrRange.GetPrefixLen(),
+			}
+			if sourceIp.GetDirectRemoteIp() {
+				ranges = append(ranges, &envoy_config_rbac_v3.Principal{
+					Identifier: &envoy_config_rbac_v3.Principal_DirectRemoteIp{
+						DirectRemoteIp: envoyCidrRange,
+					},
+				})
+			} else {
+				ranges = append(ranges, &envoy_config_rbac_v3.Principal{
+					Identifier: &envoy_config_rbac_v3.Principal_RemoteIp{
+						RemoteIp: envoyCidrRange,
+					},
+				})
+			}
+		}
+		ids = append(ids, orPrincipals(ranges))
+	}
+
+	if header := principal.GetHeader(); header != nil {
+		ids = append(ids, &envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_Header{
+				Header: translator.EnvoyHeaderMatchers(ctx, []*matchers.HeaderMatcher{header})[0],
+			},
+		})
+	}
+
+	if len(ids) == 0 {
+		return nil, EmptyPrincipalError(policyName)
+	}
+	return andPrincipals(ids), nil
+}
+
+// translateJwtPrincipal matches the claims in the payload of a token verified by the jwt_authn filter, which is
+// stored in its dynamic metadata under the name of the provider
+func translateJwtPrincipal(
+	policyName, nestedClaimDelimiter string,
+	jwtPrincipal *rbac.JWTPrincipal,
+	jwtProviders []string,
+) (*envoy_config_rbac_v3.Principal, error) {
+	providers := jwtProviders
+	if provider := jwtPrincipal.GetProvider(); provider != "" {
+		providers = []string{provider}
+	}
+	if len(providers) == 0 {
+		return nil, NoJwtProvidersError(policyName)
+	}
+
+	claimNames := make([]string, 0, len(jwtPrincipal.GetClaims()))
+	for claim := range jwtPrincipal.GetClaims() {
+		claimNames = append(claimNames, claim)
+	}
+	sort.Strings(claimNames)
+
+	var byProvider []*envoy_config_rbac_v3.Principal
+	for _, provider := range providers {
+		// without claims, any token verified by the provider matches
+		if len(claimNames) == 0 {
+			byProvider = append(byProvider, metadataPrincipal(provider, nil, &envoy_type_matcher_v3.ValueMatcher{
+				MatchPattern: &envoy_type_matcher_v3.ValueMatcher_PresentMatch{
+					PresentMatch: true,
+				},
+			}))
+			continue
+		}
+
+		var claims []*envoy_config_rbac_v3.Principal
+		for _, claim := range claimNames {
+			value := jwtPrincipal.GetClaims()[claim]
+			valueMatcher, err := claimValueMatcher(jwtPrincipal.GetMatcher(), value)
+			if err != nil {
+				return nil, InvalidBooleanClaimError(policyName, claim, value)
+			}
+			claimPath := []string{claim}
+			if nestedClaimDelimiter != "" {
+				claimPath = strings.Split(claim, nestedClaimDelimiter)
+			}
+			claims = append(claims, metadataPrincipal(provider, claimPath, valueMatcher))
+		}
+		byProvider = append(byProvider, andPrincipals(claims))
+	}
+	return orPrincipals(byProvider), nil
+}
+
+func metadataPrincipal(
+	provider string,
+	claimPath []string,
+	valueMatcher *envoy_type_matcher_v3.ValueMatcher,
+) *envoy_config_rbac_v3.Principal {
+	path := []*envoy_type_matcher_v3.MetadataMatcher_PathSegment{{
+		Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{
+			Key: provider,
+		},
+	}}
+	for _, key := range claimPath {
+		path = append(path, &envoy_type_matcher_v3.MetadataMatcher_PathSegment{
+			Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{
+				Key: key,
+			},
+		})
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_Metadata{
+			Metadata: &envoy_type_matcher_v3.MetadataMatcher{
+				Filter: jwt.FilterName,
+				Path:   path,
+				Value:  valueMatcher,
+			},
+		},
+	}
+}
+
+func claimValueMatcher(
+	matcher rbac.JWTPrincipal_ClaimMatcher,
+	value string,
+) (*envoy_type_matcher_v3.ValueMatcher, error) {
+	stringMatcher := &envoy_type_matcher_v3.ValueMatcher{
+		MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+			StringMatch: &envoy_type_matcher_v3.StringMatcher{
+				MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{
+					Exact: value,
+				},
+			},
+		},
+	}
+
+	switch matcher {
+	case rbac.JWTPrincipal_BOOLEAN:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_BoolMatch{
+				BoolMatch: boolValue,
+			},
+		}, nil
+	case rbac.JWTPrincipal_LIST_CONTAINS:
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_ListMatch{
+				ListMatch: &envoy_type_matcher_v3.ListMatcher{
+					MatchPattern: &envoy_type_matcher_v3.ListMatcher_OneOf{
+						OneOf: stringMatcher,
+					},
+				},
+			},
+		}, nil
+	}
+	return stringMatcher, nil
+}
+
+func andPrincipals(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+			AndIds: &envoy_config_rbac_v3.Principal_Set{
+				Ids: ids,
+			},
+		},
+	}
+}
+
+func orPrincipals(ids []*envoy_config_rbac_v3.Principal) *envoy_config_rbac_v3.Principal {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return &envoy_config_rbac_v3.Principal{
+		Identifier: &envoy_config_rbac_v3.Principal_OrIds{
+			OrIds: &envoy_config_rbac_v3.Principal_Set{
+				Ids: ids,
+			},
+		},
+	}
+}
+
+func orPermissions(rules []*envoy_config_rbac_v3.Permission) *envoy_config_rbac_v3.Permission {
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return &envoy_config_rbac_v3.Permission{
+		Rule: &envoy_config_rbac_v3.Permission_OrRules{
+			OrRules: &envoy_config_rbac_v3.Permission_Set{
+				Rules: rules,
+			},
+		},
+	}
+}
@@ -0,0 +1,448 @@
+package rbac_test
+
+import (
+	"context"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	solocorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloomatchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/jwt"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/enterprise/options/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	jwtplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		ctx      context.Context
+		listener *v1.HttpListener
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		listener = &v1.HttpListener{}
+	})
+
+	initPlugin := func(settings *v1.Settings) plugins.Plugin {
+		p := NewPlugin()
+		p.Init(plugins.InitParams{Ctx: ctx, Settings: settings})
+		return p
+	}
+
+	processVirtualHost := func(p plugins.Plugin, options *v1.VirtualHostOptions) (*envoyrbac.RBACPerRoute, error) {
+		out := &envoy_config_route_v3.VirtualHost{}
+		err := p.(plugins.VirtualHostPlugin).ProcessVirtualHost(plugins.VirtualHostParams{
+			Params:       plugins.Params{Ctx: ctx},
+			HttpListener: listener,
+		}, &v1.VirtualHost{Name: "vhost", Options: options}, out)
+		if err != nil {
+			return nil, err
+		}
+		msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+		Expect(err).NotTo(HaveOccurred())
+		return msg.(*envoyrbac.RBACPerRoute), nil
+	}
+
+	jwtMetadataPrincipal := func(value *envoy_type_matcher_v3.ValueMatcher, path ...string) *envoy_config_rbac_v3.Principal {
+		var segments []*envoy_type_matcher_v3.MetadataMatcher_PathSegment
+		for _, key := range path {
+			segments = append(segments, &envoy_type_matcher_v3.MetadataMatcher_PathSegment{
+				Segment: &envoy_type_matcher_v3.MetadataMatcher_PathSegment_Key{Key: key},
+			})
+		}
+		return &envoy_config_rbac_v3.Principal{
+			Identifier: &envoy_config_rbac_v3.Principal_Metadata{
+				Metadata: &envoy_type_matcher_v3.MetadataMatcher{
+					Filter: jwtplugin.FilterName,
+					Path:   segments,
+					Value:  value,
+				},
+			},
+		}
+	}
+
+	exactValue := func(value string) *envoy_type_matcher_v3.ValueMatcher {
+		return &envoy_type_matcher_v3.ValueMatcher{
+			MatchPattern: &envoy_type_matcher_v3.ValueMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: value},
+				},
+			},
+		}
+	}
+
+	Context("HttpFilters", func() {
+
+		It("does not add the filter when rbac is unused", func() {
+			p := initPlugin(&v1.Settings{})
+			filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+		})
+
+		It("adds the filter when rbac is used on the listener", func() {
+			p := initPlugin(&v1.Settings{})
+			_, err := processVirtualHost(p, &v1.VirtualHostOptions{
+				Rbac: &rbac.ExtensionSettings{Disable: true},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+			msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyrbac.RBAC{}))
+		})
+
+		It("denies all requests by default when rbac is required", func() {
+			p := initPlugin(&v1.Settings{
+				Rbac: &rbac.Settings{RequireRbac: true},
+			})
+			filters, err := p.(plugins.HttpFilterPlugin).HttpFilters(plugins.Params{}, listener)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg).To(matchers.MatchProto(&envoyrbac.RBAC{
+				Rules: &envoy_config_rbac_v3.RBAC{Action: envoy_config_rbac_v3.RBAC_ALLOW},
+			}))
+		})
+	})
+
+	Context("ProcessVirtualHost", func() {
+
+		It("disables the filter", func() {
+			perRoute, err := processVirtualHost(initPlugin(&v1.Settings{}), &v1.VirtualHostOptions{
+				Rbac: &rbac.ExtensionSettings{Disable: true},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRoute).To(matchers.MatchProto(&envoyrbac.RBACPerRoute{}))
+		})
+
+		It("translates jwt principals for the providers of the virtual host", func() {
+			perRoute, err := processVirtualHost(initPlugin(&v1.Settings{}), &v1.VirtualHostOptions{
+				JwtConfig: &v1.VirtualHostOptions_Jwt{
+					Jwt: &jwt.VhostExtension{
+						Providers: map[string]*jwt.Provider{
+							"first":  {},
+							"second": {},
+						},
+					},
+				},
+				Rbac: &rbac.ExtensionSettings{
+					Policies: map[string]*rbac.Policy{
+						"admins": {
+							NestedClaimDelimiter: ".",
+							Principals: []*rbac.Principal{{
+								JwtPrincipal: &rbac.JWTPrincipal{
+									Claims: map[string]string{
+										"iss":           "issuer",
+										"metadata.role": "admin",
+									},
+								},
+							}},
+							Permissions: &rbac.Permissions{
+								PathPrefix: "/admin",
+								Methods:    []string{"GET", "POST"},
+							},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			claimsOf := func(provider string) *envoy_config_rbac_v3.Principal {
+				return &envoy_config_rbac_v3.Principal{
+					Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+						AndIds: &envoy_config_rbac_v3.Principal_Set{
+							Ids: []*envoy_config_rbac_v3.Principal{
+								jwtMetadataPrincipal(exactValue("issuer"), provider, "iss"),
+								jwtMetadataPrincipal(exactValue("admin"), provider, "metadata", "role"),
+							},
+						},
+					},
+				}
+			}
+			method := func(method string) *envoy_config_rbac_v3.Permission {
+				return &envoy_config_rbac_v3.Permission{
+					Rule: &envoy_config_rbac_v3.Permission_Header{
+						Header: &envoy_config_route_v3.HeaderMatcher{
+							Name:                 ":method",
+							HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: method},
+						},
+					},
+				}
+			}
+			Expect(perRoute).To(matchers.MatchProto(&envoyrbac.RBACPerRoute{
+				Rbac: &envoyrbac.RBAC{
+					Rules: &envoy_config_rbac_v3.RBAC{
+						Action: envoy_config_rbac_v3.RBAC_ALLOW,
+						Policies: map[string]*envoy_config_rbac_v3.Policy{
+							"admins": {
+								Permissions: []*envoy_config_rbac_v3.Permission{{
+									Rule: &envoy_config_rbac_v3.Permission_AndRules{
+										AndRules: &envoy_config_rbac_v3.Permission_Set{
+											Rules: []*envoy_config_rbac_v3.Permission{
+												{
+													Rule: &envoy_config_rbac_v3.Permission_UrlPath{
+														UrlPath: &envoy_type_matcher_v3.PathMatcher{
+															Rule: &envoy_type_matcher_v3.PathMatcher_Path{
+																Path: &envoy_type_matcher_v3.StringMatcher{
+																	MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "/admin"},
+																},
+															},
+														},
+													},
+												},
+												{
+													Rule: &envoy_config_rbac_v3.Permission_OrRules{
+														OrRules: &envoy_config_rbac_v3.Permission_Set{
+															Rules: []*envoy_config_rbac_v3.Permission{method("GET"), method("POST")},
+														},
+													},
+												},
+											},
+										},
+									},
+								}},
+								Principals: []*envoy_config_rbac_v3.Principal{{
+									Identifier: &envoy_config_rbac_v3.Principal_OrIds{
+										OrIds: &envoy_config_rbac_v3.Principal_Set{
+											Ids: []*envoy_config_rbac_v3.Principal{claimsOf("first"), claimsOf("second")},
+										},
+									},
+								}},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("translates client certificate, source ip and header principals", func() {
+			perRoute, err := processVirtualHost(initPlugin(&v1.Settings{}), &v1.VirtualHostOptions{
+				Rbac: &rbac.ExtensionSettings{
+					Policies: map[string]*rbac.Policy{
+						"internal": {
+							Principals: []*rbac.Principal{
+								{
+									ClientCertificate: &rbac.ClientCertificatePrincipal{
+										PrincipalName: "spiffe://cluster.local/ns/default/sa/client",
+									},
+								},
+								{
+									SourceIp: &rbac.SourceIpPrincipal{
+										CidrRanges: []*solocorev3.CidrRange{{
+											AddressPrefix: "10.0.0.0",
+											PrefixLen:     wrapperspb.UInt32(8),
+										}},
+										DirectRemoteIp: true,
+									},
+									Header: &gloomatchers.HeaderMatcher{
+										Name:  "x-internal",
+										Value: "true",
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRoute.GetRbac().GetRules().GetPolicies()["internal"]).To(matchers.MatchProto(&envoy_config_rbac_v3.Policy{
+				Permissions: []*envoy_config_rbac_v3.Permission{{
+					Rule: &envoy_config_rbac_v3.Permission_Any{Any: true},
+				}},
+				Principals: []*envoy_config_rbac_v3.Principal{
+					{
+						Identifier: &envoy_config_rbac_v3.Principal_Authenticated_{
+							Authenticated: &envoy_config_rbac_v3.Principal_Authenticated{
+								PrincipalName: &envoy_type_matcher_v3.StringMatcher{
+									MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{
+										Exact: "spiffe://cluster.local/ns/default/sa/client",
+									},
+								},
+							},
+						},
+					},
+					{
+						Identifier: &envoy_config_rbac_v3.Principal_AndIds{
+							AndIds: &envoy_config_rbac_v3.Principal_Set{
+								Ids: []*envoy_config_rbac_v3.Principal{
+									{
+										Identifier: &envoy_config_rbac_v3.Principal_DirectRemoteIp{
+											DirectRemoteIp: &envoy_config_core_v3.CidrRange{
+												AddressPrefix: "10.0.0.0",
+												PrefixLen:     wrapperspb.UInt32(8),
+											},
+										},
+									},
+									{
+										Identifier: &envoy_config_rbac_v3.Principal_Header{
+											Header: &envoy_config_route_v3.HeaderMatcher{
+												Name:                 "x-internal",
+												HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("does not enforce shadow policies", func() {
+			perRoute, err := processVirtualHost(initPlugin(&v1.Settings{}), &v1.VirtualHostOptions{
+				Rbac: &rbac.ExtensionSettings{
+					ShadowPolicies: map[string]*rbac.Policy{
+						"dry-run": {
+							Principals: []*rbac.Principal{{
+								JwtPrincipal: &rbac.JWTPrincipal{
+									Provider: "provider",
+									Claims:   map[string]string{"admin": "true"},
+									Matcher:  rbac.JWTPrincipal_BOOLEAN,
+								},
+							}},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRoute.GetRbac().GetRules()).To(BeNil())
+			Expect(perRoute.GetRbac().GetShadowRules().GetPolicies()["dry-run"].GetPrincipals()).To(matchers.ConsistOfProtos(
+				jwtMetadataPrincipal(&envoy_type_matcher_v3.ValueMatcher{
+					MatchPattern: &envoy_type_matcher_v3.ValueMatcher_BoolMatch{BoolMatch: true},
+				}, "provider", "admin"),
+			))
+		})
+
+		It("matches any verified token of the provider when a jwt principal has no claims", func() {
+			perRoute, err := processVirtualHost(initPlugin(&v1.Settings{}), &v1.VirtualHostOptions{
+				Rbac: &rbac.ExtensionSettings{
+					Policies: map[string]*rbac.Policy{
+						"authenticated": {
+							Principals: []*rbac.Principal{{
+								JwtPrincipal: &rbac.JWTPrincipal{Provider: "provider"},
+							}},
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRoute.GetRbac().GetRules().GetPolicies()["authenticated"].GetPrincipals()).To(matchers.ConsistOfProtos(
+				jwtMetadataPrincipal(&envoy_type_matcher_v3.ValueMatcher{
+					MatchPattern: &envoy_type_matcher_v3.ValueMatcher_PresentMatch{PresentMatch: true},
+				}, "provider"),
+			))
+		})
+
+		It("denies all requests when no policy is set", func() {
+			perRoute, err := processVirtualHost(initPlugin(&v1.Settings{}), &v1.VirtualHostOptions{
+				Rbac: &rbac.ExtensionSettings{},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(perRoute).To(matchers.MatchProto(&envoyrbac.RBACPerRoute{
+				Rbac: &envoyrbac.RBAC{
+					Rules: &envoy_config_rbac_v3.RBAC{Action: envoy_config_rbac_v3.RBAC_ALLOW},
+				},
+			}))
+		})
+
+		DescribeTable("invalid policies",
+			func(policy *rbac.Policy, expectedErr error) {
+				_, err := processVirtualHost(initPlugin(&v1.Settings{}), &v1.VirtualHostOptions{
+					Rbac: &rbac.ExtensionSettings{
+						Policies: map[string]*rbac.Policy{"policy": policy},
+					},
+				})
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("without principals", &rbac.Policy{}, NoPrincipalsError("policy")),
+			Entry("with an empty principal", &rbac.Policy{
+				Principals: []*rbac.Principal{{}},
+			}, EmptyPrincipalError("policy")),
+			Entry("with a source ip principal without ranges", &rbac.Policy{
+				Principals: []*rbac.Principal{{SourceIp: &rbac.SourceIpPrincipal{}}},
+			}, NoCidrRangesError("policy")),
+			Entry("with a jwt principal without providers", &rbac.Policy{
+				Principals: []*rbac.Principal{{JwtPrincipal: &rbac.JWTPrincipal{}}},
+			}, NoJwtProvidersError("policy")),
+			Entry("with an invalid boolean claim", &rbac.Policy{
+				Principals: []*rbac.Principal{{
+					JwtPrincipal: &rbac.JWTPrincipal{
+						Provider: "provider",
+						Claims:   map[string]string{"admin": "yes"},
+						Matcher:  rbac.JWTPrincipal_BOOLEAN,
+					},
+				}},
+			}, InvalidBooleanClaimError("policy", "admin", "yes")),
+		)
+	})
+
+	Context("ProcessRoute", func() {
+
+		It("uses the jwt providers of the virtual host", func() {
+			out := &envoy_config_route_v3.Route{
+				Action: &envoy_config_route_v3.Route_Route{Route: &envoy_config_route_v3.RouteAction{}},
+			}
+			err := initPlugin(&v1.Settings{}).(plugins.RoutePlugin).ProcessRoute(plugins.RouteParams{
+				VirtualHostParams: plugins.VirtualHostParams{
+					Params:       plugins.Params{Ctx: ctx},
+					HttpListener: listener,
+				},
+				VirtualHost: &v1.VirtualHost{
+					Options: &v1.VirtualHostOptions{
+						JwtConfig: &v1.VirtualHostOptions_Jwt{
+							Jwt: &jwt.VhostExtension{
+								Providers: map[string]*jwt.Provider{"provider": {}},
+							},
+						},
+					},
+				},
+			}, &v1.Route{
+				Options: &v1.RouteOptions{
+					Rbac: &rbac.ExtensionSettings{
+						Policies: map[string]*rbac.Policy{
+							"users": {
+								Principals: []*rbac.Principal{{
+									JwtPrincipal: &rbac.JWTPrincipal{
+										Claims:  map[string]string{"groups": "users"},
+										Matcher: rbac.JWTPrincipal_LIST_CONTAINS,
+									},
+								}},
+							},
+						},
+					},
+				},
+			}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err := utils.AnyToMessage(out.GetTypedPerFilterConfig()[FilterName])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(msg.(*envoyrbac.RBACPerRoute).GetRbac().GetRules().GetPolicies()["users"].GetPrincipals()).To(matchers.ConsistOfProtos(
+				jwtMetadataPrincipal(&envoy_type_matcher_v3.ValueMatcher{
+					MatchPattern: &envoy_type_matcher_v3.ValueMatcher_ListMatch{
+						ListMatch: &envoy_type_matcher_v3.ListMatcher{
+							MatchPattern: &envoy_type_matcher_v3.ListMatcher_OneOf{OneOf: exactValue("users")},
+						},
+					},
+				}, "provider", "groups"),
+			))
+		})
+	})
+})
@@ -8,12 +8,18 @@ import (
 
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/adaptive_concurrency"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/admission_control"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/als"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/aws/ec2"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/azure"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/basicroute"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/buffer"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/caching"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/client_cert"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/coldstart"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/compression"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/connection_limit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/cors"
@@ -22,6 +28,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/dynamic_forward_proxy"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/enterprise_warning"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extauth"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/extproc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/faultinjection"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpc"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/grpcjson"
@@ -31,6 +38,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/headers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/healthcheck"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/istio_integration"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/jwt"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/linkerd"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/listener"
@@ -41,10 +49,12 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/protocoloptions"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/proxyprotocol"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/ratelimit"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rbac"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/rest"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/static"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/stats"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tcp"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tls_inspector"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tracing"
@@ -52,6 +62,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/tunneling"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/upstreamconn"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/virtualhost"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
 	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 	"github.com/solo-io/go-utils/contextutils"
 )
@@ -98,6 +109,11 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		extauth.NewPlugin(),
 		ratelimit.NewPlugin(),
 		gzip.NewPlugin(),
+		compression.NewPlugin(),
+		adaptive_concurrency.NewPlugin(),
+		admission_control.NewPlugin(),
+		caching.NewPlugin(),
+		client_cert.NewPlugin(),
 		buffer.NewPlugin(),
 		csrf.NewPlugin(),
 		listener.NewPlugin(),
@@ -106,9 +122,15 @@ func Plugins(opts bootstrap.Opts) []plugins.Plugin {
 		grpcjson.NewPlugin(),
 		metadata.NewPlugin(),
 		tunneling.NewPlugin(),
+		coldstart.NewPlugin(),
 		dynamic_forward_proxy.NewPlugin(),
 		deprecated_cipher_passthrough.NewPlugin(),
 		local_ratelimit.NewPlugin(),
+		jwt.NewPlugin(),
+		rbac.NewPlugin(),
+		extproc.NewPlugin(),
+		wasm.NewPlugin(opts.WasmImageCache),
+		tap.NewPlugin(),
 	)
 
 	if opts.KubeClient != nil {
@@ -1,37 +1,84 @@
 package shadowing
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_common_mutation_rules_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_header_mutation_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoy_extensions_filters_http_upstream_codec_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/upstream_codec/v3"
+	envoy_extensions_filters_network_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_extensions_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/internal/common"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/pluginutils"
 	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
 )
 
 var (
-	_ plugins.Plugin      = new(plugin)
-	_ plugins.RoutePlugin = new(plugin)
+	_ plugins.Plugin                  = new(plugin)
+	_ plugins.RoutePlugin             = new(plugin)
+	_ plugins.ResourceGeneratorPlugin = new(plugin)
 )
 
 const (
 	ExtensionName = "shadowing"
+	// MaxTargetsWithHeaders is the maximum number of targets with headers of a route, as the route is copied for each
+	// combination of these targets
+	MaxTargetsWithHeaders = 4
+
+	httpProtocolOptionsName  = "envoy.extensions.upstreams.http.v3.HttpProtocolOptions"
+	headerMutationFilterName = "envoy.filters.http.header_mutation"
+	upstreamCodecFilterName  = "envoy.filters.http.upstream_codec"
 )
 
 var (
-	InvalidRouteActionError  = eris.New("cannot use shadowing plugin on non-Route_Route route actions")
-	UnspecifiedUpstreamError = eris.New("invalid plugin spec: must specify an upstream ref")
-	InvalidNumeratorError    = func(num float32) error {
+	InvalidRouteActionError        = eris.New("cannot use shadowing plugin on non-Route_Route route actions")
+	UnspecifiedUpstreamError       = eris.New("invalid plugin spec: must specify an upstream ref")
+	UpstreamAndTargetsError        = eris.New("invalid plugin spec: cannot specify both an upstream ref and a list of targets")
+	UnspecifiedTargetError         = eris.New("invalid plugin spec: each target must specify an upstream ref")
+	TooManyTargetsWithHeadersError = eris.Errorf("invalid plugin spec: a route cannot have more than %d targets with headers", MaxTargetsWithHeaders)
+	InvalidNumeratorError          = func(num float32) error {
 		return eris.Errorf("shadow percentage must be between 0 and 100, received %v", num)
 	}
 )
 
-type plugin struct{}
+type plugin struct {
+	// the mirror policies of the targets with headers, by the route they belong to
+	triggeredPolicies map[*envoy_config_route_v3.Route][]*triggeredPolicy
+	// the shadow clusters to generate, by name
+	shadowClusters map[string]*shadowCluster
+}
+
+// a mirror policy which only applies to the requests which match the headers
+type triggeredPolicy struct {
+	headers []*envoy_config_route_v3.HeaderMatcher
+	policy  *envoy_config_route_v3.RouteAction_RequestMirrorPolicy
+}
+
+// a copy of the cluster of an upstream, to which a target mirrors
+type shadowCluster struct {
+	upstreamCluster string
+	hostRewrite     string
+}
 
 func NewPlugin() *plugin {
-	return &plugin{}
+	p := &plugin{}
+	p.Init(plugins.InitParams{})
+	return p
 }
 
 func (p *plugin) Name() string {
@@ -39,6 +86,8 @@ func (p *plugin) Name() string {
 }
 
 func (p *plugin) Init(_ plugins.InitParams) {
+	p.triggeredPolicies = map[*envoy_config_route_v3.Route][]*triggeredPolicy{}
+	p.shadowClusters = map[string]*shadowCluster{}
 }
 
 func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *envoy_config_route_v3.Route) error {
@@ -60,6 +109,12 @@ func (p *plugin) ProcessRoute(params plugins.RouteParams, in *v1.Route, out *env
 		}
 		outRa = out.GetRoute()
 	}
+	if len(shadowSpec.GetTargets()) > 0 {
+		if shadowSpec.GetUpstream() != nil {
+			return UpstreamAndTargetsError
+		}
+		return p.applyShadowTargets(params, out, shadowSpec.GetTargets())
+	}
 	return applyShadowSpec(outRa, shadowSpec)
 }
 
@@ -79,6 +134,215 @@ func applyShadowSpec(out *envoy_config_route_v3.RouteAction, spec *shadowing.Rou
 	return nil
 }
 
+// applyShadowTargets mirrors the route to the shadow clusters of the targets. The targets with headers are applied
+// to copies of the route by GeneratedResources.
+func (p *plugin) applyShadowTargets(params plugins.RouteParams, out *envoy_config_route_v3.Route, targets []*shadowing.ShadowTarget) error {
+	var policies []*envoy_config_route_v3.RouteAction_RequestMirrorPolicy
+	var triggered []*triggeredPolicy
+	for _, target := range targets {
+		if target.GetUpstream() == nil {
+			return UnspecifiedTargetError
+		}
+		if target.GetPercentage() < 0 || target.GetPercentage() > 100 {
+			return InvalidNumeratorError(target.GetPercentage())
+		}
+		upstreamCluster := translator.UpstreamToClusterName(target.GetUpstream())
+		clusterName := ShadowClusterName(upstreamCluster, target.GetHostRewrite())
+		p.shadowClusters[clusterName] = &shadowCluster{
+			upstreamCluster: upstreamCluster,
+			hostRewrite:     target.GetHostRewrite(),
+		}
+
+		policy := &envoy_config_route_v3.RouteAction_RequestMirrorPolicy{
+			Cluster:         clusterName,
+			RuntimeFraction: getFractionalPercent(target.GetPercentage()),
+			TraceSampled:    target.GetTraceSampled(),
+		}
+		policy.GetRuntimeFraction().RuntimeKey = target.GetRuntimeKey()
+		if len(target.GetHeaders()) == 0 {
+			policies = append(policies, policy)
+			continue
+		}
+		triggered = append(triggered, &triggeredPolicy{
+			headers: translator.EnvoyHeaderMatchers(params.Ctx, target.GetHeaders()),
+			policy:  policy,
+		})
+	}
+	if len(triggered) > MaxTargetsWithHeaders {
+		return TooManyTargetsWithHeadersError
+	}
+	out.GetRoute().RequestMirrorPolicies = policies
+	if len(triggered) > 0 {
+		p.triggeredPolicies[out] = triggered
+	}
+	return nil
+}
+
+// ShadowClusterName returns the name of the cluster to which a target mirrors the requests for the given upstream
+// cluster and host rewrite
+func ShadowClusterName(upstreamCluster, hostRewrite string) string {
+	name := upstreamCluster + "_shadow"
+	if hostRewrite != "" {
+		// dots delimit the names of the stats of the cluster
+		name += "_" + strings.NewReplacer(".", "_", ":", "_").Replace(hostRewrite)
+	}
+	return name
+}
+
+// GeneratedResources copies the routes with targets with headers for each combination of these targets, and generates
+// the shadow clusters of the targets
+func (p *plugin) GeneratedResources(params plugins.Params,
+	inClusters []*envoy_config_cluster_v3.Cluster,
+	inEndpoints []*envoy_config_endpoint_v3.ClusterLoadAssignment,
+	inRouteConfigurations []*envoy_config_route_v3.RouteConfiguration,
+	inListeners []*envoy_config_listener_v3.Listener,
+) ([]*envoy_config_cluster_v3.Cluster, []*envoy_config_endpoint_v3.ClusterLoadAssignment, []*envoy_config_route_v3.RouteConfiguration, []*envoy_config_listener_v3.Listener, error) {
+
+	if len(p.triggeredPolicies) > 0 {
+		for _, rtConfig := range inRouteConfigurations {
+			for _, vh := range rtConfig.GetVirtualHosts() {
+				var routes []*envoy_config_route_v3.Route
+				for _, rt := range vh.GetRoutes() {
+					routes = append(routes, triggeredRoutes(rt, p.triggeredPolicies[rt])...)
+					routes = append(routes, rt)
+				}
+				vh.Routes = routes
+			}
+		}
+	}
+
+	if len(p.shadowClusters) == 0 {
+		return nil, nil, nil, nil, nil
+	}
+	clustersByName := make(map[string]*envoy_config_cluster_v3.Cluster, len(inClusters))
+	for _, c := range inClusters {
+		clustersByName[c.GetName()] = c
+	}
+	var generated []*envoy_config_cluster_v3.Cluster
+	var errs error
+	for _, name := range sortedKeys(p.shadowClusters) {
+		shadow := p.shadowClusters[name]
+		upstreamCluster, ok := clustersByName[shadow.upstreamCluster]
+		if !ok {
+			// the missing upstream is reported by the route of the target
+			continue
+		}
+		out, err := shadow.generate(name, upstreamCluster)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		generated = append(generated, out)
+	}
+	return generated, nil, nil, nil, errs
+}
+
+// triggeredRoutes returns a copy of the route for each combination of the policies, which matches the headers of the
+// policies in addition to the route, from the largest combinations to the smallest, so that a request matches the
+// copy with all the policies whose headers it matches
+func triggeredRoutes(rt *envoy_config_route_v3.Route, policies []*triggeredPolicy) []*envoy_config_route_v3.Route {
+	var combinations [][]int
+	for set := (1 << len(policies)) - 1; set > 0; set-- {
+		var combination []int
+		for i := range policies {
+			if set&(1<<i) != 0 {
+				combination = append(combination, i)
+			}
+		}
+		combinations = append(combinations, combination)
+	}
+	sort.SliceStable(combinations, func(i, j int) bool {
+		return len(combinations[i]) > len(combinations[j])
+	})
+
+	out := make([]*envoy_config_route_v3.Route, 0, len(combinations))
+	for _, combination := range combinations {
+		copied := proto.Clone(rt).(*envoy_config_route_v3.Route)
+		var suffix []string
+		for _, i := range combination {
+			copied.GetMatch().Headers = append(copied.GetMatch().GetHeaders(), policies[i].headers...)
+			copied.GetRoute().RequestMirrorPolicies = append(copied.GetRoute().GetRequestMirrorPolicies(), policies[i].policy)
+			suffix = append(suffix, strconv.Itoa(i))
+		}
+		if copied.GetName() != "" {
+			copied.Name = fmt.Sprintf("%s-shadow-%s", copied.GetName(), strings.Join(suffix, "-"))
+		}
+		out = append(out, copied)
+	}
+	return out
+}
+
+// generate returns a copy of the upstream cluster with the given name, which rewrites the host of the requests if
+// the shadow cluster has a host rewrite. The copy shares the endpoints of the upstream cluster.
+func (s *shadowCluster) generate(name string, upstreamCluster *envoy_config_cluster_v3.Cluster) (*envoy_config_cluster_v3.Cluster, error) {
+	out := proto.Clone(upstreamCluster).(*envoy_config_cluster_v3.Cluster)
+	out.Name = name
+	out.AltStatName = ""
+	if out.GetLoadAssignment() != nil {
+		out.GetLoadAssignment().ClusterName = name
+	}
+	if s.hostRewrite == "" {
+		return out, nil
+	}
+
+	httpProtocolOptions := &envoy_extensions_upstreams_http_v3.HttpProtocolOptions{}
+	if existing, ok := out.GetTypedExtensionProtocolOptions()[httpProtocolOptionsName]; ok {
+		if err := existing.UnmarshalTo(httpProtocolOptions); err != nil {
+			return nil, eris.Wrapf(err, "reading the protocol options of the cluster %v", upstreamCluster.GetName())
+		}
+	} else {
+		httpProtocolOptions.UpstreamProtocolOptions = &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_extensions_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+					HttpProtocolOptions: &envoy_config_core_v3.Http1ProtocolOptions{},
+				},
+			},
+		}
+	}
+	headerMutation, err := utils.MessageToAny(&envoy_extensions_filters_http_header_mutation_v3.HeaderMutation{
+		Mutations: &envoy_extensions_filters_http_header_mutation_v3.Mutations{
+			RequestMutations: []*envoy_config_common_mutation_rules_v3.HeaderMutation{{
+				Action: &envoy_config_common_mutation_rules_v3.HeaderMutation_Append{
+					Append: &envoy_config_core_v3.HeaderValueOption{
+						Header:       &envoy_config_core_v3.HeaderValue{Key: ":authority", Value: s.hostRewrite},
+						AppendAction: envoy_config_core_v3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+					},
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	upstreamCodec, err := utils.MessageToAny(&envoy_extensions_filters_http_upstream_codec_v3.UpstreamCodec{})
+	if err != nil {
+		return nil, err
+	}
+	httpProtocolOptions.HttpFilters = append(httpProtocolOptions.GetHttpFilters(),
+		&envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter{
+			Name:       headerMutationFilterName,
+			ConfigType: &envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter_TypedConfig{TypedConfig: headerMutation},
+		},
+		&envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter{
+			Name:       upstreamCodecFilterName,
+			ConfigType: &envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter_TypedConfig{TypedConfig: upstreamCodec},
+		},
+	)
+	if err := pluginutils.SetExtensionProtocolOptions(out, httpProtocolOptionsName, httpProtocolOptions); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func sortedKeys(shadowClusters map[string]*shadowCluster) []string {
+	keys := make([]string, 0, len(shadowClusters))
+	for name := range shadowClusters {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func getFractionalPercent(numerator float32) *envoy_config_core_v3.RuntimeFractionalPercent {
 	return &envoy_config_core_v3.RuntimeFractionalPercent{
 		DefaultValue: common.ToEnvoyPercentage(numerator),
@@ -1,13 +1,22 @@
 package shadowing
 
 import (
+	"context"
+	"fmt"
+
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_header_mutation_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoy_extensions_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/shadowing"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	. "github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 )
@@ -142,6 +151,232 @@ var _ = Describe("Plugin", func() {
 		Expect(err).To(HaveInErrorChain(UnspecifiedUpstreamError))
 	})
 
+	Context("targets", func() {
+
+		var (
+			p       *plugin
+			primary *core.ResourceRef
+		)
+
+		BeforeEach(func() {
+			p = NewPlugin()
+			p.Init(plugins.InitParams{Ctx: context.Background()})
+			primary = &core.ResourceRef{Name: "primary", Namespace: "default"}
+		})
+
+		upstreamCluster := func(name string) *envoy_config_cluster_v3.Cluster {
+			return &envoy_config_cluster_v3.Cluster{
+				Name: name,
+				ClusterDiscoveryType: &envoy_config_cluster_v3.Cluster_Type{
+					Type: envoy_config_cluster_v3.Cluster_EDS,
+				},
+				EdsClusterConfig: &envoy_config_cluster_v3.Cluster_EdsClusterConfig{ServiceName: name + "-hash"},
+			}
+		}
+
+		// translates the route with the targets, and returns its routes and the generated clusters
+		translate := func(targets ...*shadowing.ShadowTarget) ([]*envoy_config_route_v3.Route, []*envoy_config_cluster_v3.Cluster) {
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					Shadowing: &shadowing.RouteShadowing{Targets: targets},
+				},
+			}
+			out := &envoy_config_route_v3.Route{
+				Name:  "route",
+				Match: &envoy_config_route_v3.RouteMatch{PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{Prefix: "/"}},
+				Action: &envoy_config_route_v3.Route_Route{Route: &envoy_config_route_v3.RouteAction{
+					ClusterSpecifier: &envoy_config_route_v3.RouteAction_Cluster{Cluster: translator.UpstreamToClusterName(primary)},
+				}},
+			}
+			err := p.ProcessRoute(plugins.RouteParams{VirtualHostParams: plugins.VirtualHostParams{Params: plugins.Params{Ctx: context.Background()}}}, in, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			routeConfig := &envoy_config_route_v3.RouteConfiguration{
+				VirtualHosts: []*envoy_config_route_v3.VirtualHost{{Routes: []*envoy_config_route_v3.Route{out}}},
+			}
+			clusters := []*envoy_config_cluster_v3.Cluster{upstreamCluster("primary_default")}
+			for _, target := range targets {
+				clusters = append(clusters, upstreamCluster(translator.UpstreamToClusterName(target.GetUpstream())))
+			}
+			generated, _, _, _, err := p.GeneratedResources(plugins.Params{}, clusters, nil, []*envoy_config_route_v3.RouteConfiguration{routeConfig}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			return routeConfig.GetVirtualHosts()[0].GetRoutes(), generated
+		}
+
+		It("should mirror to the shadow cluster of each target", func() {
+			routes, clusters := translate(
+				&shadowing.ShadowTarget{
+					Upstream:     &core.ResourceRef{Name: "some-upstream", Namespace: "default"},
+					Percentage:   50,
+					RuntimeKey:   "shadow.some-upstream",
+					TraceSampled: &wrappers.BoolValue{Value: false},
+				},
+				&shadowing.ShadowTarget{
+					Upstream:   &core.ResourceRef{Name: "other-upstream", Namespace: "default"},
+					Percentage: 100,
+				},
+			)
+			Expect(routes).To(HaveLen(1))
+			policies := routes[0].GetRoute().GetRequestMirrorPolicies()
+			Expect(policies).To(HaveLen(2))
+
+			Expect(policies[0].GetCluster()).To(Equal("some-upstream_default_shadow"))
+			checkFraction(policies[0].GetRuntimeFraction(), 50)
+			Expect(policies[0].GetRuntimeFraction().GetRuntimeKey()).To(Equal("shadow.some-upstream"))
+			Expect(policies[0].GetTraceSampled().GetValue()).To(BeFalse())
+
+			Expect(policies[1].GetCluster()).To(Equal("other-upstream_default_shadow"))
+			checkFraction(policies[1].GetRuntimeFraction(), 100)
+			Expect(policies[1].GetTraceSampled()).To(BeNil())
+
+			// the shadow clusters are copies of the clusters of the upstreams, which share their endpoints
+			Expect(clusters).To(HaveLen(2))
+			Expect(clusters[0].GetName()).To(Equal("other-upstream_default_shadow"))
+			Expect(clusters[0].GetEdsClusterConfig().GetServiceName()).To(Equal("other-upstream_default-hash"))
+			Expect(clusters[0].GetTypedExtensionProtocolOptions()).To(BeEmpty())
+			Expect(clusters[1].GetName()).To(Equal("some-upstream_default_shadow"))
+		})
+
+		It("should only mirror the requests which match the headers of the targets", func() {
+			routes, _ := translate(
+				&shadowing.ShadowTarget{
+					Upstream:   &core.ResourceRef{Name: "always", Namespace: "default"},
+					Percentage: 100,
+				},
+				&shadowing.ShadowTarget{
+					Upstream:   &core.ResourceRef{Name: "v2", Namespace: "default"},
+					Headers:    []*matchers.HeaderMatcher{{Name: "x-shadow-v2", Value: "true"}},
+					Percentage: 100,
+				},
+				&shadowing.ShadowTarget{
+					Upstream:   &core.ResourceRef{Name: "v3", Namespace: "default"},
+					Headers:    []*matchers.HeaderMatcher{{Name: "x-shadow-v3"}},
+					Percentage: 100,
+				},
+			)
+
+			type routeSummary struct {
+				Name     string
+				Headers  []string
+				Clusters []string
+			}
+			var summaries []routeSummary
+			for _, rt := range routes {
+				summary := routeSummary{Name: rt.GetName()}
+				for _, h := range rt.GetMatch().GetHeaders() {
+					summary.Headers = append(summary.Headers, h.GetName())
+				}
+				for _, policy := range rt.GetRoute().GetRequestMirrorPolicies() {
+					summary.Clusters = append(summary.Clusters, policy.GetCluster())
+				}
+				// the copies still route to the primary upstream
+				Expect(rt.GetRoute().GetCluster()).To(Equal("primary_default"))
+				summaries = append(summaries, summary)
+			}
+			Expect(summaries).To(Equal([]routeSummary{
+				{
+					Name:     "route-shadow-0-1",
+					Headers:  []string{"x-shadow-v2", "x-shadow-v3"},
+					Clusters: []string{"always_default_shadow", "v2_default_shadow", "v3_default_shadow"},
+				},
+				{
+					Name:     "route-shadow-1",
+					Headers:  []string{"x-shadow-v3"},
+					Clusters: []string{"always_default_shadow", "v3_default_shadow"},
+				},
+				{
+					Name:     "route-shadow-0",
+					Headers:  []string{"x-shadow-v2"},
+					Clusters: []string{"always_default_shadow", "v2_default_shadow"},
+				},
+				{
+					Name:     "route",
+					Clusters: []string{"always_default_shadow"},
+				},
+			}))
+		})
+
+		It("should rewrite the host of the mirrored requests", func() {
+			_, clusters := translate(&shadowing.ShadowTarget{
+				Upstream:    &core.ResourceRef{Name: "some-upstream", Namespace: "default"},
+				Percentage:  100,
+				HostRewrite: "shadow.example.com",
+			})
+			Expect(clusters).To(HaveLen(1))
+			Expect(clusters[0].GetName()).To(Equal("some-upstream_default_shadow_shadow_example_com"))
+
+			protocolOptions := &envoy_extensions_upstreams_http_v3.HttpProtocolOptions{}
+			err := clusters[0].GetTypedExtensionProtocolOptions()["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"].UnmarshalTo(protocolOptions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(protocolOptions.GetExplicitHttpConfig().GetHttpProtocolOptions()).NotTo(BeNil())
+
+			filters := protocolOptions.GetHttpFilters()
+			Expect(filters).To(HaveLen(2))
+			Expect(filters[0].GetName()).To(Equal("envoy.filters.http.header_mutation"))
+			headerMutation := &envoy_extensions_filters_http_header_mutation_v3.HeaderMutation{}
+			Expect(filters[0].GetTypedConfig().UnmarshalTo(headerMutation)).To(Succeed())
+			header := headerMutation.GetMutations().GetRequestMutations()[0].GetAppend().GetHeader()
+			Expect(header.GetKey()).To(Equal(":authority"))
+			Expect(header.GetValue()).To(Equal("shadow.example.com"))
+			Expect(filters[1].GetName()).To(Equal("envoy.filters.http.upstream_codec"))
+		})
+
+		It("should error when both an upstream and targets are specified", func() {
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					Shadowing: &shadowing.RouteShadowing{
+						Upstream: &core.ResourceRef{Name: "some-upstream", Namespace: "default"},
+						Targets: []*shadowing.ShadowTarget{
+							{
+								Upstream:   &core.ResourceRef{Name: "other-upstream", Namespace: "default"},
+								Percentage: 100,
+							},
+						},
+					},
+				},
+			}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(HaveInErrorChain(UpstreamAndTargetsError))
+		})
+
+		It("should error when given invalid targets", func() {
+			in := &v1.Route{
+				Options: &v1.RouteOptions{
+					Shadowing: &shadowing.RouteShadowing{
+						Targets: []*shadowing.ShadowTarget{
+							{Percentage: 100},
+						},
+					},
+				},
+			}
+			err := p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(HaveInErrorChain(UnspecifiedTargetError))
+
+			in.GetOptions().GetShadowing().GetTargets()[0] = &shadowing.ShadowTarget{
+				Upstream:   &core.ResourceRef{Name: "some-upstream", Namespace: "default"},
+				Percentage: 101,
+			}
+			err = p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(HaveInErrorChain(InvalidNumeratorError(101)))
+
+			var targets []*shadowing.ShadowTarget
+			for i := 0; i <= MaxTargetsWithHeaders; i++ {
+				targets = append(targets, &shadowing.ShadowTarget{
+					Upstream:   &core.ResourceRef{Name: "some-upstream", Namespace: "default"},
+					Headers:    []*matchers.HeaderMatcher{{Name: fmt.Sprintf("x-shadow-%d", i)}},
+					Percentage: 100,
+				})
+			}
+			in.GetOptions().GetShadowing().Targets = targets
+			err = p.ProcessRoute(plugins.RouteParams{}, in, &envoy_config_route_v3.Route{})
+			Expect(err).To(HaveOccurred())
+			Expect(err).To(HaveInErrorChain(TooManyTargetsWithHeadersError))
+		})
+	})
+
 })
 
 func checkFraction(frac *envoy_config_core_v3.RuntimeFractionalPercent, percentage float32) {
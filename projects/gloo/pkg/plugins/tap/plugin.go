@@ -0,0 +1,336 @@
+package tap
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	envoy_config_common_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoy_extensions_common_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	envoy_extensions_filters_http_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "tap"
+	FilterName    = "envoy.filters.http.tap"
+)
+
+// the tap filter is the first filter, so that it reports the requests as they were received
+var pluginStage = plugins.BeforeStage(plugins.FaultStage)
+
+var (
+	InvalidSinksError          = eris.New("tap must have exactly one sink")
+	UnsupportedSinkError       = eris.New("tap http sinks are not supported")
+	NoTapServerError           = eris.New("tap grpc sink must specify a tap server")
+	QueryParameterMatcherError = eris.New("tap route matchers with query parameters are not supported")
+	TapServerNotFoundError     = func(ref *core.ResourceRef) error {
+		return eris.Errorf("tap server upstream %s does not exist", ref.Key())
+	}
+)
+
+type plugin struct{}
+
+func NewPlugin() *plugin {
+	return &plugin{}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	tapConfig := listener.GetOptions().GetTap()
+	if tapConfig == nil {
+		return []plugins.StagedHttpFilter{}, nil
+	}
+
+	if len(tapConfig.GetSinks()) != 1 {
+		return nil, InvalidSinksError
+	}
+	sink, err := translateSink(params, tapConfig.GetSinks()[0])
+	if err != nil {
+		return nil, err
+	}
+	match, err := translateMatch(params.Ctx, tapConfig.GetMatch())
+	if err != nil {
+		return nil, err
+	}
+
+	config := &envoy_extensions_filters_http_tap_v3.Tap{
+		CommonConfig: &envoy_extensions_common_tap_v3.CommonExtensionConfig{
+			ConfigType: &envoy_extensions_common_tap_v3.CommonExtensionConfig_StaticConfig{
+				StaticConfig: &envoy_config_tap_v3.TapConfig{
+					Match: match,
+					OutputConfig: &envoy_config_tap_v3.OutputConfig{
+						Sinks:              []*envoy_config_tap_v3.OutputSink{sink},
+						MaxBufferedRxBytes: tapConfig.GetMaxBufferedRxBytes(),
+						MaxBufferedTxBytes: tapConfig.GetMaxBufferedTxBytes(),
+					},
+				},
+			},
+		},
+	}
+
+	filter, err := plugins.NewStagedFilter(FilterName, config, pluginStage)
+	if err != nil {
+		return nil, eris.Wrap(err, "generating filter config")
+	}
+	return []plugins.StagedHttpFilter{filter}, nil
+}
+
+func translateSink(params plugins.Params, sink *tap.Sink) (*envoy_config_tap_v3.OutputSink, error) {
+	switch sinkType := sink.GetSinkType().(type) {
+	case *tap.Sink_FileSink:
+		return &envoy_config_tap_v3.OutputSink{
+			Format: envoy_config_tap_v3.OutputSink_Format(sinkType.FileSink.GetFormat()),
+			OutputSinkType: &envoy_config_tap_v3.OutputSink_FilePerTap{
+				FilePerTap: &envoy_config_tap_v3.FilePerTapSink{
+					PathPrefix: sinkType.FileSink.GetPathPrefix(),
+				},
+			},
+		}, nil
+	case *tap.Sink_GrpcService:
+		serverRef := sinkType.GrpcService.GetTapServer()
+		if serverRef == nil {
+			return nil, NoTapServerError
+		}
+		if _, err := params.Snapshot.Upstreams.Find(serverRef.GetNamespace(), serverRef.GetName()); err != nil {
+			return nil, TapServerNotFoundError(serverRef)
+		}
+		return &envoy_config_tap_v3.OutputSink{
+			// Envoy streams the traces to a tap server only as binary protos
+			Format: envoy_config_tap_v3.OutputSink_PROTO_BINARY,
+			OutputSinkType: &envoy_config_tap_v3.OutputSink_StreamingGrpc{
+				StreamingGrpc: &envoy_config_tap_v3.StreamingGrpcSink{
+					GrpcService: &envoy_config_core_v3.GrpcService{
+						TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+							EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+								ClusterName: translator.UpstreamToClusterName(serverRef),
+							},
+						},
+					},
+				},
+			},
+		}, nil
+	}
+	return nil, UnsupportedSinkError
+}
+
+// translateMatch returns a predicate which matches when all the predicates of the match match,
+// or which matches any request if there are none
+func translateMatch(ctx context.Context, match *tap.Match) (*envoy_config_common_matcher_v3.MatchPredicate, error) {
+	var rules []*envoy_config_common_matcher_v3.MatchPredicate
+	if len(match.GetRequestHeaders()) > 0 {
+		rules = append(rules, requestHeadersPredicate(translator.EnvoyHeaderMatchers(ctx, match.GetRequestHeaders())))
+	}
+	if len(match.GetResponseHeaders()) > 0 {
+		rules = append(rules, &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpResponseHeadersMatch{
+				HttpResponseHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+					Headers: translator.EnvoyHeaderMatchers(ctx, match.GetResponseHeaders()),
+				},
+			},
+		})
+	}
+	if len(match.GetPaths()) > 0 {
+		rules = append(rules, translatePaths(ctx, match.GetPaths()))
+	}
+	if len(match.GetRouteMatchers()) > 0 {
+		routeMatchers, err := translateRouteMatchers(ctx, match.GetRouteMatchers())
+		if err != nil {
+			return nil, err
+		}
+		if routeMatchers != nil {
+			rules = append(rules, routeMatchers)
+		}
+	}
+	if domains := translateDomains(ctx, match.GetDomains()); domains != nil {
+		rules = append(rules, domains)
+	}
+
+	if len(rules) == 0 {
+		return &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_AnyMatch{AnyMatch: true},
+		}, nil
+	}
+	return andMatch(rules), nil
+}
+
+// translatePaths returns a predicate which matches when the :path header matches one of the paths
+func translatePaths(ctx context.Context, paths []*tap.PathMatcher) *envoy_config_common_matcher_v3.MatchPredicate {
+	var rules []*envoy_config_common_matcher_v3.MatchPredicate
+	for _, path := range paths {
+		stringMatch := &envoy_type_matcher_v3.StringMatcher{}
+		switch pathSpecifier := path.GetPathSpecifier().(type) {
+		case *tap.PathMatcher_Prefix:
+			stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: pathSpecifier.Prefix}
+		case *tap.PathMatcher_Exact:
+			stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Exact{Exact: pathSpecifier.Exact}
+		case *tap.PathMatcher_Regex:
+			stringMatch.MatchPattern = &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: regexutils.NewRegex(ctx, pathSpecifier.Regex),
+			}
+		}
+		rules = append(rules, requestHeadersPredicate([]*envoy_config_route_v3.HeaderMatcher{pathHeaderMatcher(stringMatch)}))
+	}
+	return orMatch(rules)
+}
+
+// translateRouteMatchers returns a predicate which matches when one of the route matchers matches the path,
+// headers and methods of the request, or nil if one of the matchers matches all the requests
+func translateRouteMatchers(ctx context.Context, routeMatchers []*matchers.Matcher) (*envoy_config_common_matcher_v3.MatchPredicate, error) {
+	var rules []*envoy_config_common_matcher_v3.MatchPredicate
+	for _, routeMatcher := range routeMatchers {
+		if len(routeMatcher.GetQueryParameters()) > 0 {
+			return nil, QueryParameterMatcherError
+		}
+		routeMatch := translator.GlooMatcherToEnvoyMatcher(ctx, routeMatcher)
+		headers := routeMatch.GetHeaders()
+		if pathMatch := routePathMatch(ctx, &routeMatch); pathMatch != nil {
+			headers = append([]*envoy_config_route_v3.HeaderMatcher{pathHeaderMatcher(pathMatch)}, headers...)
+		}
+		if len(headers) == 0 {
+			// the matcher matches all the requests
+			return nil, nil
+		}
+		rules = append(rules, requestHeadersPredicate(headers))
+	}
+	return orMatch(rules), nil
+}
+
+// routePathMatch returns the match of the :path header which matches the same requests as the path of the route.
+// The :path header includes the query string, which the path of a route does not.
+func routePathMatch(ctx context.Context, routeMatch *envoy_config_route_v3.RouteMatch) *envoy_type_matcher_v3.StringMatcher {
+	ignoreCase := routeMatch.GetCaseSensitive() != nil && !routeMatch.GetCaseSensitive().GetValue()
+	var regexFlags string
+	if ignoreCase {
+		regexFlags = "(?i)"
+	}
+	switch pathSpecifier := routeMatch.GetPathSpecifier().(type) {
+	case *envoy_config_route_v3.RouteMatch_Prefix:
+		if pathSpecifier.Prefix == "/" {
+			return nil
+		}
+		return &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: pathSpecifier.Prefix},
+			IgnoreCase:   ignoreCase,
+		}
+	case *envoy_config_route_v3.RouteMatch_Path:
+		return &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: regexutils.NewRegex(ctx, regexFlags+regexp.QuoteMeta(pathSpecifier.Path)+queryStringRegex),
+			},
+		}
+	case *envoy_config_route_v3.RouteMatch_SafeRegex:
+		return &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: regexutils.NewRegex(ctx, "(?:"+pathSpecifier.SafeRegex.GetRegex()+")"+queryStringRegex),
+			},
+		}
+	}
+	return nil
+}
+
+// matches the optional query string at the end of the :path header
+const queryStringRegex = `(\?.*)?`
+
+// translateDomains returns a predicate which matches when the :authority header matches one of the domains,
+// or nil if one of the domains matches all the requests
+func translateDomains(ctx context.Context, domains []string) *envoy_config_common_matcher_v3.MatchPredicate {
+	var rules []*envoy_config_common_matcher_v3.MatchPredicate
+	for _, domain := range domains {
+		if domain == "*" {
+			return nil
+		}
+		rules = append(rules, requestHeadersPredicate([]*envoy_config_route_v3.HeaderMatcher{{
+			Name: ":authority",
+			HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_type_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+						SafeRegex: regexutils.NewRegex(ctx, domainRegex(domain)),
+					},
+				},
+			},
+		}}))
+	}
+	return orMatch(rules)
+}
+
+// domainRegex returns a regex which matches the authorities matched by the domain of a virtual host.
+// Domains are case insensitive, may start or end with a wildcard, and match any port when they have none.
+func domainRegex(domain string) string {
+	var prefix, suffix string
+	switch {
+	case strings.HasPrefix(domain, "*"):
+		prefix, domain = ".+", strings.TrimPrefix(domain, "*")
+	case strings.HasSuffix(domain, "*"):
+		domain, suffix = strings.TrimSuffix(domain, "*"), ".+"
+	}
+	regex := "(?i)" + prefix + regexp.QuoteMeta(domain) + suffix
+	if !strings.Contains(domain, ":") && suffix == "" {
+		regex += "(:[0-9]+)?"
+	}
+	return regex
+}
+
+func pathHeaderMatcher(stringMatch *envoy_type_matcher_v3.StringMatcher) *envoy_config_route_v3.HeaderMatcher {
+	return &envoy_config_route_v3.HeaderMatcher{
+		Name:                 ":path",
+		HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{StringMatch: stringMatch},
+	}
+}
+
+func requestHeadersPredicate(headers []*envoy_config_route_v3.HeaderMatcher) *envoy_config_common_matcher_v3.MatchPredicate {
+	return &envoy_config_common_matcher_v3.MatchPredicate{
+		Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+			HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{Headers: headers},
+		},
+	}
+}
+
+// andMatch returns a predicate which matches when all the rules match
+func andMatch(rules []*envoy_config_common_matcher_v3.MatchPredicate) *envoy_config_common_matcher_v3.MatchPredicate {
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return &envoy_config_common_matcher_v3.MatchPredicate{
+		Rule: &envoy_config_common_matcher_v3.MatchPredicate_AndMatch{
+			AndMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{Rules: rules},
+		},
+	}
+}
+
+// orMatch returns a predicate which matches when one of the rules matches, or nil if there are none
+func orMatch(rules []*envoy_config_common_matcher_v3.MatchPredicate) *envoy_config_common_matcher_v3.MatchPredicate {
+	switch len(rules) {
+	case 0:
+		return nil
+	case 1:
+		return rules[0]
+	}
+	return &envoy_config_common_matcher_v3.MatchPredicate{
+		Rule: &envoy_config_common_matcher_v3.MatchPredicate_OrMatch{
+			OrMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{Rules: rules},
+		},
+	}
+}
@@ -0,0 +1,375 @@
+package tap_test
+
+import (
+	"context"
+
+	envoy_config_common_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_config_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	envoy_extensions_common_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	envoy_extensions_filters_http_tap_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/pkg/utils/regexutils"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloomatchers "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/tap"
+	"github.com/solo-io/gloo/projects/gloo/pkg/translator"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		params    plugins.Params
+		serverRef *core.ResourceRef
+		fileSink  *tap.Sink
+	)
+
+	BeforeEach(func() {
+		serverRef = &core.ResourceRef{Name: "tap-server", Namespace: "gloo-system"}
+		params = plugins.Params{
+			Ctx: context.Background(),
+			Snapshot: &gloov1snap.ApiSnapshot{
+				Upstreams: v1.UpstreamList{{
+					Metadata: &core.Metadata{Name: serverRef.GetName(), Namespace: serverRef.GetNamespace()},
+				}},
+			},
+		}
+		fileSink = &tap.Sink{
+			SinkType: &tap.Sink_FileSink{FileSink: &tap.FileSink{PathPrefix: "/tmp/tap/route"}},
+		}
+	})
+
+	listenerWithTap := func(tapConfig *tap.Tap) *v1.HttpListener {
+		return &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{Tap: tapConfig},
+		}
+	}
+
+	tapConfig := func(filters []plugins.StagedHttpFilter) *envoy_config_tap_v3.TapConfig {
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].HttpFilter.GetName()).To(Equal(FilterName))
+		Expect(filters[0].Stage).To(Equal(plugins.BeforeStage(plugins.FaultStage)))
+		msg, err := utils.AnyToMessage(filters[0].HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		commonConfig := msg.(*envoy_extensions_filters_http_tap_v3.Tap).GetCommonConfig()
+		return commonConfig.GetConfigType().(*envoy_extensions_common_tap_v3.CommonExtensionConfig_StaticConfig).StaticConfig
+	}
+
+	pathPredicate := func(stringMatch *envoy_type_matcher_v3.StringMatcher) *envoy_config_common_matcher_v3.MatchPredicate {
+		return &envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+				HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+					Headers: []*envoy_config_route_v3.HeaderMatcher{{
+						Name:                 ":path",
+						HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{StringMatch: stringMatch},
+					}},
+				},
+			},
+		}
+	}
+
+	It("does not add the filter when tap is not configured", func() {
+		filters, err := NewPlugin().HttpFilters(params, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("taps all the traffic to a file sink with body limits", func() {
+		fileSink.GetFileSink().Format = tap.FileSink_JSON_BODY_AS_STRING
+
+		filters, err := NewPlugin().HttpFilters(params, listenerWithTap(&tap.Tap{
+			Sinks:              []*tap.Sink{fileSink},
+			MaxBufferedRxBytes: &wrappers.UInt32Value{Value: 2048},
+			MaxBufferedTxBytes: &wrappers.UInt32Value{Value: 4096},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tapConfig(filters)).To(matchers.MatchProto(&envoy_config_tap_v3.TapConfig{
+			Match: &envoy_config_common_matcher_v3.MatchPredicate{
+				Rule: &envoy_config_common_matcher_v3.MatchPredicate_AnyMatch{AnyMatch: true},
+			},
+			OutputConfig: &envoy_config_tap_v3.OutputConfig{
+				Sinks: []*envoy_config_tap_v3.OutputSink{{
+					Format: envoy_config_tap_v3.OutputSink_JSON_BODY_AS_STRING,
+					OutputSinkType: &envoy_config_tap_v3.OutputSink_FilePerTap{
+						FilePerTap: &envoy_config_tap_v3.FilePerTapSink{PathPrefix: "/tmp/tap/route"},
+					},
+				}},
+				MaxBufferedRxBytes: &wrappers.UInt32Value{Value: 2048},
+				MaxBufferedTxBytes: &wrappers.UInt32Value{Value: 4096},
+			},
+		}))
+	})
+
+	It("streams the taps to a grpc tap server as binary protos", func() {
+		filters, err := NewPlugin().HttpFilters(params, listenerWithTap(&tap.Tap{
+			Sinks: []*tap.Sink{{
+				SinkType: &tap.Sink_GrpcService{GrpcService: &tap.GrpcService{TapServer: serverRef}},
+			}},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tapConfig(filters).GetOutputConfig().GetSinks()).To(matchers.ConsistOfProtos(&envoy_config_tap_v3.OutputSink{
+			Format: envoy_config_tap_v3.OutputSink_PROTO_BINARY,
+			OutputSinkType: &envoy_config_tap_v3.OutputSink_StreamingGrpc{
+				StreamingGrpc: &envoy_config_tap_v3.StreamingGrpcSink{
+					GrpcService: &envoy_config_core_v3.GrpcService{
+						TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+							EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+								ClusterName: translator.UpstreamToClusterName(serverRef),
+							},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("matches on headers and paths", func() {
+		filters, err := NewPlugin().HttpFilters(params, listenerWithTap(&tap.Tap{
+			Sinks: []*tap.Sink{fileSink},
+			Match: &tap.Match{
+				RequestHeaders:  []*gloomatchers.HeaderMatcher{{Name: "x-debug", Value: "true"}},
+				ResponseHeaders: []*gloomatchers.HeaderMatcher{{Name: "x-error"}},
+				Paths: []*tap.PathMatcher{
+					{PathSpecifier: &tap.PathMatcher_Prefix{Prefix: "/api"}},
+					{PathSpecifier: &tap.PathMatcher_Exact{Exact: "/health"}},
+				},
+			},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tapConfig(filters).GetMatch()).To(matchers.MatchProto(&envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_AndMatch{
+				AndMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{
+					Rules: []*envoy_config_common_matcher_v3.MatchPredicate{
+						{
+							Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+								HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+									Headers: []*envoy_config_route_v3.HeaderMatcher{{
+										Name:                 "x-debug",
+										HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "true"},
+									}},
+								},
+							},
+						},
+						{
+							Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpResponseHeadersMatch{
+								HttpResponseHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+									Headers: []*envoy_config_route_v3.HeaderMatcher{{
+										Name:                 "x-error",
+										HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_PresentMatch{PresentMatch: true},
+									}},
+								},
+							},
+						},
+						{
+							Rule: &envoy_config_common_matcher_v3.MatchPredicate_OrMatch{
+								OrMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{
+									Rules: []*envoy_config_common_matcher_v3.MatchPredicate{
+										pathPredicate(&envoy_type_matcher_v3.StringMatcher{
+											MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "/api"},
+										}),
+										pathPredicate(&envoy_type_matcher_v3.StringMatcher{
+											MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: "/health"},
+										}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("matches a single path without a match set", func() {
+		filters, err := NewPlugin().HttpFilters(params, listenerWithTap(&tap.Tap{
+			Sinks: []*tap.Sink{fileSink},
+			Match: &tap.Match{
+				Paths: []*tap.PathMatcher{{PathSpecifier: &tap.PathMatcher_Prefix{Prefix: "/api"}}},
+			},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tapConfig(filters).GetMatch()).To(matchers.MatchProto(pathPredicate(&envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "/api"},
+		})))
+	})
+
+	It("matches the path, headers and methods of route matchers and the domains of a virtual host", func() {
+		filters, err := NewPlugin().HttpFilters(params, listenerWithTap(&tap.Tap{
+			Sinks: []*tap.Sink{fileSink},
+			Match: &tap.Match{
+				RouteMatchers: []*gloomatchers.Matcher{{
+					PathSpecifier: &gloomatchers.Matcher_Exact{Exact: "/status"},
+					Methods:       []string{"GET"},
+				}, {
+					PathSpecifier: &gloomatchers.Matcher_Prefix{Prefix: "/api"},
+					Headers:       []*gloomatchers.HeaderMatcher{{Name: "x-version", Value: "2"}},
+					CaseSensitive: &wrappers.BoolValue{Value: false},
+				}},
+				Domains: []string{"petstore.example.com", "*.example.org"},
+			},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+
+		authorityPredicate := func(regex string) *envoy_config_common_matcher_v3.MatchPredicate {
+			return &envoy_config_common_matcher_v3.MatchPredicate{
+				Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+					HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+						Headers: []*envoy_config_route_v3.HeaderMatcher{{
+							Name: ":authority",
+							HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+								StringMatch: &envoy_type_matcher_v3.StringMatcher{
+									MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+										SafeRegex: regexutils.NewRegex(params.Ctx, regex),
+									},
+								},
+							},
+						}},
+					},
+				},
+			}
+		}
+		Expect(tapConfig(filters).GetMatch()).To(matchers.MatchProto(&envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_AndMatch{
+				AndMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{
+					Rules: []*envoy_config_common_matcher_v3.MatchPredicate{
+						{
+							Rule: &envoy_config_common_matcher_v3.MatchPredicate_OrMatch{
+								OrMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{
+									Rules: []*envoy_config_common_matcher_v3.MatchPredicate{
+										{
+											Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+												HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+													Headers: []*envoy_config_route_v3.HeaderMatcher{
+														{
+															Name: ":path",
+															HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+																StringMatch: &envoy_type_matcher_v3.StringMatcher{
+																	MatchPattern: &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+																		SafeRegex: regexutils.NewRegex(params.Ctx, `/status(\?.*)?`),
+																	},
+																},
+															},
+														},
+														{
+															Name: ":method",
+															HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+																SafeRegexMatch: regexutils.NewRegex(params.Ctx, "GET"),
+															},
+														},
+													},
+												},
+											},
+										},
+										{
+											Rule: &envoy_config_common_matcher_v3.MatchPredicate_HttpRequestHeadersMatch{
+												HttpRequestHeadersMatch: &envoy_config_common_matcher_v3.HttpHeadersMatch{
+													Headers: []*envoy_config_route_v3.HeaderMatcher{
+														{
+															Name: ":path",
+															HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_StringMatch{
+																StringMatch: &envoy_type_matcher_v3.StringMatcher{
+																	MatchPattern: &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: "/api"},
+																	IgnoreCase:   true,
+																},
+															},
+														},
+														{
+															Name:                 "x-version",
+															HeaderMatchSpecifier: &envoy_config_route_v3.HeaderMatcher_ExactMatch{ExactMatch: "2"},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Rule: &envoy_config_common_matcher_v3.MatchPredicate_OrMatch{
+								OrMatch: &envoy_config_common_matcher_v3.MatchPredicate_MatchSet{
+									Rules: []*envoy_config_common_matcher_v3.MatchPredicate{
+										authorityPredicate(`(?i)petstore\.example\.com(:[0-9]+)?`),
+										authorityPredicate(`(?i).+\.example\.org(:[0-9]+)?`),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("matches any request when a route matcher or a domain matches all the requests", func() {
+		filters, err := NewPlugin().HttpFilters(params, listenerWithTap(&tap.Tap{
+			Sinks: []*tap.Sink{fileSink},
+			Match: &tap.Match{
+				RouteMatchers: []*gloomatchers.Matcher{
+					{PathSpecifier: &gloomatchers.Matcher_Exact{Exact: "/status"}},
+					{PathSpecifier: &gloomatchers.Matcher_Prefix{Prefix: "/"}},
+				},
+				Domains: []string{"petstore.example.com", "*"},
+			},
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tapConfig(filters).GetMatch()).To(matchers.MatchProto(&envoy_config_common_matcher_v3.MatchPredicate{
+			Rule: &envoy_config_common_matcher_v3.MatchPredicate_AnyMatch{AnyMatch: true},
+		}))
+	})
+
+	DescribeTable("invalid taps",
+		func(tapConfig func() *tap.Tap, expectedErr error) {
+			_, err := NewPlugin().HttpFilters(params, listenerWithTap(tapConfig()))
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("without sinks", func() *tap.Tap {
+			return &tap.Tap{}
+		}, InvalidSinksError),
+		Entry("with multiple sinks", func() *tap.Tap {
+			return &tap.Tap{Sinks: []*tap.Sink{fileSink, fileSink}}
+		}, InvalidSinksError),
+		Entry("with a http sink", func() *tap.Tap {
+			return &tap.Tap{Sinks: []*tap.Sink{{
+				SinkType: &tap.Sink_HttpService{HttpService: &tap.HttpService{
+					TapServer: serverRef,
+					Timeout:   durationpb.New(0),
+				}},
+			}}}
+		}, UnsupportedSinkError),
+		Entry("with a grpc sink without tap server", func() *tap.Tap {
+			return &tap.Tap{Sinks: []*tap.Sink{{
+				SinkType: &tap.Sink_GrpcService{GrpcService: &tap.GrpcService{}},
+			}}}
+		}, NoTapServerError),
+		Entry("with a missing tap server", func() *tap.Tap {
+			return &tap.Tap{Sinks: []*tap.Sink{{
+				SinkType: &tap.Sink_GrpcService{GrpcService: &tap.GrpcService{
+					TapServer: &core.ResourceRef{Name: "missing", Namespace: "gloo-system"},
+				}},
+			}}}
+		}, TapServerNotFoundError(&core.ResourceRef{Name: "missing", Namespace: "gloo-system"})),
+		Entry("with a route matcher on query parameters", func() *tap.Tap {
+			return &tap.Tap{
+				Sinks: []*tap.Sink{fileSink},
+				Match: &tap.Match{
+					RouteMatchers: []*gloomatchers.Matcher{{
+						PathSpecifier:   &gloomatchers.Matcher_Prefix{Prefix: "/search"},
+						QueryParameters: []*gloomatchers.QueryParameterMatcher{{Name: "q"}},
+					}},
+				},
+			}
+		}, QueryParameterMatcherError),
+	)
+})
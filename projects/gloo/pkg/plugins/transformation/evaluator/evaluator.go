@@ -0,0 +1,167 @@
+package evaluator
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	envoytransformation "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	transformationplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// the stage of the regular transformation filter
+const regularStageNumber = 0
+
+var (
+	// requests go through the early transformation filter first, and responses go through it last
+	requestStages  = []uint32{transformationplugin.EarlyStageNumber, regularStageNumber}
+	responseStages = []uint32{regularStageNumber, transformationplugin.EarlyStageNumber}
+)
+
+// Result holds the messages and the dynamic metadata produced by the transformation filters
+type Result struct {
+	Request         *HttpMessage    `json:"request"`
+	Response        *HttpMessage    `json:"response,omitempty"`
+	DynamicMetadata DynamicMetadata `json:"dynamicMetadata,omitempty"`
+}
+
+// ConvertTransformations converts the transformations of a virtual host or a route to the per-route configuration
+// of the transformation filter, as the transformation plugin does.
+// escapeCharacters is the default set in Settings.Gloo.TransformationEscapeCharacters.
+func ConvertTransformations(
+	ctx context.Context,
+	transformations *transformation.Transformations,
+	stagedTransformations *transformation.TransformationStages,
+	escapeCharacters *wrapperspb.BoolValue,
+) (*envoytransformation.RouteTransformations, error) {
+	plugin := transformationplugin.NewPlugin()
+	plugin.Init(plugins.InitParams{
+		Ctx: ctx,
+		Settings: &v1.Settings{
+			Gloo: &v1.GlooOptions{
+				TransformationEscapeCharacters: escapeCharacters,
+			},
+		},
+	})
+	return plugin.ConvertTransformation(ctx, transformations, stagedTransformations)
+}
+
+// Evaluate runs a request, and optionally its response, through the transformation filters configured with
+// the given per-route configuration. env resolves the environment variables used by templates, and may be nil.
+func Evaluate(
+	routeTransformations *envoytransformation.RouteTransformations,
+	request, response *HttpMessage,
+	env func(name string) string,
+) (*Result, error) {
+	if request == nil {
+		return nil, eris.New("a request is required")
+	}
+	result := &Result{
+		Request:         request.Clone(),
+		Response:        response.Clone(),
+		DynamicMetadata: DynamicMetadata{},
+	}
+
+	// the request matched by each stage, whose response transformation is used if no response matcher matches
+	requestMatches := map[uint32]*envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{}
+	for _, stage := range requestStages {
+		requestMatch, err := matchRequest(routeTransformations, stage, result.Request.Headers)
+		if err != nil {
+			return nil, err
+		}
+		requestMatches[stage] = requestMatch
+		transformer := &messageTransformer{
+			message:        result.Request,
+			requestHeaders: &result.Request.Headers,
+			isRequest:      true,
+			metadata:       result.DynamicMetadata,
+			env:            env,
+		}
+		if err := transformer.transform(requestMatch.GetRequestTransformation()); err != nil {
+			return nil, eris.Wrapf(err, "transforming request in stage %d", stage)
+		}
+	}
+
+	if result.Response != nil {
+		for _, stage := range responseStages {
+			responseTransformation, err := matchResponse(routeTransformations, stage, result.Response.Headers, requestMatches[stage])
+			if err != nil {
+				return nil, err
+			}
+			transformer := &messageTransformer{
+				message:        result.Response,
+				requestHeaders: &result.Request.Headers,
+				metadata:       result.DynamicMetadata,
+				env:            env,
+			}
+			if err := transformer.transform(responseTransformation); err != nil {
+				return nil, eris.Wrapf(err, "transforming response in stage %d", stage)
+			}
+		}
+	}
+
+	if len(result.DynamicMetadata) == 0 {
+		result.DynamicMetadata = nil
+	}
+	return result, nil
+}
+
+// matchRequest returns the first request match of the stage that matches the request
+func matchRequest(
+	routeTransformations *envoytransformation.RouteTransformations,
+	stage uint32,
+	headers Headers,
+) (*envoytransformation.RouteTransformations_RouteTransformation_RequestMatch, error) {
+	// the deprecated fields are only used when no transformations are defined
+	if len(routeTransformations.GetTransformations()) == 0 {
+		if stage != regularStageNumber {
+			return nil, nil
+		}
+		return &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
+			RequestTransformation:  routeTransformations.GetRequestTransformation(),
+			ResponseTransformation: routeTransformations.GetResponseTransformation(),
+		}, nil
+	}
+
+	for _, t := range routeTransformations.GetTransformations() {
+		requestMatch := t.GetRequestMatch()
+		if t.GetStage() != stage || requestMatch == nil {
+			continue
+		}
+		matched, err := requestMatches(requestMatch.GetMatch(), headers)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return requestMatch, nil
+		}
+	}
+	return nil, nil
+}
+
+// matchResponse returns the response transformation of the first response match of the stage that matches the response,
+// or the response transformation of the request match
+func matchResponse(
+	routeTransformations *envoytransformation.RouteTransformations,
+	stage uint32,
+	headers Headers,
+	requestMatch *envoytransformation.RouteTransformations_RouteTransformation_RequestMatch,
+) (*envoytransformation.Transformation, error) {
+	for _, t := range routeTransformations.GetTransformations() {
+		responseMatch := t.GetResponseMatch()
+		if t.GetStage() != stage || responseMatch == nil {
+			continue
+		}
+		matched, err := responseMatches(responseMatch.GetMatch(), headers)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return responseMatch.GetResponseTransformation(), nil
+		}
+	}
+	return requestMatch.GetResponseTransformation(), nil
+}
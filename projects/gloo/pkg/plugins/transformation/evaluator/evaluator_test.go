@@ -0,0 +1,341 @@
+package evaluator_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	envoytransformation "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/transformation"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation/evaluator"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Evaluator", func() {
+
+	var (
+		request  *HttpMessage
+		response *HttpMessage
+	)
+
+	BeforeEach(func() {
+		request = &HttpMessage{
+			Headers: Headers{
+				{Key: ":method", Value: "POST"},
+				{Key: ":path", Value: "/pets?name=fido&name=rex&limit=10"},
+				{Key: ":authority", Value: "petstore.example.com"},
+				{Key: "content-type", Value: "application/json"},
+				{Key: "x-user", Value: "alice"},
+			},
+			Body: `{"pet": {"name": "fido", "age": 3, "tags": ["good", "dog"]}, "price": 9.5}`,
+		}
+		response = &HttpMessage{
+			Headers: Headers{
+				{Key: ":status", Value: "200"},
+				{Key: "content-type", Value: "application/json"},
+			},
+			Body: `{"id": 123}`,
+		}
+	})
+
+	header := func(message *HttpMessage, name string) string {
+		value, _ := message.Headers.Get(name)
+		return value
+	}
+
+	convert := func(t *transformation.Transformations, staged *transformation.TransformationStages) *envoytransformation.RouteTransformations {
+		routeTransformations, err := ConvertTransformations(context.Background(), t, staged, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return routeTransformations
+	}
+
+	requestTemplate := func(tmpl *transformation.TransformationTemplate) *envoytransformation.RouteTransformations {
+		return convert(&transformation.Transformations{
+			RequestTransformation: &transformation.Transformation{
+				TransformationType: &transformation.Transformation_TransformationTemplate{TransformationTemplate: tmpl},
+			},
+		}, nil)
+	}
+
+	renderBody := func(text string) (string, error) {
+		result, err := Evaluate(requestTemplate(&transformation.TransformationTemplate{
+			BodyTransformation: &transformation.TransformationTemplate_Body{
+				Body: &transformation.InjaTemplate{Text: text},
+			},
+		}), request, nil, func(name string) string {
+			return map[string]string{"REGION": "us-east-1"}[name]
+		})
+		if err != nil {
+			return "", err
+		}
+		return result.Request.Body, nil
+	}
+
+	DescribeTable("renders templates",
+		func(text, expected string) {
+			output, err := renderBody(text)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal(expected))
+		},
+		Entry("text", "hello", "hello"),
+		Entry("body fields", "{{ pet.name }} is {{ pet.age }}", "fido is 3"),
+		Entry("array elements", "{{ pet.tags.1 }}", "dog"),
+		Entry("floats", "{{ price }} {{ price * 2 }} {{ pet.age / 2 }}", "9.5 19.0 1.5"),
+		Entry("objects", "{{ pet }}", `{"age":3,"name":"fido","tags":["good","dog"]}`),
+		Entry("headers", `{{ header("x-user") }}:{{ header(":method") }}:{{ header("missing") }}`, "alice:POST:"),
+		Entry("env", `{{ env("REGION") }}{{ env("UNSET") }}`, "us-east-1"),
+		Entry("body and context", `{{ length(body()) > 0 }} {{ at(context(), "price") }}`, "true 9.5"),
+		Entry("base64", `{{ base64_encode("hello") }} {{ base64_decode("aGVsbG8=") }}`, "aGVsbG8= hello"),
+		Entry("substring", `{{ substring("transformation", 5) }} {{ substring("transformation", 0, 5) }} {{ substring("abc", 5, 1) }}`, "formation trans "),
+		Entry("core functions", `{{ upper(pet.name) }} {{ length(pet.tags) }} {{ join(sort(pet.tags), ",") }} {{ default(missing, "none") }} {{ exists("pet.name") }}`, "FIDO 2 dog,good none true"),
+		Entry("pipes", `{{ pet.name | upper }} {{ pet.tags | join("-") }}`, "FIDO good-dog"),
+		Entry("conditions", `{% if pet.age > 5 %}old{% else if pet.age > 1 %}adult{% else %}young{% endif %}`, "adult"),
+		Entry("logical operators", `{{ pet.age == 3 and not (pet.name != "fido") }} {{ "dog" in pet.tags }}`, "true true"),
+		Entry("loops", `{% for tag in pet.tags %}{{ loop.index1 }}={{ tag }}{% if not loop.is_last %},{% endif %}{% endfor %}`, "1=good,2=dog"),
+		Entry("object loops", `{% for key, value in pet %}{{ key }};{% endfor %}`, "age;name;tags;"),
+		Entry("set", `{% set greeting = "hi " + pet.name %}{{ greeting }}`, "hi fido"),
+		Entry("comments and whitespace control", "a {#- comment -#} b\n  {{- pet.name }}", "abfido"),
+		Entry("raw strings", `{{ raw_string("a\"b") }}`, `a\"b`),
+	)
+
+	DescribeTable("fails on invalid templates",
+		func(text, expectedError string) {
+			_, err := renderBody(text)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedError))
+		},
+		Entry("missing variable", "{{ pet.owner }}", "variable 'pet.owner' not found"),
+		Entry("unknown function", "{{ unknown() }}", "unknown function unknown"),
+		Entry("wrong argument count", `{{ header() }}`, "function header does not accept 0 argument(s)"),
+		Entry("unclosed expression", "{{ pet.name", "unclosed {{"),
+		Entry("missing endif", "{% if true %}yes", "missing endif statement"),
+		Entry("unknown statement", "{% include \"other\" %}", `unknown statement "include"`),
+		Entry("division by zero", "{{ pet.age / 0 }}", "division by zero"),
+	)
+
+	It("escapes characters when configured", func() {
+		request.Body = `{"message": "say \"hi\"\n"}`
+		result, err := Evaluate(requestTemplate(&transformation.TransformationTemplate{
+			EscapeCharacters: &wrapperspb.BoolValue{Value: true},
+			BodyTransformation: &transformation.TransformationTemplate_Body{
+				Body: &transformation.InjaTemplate{Text: `{"text": "{{ message }}"}`},
+			},
+		}), request, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Request.Body).To(Equal(`{"text": "say \"hi\"\n"}`))
+	})
+
+	It("fails on invalid JSON bodies unless errors are ignored", func() {
+		request.Body = "not json"
+		tmpl := &transformation.TransformationTemplate{
+			Headers: map[string]*transformation.InjaTemplate{"x-body": {Text: "{{ body() }}"}},
+		}
+		_, err := Evaluate(requestTemplate(tmpl), request, nil, nil)
+		Expect(err).To(MatchError(ContainSubstring("parsing body as JSON")))
+
+		tmpl.IgnoreErrorOnParse = true
+		result, err := Evaluate(requestTemplate(tmpl), request, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header(result.Request, "x-body")).To(Equal("not json"))
+	})
+
+	It("applies extractors, headers and dynamic metadata", func() {
+		result, err := Evaluate(requestTemplate(&transformation.TransformationTemplate{
+			Extractors: map[string]*transformation.Extraction{
+				"resource": {
+					Source:   &transformation.Extraction_Header{Header: ":path"},
+					Regex:    `/(\w+)\?.*`,
+					Subgroup: 1,
+				},
+				"nomatch": {
+					Source: &transformation.Extraction_Header{Header: ":path"},
+					Regex:  `/other`,
+				},
+			},
+			Headers: map[string]*transformation.InjaTemplate{
+				"x-resource": {Text: "{{ resource }}"},
+				"x-user":     {Text: `{{ upper(header("x-user")) }}`},
+				"x-nomatch":  {Text: "{{ nomatch }}"},
+			},
+			HeadersToAppend: []*transformation.TransformationTemplate_HeaderToAppend{
+				{Key: "x-tag", Value: &transformation.InjaTemplate{Text: "{{ pet.tags.0 }}"}},
+				{Key: "x-tag", Value: &transformation.InjaTemplate{Text: "{{ pet.tags.1 }}"}},
+			},
+			HeadersToRemove: []string{"content-type"},
+			DynamicMetadataValues: []*transformation.TransformationTemplate_DynamicMetadataValue{
+				{Key: "pet", Value: &transformation.InjaTemplate{Text: "{{ pet.name }}"}},
+				{MetadataNamespace: "custom", Key: "user", Value: &transformation.InjaTemplate{Text: `{{ header("x-user") }}`}},
+			},
+		}), request, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header(result.Request, "x-resource")).To(Equal("pets"))
+		Expect(header(result.Request, "x-user")).To(Equal("ALICE"))
+		Expect(result.Request.Headers.Values("x-nomatch")).To(BeEmpty())
+		Expect(result.Request.Headers.Values("x-tag")).To(Equal([]string{"good", "dog"}))
+		Expect(result.Request.Headers.Values("content-type")).To(BeEmpty())
+		Expect(result.Request.Body).To(Equal(request.Body))
+		Expect(result.DynamicMetadata).To(Equal(DynamicMetadata{
+			"io.solo.transformation": {"pet": "fido"},
+			"custom":                 {"user": "alice"},
+		}))
+	})
+
+	It("does not make the body available to passthrough transformations", func() {
+		_, err := Evaluate(requestTemplate(&transformation.TransformationTemplate{
+			Headers: map[string]*transformation.InjaTemplate{
+				"x-pet": {Text: "{{ pet.name }}"},
+			},
+			BodyTransformation: &transformation.TransformationTemplate_Passthrough{Passthrough: &transformation.Passthrough{}},
+		}), request, nil, nil)
+		Expect(err).To(MatchError(ContainSubstring("variable 'pet.name' not found")))
+	})
+
+	It("uses the extraction function in advanced mode", func() {
+		request.Body = `{"time": {"start": 10}}`
+		result, err := Evaluate(requestTemplate(&transformation.TransformationTemplate{
+			AdvancedTemplates: true,
+			Extractors: map[string]*transformation.Extraction{
+				"user": {
+					Source: &transformation.Extraction_Header{Header: "x-user"},
+					Regex:  ".*",
+				},
+			},
+			BodyTransformation: &transformation.TransformationTemplate_Body{
+				Body: &transformation.InjaTemplate{Text: `{{ extraction("user") }} {{ time/start }}`},
+			},
+		}), request, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Request.Body).To(Equal("alice 10"))
+		Expect(header(result.Request, "content-length")).To(Equal("8"))
+	})
+
+	It("merges extractors to the body", func() {
+		request.Body = `{"existing": true}`
+		result, err := Evaluate(requestTemplate(&transformation.TransformationTemplate{
+			Extractors: map[string]*transformation.Extraction{
+				"user.name": {
+					Source: &transformation.Extraction_Header{Header: "x-user"},
+					Regex:  ".*",
+				},
+				"everything": {
+					Source:   &transformation.Extraction_Body{Body: &emptypb.Empty{}},
+					Regex:    `\{"(\w+)".*`,
+					Subgroup: 1,
+				},
+			},
+			BodyTransformation: &transformation.TransformationTemplate_MergeExtractorsToBody{
+				MergeExtractorsToBody: &transformation.MergeExtractorsToBody{},
+			},
+		}), request, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Request.Body).To(Equal(`{"everything":"existing","existing":true,"user":{"name":"alice"}}`))
+	})
+
+	It("fails when an extractor subgroup does not exist", func() {
+		_, err := Evaluate(requestTemplate(&transformation.TransformationTemplate{
+			Extractors: map[string]*transformation.Extraction{
+				"user": {
+					Source:   &transformation.Extraction_Header{Header: "x-user"},
+					Regex:    ".*",
+					Subgroup: 1,
+				},
+			},
+		}), request, nil, nil)
+		Expect(err).To(MatchError(ContainSubstring("extractor user requests subgroup 1 but its regex has only 0 subgroup(s)")))
+	})
+
+	It("applies header body transforms", func() {
+		result, err := Evaluate(convert(&transformation.Transformations{
+			RequestTransformation: &transformation.Transformation{
+				TransformationType: &transformation.Transformation_HeaderBodyTransform{
+					HeaderBodyTransform: &transformation.HeaderBodyTransform{AddRequestMetadata: true},
+				},
+			},
+		}, nil), &HttpMessage{
+			Headers: Headers{
+				{Key: ":method", Value: "GET"},
+				{Key: ":path", Value: "/pets?name=fido&name=rex"},
+			},
+		}, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Request.Body).To(MatchJSON(`{
+			"headers": {":method": "GET", ":path": "/pets?name=fido&name=rex"},
+			"httpMethod": "GET",
+			"path": "/pets",
+			"queryString": "name=fido&name=rex",
+			"queryStringParameters": {"name": "rex"},
+			"multiValueQueryStringParameters": {"name": ["fido", "rex"]},
+			"multiValueHeaders": {":method": ["GET"], ":path": ["/pets?name=fido&name=rex"]}
+		}`))
+	})
+
+	Context("staged transformations", func() {
+
+		setHeader := func(name, text string) *transformation.Transformation {
+			return &transformation.Transformation{
+				TransformationType: &transformation.Transformation_TransformationTemplate{
+					TransformationTemplate: &transformation.TransformationTemplate{
+						Headers:           map[string]*transformation.InjaTemplate{name: {Text: text}},
+						ParseBodyBehavior: transformation.TransformationTemplate_DontParse,
+					},
+				},
+			}
+		}
+
+		It("applies the first matching transformation of each stage in order", func() {
+			routeTransformations := convert(nil, &transformation.TransformationStages{
+				Early: &transformation.RequestResponseTransformations{
+					RequestTransforms: []*transformation.RequestMatch{{
+						RequestTransformation:  setHeader("x-stage", "early"),
+						ResponseTransformation: setHeader("x-order", `{{ header("x-order") }}early`),
+					}},
+				},
+				Regular: &transformation.RequestResponseTransformations{
+					RequestTransforms: []*transformation.RequestMatch{
+						{
+							Matcher:               &matchers.Matcher{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/other"}},
+							RequestTransformation: setHeader("x-matched", "other"),
+						},
+						{
+							Matcher: &matchers.Matcher{
+								PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/pets"},
+								Headers:       []*matchers.HeaderMatcher{{Name: "x-stage", Value: "early"}},
+								Methods:       []string{"POST"},
+							},
+							RequestTransformation:  setHeader("x-matched", `pets-{{ header("x-stage") }}`),
+							ResponseTransformation: setHeader("x-order", `{{ header("x-order") }}regular-`),
+						},
+					},
+					ResponseTransforms: []*transformation.ResponseMatch{{
+						Matchers:               []*matchers.HeaderMatcher{{Name: ":status", Value: "404"}},
+						ResponseTransformation: setHeader("x-not-found", "true"),
+					}},
+				},
+			})
+
+			result, err := Evaluate(routeTransformations, request, response, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(header(result.Request, "x-matched")).To(Equal("pets-early"))
+			Expect(header(result.Response, "x-order")).To(Equal("regular-early"))
+			Expect(result.Response.Headers.Values("x-not-found")).To(BeEmpty())
+
+			response.Headers.Set(":status", "404")
+			result, err = Evaluate(routeTransformations, request, response, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(header(result.Response, "x-not-found")).To(Equal("true"))
+			Expect(header(result.Response, "x-order")).To(Equal("early"))
+		})
+
+		It("renders request headers in response transformations", func() {
+			result, err := Evaluate(convert(&transformation.Transformations{
+				RequestTransformation:  setHeader("x-user", "bob"),
+				ResponseTransformation: setHeader("x-request-user", `{{ request_header("x-user") }}`),
+			}, nil), request, response, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(header(result.Response, "x-request-user")).To(Equal("bob"))
+			Expect(header(request, "x-user")).To(Equal("alice"), "the input should not be modified")
+		})
+	})
+})
@@ -0,0 +1,399 @@
+package evaluator
+
+import (
+	"encoding/base64"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+type templateFunction struct {
+	minArgs int
+	// maxArgs is -1 for functions with a variable number of arguments
+	maxArgs int
+	call    func(r *renderer, args []interface{}) (interface{}, error)
+}
+
+func fixedArgs(count int, call func(r *renderer, args []interface{}) (interface{}, error)) templateFunction {
+	return templateFunction{minArgs: count, maxArgs: count, call: call}
+}
+
+// templateFunctions are the functions available to templates: the core Inja functions and the ones added by the
+// transformation filter. "default" and "exists" are evaluated by the renderer, as their arguments are evaluated lazily.
+var templateFunctions = map[string]templateFunction{
+	// transformation filter functions
+	"header": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		name, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		value, _ := r.ctx.headers.Get(name)
+		return value, nil
+	}),
+	"request_header": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		name, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		value, _ := r.ctx.requestHeaders.Get(name)
+		return value, nil
+	}),
+	"extraction": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		name, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return r.ctx.extractions[name], nil
+	}),
+	"env": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		name, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		if r.ctx.env == nil {
+			return "", nil
+		}
+		return r.ctx.env(name), nil
+	}),
+	"body": fixedArgs(0, func(r *renderer, args []interface{}) (interface{}, error) {
+		return r.ctx.body, nil
+	}),
+	"context": fixedArgs(0, func(r *renderer, args []interface{}) (interface{}, error) {
+		return r.ctx.data, nil
+	}),
+	"base64_encode": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString([]byte(value)), nil
+	}),
+	"base64_decode": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			// the filter renders invalid input as an empty string
+			return "", nil
+		}
+		return string(decoded), nil
+	}),
+	"substring": {minArgs: 2, maxArgs: 3, call: func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		start, ok := unwrap(args[1]).(int64)
+		if !ok {
+			return "", nil
+		}
+		length := int64(0)
+		if len(args) > 2 {
+			if length, ok = unwrap(args[2]).(int64); !ok {
+				return "", nil
+			}
+		}
+		size := int64(len(value))
+		if start < 0 || start >= size {
+			return "", nil
+		}
+		if length <= 0 || start+length > size {
+			return value[start:], nil
+		}
+		return value[start : start+length], nil
+	}},
+	"raw_string": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return rawString(escapeJSONString(value)), nil
+	}),
+
+	// core Inja functions
+	"default": {minArgs: 2, maxArgs: 2},
+	"exists":  {minArgs: 1, maxArgs: 1},
+	"existsIn": fixedArgs(2, func(r *renderer, args []interface{}) (interface{}, error) {
+		object, ok := unwrap(args[0]).(map[string]interface{})
+		if !ok {
+			return nil, eris.Errorf("expected an object, got %s", typeName(args[0]))
+		}
+		key, err := stringArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		_, found := object[key]
+		return found, nil
+	}),
+	"at": fixedArgs(2, func(r *renderer, args []interface{}) (interface{}, error) {
+		switch container := unwrap(args[0]).(type) {
+		case []interface{}:
+			index, ok := unwrap(args[1]).(int64)
+			if !ok || index < 0 || index >= int64(len(container)) {
+				return nil, eris.Errorf("index %s out of range", dumpJSON(unwrap(args[1])))
+			}
+			return container[index], nil
+		case map[string]interface{}:
+			key, err := stringArg(args, 1)
+			if err != nil {
+				return nil, err
+			}
+			value, ok := container[key]
+			if !ok {
+				return nil, eris.Errorf("key %s not found", key)
+			}
+			return value, nil
+		default:
+			return nil, eris.Errorf("expected an array or an object, got %s", typeName(container))
+		}
+	}),
+	"length": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		switch typed := unwrap(args[0]).(type) {
+		case string:
+			return int64(len(typed)), nil
+		case []interface{}:
+			return int64(len(typed)), nil
+		case map[string]interface{}:
+			return int64(len(typed)), nil
+		default:
+			return nil, eris.Errorf("expected a string, an array or an object, got %s", typeName(typed))
+		}
+	}),
+	"first": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		items, err := arrayArg(args, 0)
+		if err != nil || len(items) == 0 {
+			return nil, err
+		}
+		return items[0], nil
+	}),
+	"last": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		items, err := arrayArg(args, 0)
+		if err != nil || len(items) == 0 {
+			return nil, err
+		}
+		return items[len(items)-1], nil
+	}),
+	"sort": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		items, err := arrayArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		sorted := append([]interface{}{}, items...)
+		var sortErr error
+		sort.SliceStable(sorted, func(i, j int) bool {
+			comparison, err := compareValues(sorted[i], sorted[j])
+			if err != nil {
+				sortErr = err
+			}
+			return comparison < 0
+		})
+		return sorted, sortErr
+	}),
+	"join": fixedArgs(2, func(r *renderer, args []interface{}) (interface{}, error) {
+		items, err := arrayArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		separator, err := stringArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		parts := make([]string, 0, len(items))
+		for _, item := range items {
+			if s, ok := item.(string); ok {
+				parts = append(parts, s)
+			} else {
+				parts = append(parts, dumpJSON(item))
+			}
+		}
+		return strings.Join(parts, separator), nil
+	}),
+	"range": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		count, ok := unwrap(args[0]).(int64)
+		if !ok {
+			return nil, eris.Errorf("expected an integer, got %s", typeName(args[0]))
+		}
+		items := make([]interface{}, 0, count)
+		for i := int64(0); i < count; i++ {
+			items = append(items, i)
+		}
+		return items, nil
+	}),
+	"upper": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ToUpper(value), nil
+	}),
+	"lower": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ToLower(value), nil
+	}),
+	"capitalize": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil || value == "" {
+			return value, err
+		}
+		return strings.ToUpper(value[:1]) + strings.ToLower(value[1:]), nil
+	}),
+	"replace": fixedArgs(3, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, err := stringArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		from, err := stringArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		to, err := stringArg(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ReplaceAll(value, from, to), nil
+	}),
+	"int": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		switch typed := unwrap(args[0]).(type) {
+		case string:
+			number, err := parseNumber(strings.TrimSpace(typed))
+			if err != nil {
+				return nil, err
+			}
+			value, _ := toInt(number)
+			return value, nil
+		default:
+			value, ok := toInt(typed)
+			if !ok {
+				return nil, eris.Errorf("cannot convert %s to an integer", typeName(typed))
+			}
+			return value, nil
+		}
+	}),
+	"float": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		switch typed := unwrap(args[0]).(type) {
+		case string:
+			number, err := parseNumber(strings.TrimSpace(typed))
+			if err != nil {
+				return nil, err
+			}
+			value, _ := toFloat(number)
+			return value, nil
+		default:
+			value, ok := toFloat(typed)
+			if !ok {
+				return nil, eris.Errorf("cannot convert %s to a float", typeName(typed))
+			}
+			return value, nil
+		}
+	}),
+	"round": fixedArgs(2, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, ok := toFloat(args[0])
+		if !ok {
+			return nil, eris.Errorf("expected a number, got %s", typeName(args[0]))
+		}
+		precision, ok := unwrap(args[1]).(int64)
+		if !ok {
+			return nil, eris.Errorf("expected an integer precision, got %s", typeName(args[1]))
+		}
+		factor := math.Pow(10, float64(precision))
+		rounded := math.Round(value*factor) / factor
+		if precision == 0 {
+			return int64(rounded), nil
+		}
+		return rounded, nil
+	}),
+	"min": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		return extremum(args, -1)
+	}),
+	"max": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		return extremum(args, 1)
+	}),
+	"odd": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, ok := unwrap(args[0]).(int64)
+		return ok && value%2 != 0, nil
+	}),
+	"even": fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, ok := unwrap(args[0]).(int64)
+		return ok && value%2 == 0, nil
+	}),
+	"divisibleBy": fixedArgs(2, func(r *renderer, args []interface{}) (interface{}, error) {
+		value, ok := unwrap(args[0]).(int64)
+		divisor, divisorOk := unwrap(args[1]).(int64)
+		return ok && divisorOk && divisor != 0 && value%divisor == 0, nil
+	}),
+	"isArray": typeCheck(func(value interface{}) bool {
+		_, ok := value.([]interface{})
+		return ok
+	}),
+	"isBoolean": typeCheck(func(value interface{}) bool {
+		_, ok := value.(bool)
+		return ok
+	}),
+	"isFloat": typeCheck(func(value interface{}) bool {
+		_, ok := value.(float64)
+		return ok
+	}),
+	"isInteger": typeCheck(func(value interface{}) bool {
+		_, ok := value.(int64)
+		return ok
+	}),
+	"isNumber": typeCheck(func(value interface{}) bool {
+		_, ok := toFloat(value)
+		return ok
+	}),
+	"isObject": typeCheck(func(value interface{}) bool {
+		_, ok := value.(map[string]interface{})
+		return ok
+	}),
+	"isString": typeCheck(func(value interface{}) bool {
+		_, ok := value.(string)
+		return ok
+	}),
+}
+
+func typeCheck(check func(value interface{}) bool) templateFunction {
+	return fixedArgs(1, func(r *renderer, args []interface{}) (interface{}, error) {
+		return check(unwrap(args[0])), nil
+	})
+}
+
+func extremum(args []interface{}, sign int) (interface{}, error) {
+	items, err := arrayArg(args, 0)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	result := items[0]
+	for _, item := range items[1:] {
+		comparison, err := compareValues(item, result)
+		if err != nil {
+			return nil, err
+		}
+		if comparison*sign > 0 {
+			result = item
+		}
+	}
+	return result, nil
+}
+
+func stringArg(args []interface{}, index int) (string, error) {
+	value, ok := unwrap(args[index]).(string)
+	if !ok {
+		return "", eris.Errorf("argument %d must be a string, got %s", index+1, typeName(args[index]))
+	}
+	return value, nil
+}
+
+func arrayArg(args []interface{}, index int) ([]interface{}, error) {
+	value, ok := unwrap(args[index]).([]interface{})
+	if !ok {
+		return nil, eris.Errorf("argument %d must be an array, got %s", index+1, typeName(args[index]))
+	}
+	return value, nil
+}
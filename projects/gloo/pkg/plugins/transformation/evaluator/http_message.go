@@ -0,0 +1,131 @@
+package evaluator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HttpMessage is an HTTP request or response processed by the transformation filter.
+// Requests use the ":method", ":path" and ":authority" pseudo-headers, and responses use the ":status" pseudo-header.
+type HttpMessage struct {
+	Headers Headers `json:"headers,omitempty"`
+	Body    string  `json:"body,omitempty"`
+}
+
+func (m *HttpMessage) Clone() *HttpMessage {
+	if m == nil {
+		return nil
+	}
+	return &HttpMessage{
+		Headers: append(Headers{}, m.Headers...),
+		Body:    m.Body,
+	}
+}
+
+// Header is a single header value. Header names are lower case, as in Envoy.
+type Header struct {
+	Key   string
+	Value string
+}
+
+// Headers holds the headers of an HTTP message, in order. A header can have multiple values.
+type Headers []Header
+
+// Get returns the first value of a header
+func (h Headers) Get(name string) (string, bool) {
+	name = strings.ToLower(name)
+	for _, header := range h {
+		if header.Key == name {
+			return header.Value, true
+		}
+	}
+	return "", false
+}
+
+// Values returns all the values of a header
+func (h Headers) Values(name string) []string {
+	name = strings.ToLower(name)
+	var values []string
+	for _, header := range h {
+		if header.Key == name {
+			values = append(values, header.Value)
+		}
+	}
+	return values
+}
+
+// Add appends a value to a header
+func (h *Headers) Add(name, value string) {
+	*h = append(*h, Header{Key: strings.ToLower(name), Value: value})
+}
+
+// Del removes all the values of a header
+func (h *Headers) Del(name string) {
+	name = strings.ToLower(name)
+	filtered := (*h)[:0]
+	for _, header := range *h {
+		if header.Key != name {
+			filtered = append(filtered, header)
+		}
+	}
+	*h = filtered
+}
+
+// Set replaces all the values of a header
+func (h *Headers) Set(name, value string) {
+	h.Del(name)
+	h.Add(name, value)
+}
+
+// MarshalJSON writes headers as an object, with a list of values for headers that have multiple values
+func (h Headers) MarshalJSON() ([]byte, error) {
+	object := map[string]interface{}{}
+	for _, header := range h {
+		switch existing := object[header.Key].(type) {
+		case nil:
+			object[header.Key] = header.Value
+		case string:
+			object[header.Key] = []string{existing, header.Value}
+		case []string:
+			object[header.Key] = append(existing, header.Value)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// UnmarshalJSON reads headers from an object, whose values are either a single value or a list of values
+func (h *Headers) UnmarshalJSON(data []byte) error {
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	// pseudo-headers come first, as in Envoy
+	sort.SliceStable(keys, func(i, j int) bool {
+		iPseudo, jPseudo := strings.HasPrefix(keys[i], ":"), strings.HasPrefix(keys[j], ":")
+		if iPseudo != jPseudo {
+			return iPseudo
+		}
+		return keys[i] < keys[j]
+	})
+
+	*h = nil
+	for _, key := range keys {
+		switch value := object[key].(type) {
+		case []interface{}:
+			for _, item := range value {
+				h.Add(key, fmt.Sprint(item))
+			}
+		case nil:
+			h.Add(key, "")
+		default:
+			h.Add(key, fmt.Sprint(value))
+		}
+	}
+	return nil
+}
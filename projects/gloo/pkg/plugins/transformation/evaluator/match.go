@@ -0,0 +1,148 @@
+package evaluator
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	envoyroutev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/route/v3"
+	envoytransformation "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+)
+
+// responseCodeDetails are the response code details of responses that are sent by the upstream
+const responseCodeDetails = "via_upstream"
+
+// requestMatches returns true if the request matches the route match of a transformation. A nil match matches all requests.
+func requestMatches(match *envoyroutev3.RouteMatch, headers Headers) (bool, error) {
+	if match == nil {
+		return true, nil
+	}
+
+	fullPath, _ := headers.Get(":path")
+	path, queryString := splitPath(fullPath)
+	caseSensitive := match.GetCaseSensitive() == nil || match.GetCaseSensitive().GetValue()
+
+	switch specifier := match.GetPathSpecifier().(type) {
+	case *envoyroutev3.RouteMatch_Prefix:
+		if caseSensitive && !strings.HasPrefix(path, specifier.Prefix) ||
+			!caseSensitive && !strings.HasPrefix(strings.ToLower(path), strings.ToLower(specifier.Prefix)) {
+			return false, nil
+		}
+	case *envoyroutev3.RouteMatch_Path:
+		if caseSensitive && path != specifier.Path || !caseSensitive && !strings.EqualFold(path, specifier.Path) {
+			return false, nil
+		}
+	case *envoyroutev3.RouteMatch_SafeRegex:
+		matched, err := regexFullMatch(specifier.SafeRegex.GetRegex(), path)
+		if err != nil || !matched {
+			return false, err
+		}
+	case *envoyroutev3.RouteMatch_ConnectMatcher_:
+		if method, _ := headers.Get(":method"); method != "CONNECT" {
+			return false, nil
+		}
+	}
+
+	for _, headerMatcher := range match.GetHeaders() {
+		matched, err := headerMatches(headerMatcher, headers)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	parameters := Headers(parseQueryString(queryString))
+	for _, parameterMatcher := range match.GetQueryParameters() {
+		value, present := parameters.Get(parameterMatcher.GetName())
+		if stringMatch := parameterMatcher.GetStringMatch(); stringMatch != nil {
+			if !present {
+				return false, nil
+			}
+			matched, err := stringMatches(stringMatch, value)
+			if err != nil || !matched {
+				return false, err
+			}
+		} else if !present {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// responseMatches returns true if the response matches the matcher of a response transformation
+func responseMatches(match *envoytransformation.ResponseMatcher, headers Headers) (bool, error) {
+	for _, headerMatcher := range match.GetHeaders() {
+		matched, err := headerMatches(headerMatcher, headers)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	if details := match.GetResponseCodeDetails(); details != nil {
+		return stringMatches(details, responseCodeDetails)
+	}
+	return true, nil
+}
+
+func headerMatches(matcher *envoyroutev3.HeaderMatcher, headers Headers) (bool, error) {
+	values := headers.Values(matcher.GetName())
+	present := len(values) > 0
+	// multiple values of a header are matched as a single comma separated value
+	value := strings.Join(values, ",")
+
+	var matched bool
+	switch specifier := matcher.GetHeaderMatchSpecifier().(type) {
+	case *envoyroutev3.HeaderMatcher_ExactMatch:
+		matched = present && value == specifier.ExactMatch
+	case *envoyroutev3.HeaderMatcher_SafeRegexMatch:
+		if present {
+			var err error
+			if matched, err = regexFullMatch(specifier.SafeRegexMatch.GetRegex(), value); err != nil {
+				return false, err
+			}
+		}
+	case *envoyroutev3.HeaderMatcher_RangeMatch:
+		number, err := strconv.ParseInt(value, 10, 64)
+		matched = present && err == nil && number >= specifier.RangeMatch.GetStart() && number < specifier.RangeMatch.GetEnd()
+	case *envoyroutev3.HeaderMatcher_PresentMatch:
+		matched = present == specifier.PresentMatch
+	case *envoyroutev3.HeaderMatcher_PrefixMatch:
+		matched = present && strings.HasPrefix(value, specifier.PrefixMatch)
+	case *envoyroutev3.HeaderMatcher_SuffixMatch:
+		matched = present && strings.HasSuffix(value, specifier.SuffixMatch)
+	default:
+		matched = present
+	}
+	return matched != matcher.GetInvertMatch(), nil
+}
+
+func stringMatches(matcher *v3.StringMatcher, value string) (bool, error) {
+	if matcher.GetIgnoreCase() {
+		value = strings.ToLower(value)
+	}
+	normalize := func(pattern string) string {
+		if matcher.GetIgnoreCase() {
+			return strings.ToLower(pattern)
+		}
+		return pattern
+	}
+	switch pattern := matcher.GetMatchPattern().(type) {
+	case *v3.StringMatcher_Exact:
+		return value == normalize(pattern.Exact), nil
+	case *v3.StringMatcher_Prefix:
+		return strings.HasPrefix(value, normalize(pattern.Prefix)), nil
+	case *v3.StringMatcher_Suffix:
+		return strings.HasSuffix(value, normalize(pattern.Suffix)), nil
+	case *v3.StringMatcher_SafeRegex:
+		return regexFullMatch(pattern.SafeRegex.GetRegex(), value)
+	}
+	return true, nil
+}
+
+func regexFullMatch(regex, value string) (bool, error) {
+	compiled, err := regexp.Compile("^(?:" + regex + ")$")
+	if err != nil {
+		return false, eris.Wrapf(err, "invalid regex %s", regex)
+	}
+	return compiled.MatchString(value), nil
+}
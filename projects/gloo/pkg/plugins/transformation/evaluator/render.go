@@ -0,0 +1,431 @@
+package evaluator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rotisserie/eris"
+)
+
+var (
+	VariableNotFoundError = func(name string) error {
+		return eris.Errorf("variable '%s' not found", name)
+	}
+	InvalidOperandsError = func(operator string, left, right interface{}) error {
+		return eris.Errorf("invalid operands for %s: %s and %s", operator, typeName(left), typeName(right))
+	}
+)
+
+// renderContext holds the data that is available when rendering a template
+type renderContext struct {
+	// data is the JSON context of the template, i.e. the parsed body and the extractors in non-advanced mode
+	data          interface{}
+	escapeStrings bool
+
+	headers        *Headers
+	requestHeaders *Headers
+	extractions    map[string]string
+	body           string
+	env            func(name string) string
+}
+
+type renderer struct {
+	ctx             *renderContext
+	pointerNotation bool
+	// scopes hold the loop and set variables, which shadow the data of the context
+	scopes []map[string]interface{}
+	out    strings.Builder
+}
+
+// render renders the template with the given context
+func (t *Template) render(ctx *renderContext, pointerNotation bool) (string, error) {
+	r := &renderer{
+		ctx:             ctx,
+		pointerNotation: pointerNotation,
+		scopes:          []map[string]interface{}{{}},
+	}
+	if err := r.renderNodes(t.nodes); err != nil {
+		return "", err
+	}
+	return r.out.String(), nil
+}
+
+func (r *renderer) renderNodes(nodes []node) error {
+	for _, n := range nodes {
+		if err := r.renderNode(n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *renderer) renderNode(n node) error {
+	switch typed := n.(type) {
+	case *textNode:
+		r.out.WriteString(typed.text)
+	case *expressionNode:
+		value, err := r.eval(typed.expression)
+		if err != nil {
+			return err
+		}
+		r.out.WriteString(r.print(value))
+	case *ifNode:
+		for _, branch := range typed.branches {
+			condition, err := r.eval(branch.condition)
+			if err != nil {
+				return err
+			}
+			if truthy(condition) {
+				return r.renderNodes(branch.body)
+			}
+		}
+		return r.renderNodes(typed.elseBody)
+	case *forNode:
+		return r.renderFor(typed)
+	case *setNode:
+		value, err := r.eval(typed.value)
+		if err != nil {
+			return err
+		}
+		r.scopes[0][typed.name] = value
+	}
+	return nil
+}
+
+func (r *renderer) renderFor(loop *forNode) error {
+	iterable, err := r.eval(loop.iterable)
+	if err != nil {
+		return err
+	}
+
+	type item struct {
+		key   string
+		value interface{}
+	}
+	var items []item
+	switch typed := unwrap(iterable).(type) {
+	case []interface{}:
+		if loop.keyName != "" {
+			return eris.Errorf("cannot iterate over %s with a key and a value", typeName(typed))
+		}
+		for _, value := range typed {
+			items = append(items, item{value: value})
+		}
+	case map[string]interface{}:
+		if loop.keyName == "" {
+			return eris.Errorf("iterating over an object requires a key and a value")
+		}
+		for _, key := range sortedKeys(typed) {
+			items = append(items, item{key: key, value: typed[key]})
+		}
+	default:
+		return eris.Errorf("cannot iterate over %s", typeName(typed))
+	}
+
+	var parent interface{}
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		if outer, ok := r.scopes[i]["loop"]; ok {
+			parent = outer
+			break
+		}
+	}
+
+	for i, it := range items {
+		scope := map[string]interface{}{
+			loop.valueName: it.value,
+		}
+		if loop.keyName != "" {
+			scope[loop.keyName] = it.key
+		}
+		loopData := map[string]interface{}{
+			"index":    int64(i),
+			"index1":   int64(i + 1),
+			"is_first": i == 0,
+			"is_last":  i == len(items)-1,
+		}
+		if parent != nil {
+			loopData["parent"] = parent
+		}
+		scope["loop"] = loopData
+
+		r.scopes = append(r.scopes, scope)
+		err := r.renderNodes(loop.body)
+		r.scopes = r.scopes[:len(r.scopes)-1]
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// print converts a value to the text written to the output of the template
+func (r *renderer) print(value interface{}) string {
+	switch typed := value.(type) {
+	case rawString:
+		return string(typed)
+	case string:
+		if r.ctx.escapeStrings {
+			return escapeJSONString(typed)
+		}
+		return typed
+	default:
+		return dumpJSON(typed)
+	}
+}
+
+func (r *renderer) eval(expr expression) (interface{}, error) {
+	switch typed := expr.(type) {
+	case *literalExpression:
+		return typed.value, nil
+	case *variableExpression:
+		return r.lookup(typed.name, typed.path)
+	case *functionExpression:
+		return r.evalFunction(typed)
+	case *unaryExpression:
+		operand, err := r.eval(typed.operand)
+		if err != nil {
+			return nil, err
+		}
+		if typed.operator == "not" {
+			return !truthy(operand), nil
+		}
+		switch number := unwrap(operand).(type) {
+		case int64:
+			return -number, nil
+		case float64:
+			return -number, nil
+		}
+		return nil, eris.Errorf("invalid operand for -: %s", typeName(operand))
+	case *binaryExpression:
+		return r.evalBinary(typed)
+	case *arrayExpression:
+		items := make([]interface{}, 0, len(typed.items))
+		for _, itemExpression := range typed.items {
+			item, err := r.eval(itemExpression)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, unwrap(item))
+		}
+		return items, nil
+	case *objectExpression:
+		object := make(map[string]interface{}, len(typed.keys))
+		for i, key := range typed.keys {
+			value, err := r.eval(typed.values[i])
+			if err != nil {
+				return nil, err
+			}
+			object[key] = unwrap(value)
+		}
+		return object, nil
+	}
+	return nil, eris.Errorf("unknown expression %T", expr)
+}
+
+// lookup resolves a variable, first in the loop and set variables and then in the data of the context
+func (r *renderer) lookup(name string, path []string) (interface{}, error) {
+	for i := len(r.scopes) - 1; i >= 0; i-- {
+		if value, ok := r.scopes[i][name]; ok {
+			return value, nil
+		}
+		if value, ok := r.scopes[i][path[0]]; ok {
+			if found, ok := walk(value, path[1:]); ok {
+				return found, nil
+			}
+			return nil, VariableNotFoundError(name)
+		}
+	}
+	if found, ok := walk(r.ctx.data, path); ok {
+		return found, nil
+	}
+	return nil, VariableNotFoundError(name)
+}
+
+func walk(value interface{}, path []string) (interface{}, bool) {
+	for _, segment := range path {
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			next, ok := typed[segment]
+			if !ok {
+				return nil, false
+			}
+			value = next
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			value = typed[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+func (r *renderer) evalBinary(expr *binaryExpression) (interface{}, error) {
+	left, err := r.eval(expr.left)
+	if err != nil {
+		return nil, err
+	}
+	// logical operators short-circuit
+	switch expr.operator {
+	case "and":
+		if !truthy(left) {
+			return false, nil
+		}
+		right, err := r.eval(expr.right)
+		if err != nil {
+			return nil, err
+		}
+		return truthy(right), nil
+	case "or":
+		if truthy(left) {
+			return true, nil
+		}
+		right, err := r.eval(expr.right)
+		if err != nil {
+			return nil, err
+		}
+		return truthy(right), nil
+	}
+
+	right, err := r.eval(expr.right)
+	if err != nil {
+		return nil, err
+	}
+	left, right = unwrap(left), unwrap(right)
+
+	switch expr.operator {
+	case "==":
+		return valuesEqual(left, right), nil
+	case "!=":
+		return !valuesEqual(left, right), nil
+	case "<", "<=", ">", ">=":
+		comparison, err := compareValues(left, right)
+		if err != nil {
+			return nil, err
+		}
+		switch expr.operator {
+		case "<":
+			return comparison < 0, nil
+		case "<=":
+			return comparison <= 0, nil
+		case ">":
+			return comparison > 0, nil
+		default:
+			return comparison >= 0, nil
+		}
+	case "in":
+		items, ok := right.([]interface{})
+		if !ok {
+			return nil, InvalidOperandsError(expr.operator, left, right)
+		}
+		for _, item := range items {
+			if valuesEqual(left, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return arithmetic(expr.operator, left, right)
+}
+
+func arithmetic(operator string, left, right interface{}) (interface{}, error) {
+	if operator == "+" {
+		if leftString, ok := left.(string); ok {
+			if rightString, ok := right.(string); ok {
+				return leftString + rightString, nil
+			}
+		}
+	}
+
+	leftInt, leftIsInt := left.(int64)
+	rightInt, rightIsInt := right.(int64)
+	leftFloat, leftIsNumber := toFloat(left)
+	rightFloat, rightIsNumber := toFloat(right)
+	if !leftIsNumber || !rightIsNumber {
+		return nil, InvalidOperandsError(operator, left, right)
+	}
+	bothInts := leftIsInt && rightIsInt
+
+	switch operator {
+	case "+":
+		if bothInts {
+			return leftInt + rightInt, nil
+		}
+		return leftFloat + rightFloat, nil
+	case "-":
+		if bothInts {
+			return leftInt - rightInt, nil
+		}
+		return leftFloat - rightFloat, nil
+	case "*":
+		if bothInts {
+			return leftInt * rightInt, nil
+		}
+		return leftFloat * rightFloat, nil
+	case "/":
+		if rightFloat == 0 {
+			return nil, eris.New("division by zero")
+		}
+		return leftFloat / rightFloat, nil
+	case "%":
+		if !bothInts {
+			return nil, InvalidOperandsError(operator, left, right)
+		}
+		if rightInt == 0 {
+			return nil, eris.New("division by zero")
+		}
+		return leftInt % rightInt, nil
+	case "^":
+		if bothInts && rightInt >= 0 {
+			result := int64(1)
+			for i := int64(0); i < rightInt; i++ {
+				result *= leftInt
+			}
+			return result, nil
+		}
+		return math.Pow(leftFloat, rightFloat), nil
+	}
+	return nil, eris.Errorf("unknown operator %s", operator)
+}
+
+func (r *renderer) evalFunction(expr *functionExpression) (interface{}, error) {
+	// these functions handle variables that are not found, so their arguments are evaluated lazily
+	switch expr.name {
+	case "default":
+		value, err := r.eval(expr.args[0])
+		if err == nil {
+			return value, nil
+		}
+		return r.eval(expr.args[1])
+	case "exists":
+		name, err := r.eval(expr.args[0])
+		if err != nil {
+			return nil, err
+		}
+		nameString, ok := unwrap(name).(string)
+		if !ok {
+			return nil, eris.Errorf("exists expects a string argument, got %s", typeName(name))
+		}
+		variable := newVariableExpression(nameString, r.pointerNotation).(*variableExpression)
+		_, err = r.lookup(variable.name, variable.path)
+		return err == nil, nil
+	}
+
+	args := make([]interface{}, 0, len(expr.args))
+	for _, argExpression := range expr.args {
+		arg, err := r.eval(argExpression)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	value, err := templateFunctions[expr.name].call(r, args)
+	if err != nil {
+		return nil, eris.Wrapf(err, "calling function %s", expr.name)
+	}
+	return value, nil
+}
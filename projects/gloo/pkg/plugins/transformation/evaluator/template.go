@@ -0,0 +1,851 @@
+package evaluator
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/rotisserie/eris"
+)
+
+/*
+This file parses the subset of the Inja template language (https://github.com/pantor/inja) that is supported by the
+transformation filter:
+
+  - expressions: {{ expression }}
+  - statements: {% if %}, {% else if %}, {% else %}, {% endif %}, {% for %}, {% endfor %} and {% set %}
+  - comments: {# comment #}
+  - whitespace control with a "-" next to the delimiters, e.g. {{- expression -}}
+
+Expressions support literals (strings, numbers, booleans, null, arrays and objects), variables, function calls,
+pipes (e.g. name | upper), and the arithmetic, comparison and logical operators of Inja.
+*/
+
+var (
+	UnclosedDelimiterError = func(delimiter string, offset int) error {
+		return eris.Errorf("unclosed %s starting at offset %d", delimiter, offset)
+	}
+	UnexpectedTokenError = func(text string) error {
+		return eris.Errorf("unexpected token %q", text)
+	}
+	UnknownStatementError = func(statement string) error {
+		return eris.Errorf("unknown statement %q", statement)
+	}
+	UnknownFunctionError = func(name string) error {
+		return eris.Errorf("unknown function %s", name)
+	}
+	FunctionArgumentCountError = func(name string, count int) error {
+		return eris.Errorf("function %s does not accept %d argument(s)", name, count)
+	}
+)
+
+// Template is a parsed Inja template
+type Template struct {
+	nodes []node
+}
+
+// ParseTemplate parses an Inja template. If pointerNotation is true, variables use the JSON pointer notation
+// (e.g. "time/start") instead of the dot notation (e.g. "time.start"), as with TransformationTemplate.advanced_templates.
+func ParseTemplate(text string, pointerNotation bool) (*Template, error) {
+	segments, err := splitTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &templateParser{segments: segments, pointerNotation: pointerNotation}
+	nodes, terminator, err := p.parseNodes()
+	if err != nil {
+		return nil, err
+	}
+	if terminator != "" {
+		return nil, UnexpectedTokenError(terminator)
+	}
+	return &Template{nodes: nodes}, nil
+}
+
+type node interface{}
+
+type textNode struct {
+	text string
+}
+
+type expressionNode struct {
+	expression expression
+}
+
+type ifBranch struct {
+	condition expression
+	body      []node
+}
+
+type ifNode struct {
+	branches []ifBranch
+	elseBody []node
+}
+
+type forNode struct {
+	keyName   string
+	valueName string
+	iterable  expression
+	body      []node
+}
+
+type setNode struct {
+	name  string
+	value expression
+}
+
+type expression interface{}
+
+type literalExpression struct {
+	value interface{}
+}
+
+type variableExpression struct {
+	name string
+	path []string
+}
+
+type functionExpression struct {
+	name string
+	args []expression
+}
+
+type unaryExpression struct {
+	operator string
+	operand  expression
+}
+
+type binaryExpression struct {
+	operator    string
+	left, right expression
+}
+
+type arrayExpression struct {
+	items []expression
+}
+
+type objectExpression struct {
+	keys   []string
+	values []expression
+}
+
+type segmentKind int
+
+const (
+	textSegment segmentKind = iota
+	expressionSegment
+	statementSegment
+)
+
+type segment struct {
+	kind    segmentKind
+	content string
+}
+
+var closingDelimiters = map[string]string{
+	"{{": "}}",
+	"{%": "%}",
+	"{#": "#}",
+}
+
+// splitTemplate splits the template into text, expression and statement segments, dropping comments
+func splitTemplate(text string) ([]segment, error) {
+	var segments []segment
+	trimNextText := false
+	for len(text) > 0 {
+		start := nextOpeningDelimiter(text)
+		if start < 0 {
+			segments = appendText(segments, text, trimNextText)
+			break
+		}
+		opening := text[start : start+2]
+		closing := closingDelimiters[opening]
+		offset := start + 2
+		content := text[offset:]
+		end := strings.Index(content, closing)
+		if end < 0 {
+			return nil, UnclosedDelimiterError(opening, start)
+		}
+		content = content[:end]
+
+		preceding := text[:start]
+		if strings.HasPrefix(content, "-") {
+			content = content[1:]
+			preceding = strings.TrimRightFunc(preceding, unicode.IsSpace)
+		}
+		segments = appendText(segments, preceding, trimNextText)
+		trimNextText = strings.HasSuffix(content, "-")
+		if trimNextText {
+			content = content[:len(content)-1]
+		}
+
+		switch opening {
+		case "{{":
+			segments = append(segments, segment{kind: expressionSegment, content: content})
+		case "{%":
+			segments = append(segments, segment{kind: statementSegment, content: content})
+		}
+		text = text[offset+end+len(closing):]
+	}
+	return segments, nil
+}
+
+func nextOpeningDelimiter(text string) int {
+	for i := 0; i+1 < len(text); i++ {
+		if text[i] == '{' && (text[i+1] == '{' || text[i+1] == '%' || text[i+1] == '#') {
+			return i
+		}
+	}
+	return -1
+}
+
+func appendText(segments []segment, text string, trimLeft bool) []segment {
+	if trimLeft {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+	}
+	if text == "" {
+		return segments
+	}
+	return append(segments, segment{kind: textSegment, content: text})
+}
+
+type templateParser struct {
+	segments        []segment
+	position        int
+	pointerNotation bool
+}
+
+// parseNodes parses segments until one of the terminating statements is found, and returns the terminating statement.
+// The terminating statement is empty when the end of the template is reached.
+func (p *templateParser) parseNodes(terminators ...string) ([]node, string, error) {
+	var nodes []node
+	for p.position < len(p.segments) {
+		current := p.segments[p.position]
+		p.position++
+		switch current.kind {
+		case textSegment:
+			nodes = append(nodes, &textNode{text: current.content})
+		case expressionSegment:
+			lex, err := p.newLexer(current.content)
+			if err != nil {
+				return nil, "", err
+			}
+			expr, err := lex.parseExpression()
+			if err != nil {
+				return nil, "", err
+			}
+			if err := lex.expectEnd(); err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, &expressionNode{expression: expr})
+		case statementSegment:
+			lex, err := p.newLexer(current.content)
+			if err != nil {
+				return nil, "", err
+			}
+			keyword := lex.next()
+			for _, terminator := range terminators {
+				if keyword.kind == identifierToken && keyword.text == terminator {
+					// the lexer is rewound so that the caller can parse the rest of the statement
+					p.position--
+					return nodes, terminator, nil
+				}
+			}
+			statement, err := p.parseStatement(keyword, lex)
+			if err != nil {
+				return nil, "", err
+			}
+			if statement != nil {
+				nodes = append(nodes, statement)
+			}
+		}
+	}
+	return nodes, "", nil
+}
+
+func (p *templateParser) parseStatement(keyword token, lex *lexer) (node, error) {
+	if keyword.kind != identifierToken {
+		return nil, UnexpectedTokenError(keyword.text)
+	}
+	switch keyword.text {
+	case "if":
+		return p.parseIf(lex)
+	case "for":
+		return p.parseFor(lex)
+	case "set":
+		name := lex.next()
+		if name.kind != identifierToken {
+			return nil, UnexpectedTokenError(name.text)
+		}
+		if err := lex.expectOperator("="); err != nil {
+			return nil, err
+		}
+		value, err := lex.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if err := lex.expectEnd(); err != nil {
+			return nil, err
+		}
+		return &setNode{name: name.text, value: value}, nil
+	default:
+		return nil, UnknownStatementError(keyword.text)
+	}
+}
+
+func (p *templateParser) parseIf(lex *lexer) (node, error) {
+	statement := &ifNode{}
+	for {
+		condition, err := lex.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if err := lex.expectEnd(); err != nil {
+			return nil, err
+		}
+		body, terminator, err := p.parseNodes("else", "endif")
+		if err != nil {
+			return nil, err
+		}
+		statement.branches = append(statement.branches, ifBranch{condition: condition, body: body})
+
+		switch terminator {
+		case "endif":
+			return statement, p.consumeStatement("endif")
+		case "else":
+			lex, err = p.consumeStatementLexer("else")
+			if err != nil {
+				return nil, err
+			}
+			if lex.peek().kind == identifierToken && lex.peek().text == "if" {
+				lex.next()
+				continue
+			}
+			if err := lex.expectEnd(); err != nil {
+				return nil, err
+			}
+			elseBody, terminator, err := p.parseNodes("endif")
+			if err != nil {
+				return nil, err
+			}
+			if terminator != "endif" {
+				return nil, eris.New("missing endif statement")
+			}
+			statement.elseBody = elseBody
+			return statement, p.consumeStatement("endif")
+		default:
+			return nil, eris.New("missing endif statement")
+		}
+	}
+}
+
+func (p *templateParser) parseFor(lex *lexer) (node, error) {
+	statement := &forNode{}
+	first := lex.next()
+	if first.kind != identifierToken {
+		return nil, UnexpectedTokenError(first.text)
+	}
+	statement.valueName = first.text
+	if lex.peek().kind == operatorToken && lex.peek().text == "," {
+		lex.next()
+		second := lex.next()
+		if second.kind != identifierToken {
+			return nil, UnexpectedTokenError(second.text)
+		}
+		statement.keyName, statement.valueName = first.text, second.text
+	}
+	if in := lex.next(); in.kind != identifierToken || in.text != "in" {
+		return nil, UnexpectedTokenError(in.text)
+	}
+	iterable, err := lex.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if err := lex.expectEnd(); err != nil {
+		return nil, err
+	}
+	statement.iterable = iterable
+
+	body, terminator, err := p.parseNodes("endfor")
+	if err != nil {
+		return nil, err
+	}
+	if terminator != "endfor" {
+		return nil, eris.New("missing endfor statement")
+	}
+	statement.body = body
+	return statement, p.consumeStatement("endfor")
+}
+
+// consumeStatementLexer consumes the terminating statement returned by parseNodes and returns a lexer positioned after its keyword
+func (p *templateParser) consumeStatementLexer(keyword string) (*lexer, error) {
+	current := p.segments[p.position]
+	p.position++
+	lex, err := p.newLexer(current.content)
+	if err != nil {
+		return nil, err
+	}
+	if next := lex.next(); next.text != keyword {
+		return nil, UnexpectedTokenError(next.text)
+	}
+	return lex, nil
+}
+
+func (p *templateParser) consumeStatement(keyword string) error {
+	lex, err := p.consumeStatementLexer(keyword)
+	if err != nil {
+		return err
+	}
+	return lex.expectEnd()
+}
+
+func (p *templateParser) newLexer(content string) (*lexer, error) {
+	tokens, err := tokenize(content, p.pointerNotation)
+	if err != nil {
+		return nil, err
+	}
+	return &lexer{tokens: tokens, pointerNotation: p.pointerNotation}, nil
+}
+
+type tokenKind int
+
+const (
+	endToken tokenKind = iota
+	identifierToken
+	numberToken
+	stringToken
+	operatorToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// operators are listed longest first, so that e.g. "==" is not tokenized as two "="
+var operators = []string{"==", "!=", "<=", ">=", "<", ">", "+", "-", "*", "/", "%", "^", "=", "(", ")", "[", "]", "{", "}", ",", ":", "|"}
+
+func tokenize(content string, pointerNotation bool) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isIdentifierStart(c):
+			start := i
+			for i < len(content) && isIdentifierPart(content[i], pointerNotation) {
+				i++
+			}
+			tokens = append(tokens, token{kind: identifierToken, text: content[start:i]})
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(content) && (content[i] >= '0' && content[i] <= '9' || content[i] == '.' ||
+				content[i] == 'e' || content[i] == 'E' ||
+				(content[i] == '-' || content[i] == '+') && (content[i-1] == 'e' || content[i-1] == 'E')) {
+				i++
+			}
+			tokens = append(tokens, token{kind: numberToken, text: content[start:i]})
+		case c == '"' || c == '\'':
+			value, length, err := unquote(content[i:])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: stringToken, text: value})
+			i += length
+		default:
+			matched := false
+			for _, operator := range operators {
+				if strings.HasPrefix(content[i:], operator) {
+					tokens = append(tokens, token{kind: operatorToken, text: operator})
+					i += len(operator)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, UnexpectedTokenError(string(c))
+			}
+		}
+	}
+	return tokens, nil
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentifierPart(c byte, pointerNotation bool) bool {
+	return isIdentifierStart(c) || c >= '0' && c <= '9' || c == '.' || pointerNotation && c == '/'
+}
+
+// unquote reads a quoted string literal at the beginning of text, and returns its value and length
+func unquote(text string) (string, int, error) {
+	quote := text[0]
+	var sb strings.Builder
+	for i := 1; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == quote:
+			return sb.String(), i + 1, nil
+		case c == '\\' && i+1 < len(text):
+			i++
+			switch text[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			default:
+				sb.WriteByte(text[i])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, eris.Errorf("unterminated string literal %s", text)
+}
+
+type lexer struct {
+	tokens          []token
+	position        int
+	pointerNotation bool
+}
+
+func (l *lexer) peek() token {
+	if l.position >= len(l.tokens) {
+		return token{kind: endToken}
+	}
+	return l.tokens[l.position]
+}
+
+func (l *lexer) next() token {
+	t := l.peek()
+	if t.kind != endToken {
+		l.position++
+	}
+	return t
+}
+
+func (l *lexer) isOperator(operators ...string) bool {
+	t := l.peek()
+	if t.kind != operatorToken {
+		return false
+	}
+	for _, operator := range operators {
+		if t.text == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *lexer) isKeyword(keyword string) bool {
+	t := l.peek()
+	return t.kind == identifierToken && t.text == keyword
+}
+
+func (l *lexer) expectOperator(operator string) error {
+	if t := l.next(); t.kind != operatorToken || t.text != operator {
+		return eris.Errorf("expected %q, found %q", operator, t.text)
+	}
+	return nil
+}
+
+func (l *lexer) expectEnd() error {
+	if t := l.peek(); t.kind != endToken {
+		return UnexpectedTokenError(t.text)
+	}
+	return nil
+}
+
+func (l *lexer) parseExpression() (expression, error) {
+	return l.parseOr()
+}
+
+func (l *lexer) parseOr() (expression, error) {
+	left, err := l.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for l.isKeyword("or") {
+		l.next()
+		right, err := l.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpression{operator: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (l *lexer) parseAnd() (expression, error) {
+	left, err := l.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for l.isKeyword("and") {
+		l.next()
+		right, err := l.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpression{operator: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (l *lexer) parseNot() (expression, error) {
+	if l.isKeyword("not") {
+		l.next()
+		operand, err := l.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryExpression{operator: "not", operand: operand}, nil
+	}
+	return l.parseComparison()
+}
+
+func (l *lexer) parseComparison() (expression, error) {
+	left, err := l.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	var operator string
+	switch {
+	case l.isOperator("==", "!=", "<", "<=", ">", ">="):
+		operator = l.next().text
+	case l.isKeyword("in"):
+		operator = l.next().text
+	default:
+		return left, nil
+	}
+	right, err := l.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return &binaryExpression{operator: operator, left: left, right: right}, nil
+}
+
+func (l *lexer) parseAdditive() (expression, error) {
+	left, err := l.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for l.isOperator("+", "-") {
+		operator := l.next().text
+		right, err := l.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpression{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (l *lexer) parseMultiplicative() (expression, error) {
+	left, err := l.parsePower()
+	if err != nil {
+		return nil, err
+	}
+	for l.isOperator("*", "/", "%") {
+		operator := l.next().text
+		right, err := l.parsePower()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpression{operator: operator, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (l *lexer) parsePower() (expression, error) {
+	left, err := l.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if l.isOperator("^") {
+		l.next()
+		right, err := l.parsePower()
+		if err != nil {
+			return nil, err
+		}
+		return &binaryExpression{operator: "^", left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (l *lexer) parseUnary() (expression, error) {
+	if l.isOperator("-") {
+		l.next()
+		operand, err := l.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryExpression{operator: "-", operand: operand}, nil
+	}
+	return l.parsePipe()
+}
+
+// parsePipe parses calls with the pipe syntax, e.g. "name | upper" is equivalent to "upper(name)"
+func (l *lexer) parsePipe() (expression, error) {
+	value, err := l.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for l.isOperator("|") {
+		l.next()
+		name := l.next()
+		if name.kind != identifierToken {
+			return nil, UnexpectedTokenError(name.text)
+		}
+		args := []expression{value}
+		if l.isOperator("(") {
+			l.next()
+			more, err := l.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, more...)
+		}
+		if value, err = newFunctionExpression(name.text, args); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+func (l *lexer) parsePrimary() (expression, error) {
+	t := l.next()
+	switch t.kind {
+	case numberToken:
+		value, err := parseNumber(t.text)
+		if err != nil {
+			return nil, err
+		}
+		return &literalExpression{value: value}, nil
+	case stringToken:
+		return &literalExpression{value: t.text}, nil
+	case identifierToken:
+		switch t.text {
+		case "true":
+			return &literalExpression{value: true}, nil
+		case "false":
+			return &literalExpression{value: false}, nil
+		case "null":
+			return &literalExpression{value: nil}, nil
+		}
+		if l.isOperator("(") {
+			l.next()
+			args, err := l.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			return newFunctionExpression(t.text, args)
+		}
+		return newVariableExpression(t.text, l.pointerNotation), nil
+	case operatorToken:
+		switch t.text {
+		case "(":
+			expr, err := l.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			return expr, l.expectOperator(")")
+		case "[":
+			items, err := l.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &arrayExpression{items: items}, nil
+		case "{":
+			return l.parseObject()
+		}
+	case endToken:
+		return nil, eris.New("unexpected end of expression")
+	}
+	return nil, UnexpectedTokenError(t.text)
+}
+
+// parseList parses comma separated expressions until the closing operator
+func (l *lexer) parseList(closing string) ([]expression, error) {
+	var items []expression
+	if l.isOperator(closing) {
+		l.next()
+		return items, nil
+	}
+	for {
+		item, err := l.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if l.isOperator(",") {
+			l.next()
+			continue
+		}
+		return items, l.expectOperator(closing)
+	}
+}
+
+func (l *lexer) parseObject() (expression, error) {
+	object := &objectExpression{}
+	if l.isOperator("}") {
+		l.next()
+		return object, nil
+	}
+	for {
+		key := l.next()
+		if key.kind != stringToken {
+			return nil, UnexpectedTokenError(key.text)
+		}
+		if err := l.expectOperator(":"); err != nil {
+			return nil, err
+		}
+		value, err := l.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		object.keys = append(object.keys, key.text)
+		object.values = append(object.values, value)
+		if l.isOperator(",") {
+			l.next()
+			continue
+		}
+		return object, l.expectOperator("}")
+	}
+}
+
+func newFunctionExpression(name string, args []expression) (expression, error) {
+	fn, ok := templateFunctions[name]
+	if !ok {
+		return nil, UnknownFunctionError(name)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, FunctionArgumentCountError(name, len(args))
+	}
+	return &functionExpression{name: name, args: args}, nil
+}
+
+func newVariableExpression(name string, pointerNotation bool) expression {
+	separator := "."
+	if pointerNotation {
+		separator = "/"
+	}
+	return &variableExpression{name: name, path: strings.Split(strings.Trim(name, separator), separator)}
+}
+
+func parseNumber(text string) (interface{}, error) {
+	if !strings.ContainsAny(text, ".eE") {
+		if value, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return value, nil
+		}
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil, eris.Errorf("invalid number %s", text)
+	}
+	return value, nil
+}
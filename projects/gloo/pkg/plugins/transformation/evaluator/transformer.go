@@ -0,0 +1,297 @@
+package evaluator
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	envoytransformation "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/extensions/transformation"
+	transformationplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/transformation"
+)
+
+var (
+	UnsupportedTransformationError = func(t interface{}) error {
+		return eris.Errorf("transformation type %T is not supported by the evaluator", t)
+	}
+	ExtractorSubgroupError = func(name string, subgroup uint32, groups int) error {
+		return eris.Errorf("extractor %s requests subgroup %d but its regex has only %d subgroup(s)", name, subgroup, groups)
+	}
+)
+
+// DynamicMetadata holds the dynamic metadata set by transformations, by namespace and key
+type DynamicMetadata map[string]map[string]string
+
+func (m DynamicMetadata) set(namespace, key, value string) {
+	if m[namespace] == nil {
+		m[namespace] = map[string]string{}
+	}
+	m[namespace][key] = value
+}
+
+// messageTransformer applies transformations to a request or a response
+type messageTransformer struct {
+	message *HttpMessage
+	// requestHeaders are the headers of the request, which is the message itself when transforming a request
+	requestHeaders *Headers
+	isRequest      bool
+	metadata       DynamicMetadata
+	env            func(name string) string
+}
+
+func (m *messageTransformer) transform(t *envoytransformation.Transformation) error {
+	switch typed := t.GetTransformationType().(type) {
+	case nil:
+		return nil
+	case *envoytransformation.Transformation_TransformationTemplate:
+		return m.transformTemplate(typed.TransformationTemplate)
+	case *envoytransformation.Transformation_HeaderBodyTransform:
+		m.transformHeaderBody(typed.HeaderBodyTransform)
+		return nil
+	default:
+		return UnsupportedTransformationError(typed)
+	}
+}
+
+func (m *messageTransformer) transformTemplate(tmpl *envoytransformation.TransformationTemplate) error {
+	advanced := tmpl.GetAdvancedTemplates()
+
+	// the filter does not buffer the body of passthrough transformations, so it is not available to templates
+	body := m.message.Body
+	if tmpl.GetPassthrough() != nil {
+		body = ""
+	}
+
+	var data interface{}
+	if tmpl.GetParseBodyBehavior() == envoytransformation.TransformationTemplate_ParseAsJson && body != "" {
+		parsed, err := parseJSON(body)
+		if err != nil && !tmpl.GetIgnoreErrorOnParse() {
+			return eris.Wrap(err, "parsing body as JSON")
+		}
+		data = parsed
+	}
+
+	extractions := map[string]string{}
+	for _, name := range sortedExtractorNames(tmpl.GetExtractors()) {
+		value, err := extract(name, tmpl.GetExtractors()[name], m.message.Headers, body)
+		if err != nil {
+			return err
+		}
+		// in non-advanced mode the extractors are added to the JSON context instead
+		if advanced {
+			extractions[name] = value
+		} else if data, err = setExtraction(data, name, value); err != nil {
+			return err
+		}
+	}
+
+	ctx := &renderContext{
+		data:           data,
+		escapeStrings:  tmpl.GetEscapeCharacters(),
+		headers:        &m.message.Headers,
+		requestHeaders: m.requestHeaders,
+		extractions:    extractions,
+		body:           body,
+		env:            m.env,
+	}
+	render := func(description string, text string) (string, error) {
+		parsed, err := ParseTemplate(text, advanced)
+		if err != nil {
+			return "", eris.Wrapf(err, "parsing template for %s", description)
+		}
+		output, err := parsed.render(ctx, advanced)
+		if err != nil {
+			return "", eris.Wrapf(err, "rendering template for %s", description)
+		}
+		return output, nil
+	}
+
+	var newBody *string
+	switch {
+	case tmpl.GetBody() != nil:
+		output, err := render("body", tmpl.GetBody().GetText())
+		if err != nil {
+			return err
+		}
+		newBody = &output
+	case tmpl.GetMergeExtractorsToBody() != nil:
+		output := dumpJSON(data)
+		newBody = &output
+	}
+
+	for _, value := range tmpl.GetDynamicMetadataValues() {
+		output, err := render("dynamic metadata "+value.GetKey(), value.GetValue().GetText())
+		if err != nil {
+			return err
+		}
+		if output == "" {
+			continue
+		}
+		namespace := value.GetMetadataNamespace()
+		if namespace == "" {
+			namespace = transformationplugin.FilterName
+		}
+		m.metadata.set(namespace, value.GetKey(), output)
+	}
+
+	headerNames := make([]string, 0, len(tmpl.GetHeaders()))
+	for name := range tmpl.GetHeaders() {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	for _, name := range headerNames {
+		output, err := render("header "+name, tmpl.GetHeaders()[name].GetText())
+		if err != nil {
+			return err
+		}
+		m.message.Headers.Del(name)
+		if output != "" {
+			m.message.Headers.Add(name, output)
+		}
+	}
+
+	for _, header := range tmpl.GetHeadersToAppend() {
+		output, err := render("header "+header.GetKey(), header.GetValue().GetText())
+		if err != nil {
+			return err
+		}
+		if output != "" {
+			m.message.Headers.Add(header.GetKey(), output)
+		}
+	}
+
+	for _, name := range tmpl.GetHeadersToRemove() {
+		m.message.Headers.Del(name)
+	}
+
+	// the body is replaced last, so that headers and dynamic metadata are rendered with the original body
+	if newBody != nil {
+		m.setBody(*newBody)
+	}
+	return nil
+}
+
+func (m *messageTransformer) transformHeaderBody(transform *envoytransformation.HeaderBodyTransform) {
+	object := map[string]interface{}{}
+	if m.message.Body != "" {
+		object["body"] = m.message.Body
+	}
+
+	headers := map[string]interface{}{}
+	multiValueHeaders := map[string]interface{}{}
+	for _, header := range m.message.Headers {
+		headers[header.Key] = header.Value
+		values, _ := multiValueHeaders[header.Key].([]interface{})
+		multiValueHeaders[header.Key] = append(values, header.Value)
+	}
+	object["headers"] = headers
+
+	if m.isRequest && transform.GetAddRequestMetadata() {
+		method, _ := m.message.Headers.Get(":method")
+		path, _ := m.message.Headers.Get(":path")
+		path, queryString := splitPath(path)
+		parameters := map[string]interface{}{}
+		multiValueParameters := map[string]interface{}{}
+		for _, parameter := range parseQueryString(queryString) {
+			parameters[parameter.Key] = parameter.Value
+			values, _ := multiValueParameters[parameter.Key].([]interface{})
+			multiValueParameters[parameter.Key] = append(values, parameter.Value)
+		}
+		object["httpMethod"] = method
+		object["path"] = path
+		object["queryString"] = queryString
+		object["queryStringParameters"] = parameters
+		object["multiValueQueryStringParameters"] = multiValueParameters
+		object["multiValueHeaders"] = multiValueHeaders
+	}
+
+	m.setBody(dumpJSON(object))
+}
+
+func (m *messageTransformer) setBody(body string) {
+	m.message.Body = body
+	m.message.Headers.Set("content-length", strconv.Itoa(len(body)))
+}
+
+// extract runs an extractor. The regex must match the entire source, otherwise the extracted value is empty.
+func extract(name string, extraction *envoytransformation.Extraction, headers Headers, body string) (string, error) {
+	var source string
+	switch typed := extraction.GetSource().(type) {
+	case *envoytransformation.Extraction_Header:
+		source, _ = headers.Get(typed.Header)
+	case *envoytransformation.Extraction_Body:
+		source = body
+	default:
+		return "", eris.Errorf("extractor %s has no source", name)
+	}
+
+	regex, err := regexp.Compile("^(?:" + extraction.GetRegex() + ")$")
+	if err != nil {
+		return "", eris.Wrapf(err, "invalid regex for extractor %s", name)
+	}
+	if groups := regex.NumSubexp(); int(extraction.GetSubgroup()) > groups {
+		return "", ExtractorSubgroupError(name, extraction.GetSubgroup(), groups)
+	}
+	match := regex.FindStringSubmatch(source)
+	if match == nil {
+		return "", nil
+	}
+	return match[extraction.GetSubgroup()], nil
+}
+
+// setExtraction adds an extracted value to the JSON context. Dots in the name of the extractor create nested objects.
+func setExtraction(data interface{}, name, value string) (interface{}, error) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	current, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, eris.Errorf("cannot add extractor %s to a JSON body of type %s", name, typeName(data))
+	}
+	parts := strings.Split(name, ".")
+	for _, part := range parts[:len(parts)-1] {
+		switch next := current[part].(type) {
+		case map[string]interface{}:
+			current = next
+		case nil:
+			created := map[string]interface{}{}
+			current[part] = created
+			current = created
+		default:
+			return nil, eris.Errorf("cannot add extractor %s to a JSON value of type %s", name, typeName(next))
+		}
+	}
+	current[parts[len(parts)-1]] = value
+	return data, nil
+}
+
+func sortedExtractorNames(extractors map[string]*envoytransformation.Extraction) []string {
+	names := make([]string, 0, len(extractors))
+	for name := range extractors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// splitPath splits the value of the :path header into the path and the query string
+func splitPath(path string) (string, string) {
+	if i := strings.Index(path, "?"); i >= 0 {
+		return path[:i], path[i+1:]
+	}
+	return path, ""
+}
+
+// parseQueryString splits a query string into its parameters, without decoding them
+func parseQueryString(queryString string) []Header {
+	var parameters []Header
+	for _, parameter := range strings.Split(queryString, "&") {
+		if parameter == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(parameter, "=")
+		parameters = append(parameters, Header{Key: key, Value: value})
+	}
+	return parameters
+}
@@ -0,0 +1,285 @@
+package evaluator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Template values use the following types, which mirror the JSON types of the transformation filter:
+// nil, bool, int64, float64, string, []interface{} and map[string]interface{}.
+// Integers and floating point numbers are kept apart, as they are rendered differently.
+
+// rawString is a string that is rendered as-is, even if the template escapes characters
+type rawString string
+
+// parseJSON parses a JSON document into template values
+func parseJSON(data string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON document")
+	}
+	return fromJSONValue(value), nil
+}
+
+func fromJSONValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case json.Number:
+		number, err := parseNumber(typed.String())
+		if err != nil {
+			return typed.String()
+		}
+		return number
+	case []interface{}:
+		for i := range typed {
+			typed[i] = fromJSONValue(typed[i])
+		}
+		return typed
+	case map[string]interface{}:
+		for key := range typed {
+			typed[key] = fromJSONValue(typed[key])
+		}
+		return typed
+	default:
+		return typed
+	}
+}
+
+// dumpJSON serializes a value as compact JSON with sorted keys
+func dumpJSON(value interface{}) string {
+	var buf bytes.Buffer
+	writeJSON(&buf, value)
+	return buf.String()
+}
+
+func writeJSON(buf *bytes.Buffer, value interface{}) {
+	switch typed := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(typed))
+	case int64:
+		buf.WriteString(strconv.FormatInt(typed, 10))
+	case float64:
+		buf.WriteString(formatFloat(typed))
+	case rawString:
+		buf.WriteByte('"')
+		buf.WriteString(escapeJSONString(string(typed)))
+		buf.WriteByte('"')
+	case string:
+		buf.WriteByte('"')
+		buf.WriteString(escapeJSONString(typed))
+		buf.WriteByte('"')
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range typed {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeJSON(buf, item)
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		buf.WriteByte('{')
+		for i, key := range sortedKeys(typed) {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteByte('"')
+			buf.WriteString(escapeJSONString(key))
+			buf.WriteString(`":`)
+			writeJSON(buf, typed[key])
+		}
+		buf.WriteByte('}')
+	default:
+		buf.WriteString(fmt.Sprintf("%q", fmt.Sprint(typed)))
+	}
+}
+
+// formatFloat formats floating point numbers like the JSON library of the filter, which always keeps a decimal point
+func formatFloat(value float64) string {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return "null"
+	}
+	formatted := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(formatted, ".e") {
+		formatted += ".0"
+	}
+	return formatted
+}
+
+// escapeJSONString escapes the characters of a string that are not valid in a JSON string, without adding quotes
+func escapeJSONString(value string) string {
+	var sb strings.Builder
+	for _, r := range value {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				sb.WriteString(fmt.Sprintf(`\u%04x`, r))
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// unwrap converts raw strings to plain strings, so that they can be used in operations
+func unwrap(value interface{}) interface{} {
+	if raw, ok := value.(rawString); ok {
+		return string(raw)
+	}
+	return value
+}
+
+func truthy(value interface{}) bool {
+	switch typed := unwrap(value).(type) {
+	case nil:
+		return false
+	case bool:
+		return typed
+	case int64:
+		return typed != 0
+	case float64:
+		return typed != 0
+	case string:
+		return typed != ""
+	case []interface{}:
+		return len(typed) > 0
+	case map[string]interface{}:
+		return len(typed) > 0
+	default:
+		return true
+	}
+}
+
+func typeName(value interface{}) string {
+	switch unwrap(value).(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case int64, float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch typed := unwrap(value).(type) {
+	case int64:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	default:
+		return 0, false
+	}
+}
+
+func toInt(value interface{}) (int64, bool) {
+	switch typed := unwrap(value).(type) {
+	case int64:
+		return typed, true
+	case float64:
+		return int64(typed), true
+	default:
+		return 0, false
+	}
+}
+
+func valuesEqual(left, right interface{}) bool {
+	left, right = unwrap(left), unwrap(right)
+	if leftNumber, ok := toFloat(left); ok {
+		rightNumber, ok := toFloat(right)
+		return ok && leftNumber == rightNumber
+	}
+	switch typedLeft := left.(type) {
+	case []interface{}:
+		typedRight, ok := right.([]interface{})
+		if !ok || len(typedLeft) != len(typedRight) {
+			return false
+		}
+		for i := range typedLeft {
+			if !valuesEqual(typedLeft[i], typedRight[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		typedRight, ok := right.(map[string]interface{})
+		if !ok || len(typedLeft) != len(typedRight) {
+			return false
+		}
+		for key, value := range typedLeft {
+			other, ok := typedRight[key]
+			if !ok || !valuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return left == right
+	}
+}
+
+// compareValues orders numbers and strings. It returns an error for values that cannot be ordered.
+func compareValues(left, right interface{}) (int, error) {
+	left, right = unwrap(left), unwrap(right)
+	if leftNumber, ok := toFloat(left); ok {
+		if rightNumber, ok := toFloat(right); ok {
+			switch {
+			case leftNumber < rightNumber:
+				return -1, nil
+			case leftNumber > rightNumber:
+				return 1, nil
+			default:
+				return 0, nil
+			}
+		}
+	}
+	if leftString, ok := left.(string); ok {
+		if rightString, ok := right.(string); ok {
+			return strings.Compare(leftString, rightString), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %s with %s", typeName(left), typeName(right))
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
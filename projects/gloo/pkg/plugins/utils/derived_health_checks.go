@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"hash/fnv"
+	"strconv"
+
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+)
+
+// DerivedHealthChecksAnnotation is set by upstream discovery on the upstreams whose health checks it derived, for
+// example from the readiness probes of their pods. It holds the hash of the derived health checks, so that discovery
+// only replaces or clears the health checks of an upstream while the user has not written their own.
+const DerivedHealthChecksAnnotation = "gloo.solo.io/derived_health_checks"
+
+// SetDerivedHealthChecks sets the health checks derived by discovery on the upstream
+func SetDerivedHealthChecks(us *v1.Upstream, healthChecks []*envoycore.HealthCheck) {
+	us.HealthChecks = healthChecks
+	metadata := us.GetMetadata()
+	if metadata == nil {
+		return
+	}
+	if metadata.GetAnnotations() == nil {
+		metadata.Annotations = map[string]string{}
+	}
+	metadata.GetAnnotations()[DerivedHealthChecksAnnotation] = healthChecksHash(healthChecks)
+}
+
+// hasDerivedHealthChecks returns whether the health checks of the upstream are the ones derived by discovery
+func hasDerivedHealthChecks(us *v1.Upstream) bool {
+	derivedHash, ok := us.GetMetadata().GetAnnotations()[DerivedHealthChecksAnnotation]
+	return ok && derivedHash == healthChecksHash(us.GetHealthChecks())
+}
+
+func healthChecksHash(healthChecks []*envoycore.HealthCheck) string {
+	hasher := fnv.New64()
+	for _, healthCheck := range healthChecks {
+		// the health checks are written to the hasher in turn
+		if _, err := healthCheck.Hash(hasher); err != nil {
+			return ""
+		}
+	}
+	return strconv.FormatUint(hasher.Sum64(), 10)
+}
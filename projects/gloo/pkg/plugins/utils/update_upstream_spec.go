@@ -35,8 +35,15 @@ func UpdateUpstream(original, desired *v1.Upstream) {
 		desired.Failover = original.GetFailover()
 	}
 
-	if len(desired.GetHealthChecks()) == 0 {
+	// the health checks derived by discovery follow the ones it derives now, and are cleared once it no longer
+	// derives any, for example once the readiness probe they were derived from is removed. The health checks
+	// written by the user are kept.
+	userHealthChecks := len(original.GetHealthChecks()) > 0 && !hasDerivedHealthChecks(original)
+	if len(desired.GetHealthChecks()) == 0 && userHealthChecks {
 		desired.HealthChecks = original.GetHealthChecks()
+	} else if hasDerivedHealthChecks(desired) && userHealthChecks {
+		desired.HealthChecks = original.GetHealthChecks()
+		delete(desired.GetMetadata().GetAnnotations(), DerivedHealthChecksAnnotation)
 	}
 
 	if desired.GetOutlierDetection() == nil {
@@ -88,4 +95,9 @@ func UpdateUpstream(original, desired *v1.Upstream) {
 	if desired.GetPreconnectPolicy() == nil {
 		desired.PreconnectPolicy = original.GetPreconnectPolicy()
 	}
+
+	// the discovery status is written by function discovery, not by upstream discovery
+	if desired.GetDiscoveryStatus() == nil {
+		desired.DiscoveryStatus = original.GetDiscoveryStatus()
+	}
 }
@@ -112,12 +112,25 @@ var _ = Describe("UpdateUpstream", func() {
 		Expect(desired.DnsRefreshRate).To(Equal(desiredDnsRefreshRate))
 	})
 
+	It("should preserve the discovery status when updating the discovery metadata", func() {
+		desired := &gloov1.Upstream{
+			DiscoveryMetadata: &gloov1.DiscoveryMetadata{Labels: map[string]string{"app": "desired"}},
+		}
+		original := &gloov1.Upstream{
+			DiscoveryMetadata: &gloov1.DiscoveryMetadata{Labels: map[string]string{"app": "original"}},
+			DiscoveryStatus:   &gloov1.DiscoveryStatus{Error: "discovery failed"},
+		}
+		utils.UpdateUpstream(original, desired)
+		Expect(desired.GetDiscoveryMetadata().GetLabels()).To(HaveKeyWithValue("app", "desired"))
+		Expect(desired.GetDiscoveryStatus()).To(Equal(original.GetDiscoveryStatus()))
+	})
+
 	It("will fail if the upstream proto has a new top level field", func() {
 		// This test is important as it checks whether the upstream struct/proto have a new top level field.
 		// This should happen very rarely, and should be used as an indication that the `UpdateUpstream` function
 		// most likely needs to change.
 		Expect(reflect.TypeOf(gloov1.Upstream{}).NumField()).To(
-			Equal(28),
+			Equal(29),
 			"wrong number of fields found",
 		)
 	})
@@ -0,0 +1,312 @@
+package cache
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/go-utils/contextutils"
+)
+
+// ImagesPath is the path under which the cached wasm filters are served, keyed by the hex encoding of their sha256 digest
+const ImagesPath = "/images/"
+
+const (
+	// the number of images pulled at the same time
+	fetchWorkers = 4
+	// the number of images waiting to be pulled, images which do not fit are scheduled again on their next lookup
+	fetchQueueSize = 256
+)
+
+var (
+	ImagePendingError = func(image string) error {
+		return eris.Errorf("wasm image %s is being pulled", image)
+	}
+)
+
+// Options configures the pulls of the images and the size of the cache
+type Options struct {
+	// FetchTimeout bounds each pull of an image
+	FetchTimeout time.Duration
+	// TagTTL is how long the digest an image tag resolved to is used before the tag is resolved again.
+	// Images referenced by digest are not resolved again.
+	TagTTL time.Duration
+	// InitialBackoff is the delay before an image which failed to be pulled is pulled again.
+	// It doubles with each failure, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// MaxSize bounds the total size in bytes of the cached filters, the least recently used are evicted first
+	MaxSize int
+}
+
+// DefaultOptions are the options of the default cache
+var DefaultOptions = Options{
+	FetchTimeout:   time.Minute,
+	TagTTL:         10 * time.Minute,
+	InitialBackoff: 5 * time.Second,
+	MaxBackoff:     5 * time.Minute,
+	MaxSize:        512 << 20,
+}
+
+// Cache pulls wasm filters from OCI images in the background and serves them to Envoy over HTTP.
+type Cache interface {
+	http.Handler
+
+	// Start pulls the images in the background until the context is done.
+	// onFetched is called whenever the result of a Lookup changes, so that the filters can be translated again.
+	Start(ctx context.Context, onFetched func())
+	// Lookup returns the sha256 digest of the wasm filter of the image, if it is cached, without blocking.
+	// Otherwise it schedules a pull of the image and returns an ImagePendingError, or the error of the last pull
+	// while it waits to pull the image again.
+	Lookup(image string) (digest.Digest, error)
+	// Get returns the wasm filter with the given digest, if it is cached
+	Get(dgst digest.Digest) ([]byte, bool)
+}
+
+type imageEntry struct {
+	// the digest of the wasm filter of the image, empty until the image is pulled
+	digest     digest.Digest
+	resolvedAt time.Time
+
+	// the error of the last pull, and when the image can be pulled again
+	err      error
+	failures int
+	retryAt  time.Time
+
+	fetching bool
+}
+
+type filterEntry struct {
+	filter   []byte
+	lastUsed time.Time
+}
+
+type cache struct {
+	puller  Puller
+	options Options
+
+	requests chan string
+
+	lock      sync.Mutex
+	images    map[string]*imageEntry
+	filters   map[digest.Digest]*filterEntry
+	size      int
+	onFetched func()
+}
+
+// NewCache returns a cache which pulls the images with the given puller
+func NewCache(puller Puller, options Options) Cache {
+	return &cache{
+		puller:   puller,
+		options:  options,
+		requests: make(chan string, fetchQueueSize),
+		images:   map[string]*imageEntry{},
+		filters:  map[digest.Digest]*filterEntry{},
+	}
+}
+
+// NewDefaultCache returns a cache which pulls the images from their OCI registries
+func NewDefaultCache() Cache {
+	return NewCache(NewRegistryPuller(&http.Client{Timeout: DefaultOptions.FetchTimeout}), DefaultOptions)
+}
+
+func (c *cache) Start(ctx context.Context, onFetched func()) {
+	c.lock.Lock()
+	c.onFetched = onFetched
+	c.lock.Unlock()
+
+	for i := 0; i < fetchWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case image := <-c.requests:
+					c.fetch(ctx, image)
+				}
+			}
+		}()
+	}
+}
+
+func (c *cache) Lookup(image string) (digest.Digest, error) {
+	now := time.Now()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.images[image]
+	if !ok {
+		entry = &imageEntry{}
+		c.images[image] = entry
+	}
+
+	if entry.digest != "" {
+		if filter, ok := c.filters[entry.digest]; ok {
+			filter.lastUsed = now
+			if isTag(image) && now.Sub(entry.resolvedAt) > c.options.TagTTL && !now.Before(entry.retryAt) {
+				// keep using the filter while the tag is resolved again
+				c.schedule(image, entry)
+			}
+			return entry.digest, nil
+		}
+		// the filter was evicted
+		entry.digest = ""
+	}
+
+	if entry.err != nil {
+		if !now.Before(entry.retryAt) {
+			c.schedule(image, entry)
+		}
+		return "", entry.err
+	}
+	c.schedule(image, entry)
+	return "", ImagePendingError(image)
+}
+
+// schedule queues the image to be pulled, unless it is already queued. Must be called with the lock held.
+func (c *cache) schedule(image string, entry *imageEntry) {
+	if entry.fetching {
+		return
+	}
+	select {
+	case c.requests <- image:
+		entry.fetching = true
+	default:
+	}
+}
+
+func (c *cache) fetch(ctx context.Context, image string) {
+	logger := contextutils.LoggerFrom(ctx)
+	logger.Infof("pulling wasm image %s", image)
+	pullCtx, cancel := context.WithTimeout(ctx, c.options.FetchTimeout)
+	filter, dgst, err := c.puller.Pull(pullCtx, image)
+	cancel()
+
+	now := time.Now()
+	c.lock.Lock()
+	entry, ok := c.images[image]
+	if !ok {
+		entry = &imageEntry{}
+		c.images[image] = entry
+	}
+	entry.fetching = false
+
+	var changed bool
+	if err != nil {
+		logger.Warnf("failed to pull wasm image %s: %v", image, err)
+		changed = entry.digest == "" && (entry.err == nil || entry.err.Error() != err.Error())
+		entry.failures++
+		entry.retryAt = now.Add(c.backoff(entry.failures))
+		if entry.digest == "" {
+			// a filter which was pulled before is still used when its tag cannot be resolved again
+			entry.err = err
+		}
+	} else {
+		changed = entry.digest != dgst
+		entry.digest = dgst
+		entry.resolvedAt = now
+		entry.err = nil
+		entry.failures = 0
+		entry.retryAt = time.Time{}
+		if cached, ok := c.filters[dgst]; ok {
+			cached.lastUsed = now
+		} else {
+			c.filters[dgst] = &filterEntry{filter: filter, lastUsed: now}
+			c.size += len(filter)
+			c.evict(dgst)
+		}
+	}
+	onFetched := c.onFetched
+	c.lock.Unlock()
+
+	if changed && onFetched != nil {
+		onFetched()
+	}
+}
+
+func (c *cache) backoff(failures int) time.Duration {
+	backoff := c.options.InitialBackoff
+	for i := 1; i < failures && backoff < c.options.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.options.MaxBackoff {
+		return c.options.MaxBackoff
+	}
+	return backoff
+}
+
+// evict removes the least recently used filters until the cache fits in its maximum size.
+// The filter which was just added is kept even if it is larger than the cache. Must be called with the lock held.
+func (c *cache) evict(added digest.Digest) {
+	if c.size <= c.options.MaxSize {
+		return
+	}
+	digests := make([]digest.Digest, 0, len(c.filters))
+	for dgst := range c.filters {
+		if dgst != added {
+			digests = append(digests, dgst)
+		}
+	}
+	sort.Slice(digests, func(i, j int) bool {
+		return c.filters[digests[i]].lastUsed.Before(c.filters[digests[j]].lastUsed)
+	})
+	for _, dgst := range digests {
+		if c.size <= c.options.MaxSize {
+			break
+		}
+		c.size -= len(c.filters[dgst].filter)
+		delete(c.filters, dgst)
+	}
+	for image, entry := range c.images {
+		if _, ok := c.filters[entry.digest]; !ok && entry.digest != "" && !entry.fetching {
+			delete(c.images, image)
+		}
+	}
+}
+
+func (c *cache) Get(dgst digest.Digest) ([]byte, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.filters[dgst]
+	if !ok {
+		return nil, false
+	}
+	entry.lastUsed = time.Now()
+	return entry.filter, true
+}
+
+// isTag returns whether the image is referenced by tag rather than by digest
+func isTag(image string) bool {
+	return !strings.Contains(image, "@")
+}
+
+// ServeHTTP serves the wasm filter whose sha256 digest is encoded in the request path
+func (c *cache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, ImagesPath) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	dgst := digest.NewDigestFromEncoded(digest.SHA256, strings.TrimPrefix(r.URL.Path, ImagesPath))
+	if err := dgst.Validate(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	filter, ok := c.Get(dgst)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/wasm")
+	_, _ = w.Write(filter)
+}
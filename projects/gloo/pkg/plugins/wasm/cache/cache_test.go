@@ -0,0 +1,314 @@
+package cache_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rotisserie/eris"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm/cache"
+)
+
+const wasmLayerMediaType = "application/vnd.module.wasm.content.layer.v1+wasm"
+
+// fakeRegistry serves a single wasm image with the OCI distribution API, and requires a bearer token
+type fakeRegistry struct {
+	server *httptest.Server
+
+	lock          sync.Mutex
+	manifest      []byte
+	layer         []byte
+	pulls         int
+	serveTampered bool
+}
+
+func newFakeRegistry(layer []byte) *fakeRegistry {
+	r := &fakeRegistry{layer: layer}
+	r.setLayer(layer, wasmLayerMediaType)
+	r.server = httptest.NewServer(r)
+	return r
+}
+
+func (r *fakeRegistry) setLayer(layer []byte, mediaType string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.layer = layer
+	manifest, err := json.Marshal(ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Layers: []ocispec.Descriptor{{
+			MediaType: mediaType,
+			Digest:    digest.FromBytes(layer),
+			Size:      int64(len(layer)),
+		}},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	r.manifest = manifest
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.server.URL, "http://")
+}
+
+func (r *fakeRegistry) pullCount() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.pulls
+}
+
+func (r *fakeRegistry) setTampered(tampered bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.serveTampered = tampered
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	switch {
+	case req.URL.Path == "/token":
+		Expect(req.URL.Query().Get("scope")).To(Equal("repository:user/filter:pull"))
+		_, _ = io.WriteString(w, `{"token": "pull-token"}`)
+		return
+	case req.Header.Get("Authorization") != "Bearer pull-token":
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake"`, r.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	case req.URL.Path == "/v2/user/filter/manifests/v1",
+		req.URL.Path == "/v2/user/filter/manifests/"+digest.FromBytes(r.manifest).String():
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+		_, _ = w.Write(r.manifest)
+	case strings.HasPrefix(req.URL.Path, "/v2/user/filter/blobs/"):
+		r.pulls++
+		if r.serveTampered {
+			_, _ = w.Write([]byte("tampered"))
+			return
+		}
+		_, _ = w.Write(r.layer)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// blockingPuller pulls the filters of the images it knows, and blocks until the pull times out on the others
+type blockingPuller map[string][]byte
+
+func (p blockingPuller) Pull(ctx context.Context, image string) ([]byte, digest.Digest, error) {
+	filter, ok := p[image]
+	if !ok {
+		<-ctx.Done()
+		return nil, "", eris.Wrapf(ctx.Err(), "pulling %s", image)
+	}
+	return filter, digest.FromBytes(filter), nil
+}
+
+var _ = Describe("Cache", func() {
+
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		registry  *fakeRegistry
+		module    []byte
+		options   Options
+		fetched   chan struct{}
+		c         Cache
+		startWith func(Puller)
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		module = []byte("\x00asm\x01\x00\x00\x00")
+		registry = newFakeRegistry(module)
+		options = Options{
+			FetchTimeout:   time.Second,
+			TagTTL:         time.Hour,
+			InitialBackoff: time.Hour,
+			MaxBackoff:     time.Hour,
+			MaxSize:        1 << 20,
+		}
+		fetched = make(chan struct{}, 10)
+		startWith = func(puller Puller) {
+			c = NewCache(puller, options)
+			notify := fetched
+			c.Start(ctx, func() {
+				notify <- struct{}{}
+			})
+		}
+	})
+
+	JustBeforeEach(func() {
+		startWith(NewRegistryPuller(registry.server.Client()))
+	})
+
+	AfterEach(func() {
+		cancel()
+		registry.server.Close()
+	})
+
+	// lookup waits for the image to be pulled in the background
+	lookup := func(image string) (digest.Digest, error) {
+		_, err := c.Lookup(image)
+		Expect(err).To(MatchError(ImagePendingError(image)))
+		Eventually(fetched).Should(Receive())
+		return c.Lookup(image)
+	}
+
+	It("pulls the image once in the background and serves its wasm filter by sha256 digest", func() {
+		image := registry.host() + "/user/filter:v1"
+		dgst, err := lookup(image)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dgst).To(Equal(digest.FromBytes(module)))
+
+		_, err = c.Lookup(image)
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(registry.pullCount, "100ms").Should(Equal(1))
+		Expect(fetched).NotTo(Receive())
+
+		rec := httptest.NewRecorder()
+		c.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ImagesPath+dgst.Encoded(), nil))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.Bytes()).To(Equal(module))
+	})
+
+	It("pulls images referenced by the digest of their manifest", func() {
+		image := fmt.Sprintf("%s/user/filter@%s", registry.host(), digest.FromBytes(registry.manifest))
+		dgst, err := lookup(image)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dgst).To(Equal(digest.FromBytes(module)))
+	})
+
+	It("rejects wasm filters which do not match their digest", func() {
+		registry.setTampered(true)
+		_, err := lookup(registry.host() + "/user/filter:v1")
+		Expect(err).To(MatchError(ContainSubstring("does not match digest")))
+	})
+
+	It("rejects images without a wasm layer", func() {
+		registry.setLayer(module, ocispec.MediaTypeImageLayerGzip)
+		image := registry.host() + "/user/filter:v1"
+		_, err := lookup(image)
+		Expect(err).To(MatchError(NoWasmLayerError(image)))
+	})
+
+	It("rejects invalid image references", func() {
+		_, err := lookup("webassemblyhub.io/user/filter@sha256:invalid")
+		Expect(err).To(MatchError(InvalidImageError("webassemblyhub.io/user/filter@sha256:invalid")))
+	})
+
+	Context("failed pulls", func() {
+
+		BeforeEach(func() {
+			options.InitialBackoff = 200 * time.Millisecond
+			options.MaxBackoff = 200 * time.Millisecond
+		})
+
+		It("reports the error of the last pull and pulls the image again after a backoff", func() {
+			registry.setTampered(true)
+			image := registry.host() + "/user/filter:v1"
+			_, err := lookup(image)
+			Expect(err).To(MatchError(ContainSubstring("does not match digest")))
+
+			// the image is not pulled again until the backoff expires
+			registry.setTampered(false)
+			_, err = c.Lookup(image)
+			Expect(err).To(MatchError(ContainSubstring("does not match digest")))
+			Expect(registry.pullCount()).To(Equal(1))
+
+			Eventually(func() error {
+				_, err := c.Lookup(image)
+				return err
+			}, "2s", "50ms").ShouldNot(HaveOccurred())
+			Expect(registry.pullCount()).To(Equal(2))
+		})
+	})
+
+	It("times out pulls", func() {
+		options.FetchTimeout = 50 * time.Millisecond
+		startWith(blockingPuller{})
+		_, err := lookup("webassemblyhub.io/user/slow:v1")
+		Expect(err).To(MatchError(ContainSubstring(context.DeadlineExceeded.Error())))
+	})
+
+	Context("tags", func() {
+
+		BeforeEach(func() {
+			options.TagTTL = 100 * time.Millisecond
+		})
+
+		It("resolves tags again once their ttl expires, and serves the previous filter meanwhile", func() {
+			image := registry.host() + "/user/filter:v1"
+			dgst, err := lookup(image)
+			Expect(err).NotTo(HaveOccurred())
+
+			newModule := []byte("\x00asm\x01\x00\x00\x00new")
+			registry.setLayer(newModule, wasmLayerMediaType)
+			time.Sleep(150 * time.Millisecond)
+
+			current, err := c.Lookup(image)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current).To(Equal(dgst))
+			Eventually(fetched).Should(Receive())
+			Expect(c.Lookup(image)).To(Equal(digest.FromBytes(newModule)))
+		})
+
+		It("does not resolve digests again", func() {
+			image := fmt.Sprintf("%s/user/filter@%s", registry.host(), digest.FromBytes(registry.manifest))
+			_, err := lookup(image)
+			Expect(err).NotTo(HaveOccurred())
+			time.Sleep(150 * time.Millisecond)
+			_, err = c.Lookup(image)
+			Expect(err).NotTo(HaveOccurred())
+			Consistently(registry.pullCount, "200ms").Should(Equal(1))
+		})
+	})
+
+	It("evicts the least recently used filters when the cache is full", func() {
+		filters := blockingPuller{
+			"webassemblyhub.io/user/a:v1": []byte("\x00asm-a-12"),
+			"webassemblyhub.io/user/b:v1": []byte("\x00asm-b-12"),
+			"webassemblyhub.io/user/c:v1": []byte("\x00asm-c-12"),
+		}
+		options.MaxSize = 20
+		startWith(filters)
+
+		dgstA, err := lookup("webassemblyhub.io/user/a:v1")
+		Expect(err).NotTo(HaveOccurred())
+		dgstB, err := lookup("webassemblyhub.io/user/b:v1")
+		Expect(err).NotTo(HaveOccurred())
+		// a is used more recently than b
+		_, err = c.Lookup("webassemblyhub.io/user/a:v1")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = lookup("webassemblyhub.io/user/c:v1")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, ok := c.Get(dgstA)
+		Expect(ok).To(BeTrue())
+		_, ok = c.Get(dgstB)
+		Expect(ok).To(BeFalse())
+
+		// an evicted filter is pulled again
+		_, err = lookup("webassemblyhub.io/user/b:v1")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("serving wasm filters",
+		func(method, path string, expectedCode int) {
+			rec := httptest.NewRecorder()
+			c.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+			Expect(rec.Code).To(Equal(expectedCode))
+		},
+		Entry("unknown digest", http.MethodGet, ImagesPath+digest.FromString("unknown").Encoded(), http.StatusNotFound),
+		Entry("invalid digest", http.MethodGet, ImagesPath+"invalid", http.StatusBadRequest),
+		Entry("unknown path", http.MethodGet, "/other", http.StatusNotFound),
+		Entry("unsupported method", http.MethodPost, ImagesPath+digest.FromString("unknown").Encoded(), http.StatusMethodNotAllowed),
+	)
+})
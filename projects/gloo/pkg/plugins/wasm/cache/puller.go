@@ -0,0 +1,285 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rotisserie/eris"
+)
+
+const (
+	defaultRegistry       = "docker.io"
+	defaultRegistryHost   = "registry-1.docker.io"
+	defaultRepositoryPath = "library/"
+	defaultTag            = "latest"
+
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// maxFilterSize limits the size of the wasm filters and manifests read from the registries
+	maxFilterSize = 256 << 20
+)
+
+// wasmLayerMediaTypes are the media types of the image layers which contain a wasm filter
+var wasmLayerMediaTypes = map[string]bool{
+	"application/vnd.module.wasm.content.layer.v1+wasm": true,
+	"application/vnd.wasm.content.layer.v1+wasm":        true,
+}
+
+var (
+	InvalidImageError = func(image string) error {
+		return eris.Errorf("invalid wasm image %s", image)
+	}
+	NoWasmLayerError = func(image string) error {
+		return eris.Errorf("wasm image %s does not contain a wasm layer", image)
+	}
+	UnsupportedDigestError = func(image string, dgst digest.Digest) error {
+		return eris.Errorf("wasm image %s has unsupported digest %s, only sha256 digests are supported", image, dgst)
+	}
+	DigestMismatchError = func(image string, dgst digest.Digest) error {
+		return eris.Errorf("content of wasm image %s does not match digest %s", image, dgst)
+	}
+	RegistryResponseError = func(requestUrl string, status int) error {
+		return eris.Errorf("request to %s failed with status %d", requestUrl, status)
+	}
+)
+
+// Puller pulls wasm filters from OCI images
+type Puller interface {
+	// Pull returns the wasm filter of the image and its verified sha256 digest
+	Pull(ctx context.Context, image string) ([]byte, digest.Digest, error)
+}
+
+type registryPuller struct {
+	client *http.Client
+}
+
+// NewRegistryPuller returns a puller which pulls the images from their registries with the OCI distribution API.
+// Registries on loopback addresses are accessed over plain HTTP, other registries over HTTPS.
+func NewRegistryPuller(client *http.Client) Puller {
+	return &registryPuller{client: client}
+}
+
+// reference is a parsed image reference, e.g. `webassemblyhub.io/user/filter:v1`
+type reference struct {
+	registry   string
+	repository string
+	// tag or digest
+	reference string
+}
+
+func parseReference(image string) (*reference, error) {
+	ref := &reference{reference: defaultTag}
+
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		ref.reference = name[i+1:]
+		name = name[:i]
+		if _, err := digest.Parse(ref.reference); err != nil {
+			return nil, InvalidImageError(image)
+		}
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		ref.reference = name[i+1:]
+		name = name[:i]
+	}
+
+	ref.registry = defaultRegistry
+	if i := strings.Index(name, "/"); i >= 0 {
+		host := name[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			ref.registry = host
+			name = name[i+1:]
+		}
+	}
+	if ref.registry == defaultRegistry {
+		if !strings.Contains(name, "/") {
+			name = defaultRepositoryPath + name
+		}
+		ref.registry = defaultRegistryHost
+	}
+
+	if name == "" || ref.reference == "" {
+		return nil, InvalidImageError(image)
+	}
+	ref.repository = name
+	return ref, nil
+}
+
+// baseUrl returns the url of the registry API
+func (r *reference) baseUrl() string {
+	scheme := "https"
+	host := r.registry
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if ip := net.ParseIP(host); host == "localhost" || (ip != nil && ip.IsLoopback()) {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s/v2/%s", scheme, r.registry, r.repository)
+}
+
+func (p *registryPuller) Pull(ctx context.Context, image string) ([]byte, digest.Digest, error) {
+	ref, err := parseReference(image)
+	if err != nil {
+		return nil, "", err
+	}
+	session := &registrySession{client: p.client, ref: ref}
+
+	manifestBytes, err := session.get(ctx, ref.baseUrl()+"/manifests/"+ref.reference, ocispec.MediaTypeImageManifest, dockerManifestMediaType)
+	if err != nil {
+		return nil, "", eris.Wrapf(err, "fetching manifest of wasm image %s", image)
+	}
+	if manifestDigest, err := digest.Parse(ref.reference); err == nil {
+		if err := verify(image, manifestDigest, manifestBytes); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, "", eris.Wrapf(err, "parsing manifest of wasm image %s", image)
+	}
+	layer, err := wasmLayer(image, manifest)
+	if err != nil {
+		return nil, "", err
+	}
+	if layer.Digest.Algorithm() != digest.SHA256 {
+		return nil, "", UnsupportedDigestError(image, layer.Digest)
+	}
+
+	filter, err := session.get(ctx, ref.baseUrl()+"/blobs/"+layer.Digest.String())
+	if err != nil {
+		return nil, "", eris.Wrapf(err, "fetching wasm layer of image %s", image)
+	}
+	if err := verify(image, layer.Digest, filter); err != nil {
+		return nil, "", err
+	}
+	return filter, layer.Digest, nil
+}
+
+// wasmLayer returns the layer of the manifest which contains the wasm filter
+func wasmLayer(image string, manifest ocispec.Manifest) (ocispec.Descriptor, error) {
+	for _, layer := range manifest.Layers {
+		if wasmLayerMediaTypes[layer.MediaType] {
+			return layer, nil
+		}
+	}
+	return ocispec.Descriptor{}, NoWasmLayerError(image)
+}
+
+func verify(image string, dgst digest.Digest, content []byte) error {
+	if err := dgst.Validate(); err != nil {
+		return UnsupportedDigestError(image, dgst)
+	}
+	verifier := dgst.Verifier()
+	_, _ = verifier.Write(content)
+	if !verifier.Verified() {
+		return DigestMismatchError(image, dgst)
+	}
+	return nil
+}
+
+// registrySession sends requests to a registry and authenticates them with an anonymous bearer token if the
+// registry requires it
+type registrySession struct {
+	client *http.Client
+	ref    *reference
+	token  string
+}
+
+func (s *registrySession) get(ctx context.Context, requestUrl string, accept ...string) ([]byte, error) {
+	resp, err := s.do(ctx, requestUrl, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && s.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if s.token, err = s.fetchToken(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = s.do(ctx, requestUrl, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, RegistryResponseError(requestUrl, resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxFilterSize))
+}
+
+func (s *registrySession) do(ctx context.Context, requestUrl string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	return s.client.Do(req)
+}
+
+// fetchToken requests an anonymous pull token from the authorization server in the bearer challenge
+func (s *registrySession) fetchToken(ctx context.Context, challenge string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		return "", eris.Errorf("registry %s requires unsupported authentication %q", s.ref.registry, challenge)
+	}
+
+	query := url.Values{}
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", s.ref.repository))
+
+	tokenUrl := params["realm"] + "?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenUrl, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", RegistryResponseError(tokenUrl, resp.StatusCode)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", eris.Wrapf(err, "parsing token from %s", tokenUrl)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
+
+// parseChallenge parses a WWW-Authenticate header, e.g. `Bearer realm="https://auth.docker.io/token",service="registry.docker.io"`
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := map[string]string{}
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	for _, param := range strings.Split(rest, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok {
+			params[strings.ToLower(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return scheme, params
+}
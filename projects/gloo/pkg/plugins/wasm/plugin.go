@@ -0,0 +1,237 @@
+package wasm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_filters_http_wasm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoy_extensions_wasm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/rotisserie/eris"
+	solocorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/filters"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm/cache"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var (
+	_ plugins.Plugin           = new(plugin)
+	_ plugins.HttpFilterPlugin = new(plugin)
+)
+
+const (
+	ExtensionName = "wasm"
+	FilterName    = "envoy.filters.http.wasm"
+
+	// WasmCacheCluster is the static cluster of the Envoy bootstrap which points to the wasm cache served by Gloo
+	WasmCacheCluster = "wasm-cache"
+	VmId             = "gloo-vm-id"
+
+	V8Runtime   = "envoy.wasm.runtime.v8"
+	WavmRuntime = "envoy.wasm.runtime.wavm"
+)
+
+var (
+	// the timeout of Envoy fetching a filter from the wasm cache
+	wasmCacheTimeout = durationpb.New(5 * time.Second)
+
+	NoSourceError = func(filter *wasm.WasmFilter) error {
+		return eris.Errorf("wasm filter %s must specify a source", filter.GetName())
+	}
+	NoImageCacheError = func(filter *wasm.WasmFilter) error {
+		return eris.Errorf("wasm filter %s is sourced from an image but the wasm image cache is not available", filter.GetName())
+	}
+	ImagePullError = func(err error, filter *wasm.WasmFilter) error {
+		return eris.Wrapf(err, "pulling image of wasm filter %s", filter.GetName())
+	}
+	EmptyDataSourceError = func(filter *wasm.WasmFilter) error {
+		return eris.Errorf("wasm filter %s has an empty data source", filter.GetName())
+	}
+	NoArtifactRefError = func(filter *wasm.WasmFilter) error {
+		return eris.Errorf("wasm filter %s must specify an artifact ref", filter.GetName())
+	}
+	ArtifactNotFoundError = func(filter *wasm.WasmFilter) error {
+		return eris.Errorf("artifact %s of wasm filter %s does not exist",
+			filter.GetArtifact().GetArtifactRef().Key(), filter.GetName())
+	}
+	NoArtifactKeyError = func(filter *wasm.WasmFilter, numValues int) error {
+		return eris.Errorf("artifact %s of wasm filter %s has %d values, a key must be specified",
+			filter.GetArtifact().GetArtifactRef().Key(), filter.GetName(), numValues)
+	}
+	NoArtifactDataError = func(filter *wasm.WasmFilter, key string) error {
+		return eris.Errorf("artifact %s of wasm filter %s has no data at key %s",
+			filter.GetArtifact().GetArtifactRef().Key(), filter.GetName(), key)
+	}
+	DecodingError = func(filter *wasm.WasmFilter, key string) error {
+		return eris.Errorf("data at key %s of artifact %s of wasm filter %s is not base64-encoded",
+			key, filter.GetArtifact().GetArtifactRef().Key(), filter.GetName())
+	}
+)
+
+type plugin struct {
+	imageCache cache.Cache
+}
+
+// NewPlugin returns the wasm plugin.
+// The image cache pulls the filters sourced from images, if it is nil these filters are rejected.
+func NewPlugin(imageCache cache.Cache) *plugin {
+	return &plugin{imageCache: imageCache}
+}
+
+func (p *plugin) Name() string {
+	return ExtensionName
+}
+
+func (p *plugin) Init(_ plugins.InitParams) {
+}
+
+func (p *plugin) HttpFilters(params plugins.Params, listener *v1.HttpListener) ([]plugins.StagedHttpFilter, error) {
+	var stagedFilters []plugins.StagedHttpFilter
+	for _, wasmFilter := range listener.GetOptions().GetWasm().GetFilters() {
+		code, err := p.translateCode(params, wasmFilter)
+		if err != nil {
+			return nil, err
+		}
+
+		runtime := V8Runtime
+		if wasmFilter.GetVmType() == wasm.WasmFilter_WAVM {
+			runtime = WavmRuntime
+		}
+		config := &envoy_extensions_filters_http_wasm_v3.Wasm{
+			Config: &envoy_extensions_wasm_v3.PluginConfig{
+				Name:   wasmFilter.GetName(),
+				RootId: wasmFilter.GetRootId(),
+				Vm: &envoy_extensions_wasm_v3.PluginConfig_VmConfig{
+					VmConfig: &envoy_extensions_wasm_v3.VmConfig{
+						VmId:                VmId,
+						Runtime:             runtime,
+						Code:                code,
+						NackOnCodeCacheMiss: true,
+					},
+				},
+				Configuration: wasmFilter.GetConfig(),
+				FailOpen:      wasmFilter.GetFailOpen(),
+			},
+		}
+
+		stagedFilter, err := plugins.NewStagedFilter(FilterName, config, convertFilterStage(wasmFilter.GetFilterStage()))
+		if err != nil {
+			return nil, eris.Wrapf(err, "generating config of wasm filter %s", wasmFilter.GetName())
+		}
+		stagedFilters = append(stagedFilters, stagedFilter)
+	}
+	return stagedFilters, nil
+}
+
+// translateCode returns the source from which Envoy loads the wasm module of the filter
+func (p *plugin) translateCode(params plugins.Params, wasmFilter *wasm.WasmFilter) (*envoy_config_core_v3.AsyncDataSource, error) {
+	switch src := wasmFilter.GetSrc().(type) {
+	case *wasm.WasmFilter_Image:
+		return p.translateImage(wasmFilter)
+	case *wasm.WasmFilter_FilePath:
+		return localDataSource(&envoy_config_core_v3.DataSource{
+			Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: src.FilePath},
+		}), nil
+	case *wasm.WasmFilter_DataSource:
+		return translateDataSource(wasmFilter)
+	case *wasm.WasmFilter_Artifact:
+		return translateArtifact(params, wasmFilter)
+	}
+	return nil, NoSourceError(wasmFilter)
+}
+
+// translateImage configures Envoy to fetch the filter of the image from the wasm cache cluster and verify its sha256
+// digest. The image is pulled in the background, and the filter is rejected until it is pulled.
+func (p *plugin) translateImage(wasmFilter *wasm.WasmFilter) (*envoy_config_core_v3.AsyncDataSource, error) {
+	if p.imageCache == nil {
+		return nil, NoImageCacheError(wasmFilter)
+	}
+	dgst, err := p.imageCache.Lookup(wasmFilter.GetImage())
+	if err != nil {
+		return nil, ImagePullError(err, wasmFilter)
+	}
+
+	return &envoy_config_core_v3.AsyncDataSource{
+		Specifier: &envoy_config_core_v3.AsyncDataSource_Remote{
+			Remote: &envoy_config_core_v3.RemoteDataSource{
+				HttpUri: &envoy_config_core_v3.HttpUri{
+					Uri: fmt.Sprintf("http://gloo%s%s", cache.ImagesPath, dgst.Encoded()),
+					HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+						Cluster: WasmCacheCluster,
+					},
+					Timeout: wasmCacheTimeout,
+				},
+				Sha256: dgst.Encoded(),
+			},
+		},
+	}, nil
+}
+
+func translateDataSource(wasmFilter *wasm.WasmFilter) (*envoy_config_core_v3.AsyncDataSource, error) {
+	dataSource := wasmFilter.GetDataSource()
+	out := &envoy_config_core_v3.DataSource{}
+	switch specifier := dataSource.GetSpecifier().(type) {
+	case *solocorev3.DataSource_Filename:
+		out.Specifier = &envoy_config_core_v3.DataSource_Filename{Filename: specifier.Filename}
+	case *solocorev3.DataSource_InlineBytes:
+		out.Specifier = &envoy_config_core_v3.DataSource_InlineBytes{InlineBytes: specifier.InlineBytes}
+	case *solocorev3.DataSource_InlineString:
+		out.Specifier = &envoy_config_core_v3.DataSource_InlineString{InlineString: specifier.InlineString}
+	default:
+		return nil, EmptyDataSourceError(wasmFilter)
+	}
+	return localDataSource(out), nil
+}
+
+// translateArtifact inlines the base64-encoded wasm module stored in the referenced artifact
+func translateArtifact(params plugins.Params, wasmFilter *wasm.WasmFilter) (*envoy_config_core_v3.AsyncDataSource, error) {
+	artifactSource := wasmFilter.GetArtifact()
+	if artifactSource.GetArtifactRef() == nil {
+		return nil, NoArtifactRefError(wasmFilter)
+	}
+	artifact, err := params.Snapshot.Artifacts.Find(artifactSource.GetArtifactRef().Strings())
+	if err != nil {
+		return nil, ArtifactNotFoundError(wasmFilter)
+	}
+
+	data := artifact.GetData()
+	key := artifactSource.GetKey()
+	if key == "" {
+		// if there is exactly one value, use it
+		if len(data) != 1 {
+			return nil, NoArtifactKeyError(wasmFilter, len(data))
+		}
+		for k := range data {
+			key = k
+		}
+	}
+	if data[key] == "" {
+		return nil, NoArtifactDataError(wasmFilter, key)
+	}
+
+	module, err := base64.StdEncoding.DecodeString(data[key])
+	if err != nil {
+		return nil, DecodingError(wasmFilter, key)
+	}
+	return localDataSource(&envoy_config_core_v3.DataSource{
+		Specifier: &envoy_config_core_v3.DataSource_InlineBytes{InlineBytes: module},
+	}), nil
+}
+
+func localDataSource(dataSource *envoy_config_core_v3.DataSource) *envoy_config_core_v3.AsyncDataSource {
+	return &envoy_config_core_v3.AsyncDataSource{
+		Specifier: &envoy_config_core_v3.AsyncDataSource_Local{Local: dataSource},
+	}
+}
+
+// convertFilterStage converts the wasm filter stage, whose enums mirror the values of the gloo filter stage
+func convertFilterStage(in *wasm.FilterStage) plugins.FilterStage {
+	return *plugins.ConvertFilterStage(&filters.FilterStage{
+		Stage:     filters.FilterStage_Stage(in.GetStage()),
+		Predicate: filters.FilterStage_Predicate(in.GetPredicate()),
+	})
+}
@@ -0,0 +1,292 @@
+package wasm_test
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_filters_http_wasm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoy_extensions_wasm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	"github.com/rotisserie/eris"
+	solocorev3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/config/core/v3"
+	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	gloov1snap "github.com/solo-io/gloo/projects/gloo/pkg/api/v1/gloosnapshot"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	. "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm/cache"
+	"github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/test/matchers"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type fakePuller map[string][]byte
+
+func (f fakePuller) Pull(_ context.Context, image string) ([]byte, digest.Digest, error) {
+	filter, ok := f[image]
+	if !ok {
+		return nil, "", eris.Errorf("image %s not found", image)
+	}
+	return filter, digest.FromBytes(filter), nil
+}
+
+var _ = Describe("Plugin", func() {
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		params     plugins.Params
+		imageCache cache.Cache
+		module     []byte
+		config     *wrapperspb.StringValue
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		module = []byte("\x00asm\x01\x00\x00\x00")
+		config = &wrapperspb.StringValue{Value: "world"}
+		imageCache = cache.NewCache(fakePuller{"webassemblyhub.io/user/add-header:v1": module}, cache.DefaultOptions)
+		imageCache.Start(ctx, func() {})
+		params = plugins.Params{
+			Ctx: ctx,
+			Snapshot: &gloov1snap.ApiSnapshot{
+				Artifacts: v1.ArtifactList{{
+					Metadata: &core.Metadata{Name: "add-header", Namespace: "gloo-system"},
+					Data:     map[string]string{"filter.wasm": base64.StdEncoding.EncodeToString(module)},
+				}},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	listenerWithFilters := func(filters ...*wasm.WasmFilter) *v1.HttpListener {
+		return &v1.HttpListener{
+			Options: &v1.HttpListenerOptions{
+				Wasm: &wasm.PluginSource{Filters: filters},
+			},
+		}
+	}
+
+	expectedConfig := func(runtime string, code *envoy_config_core_v3.AsyncDataSource) *envoy_extensions_filters_http_wasm_v3.Wasm {
+		anyConfig, err := utils.MessageToAny(config)
+		Expect(err).NotTo(HaveOccurred())
+		return &envoy_extensions_filters_http_wasm_v3.Wasm{
+			Config: &envoy_extensions_wasm_v3.PluginConfig{
+				Name:   "add-header",
+				RootId: "add_header",
+				Vm: &envoy_extensions_wasm_v3.PluginConfig_VmConfig{
+					VmConfig: &envoy_extensions_wasm_v3.VmConfig{
+						VmId:                VmId,
+						Runtime:             runtime,
+						Code:                code,
+						NackOnCodeCacheMiss: true,
+					},
+				},
+				Configuration: anyConfig,
+			},
+		}
+	}
+
+	filterConfig := func(filter plugins.StagedHttpFilter) *envoy_extensions_filters_http_wasm_v3.Wasm {
+		Expect(filter.HttpFilter.GetName()).To(Equal(FilterName))
+		msg, err := utils.AnyToMessage(filter.HttpFilter.GetTypedConfig())
+		Expect(err).NotTo(HaveOccurred())
+		return msg.(*envoy_extensions_filters_http_wasm_v3.Wasm)
+	}
+
+	newFilter := func() *wasm.WasmFilter {
+		anyConfig, err := utils.MessageToAny(config)
+		Expect(err).NotTo(HaveOccurred())
+		return &wasm.WasmFilter{
+			Name:   "add-header",
+			RootId: "add_header",
+			Config: anyConfig,
+		}
+	}
+
+	It("does not add filters when wasm is not configured", func() {
+		filters, err := NewPlugin(imageCache).HttpFilters(params, &v1.HttpListener{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(BeEmpty())
+	})
+
+	It("serves images from the wasm cache and verifies their sha256 digest", func() {
+		filter := newFilter()
+		filter.Src = &wasm.WasmFilter_Image{Image: "webassemblyhub.io/user/add-header:v1"}
+		filter.FilterStage = &wasm.FilterStage{Stage: wasm.FilterStage_AuthZStage, Predicate: wasm.FilterStage_After}
+
+		var filters []plugins.StagedHttpFilter
+		Eventually(func() error {
+			var err error
+			filters, err = NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			return err
+		}).ShouldNot(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.AfterStage(plugins.AuthZStage)))
+
+		sha256 := digest.FromBytes(module).Encoded()
+		Expect(filterConfig(filters[0])).To(matchers.MatchProto(expectedConfig(V8Runtime, &envoy_config_core_v3.AsyncDataSource{
+			Specifier: &envoy_config_core_v3.AsyncDataSource_Remote{
+				Remote: &envoy_config_core_v3.RemoteDataSource{
+					HttpUri: &envoy_config_core_v3.HttpUri{
+						Uri:              "http://gloo/images/" + sha256,
+						HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{Cluster: WasmCacheCluster},
+						Timeout:          durationpb.New(5 * time.Second),
+					},
+					Sha256: sha256,
+				},
+			},
+		})))
+
+		cached, ok := imageCache.Get(digest.FromBytes(module))
+		Expect(ok).To(BeTrue())
+		Expect(cached).To(Equal(module))
+	})
+
+	It("reports the filter as pending until the image is pulled", func() {
+		filter := newFilter()
+		filter.Src = &wasm.WasmFilter_Image{Image: "webassemblyhub.io/user/add-header:v1"}
+
+		_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+		Expect(err).To(MatchError(ContainSubstring("pulling image of wasm filter add-header")))
+		Expect(err).To(MatchError(ContainSubstring(cache.ImagePendingError("webassemblyhub.io/user/add-header:v1").Error())))
+	})
+
+	It("errors when the image cannot be pulled", func() {
+		filter := newFilter()
+		filter.Src = &wasm.WasmFilter_Image{Image: "webassemblyhub.io/user/missing:v1"}
+
+		Eventually(func() error {
+			_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			return err
+		}).Should(MatchError(ContainSubstring("image webassemblyhub.io/user/missing:v1 not found")))
+		_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+		Expect(err).To(MatchError(ContainSubstring("pulling image of wasm filter add-header")))
+	})
+
+	It("errors on images when there is no image cache", func() {
+		filter := newFilter()
+		filter.Src = &wasm.WasmFilter_Image{Image: "webassemblyhub.io/user/add-header:v1"}
+
+		_, err := NewPlugin(nil).HttpFilters(params, listenerWithFilters(filter))
+		Expect(err).To(MatchError(NoImageCacheError(filter)))
+	})
+
+	It("loads the filter from a file path", func() {
+		filter := newFilter()
+		filter.Src = &wasm.WasmFilter_FilePath{FilePath: "/wasm-filters/filter.wasm"}
+		filter.VmType = wasm.WasmFilter_WAVM
+
+		filters, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Stage).To(Equal(plugins.DuringStage(plugins.FaultStage)))
+		Expect(filterConfig(filters[0])).To(matchers.MatchProto(expectedConfig(WavmRuntime, &envoy_config_core_v3.AsyncDataSource{
+			Specifier: &envoy_config_core_v3.AsyncDataSource_Local{
+				Local: &envoy_config_core_v3.DataSource{
+					Specifier: &envoy_config_core_v3.DataSource_Filename{Filename: "/wasm-filters/filter.wasm"},
+				},
+			},
+		})))
+	})
+
+	It("loads the filter from an inline data source", func() {
+		filter := newFilter()
+		filter.Src = &wasm.WasmFilter_DataSource{DataSource: &solocorev3.DataSource{
+			Specifier: &solocorev3.DataSource_InlineBytes{InlineBytes: module},
+		}}
+
+		filters, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filterConfig(filters[0])).To(matchers.MatchProto(expectedConfig(V8Runtime, &envoy_config_core_v3.AsyncDataSource{
+			Specifier: &envoy_config_core_v3.AsyncDataSource_Local{
+				Local: &envoy_config_core_v3.DataSource{
+					Specifier: &envoy_config_core_v3.DataSource_InlineBytes{InlineBytes: module},
+				},
+			},
+		})))
+	})
+
+	It("errors on an empty data source", func() {
+		filter := newFilter()
+		filter.Src = &wasm.WasmFilter_DataSource{DataSource: &solocorev3.DataSource{}}
+
+		_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+		Expect(err).To(MatchError(EmptyDataSourceError(filter)))
+	})
+
+	It("errors when the filter has no source", func() {
+		filter := newFilter()
+
+		_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+		Expect(err).To(MatchError(NoSourceError(filter)))
+	})
+
+	Context("artifacts", func() {
+
+		var filter *wasm.WasmFilter
+
+		BeforeEach(func() {
+			filter = newFilter()
+			filter.Src = &wasm.WasmFilter_Artifact{Artifact: &wasm.ArtifactSource{
+				ArtifactRef: &core.ResourceRef{Name: "add-header", Namespace: "gloo-system"},
+			}}
+		})
+
+		It("inlines the decoded filter of the artifact", func() {
+			filters, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filterConfig(filters[0])).To(matchers.MatchProto(expectedConfig(V8Runtime, &envoy_config_core_v3.AsyncDataSource{
+				Specifier: &envoy_config_core_v3.AsyncDataSource_Local{
+					Local: &envoy_config_core_v3.DataSource{
+						Specifier: &envoy_config_core_v3.DataSource_InlineBytes{InlineBytes: module},
+					},
+				},
+			})))
+		})
+
+		It("errors when the artifact does not exist", func() {
+			filter.GetArtifact().GetArtifactRef().Name = "missing"
+
+			_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			Expect(err).To(MatchError(ArtifactNotFoundError(filter)))
+		})
+
+		It("requires a key when the artifact has multiple values", func() {
+			params.Snapshot.Artifacts[0].GetData()["other.wasm"] = "b3RoZXI="
+
+			_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			Expect(err).To(MatchError(NoArtifactKeyError(filter, 2)))
+
+			filter.GetArtifact().Key = "filter.wasm"
+			filters, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+		})
+
+		It("errors when the key has no data", func() {
+			filter.GetArtifact().Key = "missing.wasm"
+
+			_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			Expect(err).To(MatchError(NoArtifactDataError(filter, "missing.wasm")))
+		})
+
+		It("errors when the data is not base64-encoded", func() {
+			params.Snapshot.Artifacts[0].GetData()["filter.wasm"] = "not base64!"
+
+			_, err := NewPlugin(imageCache).HttpFilters(params, listenerWithFilters(filter))
+			Expect(err).To(MatchError(DecodingError(filter, "filter.wasm")))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector"
@@ -41,6 +42,7 @@ import (
 	"github.com/solo-io/gloo/pkg/utils"
 	"github.com/solo-io/gloo/pkg/utils/channelutils"
 	"github.com/solo-io/gloo/pkg/utils/setuputils"
+	"github.com/solo-io/gloo/projects/gateway/pkg/acme"
 	gateway "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
 	gwdefaults "github.com/solo-io/gloo/projects/gateway/pkg/defaults"
 	gwtranslator "github.com/solo-io/gloo/projects/gateway/pkg/translator"
@@ -52,8 +54,10 @@ import (
 	bootstrap_clients "github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/discovery"
+	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/coldstart/activator"
 	consulplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/consul"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
+	wasmcache "github.com/solo-io/gloo/projects/gloo/pkg/plugins/wasm/cache"
 	extauthExt "github.com/solo-io/gloo/projects/gloo/pkg/syncer/extauth"
 	ratelimitExt "github.com/solo-io/gloo/projects/gloo/pkg/syncer/ratelimit"
 	"github.com/solo-io/gloo/projects/gloo/pkg/syncer/sanitizer"
@@ -127,7 +131,8 @@ func NewSetupFuncWithRunAndExtensions(runFunc RunFunc, extensions *Extensions) s
 			serverOpts = append(serverOpts, options...)
 			return grpc.NewServer(serverOpts...)
 		},
-		runFunc: runFunc,
+		runFunc:        runFunc,
+		wasmImageCache: wasmcache.NewDefaultCache(),
 	}
 	return s.Setup
 }
@@ -150,6 +155,7 @@ type setupSyncer struct {
 	controlPlane             bootstrap.ControlPlane
 	validationServer         bootstrap.ValidationServer
 	proxyDebugServer         bootstrap.ProxyDebugServer
+	wasmImageCache           wasmcache.Cache
 	callbacks                xdsserver.Callbacks
 }
 
@@ -198,7 +204,9 @@ var (
 	DefaultXdsBindAddr        = fmt.Sprintf("0.0.0.0:%v", defaults.GlooXdsPort)
 	DefaultValidationBindAddr = fmt.Sprintf("0.0.0.0:%v", defaults.GlooValidationPort)
 	DefaultRestXdsBindAddr    = fmt.Sprintf("0.0.0.0:%v", defaults.GlooRestXdsPort)
+	DefaultWasmCacheBindAddr  = fmt.Sprintf("0.0.0.0:%v", defaults.GlooWasmCachePort)
 	DefaultProxyDebugAddr     = fmt.Sprintf("0.0.0.0:%v", defaults.GlooProxyDebugPort)
+	DefaultColdStartBindAddr  = fmt.Sprintf("0.0.0.0:%v", defaults.GlooColdStartPort)
 )
 
 func getAddr(addr string) (*net.TCPAddr, error) {
@@ -401,6 +409,7 @@ func (s *setupSyncer) Setup(ctx context.Context, kubeCache kube.SharedCache, mem
 	opts.ControlPlane = s.controlPlane
 	opts.ValidationServer = s.validationServer
 	opts.ProxyDebugServer = s.proxyDebugServer
+	opts.WasmImageCache = s.wasmImageCache
 	// if nil, kube plugin disabled
 	opts.KubeClient = clientset
 	opts.DevMode = settings.GetDevMode()
@@ -482,7 +491,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	if opts.Settings.GetGloo().GetDisableKubernetesDestinations() {
 		kubeServiceClient = nil
 	}
-	hybridUsClient, err := upstreams.NewHybridUpstreamClient(upstreamClient, kubeServiceClient, opts.Consul.ConsulWatcher, opts.Settings)
+	hybridUsClient, err := upstreams.NewHybridUpstreamClient(upstreamClient, kubeServiceClient, opts.KubeCoreCache, opts.Consul.ConsulWatcher, opts.Settings)
 	if err != nil {
 		return err
 	}
@@ -616,6 +625,11 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	logger := contextutils.LoggerFrom(watchOpts.Ctx)
 
 	startRestXdsServer(opts)
+	startWasmCacheServer(opts, extensions.ApiEmitterChannel)
+	coldStartActivator := &coldStartActivatorSyncer{
+		Activator: activator.NewActivator(watchOpts.Ctx, activator.NewScaler(opts.KubeClient, activator.DefaultWebhookTimeout)),
+		opts:      opts,
+	}
 
 	errs := make(chan error)
 
@@ -754,6 +768,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	gwOpts := gwtranslator.Opts{
 		GlooNamespace:                  opts.WriteNamespace,
 		WriteNamespace:                 opts.WriteNamespace,
+		AcmeNamespace:                  opts.WriteNamespace,
 		StatusReporterNamespace:        opts.StatusReporterNamespace,
 		WatchNamespaces:                opts.WatchNamespaces,
 		Gateways:                       opts.Gateways,
@@ -827,6 +842,18 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 			statusClient,
 			statusMetrics,
 			opts.Identity)
+
+		// issue the certificates of the virtual services which request them from an ACME server
+		acmeManager := acme.NewManager(
+			acme.Opts{
+				WriteNamespace:  gwOpts.AcmeNamespace,
+				WatchNamespaces: opts.WatchNamespaces,
+			},
+			virtualServiceClient,
+			secretClient,
+			acme.NewIssuer(),
+			opts.Identity)
+		go acmeManager.Start(opts.WatchOpts.Ctx)
 	} else {
 		logger.Debugf("Gateway translation is disabled. Proxies are provided from another source")
 	}
@@ -873,6 +900,7 @@ func RunGlooWithExtensions(opts bootstrap.Opts, extensions Extensions) error {
 	syncers := v1snap.ApiSyncers{
 		validator,
 		translationSync,
+		coldStartActivator,
 	}
 	if opts.GatewayControllerEnabled {
 		syncers = append(syncers, gwValidationSyncer)
@@ -1013,6 +1041,77 @@ func startRestXdsServer(opts bootstrap.Opts) {
 	}()
 }
 
+// startWasmCacheServer pulls the images of the wasm filters in the background, and serves their filters to Envoy
+// through the wasm-cache cluster of the Envoy bootstrap. The proxies are translated again whenever an image is pulled.
+func startWasmCacheServer(opts bootstrap.Opts, apiEmitterChannel chan struct{}) {
+	if opts.WasmImageCache == nil {
+		return
+	}
+	ctx := opts.WatchOpts.Ctx
+	opts.WasmImageCache.Start(ctx, func() {
+		go func() {
+			select {
+			case apiEmitterChannel <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}()
+	})
+
+	srv := &http.Server{
+		Addr:    DefaultWasmCacheBindAddr,
+		Handler: opts.WasmImageCache,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Warnf("error while running wasm cache server", zap.Error(err))
+		}
+	}()
+	go func() {
+		<-opts.WatchOpts.Ctx.Done()
+		if err := srv.Close(); err != nil {
+			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Warnf("error while shutting down wasm cache server", zap.Error(err))
+		}
+	}()
+}
+
+// coldStartActivatorSyncer syncs the cold start activator, and starts serving it once an upstream has cold start, so
+// that its port is not opened in the installations which do not use it
+type coldStartActivatorSyncer struct {
+	activator.Activator
+	opts  bootstrap.Opts
+	start sync.Once
+}
+
+func (s *coldStartActivatorSyncer) Sync(ctx context.Context, snap *v1snap.ApiSnapshot) error {
+	for _, us := range snap.Upstreams {
+		if us.GetKube().GetColdStart() != nil {
+			s.start.Do(func() { startColdStartActivator(s.opts, s.Activator) })
+			break
+		}
+	}
+	return s.Activator.Sync(ctx, snap)
+}
+
+// startColdStartActivator serves the cold start activator, to which the cold start plugin routes the requests to the
+// upstreams with cold start which have no endpoints
+func startColdStartActivator(opts bootstrap.Opts, coldStartActivator activator.Activator) {
+	srv := &http.Server{
+		Addr:    DefaultColdStartBindAddr,
+		Handler: coldStartActivator,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Warnf("error while running cold start activator", zap.Error(err))
+		}
+	}()
+	go func() {
+		<-opts.WatchOpts.Ctx.Done()
+		if err := srv.Close(); err != nil {
+			contextutils.LoggerFrom(opts.WatchOpts.Ctx).Warnf("error while shutting down cold start activator", zap.Error(err))
+		}
+	}()
+}
+
 type constructOptsParams struct {
 	clientset          *kubernetes.Interface
 	kubeCache          kube.SharedCache
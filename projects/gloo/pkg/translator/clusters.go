@@ -7,6 +7,7 @@ import (
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
@@ -69,6 +70,10 @@ func (t *translatorInstance) computeCluster(
 
 	for _, plugin := range t.pluginRegistry.GetUpstreamPlugins() {
 		if err := plugin.ProcessUpstream(params, upstream, out); err != nil {
+			if plugins.IsUpstreamWarning(err) {
+				reports.AddWarning(upstream, err.Error())
+				continue
+			}
 			reports.AddError(upstream, err)
 		}
 	}
@@ -99,11 +104,15 @@ func (t *translatorInstance) initializeCluster(
 		reports.AddError(upstream, err)
 	}
 
-	circuitBreakers := t.settings.GetGloo().GetCircuitBreakers()
+	circuitBreakers, err := getCircuitBreakers(upstream.GetCircuitBreakers(), t.settings.GetGloo().GetCircuitBreakers())
+	if err != nil {
+		reports.AddError(upstream, err)
+	}
+
 	out := &envoy_config_cluster_v3.Cluster{
 		Name:             UpstreamToClusterName(upstream.GetMetadata().Ref()),
 		Metadata:         new(envoy_config_core_v3.Metadata),
-		CircuitBreakers:  getCircuitBreakers(upstream.GetCircuitBreakers(), circuitBreakers),
+		CircuitBreakers:  circuitBreakers,
 		LbSubsetConfig:   createLbConfig(upstream),
 		HealthChecks:     hcConfig,
 		OutlierDetection: detectCfg,
@@ -283,20 +292,41 @@ func validateCluster(c *envoy_config_cluster_v3.Cluster) error {
 }
 
 // Convert the first non nil circuit breaker.
-func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) *envoy_config_cluster_v3.CircuitBreakers {
+func getCircuitBreakers(cfgs ...*v1.CircuitBreakerConfig) (*envoy_config_cluster_v3.CircuitBreakers, error) {
 	for _, cfg := range cfgs {
 		if cfg != nil {
+			retryBudget, err := getRetryBudget(cfg.GetRetryBudget())
+			if err != nil {
+				return nil, err
+			}
 			envoyCfg := &envoy_config_cluster_v3.CircuitBreakers{}
 			envoyCfg.Thresholds = []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{{
 				MaxConnections:     cfg.GetMaxConnections(),
 				MaxPendingRequests: cfg.GetMaxPendingRequests(),
 				MaxRequests:        cfg.GetMaxRequests(),
 				MaxRetries:         cfg.GetMaxRetries(),
+				RetryBudget:        retryBudget,
 			}}
-			return envoyCfg
+			return envoyCfg, nil
 		}
 	}
-	return nil
+	return nil, nil
+}
+
+func getRetryBudget(budget *v1.RetryBudget) (*envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget, error) {
+	if budget == nil {
+		return nil, nil
+	}
+	out := &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+		MinRetryConcurrency: budget.GetMinRetryConcurrency(),
+	}
+	if budgetPercent := budget.GetBudgetPercent(); budgetPercent != nil {
+		if budgetPercent.GetValue() < 0 || budgetPercent.GetValue() > 100 {
+			return nil, eris.Errorf("retry budget percent must be between 0 and 100, received %v", budgetPercent.GetValue())
+		}
+		out.BudgetPercent = &envoy_type_v3.Percent{Value: budgetPercent.GetValue()}
+	}
+	return out, nil
 }
 
 // getPreconnectPolicy follows the naming of the rest of functions here
@@ -246,7 +246,7 @@ func validateEnvoyRoute(r *envoy_config_route_v3.Route, routeReport *validationa
 // utility function to transform gloo matcher to envoy route matcher
 func GlooMatcherToEnvoyMatcher(ctx context.Context, matcher *matchers.Matcher) envoy_config_route_v3.RouteMatch {
 	match := envoy_config_route_v3.RouteMatch{
-		Headers:         envoyHeaderMatcher(ctx, matcher.GetHeaders()),
+		Headers:         EnvoyHeaderMatchers(ctx, matcher.GetHeaders()),
 		QueryParameters: envoyQueryMatcher(ctx, matcher.GetQueryParameters()),
 	}
 	if len(matcher.GetMethods()) > 0 {
@@ -633,7 +633,8 @@ func setEnvoyPathMatcher(ctx context.Context, in *matchers.Matcher, out *envoy_c
 	}
 }
 
-func envoyHeaderMatcher(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
+// EnvoyHeaderMatchers converts the gloo header matchers to envoy header matchers
+func EnvoyHeaderMatchers(ctx context.Context, in []*matchers.HeaderMatcher) []*envoy_config_route_v3.HeaderMatcher {
 	var out []*envoy_config_route_v3.HeaderMatcher
 	for _, matcher := range in {
 
@@ -63,6 +63,7 @@ import (
 	"github.com/solo-io/gloo/projects/gloo/pkg/bootstrap"
 	"github.com/solo-io/gloo/projects/gloo/pkg/defaults"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/plugins/registry"
 	. "github.com/solo-io/gloo/projects/gloo/pkg/translator"
 	"github.com/solo-io/gloo/projects/gloo/pkg/upstreams/consul"
@@ -1480,6 +1481,46 @@ var _ = Describe("Translator", func() {
 
 			Expect(cluster.CircuitBreakers).To(MatchProto(expectedCircuitBreakers))
 		})
+
+		It("should translate retry budgets on upstream", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				MaxRequests: &wrappers.UInt32Value{Value: 3},
+				RetryBudget: &v1.RetryBudget{
+					BudgetPercent:       &wrappers.DoubleValue{Value: 25},
+					MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+				},
+			}
+
+			expectedCircuitBreakers := &envoy_config_cluster_v3.CircuitBreakers{
+				Thresholds: []*envoy_config_cluster_v3.CircuitBreakers_Thresholds{
+					{
+						MaxRequests: &wrappers.UInt32Value{Value: 3},
+						RetryBudget: &envoy_config_cluster_v3.CircuitBreakers_Thresholds_RetryBudget{
+							BudgetPercent:       &envoy_type_v3.Percent{Value: 25},
+							MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+						},
+					},
+				},
+			}
+			translate()
+
+			Expect(cluster.CircuitBreakers).To(MatchProto(expectedCircuitBreakers))
+		})
+
+		It("should error on invalid retry budgets", func() {
+
+			upstream.CircuitBreakers = &v1.CircuitBreakerConfig{
+				RetryBudget: &v1.RetryBudget{
+					BudgetPercent: &wrappers.DoubleValue{Value: 120},
+				},
+			}
+
+			_, errs, _ := translator.Translate(params, proxy)
+			_, usReport := errs.Find("*v1.Upstream", upstream.Metadata.Ref())
+			Expect(usReport.Errors).To(HaveOccurred())
+			Expect(usReport.Errors.Error()).To(ContainSubstring("retry budget percent must be between 0 and 100"))
+		})
 	})
 
 	Context("eds", func() {
@@ -2086,7 +2127,7 @@ var _ = Describe("Translator", func() {
 				},
 			}
 			// These are the "fake" upstreams that represent the above service in the snapshot
-			fakeUsList = kubernetes.KubeServicesToUpstreams(context.TODO(), skkube.ServiceList{svc})
+			fakeUsList = kubernetes.KubeServicesToUpstreams(context.TODO(), kubeplugin.DefaultUpstreamConverter(), skkube.ServiceList{svc})
 			params.Snapshot.Upstreams = append(params.Snapshot.Upstreams, fakeUsList...)
 
 			// We need to manually add some fake endpoints for the above kubernetes services to the snapshot
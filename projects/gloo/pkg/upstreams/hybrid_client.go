@@ -15,8 +15,10 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	"github.com/solo-io/go-utils/errutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	corecache "github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 )
@@ -36,6 +38,7 @@ var (
 func NewHybridUpstreamClient(
 	upstreamClient v1.UpstreamClient,
 	serviceClient skkube.ServiceClient,
+	kubeCoreCache corecache.KubeCoreCache,
 	consulClient consul.ConsulWatcher,
 	settings *v1.Settings) (v1.UpstreamClient, error) {
 
@@ -47,7 +50,9 @@ func NewHybridUpstreamClient(
 	clientMap[sourceGloo] = upstreamClient
 
 	if serviceClient != nil {
-		clientMap[sourceKube] = kubernetes.NewKubernetesUpstreamClient(serviceClient)
+		// the events about the options of the services are recorded by discovery only
+		converter := kubeplugin.NewUpstreamConverter(nil, kubeCoreCache)
+		clientMap[sourceKube] = kubernetes.NewKubernetesUpstreamClient(serviceClient, converter, kubeCoreCache)
 	}
 
 	if consulClient != nil {
@@ -91,6 +91,7 @@ var _ = Describe("Hybrid Upstream Client", func() {
 		hybridClient, err = upstreams.NewHybridUpstreamClient(
 			baseUsClient,
 			svcClient,
+			nil,
 			consul.NewConsulWatcherFromClient(mockInternalConsulClient),
 			nil,
 		)
@@ -29,21 +29,20 @@ func fakeUpstreamName(serviceName, serviceNamespace string, port int32) string {
 }
 
 // Public because it's needed in the translator test
-func KubeServicesToUpstreams(ctx context.Context, services skkube.ServiceList) v1.UpstreamList {
+func KubeServicesToUpstreams(ctx context.Context, converter kubeplugin.UpstreamConverter, services skkube.ServiceList) v1.UpstreamList {
 	var result v1.UpstreamList
 	for _, svc := range services {
-		for _, port := range svc.Spec.Ports {
-			kubeSvc := svc.Service.GetKubeService()
-			result = append(result, serviceToUpstream(ctx, &kubeSvc, port))
+		kubeSvc := svc.Service.GetKubeService()
+		for _, us := range converter.UpstreamsForService(ctx, &kubeSvc) {
+			result = append(result, toServiceDerivedUpstream(&kubeSvc, us))
 		}
 	}
 	return result
 }
 
-func serviceToUpstream(ctx context.Context, svc *kubev1.Service, port kubev1.ServicePort) *gloov1.Upstream {
-	us := kubeplugin.DefaultUpstreamConverter().CreateUpstream(ctx, svc, port)
-
-	us.GetMetadata().Name = fakeUpstreamName(svc.Name, svc.Namespace, port.Port)
+// toServiceDerivedUpstream renames an upstream converted from a service so that it cannot be written to storage
+func toServiceDerivedUpstream(svc *kubev1.Service, us *gloov1.Upstream) *gloov1.Upstream {
+	us.GetMetadata().Name = fakeUpstreamName(svc.Name, svc.Namespace, int32(us.GetKube().GetServicePort()))
 	us.GetMetadata().Namespace = svc.Namespace
 	us.GetMetadata().ResourceVersion = ""
 
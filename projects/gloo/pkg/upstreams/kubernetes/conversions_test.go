@@ -5,6 +5,9 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -39,7 +42,7 @@ var _ = Describe("Conversions", func() {
 				},
 			},
 		}
-		usList := KubeServicesToUpstreams(context.TODO(), skkube.ServiceList{svc})
+		usList := KubeServicesToUpstreams(context.TODO(), kubeplugin.DefaultUpstreamConverter(), skkube.ServiceList{svc})
 		usList.Sort()
 		Expect(usList).To(HaveLen(2))
 		Expect(usList[0].Metadata.Name).To(Equal(upstreamNamePrefix + "ns-1-svc-1-8080"))
@@ -56,4 +59,36 @@ var _ = Describe("Conversions", func() {
 		Expect(usList[1].GetKube().ServiceNamespace).To(Equal("ns-1"))
 		Expect(usList[1].GetKube().ServicePort).To(BeEquivalentTo(8081))
 	})
+
+	It("converts the services with the given upstream converter", func() {
+		svc := skkube.NewService("ns-1", "svc-1")
+		svc.Spec = corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "port-1", Port: 8080},
+				{Name: "port-2", Port: 8081},
+			},
+		}
+		converter := &healthCheckingConverter{UpstreamConverter: kubeplugin.DefaultUpstreamConverter()}
+		usList := KubeServicesToUpstreams(context.TODO(), converter, skkube.ServiceList{svc})
+		usList.Sort()
+		Expect(usList).To(HaveLen(2))
+		for i, port := range []uint32{8080, 8081} {
+			Expect(usList[i].GetMetadata().GetName()).To(Equal(fakeUpstreamName("svc-1", "ns-1", int32(port))))
+			Expect(usList[i].GetKube().GetServicePort()).To(Equal(port))
+			Expect(usList[i].GetHealthChecks()).To(HaveLen(1))
+		}
+	})
 })
+
+// healthCheckingConverter adds a health check to the upstreams, as a converter reading the pods of the services would
+type healthCheckingConverter struct {
+	kubeplugin.UpstreamConverter
+}
+
+func (c *healthCheckingConverter) UpstreamsForService(ctx context.Context, svc *corev1.Service) gloov1.UpstreamList {
+	upstreams := c.UpstreamConverter.UpstreamsForService(ctx, svc)
+	for _, us := range upstreams {
+		us.HealthChecks = append(us.GetHealthChecks(), &envoycore.HealthCheck{})
+	}
+	return upstreams
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 
 	v1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
 	skclients "github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
 )
@@ -17,12 +18,24 @@ const upstreamNamePrefix = "kube-svc:"
 
 const notImplementedErrMsg = "this operation is not supported by this client"
 
-func NewKubernetesUpstreamClient(serviceClient skkube.ServiceClient) v1.UpstreamClient {
-	return &kubernetesUpstreamClient{serviceClient: serviceClient}
+// CacheSubscriber notifies of the changes to the cached kubernetes resources, such as the pods selected by the
+// services. It is implemented by the KubeCoreCache.
+type CacheSubscriber interface {
+	Subscribe() <-chan struct{}
+	Unsubscribe(<-chan struct{})
+}
+
+// NewKubernetesUpstreamClient returns a client of the upstreams derived from the services, which are converted with
+// the same upstream converter as the upstreams discovered from them. The watches convert the services again on the
+// changes notified by the cache, as the converter reads the pods of the services. The cache may be nil.
+func NewKubernetesUpstreamClient(serviceClient skkube.ServiceClient, converter kubeplugin.UpstreamConverter, cache CacheSubscriber) v1.UpstreamClient {
+	return &kubernetesUpstreamClient{serviceClient: serviceClient, converter: converter, cache: cache}
 }
 
 type kubernetesUpstreamClient struct {
 	serviceClient skkube.ServiceClient
+	converter     kubeplugin.UpstreamConverter
+	cache         CacheSubscriber
 }
 
 func (c *kubernetesUpstreamClient) BaseClient() skclients.ResourceClient {
@@ -55,7 +68,7 @@ func (c *kubernetesUpstreamClient) List(namespace string, opts skclients.ListOpt
 	if err != nil {
 		return nil, err
 	}
-	return KubeServicesToUpstreams(opts.Ctx, services), nil
+	return KubeServicesToUpstreams(opts.Ctx, c.converter, services), nil
 }
 
 func (c *kubernetesUpstreamClient) Watch(namespace string, opts skclients.WatchOpts) (<-chan v1.UpstreamList, <-chan error, error) {
@@ -63,28 +76,48 @@ func (c *kubernetesUpstreamClient) Watch(namespace string, opts skclients.WatchO
 	if err != nil {
 		return nil, nil, err
 	}
-	return transform(opts.Ctx, servicesChan), errChan, nil
+	return c.transform(opts.Ctx, servicesChan), errChan, nil
 }
 
-func transform(ctx context.Context, src <-chan skkube.ServiceList) <-chan v1.UpstreamList {
+func (c *kubernetesUpstreamClient) transform(ctx context.Context, src <-chan skkube.ServiceList) <-chan v1.UpstreamList {
 	upstreams := make(chan v1.UpstreamList)
 
+	// a nil channel never receives, if there is no cache
+	var cacheUpdates <-chan struct{}
+	if c.cache != nil {
+		cacheUpdates = c.cache.Subscribe()
+	}
+
 	go func() {
+		if c.cache != nil {
+			defer c.cache.Unsubscribe(cacheUpdates)
+		}
+		var services skkube.ServiceList
+		received := false
 		for {
 			select {
-			case services, ok := <-src:
+			case list, ok := <-src:
 				if !ok {
 					close(upstreams)
 					return
 				}
-				select {
-				case upstreams <- KubeServicesToUpstreams(ctx, services):
-				case <-ctx.Done():
-					return
+				services, received = list, true
+			case _, ok := <-cacheUpdates:
+				if !ok {
+					cacheUpdates = nil
+					continue
+				}
+				if !received {
+					continue
 				}
 			case <-ctx.Done():
 				return
 			}
+			select {
+			case upstreams <- KubeServicesToUpstreams(ctx, c.converter, services):
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 
@@ -0,0 +1,106 @@
+package kubernetes
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	envoycore "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/api/v2/core"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	kubeplugin "github.com/solo-io/gloo/projects/gloo/pkg/plugins/kubernetes"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// fakeCache notifies its subscribers of the changes to the pods
+type fakeCache struct {
+	lock        sync.Mutex
+	subscribers []chan struct{}
+}
+
+func (c *fakeCache) Subscribe() <-chan struct{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	subscriber := make(chan struct{}, 1)
+	c.subscribers = append(c.subscribers, subscriber)
+	return subscriber
+}
+
+func (c *fakeCache) Unsubscribe(<-chan struct{}) {}
+
+func (c *fakeCache) notify() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, subscriber := range c.subscribers {
+		subscriber <- struct{}{}
+	}
+}
+
+// probeConverter adds a health check to the upstreams while the pods of the services have a readiness probe
+type probeConverter struct {
+	kubeplugin.UpstreamConverter
+
+	lock     sync.Mutex
+	hasProbe bool
+}
+
+func (c *probeConverter) setProbe(hasProbe bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.hasProbe = hasProbe
+}
+
+func (c *probeConverter) UpstreamsForService(ctx context.Context, svc *corev1.Service) gloov1.UpstreamList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	upstreams := c.UpstreamConverter.UpstreamsForService(ctx, svc)
+	for _, us := range upstreams {
+		if c.hasProbe {
+			us.HealthChecks = []*envoycore.HealthCheck{{}}
+		}
+	}
+	return upstreams
+}
+
+var _ = Describe("Kubernetes Upstream Client", func() {
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("converts the services again when the pods change", func() {
+		svcClient, err := skkube.NewServiceClient(ctx, &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		svc := skkube.NewService("ns", "svc")
+		svc.Spec.Ports = []corev1.ServicePort{{Name: "http", Port: 8080}}
+		_, err = svcClient.Write(svc, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+
+		cache := &fakeCache{}
+		converter := &probeConverter{UpstreamConverter: kubeplugin.DefaultUpstreamConverter()}
+		usClient := NewKubernetesUpstreamClient(svcClient, converter, cache)
+
+		upstreams, _, err := usClient.Watch("ns", clients.WatchOpts{Ctx: ctx})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(upstreams).Should(Receive(ConsistOf(HaveField("HealthChecks", BeEmpty()))))
+
+		converter.setProbe(true)
+		cache.notify()
+		Eventually(upstreams).Should(Receive(ConsistOf(HaveField("HealthChecks", HaveLen(1)))))
+	})
+})
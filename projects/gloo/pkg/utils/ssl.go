@@ -83,6 +83,11 @@ func (s *sslConfigTranslator) ResolveUpstreamSslConfig(secrets v1.SecretList, uc
 }
 
 func (s *sslConfigTranslator) ResolveDownstreamSslConfig(secrets v1.SecretList, dc *ssl.SslConfig) (*envoyauth.DownstreamTlsContext, error) {
+	if acme := dc.GetAcme(); acme != nil {
+		// the certificate issued by the ACME server is stored in the referenced secret
+		dc = dc.Clone().(*ssl.SslConfig)
+		dc.SslSecrets = &ssl.SslConfig_SecretRef{SecretRef: acme.GetSecretRef()}
+	}
 	common, err := s.ResolveCommonSslConfig(dc, secrets, true)
 	if err != nil {
 		return nil, err
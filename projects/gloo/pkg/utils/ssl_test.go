@@ -247,6 +247,17 @@ var _ = Describe("Ssl", func() {
 			Expect(cfg.RequireClientCertificate.GetValue()).To(BeFalse())
 		})
 
+		It("should resolve the certificate issued by an acme server for downstream config", func() {
+			downstreamCfg.SslSecrets = &ssl.SslConfig_Acme{
+				Acme: &ssl.AcmeConfig{SecretRef: secret.Metadata.Ref()},
+			}
+			cfg, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
+			Expect(err).NotTo(HaveOccurred())
+			ValidateCommonContextInline(cfg.GetCommonTlsContext(), nil)
+			// the ssl config is not modified
+			Expect(downstreamCfg.GetAcme()).NotTo(BeNil())
+		})
+
 		It("should require cert and key for downstream config", func() {
 			downstreamCfg.SslSecrets = nil
 			cfg, err := configTranslator.ResolveDownstreamSslConfig(secrets, downstreamCfg)
@@ -0,0 +1,30 @@
+package networking
+
+import (
+	"reflect"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/kubeutils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type IngressClass networkingv1.IngressClass
+
+func (p *IngressClass) GetMetadata() *core.Metadata {
+	return kubeutils.FromKubeMeta(p.ObjectMeta, true)
+}
+
+func (p *IngressClass) SetMetadata(meta *core.Metadata) {
+	p.ObjectMeta = kubeutils.ToKubeMeta(meta)
+}
+
+func (p *IngressClass) Equal(that interface{}) bool {
+	return reflect.DeepEqual(p, that)
+}
+
+func (p *IngressClass) Clone() *IngressClass {
+	ic := networkingv1.IngressClass(*p)
+	icCopy := ic.DeepCopy()
+	newIc := IngressClass(*icCopy)
+	return &newIc
+}
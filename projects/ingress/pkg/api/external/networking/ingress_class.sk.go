@@ -0,0 +1,159 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"log"
+	"sort"
+
+	github_com_solo_io_gloo_projects_ingress_api_external_networking "github.com/solo-io/gloo/projects/ingress/api/external/networking"
+
+	"github.com/solo-io/go-utils/hashutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// Compile-time assertion
+	_ resources.Resource = new(IngressClass)
+)
+
+func NewIngressClassHashableResource() resources.HashableResource {
+	return new(IngressClass)
+}
+
+func NewIngressClass(namespace, name string) *IngressClass {
+	ingressclass := &IngressClass{}
+	ingressclass.IngressClass.SetMetadata(&core.Metadata{
+		Name:      name,
+		Namespace: namespace,
+	})
+	return ingressclass
+}
+
+// require custom resource to implement Clone() as well as resources.Resource interface
+
+type CloneableIngressClass interface {
+	resources.Resource
+	Clone() *github_com_solo_io_gloo_projects_ingress_api_external_networking.IngressClass
+}
+
+var _ CloneableIngressClass = &github_com_solo_io_gloo_projects_ingress_api_external_networking.IngressClass{}
+
+type IngressClass struct {
+	github_com_solo_io_gloo_projects_ingress_api_external_networking.IngressClass
+}
+
+func (r *IngressClass) Clone() resources.Resource {
+	return &IngressClass{IngressClass: *r.IngressClass.Clone()}
+}
+
+func (r *IngressClass) Hash(hasher hash.Hash64) (uint64, error) {
+	if hasher == nil {
+		hasher = fnv.New64()
+	}
+	clone := r.IngressClass.Clone()
+	resources.UpdateMetadata(clone, func(meta *core.Metadata) {
+		meta.ResourceVersion = ""
+	})
+	err := binary.Write(hasher, binary.LittleEndian, hashutils.HashAll(clone))
+	if err != nil {
+		return 0, err
+	}
+	return hasher.Sum64(), nil
+}
+
+func (r *IngressClass) MustHash() uint64 {
+	hashVal, err := r.Hash(nil)
+	if err != nil {
+		log.Panicf("error while hashing: (%s) this should never happen", err)
+	}
+	return hashVal
+}
+
+func (r *IngressClass) GroupVersionKind() schema.GroupVersionKind {
+	return IngressClassGVK
+}
+
+type IngressClassList []*IngressClass
+
+func (list IngressClassList) Find(namespace, name string) (*IngressClass, error) {
+	for _, ingressClass := range list {
+		if ingressClass.GetMetadata().Name == name && ingressClass.GetMetadata().Namespace == namespace {
+			return ingressClass, nil
+		}
+	}
+	return nil, errors.Errorf("list did not find ingressClass %v.%v", namespace, name)
+}
+
+func (list IngressClassList) AsResources() resources.ResourceList {
+	var ress resources.ResourceList
+	for _, ingressClass := range list {
+		ress = append(ress, ingressClass)
+	}
+	return ress
+}
+
+func (list IngressClassList) Names() []string {
+	var names []string
+	for _, ingressClass := range list {
+		names = append(names, ingressClass.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list IngressClassList) NamespacesDotNames() []string {
+	var names []string
+	for _, ingressClass := range list {
+		names = append(names, ingressClass.GetMetadata().Namespace+"."+ingressClass.GetMetadata().Name)
+	}
+	return names
+}
+
+func (list IngressClassList) Sort() IngressClassList {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].GetMetadata().Less(list[j].GetMetadata())
+	})
+	return list
+}
+
+func (list IngressClassList) Clone() IngressClassList {
+	var ingressClassList IngressClassList
+	for _, ingressClass := range list {
+		ingressClassList = append(ingressClassList, resources.Clone(ingressClass).(*IngressClass))
+	}
+	return ingressClassList
+}
+
+func (list IngressClassList) Each(f func(element *IngressClass)) {
+	for _, ingressClass := range list {
+		f(ingressClass)
+	}
+}
+
+func (list IngressClassList) EachResource(f func(element resources.Resource)) {
+	for _, ingressClass := range list {
+		f(ingressClass)
+	}
+}
+
+func (list IngressClassList) AsInterfaces() []interface{} {
+	var asInterfaces []interface{}
+	list.Each(func(element *IngressClass) {
+		asInterfaces = append(asInterfaces, element)
+	})
+	return asInterfaces
+}
+
+var (
+	IngressClassGVK = schema.GroupVersionKind{
+		Version: "v1",
+		Group:   "networking.k8s.io",
+		Kind:    "IngressClass",
+	}
+)
@@ -0,0 +1,130 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+)
+
+type IngressClassWatcher interface {
+	// watch namespace-scoped ingressclasses
+	Watch(namespace string, opts clients.WatchOpts) (<-chan IngressClassList, <-chan error, error)
+}
+
+type IngressClassClient interface {
+	BaseClient() clients.ResourceClient
+	Register() error
+	Read(namespace, name string, opts clients.ReadOpts) (*IngressClass, error)
+	Write(resource *IngressClass, opts clients.WriteOpts) (*IngressClass, error)
+	Delete(namespace, name string, opts clients.DeleteOpts) error
+	List(namespace string, opts clients.ListOpts) (IngressClassList, error)
+	IngressClassWatcher
+}
+
+type ingressClassClient struct {
+	rc clients.ResourceClient
+}
+
+func NewIngressClassClient(ctx context.Context, rcFactory factory.ResourceClientFactory) (IngressClassClient, error) {
+	return NewIngressClassClientWithToken(ctx, rcFactory, "")
+}
+
+func NewIngressClassClientWithToken(ctx context.Context, rcFactory factory.ResourceClientFactory, token string) (IngressClassClient, error) {
+	rc, err := rcFactory.NewResourceClient(ctx, factory.NewResourceClientParams{
+		ResourceType: &IngressClass{},
+		Token:        token,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating base IngressClass resource client")
+	}
+	return NewIngressClassClientWithBase(rc), nil
+}
+
+func NewIngressClassClientWithBase(rc clients.ResourceClient) IngressClassClient {
+	return &ingressClassClient{
+		rc: rc,
+	}
+}
+
+func (client *ingressClassClient) BaseClient() clients.ResourceClient {
+	return client.rc
+}
+
+func (client *ingressClassClient) Register() error {
+	return client.rc.Register()
+}
+
+func (client *ingressClassClient) Read(namespace, name string, opts clients.ReadOpts) (*IngressClass, error) {
+	opts = opts.WithDefaults()
+
+	resource, err := client.rc.Read(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*IngressClass), nil
+}
+
+func (client *ingressClassClient) Write(ingressClass *IngressClass, opts clients.WriteOpts) (*IngressClass, error) {
+	opts = opts.WithDefaults()
+	resource, err := client.rc.Write(ingressClass, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resource.(*IngressClass), nil
+}
+
+func (client *ingressClassClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	opts = opts.WithDefaults()
+
+	return client.rc.Delete(namespace, name, opts)
+}
+
+func (client *ingressClassClient) List(namespace string, opts clients.ListOpts) (IngressClassList, error) {
+	opts = opts.WithDefaults()
+
+	resourceList, err := client.rc.List(namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertToIngressClass(resourceList), nil
+}
+
+func (client *ingressClassClient) Watch(namespace string, opts clients.WatchOpts) (<-chan IngressClassList, <-chan error, error) {
+	opts = opts.WithDefaults()
+
+	resourcesChan, errs, initErr := client.rc.Watch(namespace, opts)
+	if initErr != nil {
+		return nil, nil, initErr
+	}
+	ingressclassesChan := make(chan IngressClassList)
+	go func() {
+		for {
+			select {
+			case resourceList := <-resourcesChan:
+				select {
+				case ingressclassesChan <- convertToIngressClass(resourceList):
+				case <-opts.Ctx.Done():
+					close(ingressclassesChan)
+					return
+				}
+			case <-opts.Ctx.Done():
+				close(ingressclassesChan)
+				return
+			}
+		}
+	}()
+	return ingressclassesChan, errs, nil
+}
+
+func convertToIngressClass(resources resources.ResourceList) IngressClassList {
+	var ingressClassList IngressClassList
+	for _, resource := range resources {
+		ingressClassList = append(ingressClassList, resource.(*IngressClass))
+	}
+	return ingressClassList
+}
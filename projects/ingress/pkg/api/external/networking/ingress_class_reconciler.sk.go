@@ -0,0 +1,47 @@
+// Code generated by solo-kit. DO NOT EDIT.
+
+package v1
+
+import (
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/reconcile"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+)
+
+// Option to copy anything from the original to the desired before writing. Return value of false means don't update
+type TransitionIngressClassFunc func(original, desired *IngressClass) (bool, error)
+
+type IngressClassReconciler interface {
+	Reconcile(namespace string, desiredResources IngressClassList, transition TransitionIngressClassFunc, opts clients.ListOpts) error
+}
+
+func ingressClasssToResources(list IngressClassList) resources.ResourceList {
+	var resourceList resources.ResourceList
+	for _, ingressClass := range list {
+		resourceList = append(resourceList, ingressClass)
+	}
+	return resourceList
+}
+
+func NewIngressClassReconciler(client IngressClassClient, statusSetter resources.StatusSetter) IngressClassReconciler {
+	return &ingressClassReconciler{
+		base: reconcile.NewReconciler(client.BaseClient(), statusSetter),
+	}
+}
+
+type ingressClassReconciler struct {
+	base reconcile.Reconciler
+}
+
+func (r *ingressClassReconciler) Reconcile(namespace string, desiredResources IngressClassList, transition TransitionIngressClassFunc, opts clients.ListOpts) error {
+	opts = opts.WithDefaults()
+	opts.Ctx = contextutils.WithLogger(opts.Ctx, "ingressClass_reconciler")
+	var transitionResources reconcile.TransitionResourcesFunc
+	if transition != nil {
+		transitionResources = func(original, desired resources.Resource) (bool, error) {
+			return transition(original.(*IngressClass), desired.(*IngressClass))
+		}
+	}
+	return r.base.Reconcile(namespace, ingressClasssToResources(desiredResources), transitionResources, opts)
+}
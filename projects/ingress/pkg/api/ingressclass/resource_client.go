@@ -0,0 +1,164 @@
+package ingressclass
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/solo-io/gloo/projects/ingress/api/external/networking"
+	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
+	"github.com/solo-io/solo-kit/pkg/errors"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubewatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ResourceClient is a read-only client for the IngressClasses of the cluster.
+// IngressClasses are cluster-scoped, so the namespaces passed to the client are ignored.
+type ResourceClient struct {
+	kube kubernetes.Interface
+}
+
+func NewResourceClient(kube kubernetes.Interface) *ResourceClient {
+	return &ResourceClient{
+		kube: kube,
+	}
+}
+
+func FromKube(ic *networkingv1.IngressClass) *v1.IngressClass {
+	deepCopy := ic.DeepCopy()
+	baseType := networking.IngressClass(*deepCopy)
+	resource := &v1.IngressClass{
+		IngressClass: baseType,
+	}
+
+	return resource
+}
+
+func ToKube(resource resources.Resource) (*networkingv1.IngressClass, error) {
+	ingressClassResource, ok := resource.(*v1.IngressClass)
+	if !ok {
+		return nil, errors.Errorf("internal error: invalid resource %v passed to ingressclass client", resources.Kind(resource))
+	}
+
+	ingressClass := networkingv1.IngressClass(ingressClassResource.IngressClass)
+
+	return &ingressClass, nil
+}
+
+var _ clients.ResourceClient = &ResourceClient{}
+
+func (rc *ResourceClient) Kind() string {
+	return resources.Kind(&v1.IngressClass{})
+}
+
+func (rc *ResourceClient) NewResource() resources.Resource {
+	return resources.Clone(&v1.IngressClass{})
+}
+
+func (rc *ResourceClient) Register() error {
+	return nil
+}
+
+func (rc *ResourceClient) Read(namespace, name string, opts clients.ReadOpts) (resources.Resource, error) {
+	contextutils.LoggerFrom(context.Background()).DPanic("this client does not support read operations")
+	return nil, fmt.Errorf("this client does not support read operations")
+}
+
+func (rc *ResourceClient) Write(resource resources.Resource, opts clients.WriteOpts) (resources.Resource, error) {
+	contextutils.LoggerFrom(context.Background()).DPanic("this client does not support write operations")
+	return nil, fmt.Errorf("this client does not support write operations")
+}
+
+func (rc *ResourceClient) Delete(namespace, name string, opts clients.DeleteOpts) error {
+	contextutils.LoggerFrom(context.Background()).DPanic("this client does not support delete operations")
+	return fmt.Errorf("this client does not support delete operations")
+}
+
+func (rc *ResourceClient) ApplyStatus(statusClient resources.StatusClient, inputResource resources.InputResource, opts clients.ApplyStatusOpts) (resources.Resource, error) {
+	contextutils.LoggerFrom(context.Background()).DPanic("this client does not support apply status operations")
+	return nil, fmt.Errorf("this client does not support apply status operations")
+}
+
+func (rc *ResourceClient) List(_ string, opts clients.ListOpts) (resources.ResourceList, error) {
+	opts = opts.WithDefaults()
+
+	ingressClassObjList, err := rc.kube.NetworkingV1().IngressClasses().List(opts.Ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(opts.Selector).String(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing IngressClasses")
+	}
+	var resourceList resources.ResourceList
+	for _, ingressClassObj := range ingressClassObjList.Items {
+		resourceList = append(resourceList, FromKube(&ingressClassObj))
+	}
+
+	sort.SliceStable(resourceList, func(i, j int) bool {
+		return resourceList[i].GetMetadata().GetName() < resourceList[j].GetMetadata().GetName()
+	})
+
+	return resourceList, nil
+}
+
+func (rc *ResourceClient) Watch(_ string, opts clients.WatchOpts) (<-chan resources.ResourceList, <-chan error, error) {
+	opts = opts.WithDefaults()
+	watch, err := rc.kube.NetworkingV1().IngressClasses().Watch(opts.Ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(opts.Selector).String(),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "initiating kube watch of IngressClasses")
+	}
+	resourcesChan := make(chan resources.ResourceList)
+	errs := make(chan error)
+	// prevent flooding the channel with duplicates
+	var previous *resources.ResourceList
+	updateResourceList := func() {
+		list, err := rc.List("", clients.ListOpts{
+			Ctx:      opts.Ctx,
+			Selector: opts.Selector,
+		})
+		if err != nil {
+			errs <- err
+			return
+		}
+		if previous != nil {
+			if list.Equal(*previous) {
+				return
+			}
+		}
+		previous = &list
+		resourcesChan <- list
+	}
+
+	go func() {
+		// watch should open up with an initial read
+		updateResourceList()
+		for {
+			select {
+			case <-time.After(opts.RefreshRate):
+				updateResourceList()
+			case event := <-watch.ResultChan():
+				switch event.Type {
+				case kubewatch.Error:
+					errs <- errors.Errorf("error during watch: %v", event)
+				default:
+					updateResourceList()
+				}
+			case <-opts.Ctx.Done():
+				watch.Stop()
+				close(resourcesChan)
+				close(errs)
+				return
+			}
+		}
+	}()
+
+	return resourcesChan, errs, nil
+}
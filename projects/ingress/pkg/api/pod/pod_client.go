@@ -0,0 +1,29 @@
+package pod
+
+import (
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+)
+
+// ClientWithSelector only lists and watches the pods with the labels of its selector,
+// e.g. the pods of the proxy, rather than all the pods of the watched namespaces
+type ClientWithSelector struct {
+	skkube.PodClient
+	Selector map[string]string
+}
+
+func NewClientWithSelector(podClient skkube.PodClient, selector map[string]string) skkube.PodClient {
+	return &ClientWithSelector{PodClient: podClient, Selector: selector}
+}
+
+func (c *ClientWithSelector) List(namespace string, opts clients.ListOpts) (skkube.PodList, error) {
+	// override selector
+	opts.Selector = c.Selector
+	return c.PodClient.List(namespace, opts)
+}
+
+func (c *ClientWithSelector) Watch(namespace string, opts clients.WatchOpts) (<-chan skkube.PodList, <-chan error, error) {
+	// override selector
+	opts.Selector = c.Selector
+	return c.PodClient.Watch(namespace, opts)
+}
@@ -16,6 +16,12 @@ func NewClientWithSelector(kubeServiceClient v1.KubeServiceClient, selector map[
 	return &ClientWithSelector{KubeServiceClient: kubeServiceClient, Selector: selector}
 }
 
+func (c *ClientWithSelector) List(namespace string, opts clients.ListOpts) (v1.KubeServiceList, error) {
+	// override selector
+	opts.Selector = c.Selector
+	return c.KubeServiceClient.List(namespace, opts)
+}
+
 func (c *ClientWithSelector) Watch(namespace string, opts clients.WatchOpts) (<-chan v1.KubeServiceList, <-chan error, error) {
 	// override selector
 	opts.Selector = c.Selector
@@ -8,6 +8,9 @@ import (
 	"hash/fnv"
 	"log"
 
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/go-utils/hashutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources"
@@ -16,14 +19,18 @@ import (
 )
 
 type StatusSnapshot struct {
-	Services  KubeServiceList
-	Ingresses IngressList
+	Services       KubeServiceList
+	Ingresses      IngressList
+	Ingressclasses github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
+	Pods           github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodList
 }
 
 func (s StatusSnapshot) Clone() StatusSnapshot {
 	return StatusSnapshot{
-		Services:  s.Services.Clone(),
-		Ingresses: s.Ingresses.Clone(),
+		Services:       s.Services.Clone(),
+		Ingresses:      s.Ingresses.Clone(),
+		Ingressclasses: s.Ingressclasses.Clone(),
+		Pods:           s.Pods.Clone(),
 	}
 }
 
@@ -37,6 +44,12 @@ func (s StatusSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashIngresses(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashIngressclasses(hasher); err != nil {
+		return 0, err
+	}
+	if _, err := s.hashPods(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -48,6 +61,14 @@ func (s StatusSnapshot) hashIngresses(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Ingresses.AsInterfaces()...)
 }
 
+func (s StatusSnapshot) hashIngressclasses(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.Ingressclasses.AsInterfaces()...)
+}
+
+func (s StatusSnapshot) hashPods(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.Pods.AsInterfaces()...)
+}
+
 func (s StatusSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -61,6 +82,16 @@ func (s StatusSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("ingresses", IngressesHash))
+	IngressclassesHash, err := s.hashIngressclasses(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("ingressclasses", IngressclassesHash))
+	PodsHash, err := s.hashPods(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("pods", PodsHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -74,6 +105,10 @@ func (s *StatusSnapshot) GetResourcesList(resource resources.Resource) (resource
 		return s.Services.AsResources(), nil
 	case *Ingress:
 		return s.Ingresses.AsResources(), nil
+	case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+		return s.Ingressclasses.AsResources(), nil
+	case *github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.Pod:
+		return s.Pods.AsResources(), nil
 	default:
 		return resources.ResourceList{}, eris.New("did not contain the input resource type returning empty list")
 	}
@@ -100,6 +135,24 @@ func (s *StatusSnapshot) RemoveFromResourceList(resource resources.Resource) err
 			}
 		}
 		return nil
+	case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+
+		for i, res := range s.Ingressclasses {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Ingressclasses = append(s.Ingressclasses[:i], s.Ingressclasses[i+1:]...)
+				break
+			}
+		}
+		return nil
+	case *github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.Pod:
+
+		for i, res := range s.Pods {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Pods = append(s.Pods[:i], s.Pods[i+1:]...)
+				break
+			}
+		}
+		return nil
 	default:
 		return eris.Errorf("did not remove the resource because its type does not exist [%T]", resource)
 	}
@@ -134,15 +187,43 @@ func (s *StatusSnapshot) UpsertToResourceList(resource resources.Resource) error
 		}
 		s.Ingresses.Sort()
 		return nil
+	case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+		updated := false
+		for i, res := range s.Ingressclasses {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Ingressclasses[i] = typed
+				updated = true
+			}
+		}
+		if !updated {
+			s.Ingressclasses = append(s.Ingressclasses, typed)
+		}
+		s.Ingressclasses.Sort()
+		return nil
+	case *github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.Pod:
+		updated := false
+		for i, res := range s.Pods {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Pods[i] = typed
+				updated = true
+			}
+		}
+		if !updated {
+			s.Pods = append(s.Pods, typed)
+		}
+		s.Pods.Sort()
+		return nil
 	default:
 		return eris.Errorf("did not add/replace the resource type because it does not exist %T", resource)
 	}
 }
 
 type StatusSnapshotStringer struct {
-	Version   uint64
-	Services  []string
-	Ingresses []string
+	Version        uint64
+	Services       []string
+	Ingresses      []string
+	Ingressclasses []string
+	Pods           []string
 }
 
 func (ss StatusSnapshotStringer) String() string {
@@ -158,6 +239,16 @@ func (ss StatusSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  Ingressclasses %v\n", len(ss.Ingressclasses))
+	for _, name := range ss.Ingressclasses {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
+	s += fmt.Sprintf("  Pods %v\n", len(ss.Pods))
+	for _, name := range ss.Pods {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -167,13 +258,17 @@ func (s StatusSnapshot) Stringer() StatusSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return StatusSnapshotStringer{
-		Version:   snapshotHash,
-		Services:  s.Services.NamespacesDotNames(),
-		Ingresses: s.Ingresses.NamespacesDotNames(),
+		Version:        snapshotHash,
+		Services:       s.Services.NamespacesDotNames(),
+		Ingresses:      s.Ingresses.NamespacesDotNames(),
+		Ingressclasses: s.Ingressclasses.NamespacesDotNames(),
+		Pods:           s.Pods.NamespacesDotNames(),
 	}
 }
 
 var StatusGvkToHashableResource = map[schema.GroupVersionKind]func() resources.HashableResource{
 	KubeServiceGVK: NewKubeServiceHashableResource,
 	IngressGVK:     NewIngressHashableResource,
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassGVK: github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.NewIngressClassHashableResource,
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodGVK:            github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.NewPodHashableResource,
 }
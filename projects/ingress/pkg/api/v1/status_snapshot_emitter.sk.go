@@ -6,6 +6,9 @@ import (
 	"sync"
 	"time"
 
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
@@ -82,24 +85,30 @@ type StatusEmitter interface {
 	Register() error
 	KubeService() KubeServiceClient
 	Ingress() IngressClient
+	IngressClass() github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient
+	Pod() github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodClient
 }
 
-func NewStatusEmitter(kubeServiceClient KubeServiceClient, ingressClient IngressClient) StatusEmitter {
-	return NewStatusEmitterWithEmit(kubeServiceClient, ingressClient, make(chan struct{}))
+func NewStatusEmitter(kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient, podClient github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodClient) StatusEmitter {
+	return NewStatusEmitterWithEmit(kubeServiceClient, ingressClient, ingressClassClient, podClient, make(chan struct{}))
 }
 
-func NewStatusEmitterWithEmit(kubeServiceClient KubeServiceClient, ingressClient IngressClient, emit <-chan struct{}) StatusEmitter {
+func NewStatusEmitterWithEmit(kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient, podClient github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodClient, emit <-chan struct{}) StatusEmitter {
 	return &statusEmitter{
-		kubeService: kubeServiceClient,
-		ingress:     ingressClient,
-		forceEmit:   emit,
+		kubeService:  kubeServiceClient,
+		ingress:      ingressClient,
+		ingressClass: ingressClassClient,
+		pod:          podClient,
+		forceEmit:    emit,
 	}
 }
 
 type statusEmitter struct {
-	forceEmit   <-chan struct{}
-	kubeService KubeServiceClient
-	ingress     IngressClient
+	forceEmit    <-chan struct{}
+	kubeService  KubeServiceClient
+	ingress      IngressClient
+	ingressClass github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient
+	pod          github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodClient
 }
 
 func (c *statusEmitter) Register() error {
@@ -109,6 +118,12 @@ func (c *statusEmitter) Register() error {
 	if err := c.ingress.Register(); err != nil {
 		return err
 	}
+	if err := c.ingressClass.Register(); err != nil {
+		return err
+	}
+	if err := c.pod.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -120,6 +135,14 @@ func (c *statusEmitter) Ingress() IngressClient {
 	return c.ingress
 }
 
+func (c *statusEmitter) IngressClass() github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient {
+	return c.ingressClass
+}
+
+func (c *statusEmitter) Pod() github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodClient {
+	return c.pod
+}
+
 func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *StatusSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -152,10 +175,28 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 	ingressChan := make(chan ingressListWithNamespace)
 
 	var initialIngressList IngressList
+	/* Create channel for IngressClass */
+	type ingressClassListWithNamespace struct {
+		list      github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
+		namespace string
+	}
+	ingressClassChan := make(chan ingressClassListWithNamespace)
+
+	var initialIngressClassList github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
+	/* Create channel for Pod */
+	type podListWithNamespace struct {
+		list      github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodList
+		namespace string
+	}
+	podChan := make(chan podListWithNamespace)
+
+	var initialPodList github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodList
 
 	currentSnapshot := StatusSnapshot{}
 	servicesByNamespace := make(map[string]KubeServiceList)
 	ingressesByNamespace := make(map[string]IngressList)
+	ingressclassesByNamespace := make(map[string]github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList)
+	podsByNamespace := make(map[string]github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodList)
 
 	for _, namespace := range watchNamespaces {
 		/* Setup namespaced watch for KubeService */
@@ -196,6 +237,44 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, ingressErrs, namespace+"-ingresses")
 		}(namespace)
+		/* Setup namespaced watch for IngressClass */
+		{
+			ingressclasses, err := c.ingressClass.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial IngressClass list")
+			}
+			initialIngressClassList = append(initialIngressClassList, ingressclasses...)
+			ingressclassesByNamespace[namespace] = ingressclasses
+		}
+		ingressClassNamespacesChan, ingressClassErrs, err := c.ingressClass.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting IngressClass watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, ingressClassErrs, namespace+"-ingressclasses")
+		}(namespace)
+		/* Setup namespaced watch for Pod */
+		{
+			pods, err := c.pod.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial Pod list")
+			}
+			initialPodList = append(initialPodList, pods...)
+			podsByNamespace[namespace] = pods
+		}
+		podNamespacesChan, podErrs, err := c.pod.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting Pod watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, podErrs, namespace+"-pods")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -221,6 +300,24 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 						return
 					case ingressChan <- ingressListWithNamespace{list: ingressList, namespace: namespace}:
 					}
+				case ingressClassList, ok := <-ingressClassNamespacesChan:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case ingressClassChan <- ingressClassListWithNamespace{list: ingressClassList, namespace: namespace}:
+					}
+				case podList, ok := <-podNamespacesChan:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case podChan <- podListWithNamespace{list: podList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -229,6 +326,10 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 	currentSnapshot.Services = initialKubeServiceList.Sort()
 	/* Initialize snapshot for Ingresses */
 	currentSnapshot.Ingresses = initialIngressList.Sort()
+	/* Initialize snapshot for Ingressclasses */
+	currentSnapshot.Ingressclasses = initialIngressClassList.Sort()
+	/* Initialize snapshot for Pods */
+	currentSnapshot.Pods = initialPodList.Sort()
 
 	snapshots := make(chan *StatusSnapshot)
 	go func() {
@@ -323,6 +424,50 @@ func (c *statusEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOp
 					ingressList = append(ingressList, ingresses...)
 				}
 				currentSnapshot.Ingresses = ingressList.Sort()
+			case ingressClassNamespacedList, ok := <-ingressClassChan:
+				if !ok {
+					return
+				}
+				record()
+
+				namespace := ingressClassNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"ingress_class",
+					mStatusResourcesIn,
+				)
+
+				// merge lists by namespace
+				ingressclassesByNamespace[namespace] = ingressClassNamespacedList.list
+				var ingressClassList github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
+				for _, ingressclasses := range ingressclassesByNamespace {
+					ingressClassList = append(ingressClassList, ingressclasses...)
+				}
+				currentSnapshot.Ingressclasses = ingressClassList.Sort()
+			case podNamespacedList, ok := <-podChan:
+				if !ok {
+					return
+				}
+				record()
+
+				namespace := podNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"pod",
+					mStatusResourcesIn,
+				)
+
+				// merge lists by namespace
+				podsByNamespace[namespace] = podNamespacedList.list
+				var podList github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.PodList
+				for _, pods := range podsByNamespace {
+					podList = append(podList, pods...)
+				}
+				currentSnapshot.Pods = podList.Sort()
 			}
 		}
 	}()
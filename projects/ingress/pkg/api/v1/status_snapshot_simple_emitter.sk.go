@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"time"
 
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
+	github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+
 	"go.opencensus.io/stats"
 	"go.uber.org/zap"
 
@@ -92,6 +95,10 @@ func (c *statusSimpleEmitter) Snapshots(ctx context.Context) (<-chan *StatusSnap
 						currentSnapshot.Services = append(currentSnapshot.Services, typed)
 					case *Ingress:
 						currentSnapshot.Ingresses = append(currentSnapshot.Ingresses, typed)
+					case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+						currentSnapshot.Ingressclasses = append(currentSnapshot.Ingressclasses, typed)
+					case *github_com_solo_io_solo_kit_pkg_api_v1_resources_common_kubernetes.Pod:
+						currentSnapshot.Pods = append(currentSnapshot.Pods, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("StatusSnapshotEmitter "+
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
 
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/go-utils/hashutils"
@@ -18,16 +19,18 @@ import (
 )
 
 type TranslatorSnapshot struct {
-	Upstreams gloo_solo_io.UpstreamList
-	Services  KubeServiceList
-	Ingresses IngressList
+	Upstreams      gloo_solo_io.UpstreamList
+	Services       KubeServiceList
+	Ingresses      IngressList
+	Ingressclasses github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
 }
 
 func (s TranslatorSnapshot) Clone() TranslatorSnapshot {
 	return TranslatorSnapshot{
-		Upstreams: s.Upstreams.Clone(),
-		Services:  s.Services.Clone(),
-		Ingresses: s.Ingresses.Clone(),
+		Upstreams:      s.Upstreams.Clone(),
+		Services:       s.Services.Clone(),
+		Ingresses:      s.Ingresses.Clone(),
+		Ingressclasses: s.Ingressclasses.Clone(),
 	}
 }
 
@@ -44,6 +47,9 @@ func (s TranslatorSnapshot) Hash(hasher hash.Hash64) (uint64, error) {
 	if _, err := s.hashIngresses(hasher); err != nil {
 		return 0, err
 	}
+	if _, err := s.hashIngressclasses(hasher); err != nil {
+		return 0, err
+	}
 	return hasher.Sum64(), nil
 }
 
@@ -59,6 +65,10 @@ func (s TranslatorSnapshot) hashIngresses(hasher hash.Hash64) (uint64, error) {
 	return hashutils.HashAllSafe(hasher, s.Ingresses.AsInterfaces()...)
 }
 
+func (s TranslatorSnapshot) hashIngressclasses(hasher hash.Hash64) (uint64, error) {
+	return hashutils.HashAllSafe(hasher, s.Ingressclasses.AsInterfaces()...)
+}
+
 func (s TranslatorSnapshot) HashFields() []zap.Field {
 	var fields []zap.Field
 	hasher := fnv.New64()
@@ -77,6 +87,11 @@ func (s TranslatorSnapshot) HashFields() []zap.Field {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	fields = append(fields, zap.Uint64("ingresses", IngressesHash))
+	IngressclassesHash, err := s.hashIngressclasses(hasher)
+	if err != nil {
+		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
+	}
+	fields = append(fields, zap.Uint64("ingressclasses", IngressclassesHash))
 	snapshotHash, err := s.Hash(hasher)
 	if err != nil {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
@@ -92,6 +107,8 @@ func (s *TranslatorSnapshot) GetResourcesList(resource resources.Resource) (reso
 		return s.Services.AsResources(), nil
 	case *Ingress:
 		return s.Ingresses.AsResources(), nil
+	case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+		return s.Ingressclasses.AsResources(), nil
 	default:
 		return resources.ResourceList{}, eris.New("did not contain the input resource type returning empty list")
 	}
@@ -127,6 +144,15 @@ func (s *TranslatorSnapshot) RemoveFromResourceList(resource resources.Resource)
 			}
 		}
 		return nil
+	case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+
+		for i, res := range s.Ingressclasses {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Ingressclasses = append(s.Ingressclasses[:i], s.Ingressclasses[i+1:]...)
+				break
+			}
+		}
+		return nil
 	default:
 		return eris.Errorf("did not remove the resource because its type does not exist [%T]", resource)
 	}
@@ -174,16 +200,30 @@ func (s *TranslatorSnapshot) UpsertToResourceList(resource resources.Resource) e
 		}
 		s.Ingresses.Sort()
 		return nil
+	case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+		updated := false
+		for i, res := range s.Ingressclasses {
+			if refKey == res.GetMetadata().Ref().Key() {
+				s.Ingressclasses[i] = typed
+				updated = true
+			}
+		}
+		if !updated {
+			s.Ingressclasses = append(s.Ingressclasses, typed)
+		}
+		s.Ingressclasses.Sort()
+		return nil
 	default:
 		return eris.Errorf("did not add/replace the resource type because it does not exist %T", resource)
 	}
 }
 
 type TranslatorSnapshotStringer struct {
-	Version   uint64
-	Upstreams []string
-	Services  []string
-	Ingresses []string
+	Version        uint64
+	Upstreams      []string
+	Services       []string
+	Ingresses      []string
+	Ingressclasses []string
 }
 
 func (ss TranslatorSnapshotStringer) String() string {
@@ -204,6 +244,11 @@ func (ss TranslatorSnapshotStringer) String() string {
 		s += fmt.Sprintf("    %v\n", name)
 	}
 
+	s += fmt.Sprintf("  Ingressclasses %v\n", len(ss.Ingressclasses))
+	for _, name := range ss.Ingressclasses {
+		s += fmt.Sprintf("    %v\n", name)
+	}
+
 	return s
 }
 
@@ -213,10 +258,11 @@ func (s TranslatorSnapshot) Stringer() TranslatorSnapshotStringer {
 		log.Println(eris.Wrapf(err, "error hashing, this should never happen"))
 	}
 	return TranslatorSnapshotStringer{
-		Version:   snapshotHash,
-		Upstreams: s.Upstreams.NamespacesDotNames(),
-		Services:  s.Services.NamespacesDotNames(),
-		Ingresses: s.Ingresses.NamespacesDotNames(),
+		Version:        snapshotHash,
+		Upstreams:      s.Upstreams.NamespacesDotNames(),
+		Services:       s.Services.NamespacesDotNames(),
+		Ingresses:      s.Ingresses.NamespacesDotNames(),
+		Ingressclasses: s.Ingressclasses.NamespacesDotNames(),
 	}
 }
 
@@ -224,4 +270,5 @@ var TranslatorGvkToHashableResource = map[schema.GroupVersionKind]func() resourc
 	gloo_solo_io.UpstreamGVK: gloo_solo_io.NewUpstreamHashableResource,
 	KubeServiceGVK:           NewKubeServiceHashableResource,
 	IngressGVK:               NewIngressHashableResource,
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassGVK: github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.NewIngressClassHashableResource,
 }
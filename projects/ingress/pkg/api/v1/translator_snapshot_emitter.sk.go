@@ -7,6 +7,7 @@ import (
 	"time"
 
 	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
 
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
@@ -85,26 +86,29 @@ type TranslatorEmitter interface {
 	Upstream() gloo_solo_io.UpstreamClient
 	KubeService() KubeServiceClient
 	Ingress() IngressClient
+	IngressClass() github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient
 }
 
-func NewTranslatorEmitter(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient) TranslatorEmitter {
-	return NewTranslatorEmitterWithEmit(upstreamClient, kubeServiceClient, ingressClient, make(chan struct{}))
+func NewTranslatorEmitter(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient) TranslatorEmitter {
+	return NewTranslatorEmitterWithEmit(upstreamClient, kubeServiceClient, ingressClient, ingressClassClient, make(chan struct{}))
 }
 
-func NewTranslatorEmitterWithEmit(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, emit <-chan struct{}) TranslatorEmitter {
+func NewTranslatorEmitterWithEmit(upstreamClient gloo_solo_io.UpstreamClient, kubeServiceClient KubeServiceClient, ingressClient IngressClient, ingressClassClient github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient, emit <-chan struct{}) TranslatorEmitter {
 	return &translatorEmitter{
-		upstream:    upstreamClient,
-		kubeService: kubeServiceClient,
-		ingress:     ingressClient,
-		forceEmit:   emit,
+		upstream:     upstreamClient,
+		kubeService:  kubeServiceClient,
+		ingress:      ingressClient,
+		ingressClass: ingressClassClient,
+		forceEmit:    emit,
 	}
 }
 
 type translatorEmitter struct {
-	forceEmit   <-chan struct{}
-	upstream    gloo_solo_io.UpstreamClient
-	kubeService KubeServiceClient
-	ingress     IngressClient
+	forceEmit    <-chan struct{}
+	upstream     gloo_solo_io.UpstreamClient
+	kubeService  KubeServiceClient
+	ingress      IngressClient
+	ingressClass github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient
 }
 
 func (c *translatorEmitter) Register() error {
@@ -117,6 +121,9 @@ func (c *translatorEmitter) Register() error {
 	if err := c.ingress.Register(); err != nil {
 		return err
 	}
+	if err := c.ingressClass.Register(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -132,6 +139,10 @@ func (c *translatorEmitter) Ingress() IngressClient {
 	return c.ingress
 }
 
+func (c *translatorEmitter) IngressClass() github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassClient {
+	return c.ingressClass
+}
+
 func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.WatchOpts) (<-chan *TranslatorSnapshot, <-chan error, error) {
 
 	if len(watchNamespaces) == 0 {
@@ -172,11 +183,20 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 	ingressChan := make(chan ingressListWithNamespace)
 
 	var initialIngressList IngressList
+	/* Create channel for IngressClass */
+	type ingressClassListWithNamespace struct {
+		list      github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
+		namespace string
+	}
+	ingressClassChan := make(chan ingressClassListWithNamespace)
+
+	var initialIngressClassList github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
 
 	currentSnapshot := TranslatorSnapshot{}
 	upstreamsByNamespace := make(map[string]gloo_solo_io.UpstreamList)
 	servicesByNamespace := make(map[string]KubeServiceList)
 	ingressesByNamespace := make(map[string]IngressList)
+	ingressclassesByNamespace := make(map[string]github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList)
 
 	for _, namespace := range watchNamespaces {
 		/* Setup namespaced watch for Upstream */
@@ -236,6 +256,25 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 			defer done.Done()
 			errutils.AggregateErrs(ctx, errs, ingressErrs, namespace+"-ingresses")
 		}(namespace)
+		/* Setup namespaced watch for IngressClass */
+		{
+			ingressclasses, err := c.ingressClass.List(namespace, clients.ListOpts{Ctx: opts.Ctx, Selector: opts.Selector})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "initial IngressClass list")
+			}
+			initialIngressClassList = append(initialIngressClassList, ingressclasses...)
+			ingressclassesByNamespace[namespace] = ingressclasses
+		}
+		ingressClassNamespacesChan, ingressClassErrs, err := c.ingressClass.Watch(namespace, opts)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "starting IngressClass watch")
+		}
+
+		done.Add(1)
+		go func(namespace string) {
+			defer done.Done()
+			errutils.AggregateErrs(ctx, errs, ingressClassErrs, namespace+"-ingressclasses")
+		}(namespace)
 
 		/* Watch for changes and update snapshot */
 		go func(namespace string) {
@@ -270,6 +309,15 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 						return
 					case ingressChan <- ingressListWithNamespace{list: ingressList, namespace: namespace}:
 					}
+				case ingressClassList, ok := <-ingressClassNamespacesChan:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case ingressClassChan <- ingressClassListWithNamespace{list: ingressClassList, namespace: namespace}:
+					}
 				}
 			}
 		}(namespace)
@@ -280,6 +328,8 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 	currentSnapshot.Services = initialKubeServiceList.Sort()
 	/* Initialize snapshot for Ingresses */
 	currentSnapshot.Ingresses = initialIngressList.Sort()
+	/* Initialize snapshot for Ingressclasses */
+	currentSnapshot.Ingressclasses = initialIngressClassList.Sort()
 
 	snapshots := make(chan *TranslatorSnapshot)
 	go func() {
@@ -396,6 +446,28 @@ func (c *translatorEmitter) Snapshots(watchNamespaces []string, opts clients.Wat
 					ingressList = append(ingressList, ingresses...)
 				}
 				currentSnapshot.Ingresses = ingressList.Sort()
+			case ingressClassNamespacedList, ok := <-ingressClassChan:
+				if !ok {
+					return
+				}
+				record()
+
+				namespace := ingressClassNamespacedList.namespace
+
+				skstats.IncrementResourceCount(
+					ctx,
+					namespace,
+					"ingress_class",
+					mTranslatorResourcesIn,
+				)
+
+				// merge lists by namespace
+				ingressclassesByNamespace[namespace] = ingressClassNamespacedList.list
+				var ingressClassList github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClassList
+				for _, ingressclasses := range ingressclassesByNamespace {
+					ingressClassList = append(ingressClassList, ingressclasses...)
+				}
+				currentSnapshot.Ingressclasses = ingressClassList.Sort()
 			}
 		}
 	}()
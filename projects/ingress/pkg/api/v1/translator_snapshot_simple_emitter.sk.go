@@ -8,6 +8,7 @@ import (
 	"time"
 
 	gloo_solo_io "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
 
 	"go.opencensus.io/stats"
 	"go.uber.org/zap"
@@ -96,6 +97,8 @@ func (c *translatorSimpleEmitter) Snapshots(ctx context.Context) (<-chan *Transl
 						currentSnapshot.Services = append(currentSnapshot.Services, typed)
 					case *Ingress:
 						currentSnapshot.Ingresses = append(currentSnapshot.Ingresses, typed)
+					case *github_com_solo_io_gloo_projects_ingress_pkg_api_external_networking.IngressClass:
+						currentSnapshot.Ingressclasses = append(currentSnapshot.Ingressclasses, typed)
 					default:
 						select {
 						case errs <- fmt.Errorf("TranslatorSnapshotEmitter "+
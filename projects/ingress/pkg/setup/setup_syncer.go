@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/solo-io/gloo/pkg/bootstrap/leaderelector"
 
@@ -19,7 +20,10 @@ import (
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	bootstrap "github.com/solo-io/gloo/projects/gloo/pkg/bootstrap/clients"
 	gloodefaults "github.com/solo-io/gloo/projects/gloo/pkg/defaults"
+	networkingv1 "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/pod"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/ingress/pkg/status"
@@ -31,6 +35,7 @@ import (
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/errutils"
 	"github.com/solo-io/k8s-utils/kubeutils"
+	skpod "github.com/solo-io/solo-kit/pkg/api/external/kubernetes/pod"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
@@ -198,7 +203,10 @@ func RunIngress(opts Opts) error {
 		baseKubeServiceClient := service.NewResourceClient(kube, &v1.KubeService{})
 		kubeServiceClient := v1.NewKubeServiceClientWithBase(baseKubeServiceClient)
 
-		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient)
+		baseIngressClassClient := ingressclass.NewResourceClient(kube)
+		ingressClassClient := networkingv1.NewIngressClassClientWithBase(baseIngressClassClient)
+
+		translatorEmitter := v1.NewTranslatorEmitter(upstreamClient, kubeServiceClient, ingressClient, ingressClassClient)
 		statusClient := statusutils.GetStatusClientForNamespace(opts.StatusReporterNamespace)
 		translatorSync := translator.NewSyncer(
 			opts.WriteNamespace,
@@ -216,12 +224,21 @@ func RunIngress(opts Opts) error {
 		go errutils.AggregateErrs(opts.WatchOpts.Ctx, writeErrs, translatorEventLoopErrs, "ingress_translator_event_loop")
 
 		// note (ilackarms): we must set the selector correctly here or the status syncer will not work
-		// the selector should return exactly 1 service which is our <install-namespace>.ingress-proxy service
+		// the selector should return the services of the proxies, e.g. our <install-namespace>.ingress-proxy service
 		ingressServiceClient := service.NewClientWithSelector(kubeServiceClient, map[string]string{
 			"gloo": opts.IngressProxyLabel,
 		})
-		statusEmitter := v1.NewStatusEmitter(ingressServiceClient, ingressClient)
-		statusSync := status.NewSyncer(ingressClient)
+		// the pods of the proxies, which publish the addresses of their nodes for the NodePort services or if they use
+		// the network of their nodes
+		kubeCoreCache, err := cache.NewKubeCoreCacheWithOptions(opts.WatchOpts.Ctx, kube, 12*time.Hour, opts.WatchNamespaces)
+		if err != nil {
+			return errors.Wrapf(err, "creating kube core cache")
+		}
+		ingressPodClient := pod.NewClientWithSelector(skpod.NewPodClient(kube, kubeCoreCache), map[string]string{
+			"gloo": opts.IngressProxyLabel,
+		})
+		statusEmitter := v1.NewStatusEmitter(ingressServiceClient, ingressClient, ingressClassClient, ingressPodClient)
+		statusSync := status.NewSyncer(ingressClient, opts.RequireIngressClass, opts.CustomIngressClass)
 		statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 		statusEventLoopErrs, err := statusEventLoop.Run(opts.WatchNamespaces, opts.WatchOpts)
 		if err != nil {
@@ -15,21 +15,33 @@ import (
 	errors "github.com/rotisserie/eris"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
+	"github.com/solo-io/gloo/projects/ingress/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/external/kubernetes/pod"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	kubev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
 )
 
+// the annotation of the services of the proxies which serve a single ingress class, e.g. when there is a proxy
+// per ingress class. Their addresses are only published to the ingresses of that class, while the addresses
+// of the services without the annotation are published to all the ingresses processed by gloo
+const IngressClassAnnotation = "ingress.solo.io/ingress-class"
+
 type statusSyncer struct {
-	ingressClient v1.IngressClient
+	ingressClient       v1.IngressClient
+	requireIngressClass bool
+	ingressClass        string
 }
 
-func NewSyncer(ingressClient v1.IngressClient) v1.StatusSyncer {
+func NewSyncer(ingressClient v1.IngressClient, requireIngressClass bool, customIngressClass string) v1.StatusSyncer {
 	return &statusSyncer{
-		ingressClient: ingressClient,
+		ingressClient:       ingressClient,
+		requireIngressClass: requireIngressClass,
+		ingressClass:        customIngressClass,
 	}
 }
 
@@ -38,10 +50,9 @@ func (s *statusSyncer) Sync(ctx context.Context, snap *v1.StatusSnapshot) error
 	ctx = contextutils.WithLogger(ctx, "statusSyncer")
 	snapHash := hashutils.MustHash(snap)
 	logger := contextutils.LoggerFrom(ctx)
-	logger.Infof("begin sync %v (%v ingresses, %v services)", snapHash,
-		len(snap.Ingresses), len(snap.Services))
+	logger.Infof("begin sync %v (%v ingresses, %v services, %v pods)", snapHash,
+		len(snap.Ingresses), len(snap.Services), len(snap.Pods))
 	defer logger.Infof("end sync %v", snapHash)
-	services := snap.Services
 
 	// stringifying the snapshot may be an expensive operation, so we'd like to avoid building the large
 	// string if we're not even going to log it anyway
@@ -49,17 +60,32 @@ func (s *statusSyncer) Sync(ctx context.Context, snap *v1.StatusSnapshot) error
 		logger.Debug(syncutil.StringifySnapshot(snap))
 	}
 
-	lbStatus, err := getLbStatus(services)
+	var pods []*kubev1.Pod
+	for _, p := range snap.Pods {
+		kubePod, err := pod.ToKubePod(p)
+		if err != nil {
+			return errors.Wrapf(err, "internal error: converting proto pod to kube pod")
+		}
+		pods = append(pods, kubePod)
+	}
+
+	lbStatus, err := getLbStatus(s.proxyServices(snap.Services), pods)
 	if err != nil {
 		return err
 	}
 
+	ingressClasses := translator.IngressClassesByName(ctx, snap.Ingressclasses)
 	for _, ing := range snap.Ingresses {
 		kubeIngress, err := ingress.ToKube(ing)
 		if err != nil {
 			return errors.Wrapf(err, "internal error: converting proto ingress to kube ingress")
 		}
-		kubeIngress.Status.LoadBalancer.Ingress = lbStatus
+		if translator.IsOurIngress(kubeIngress, s.requireIngressClass, s.ingressClass, ingressClasses) {
+			kubeIngress.Status.LoadBalancer.Ingress = lbStatus
+		} else {
+			// the ingress may have been processed by gloo before, in which case its status has our addresses
+			kubeIngress.Status.LoadBalancer.Ingress = withoutAddrs(kubeIngress.Status.LoadBalancer.Ingress, lbStatus)
+		}
 
 		updatedIngress, err := ingress.FromKube(kubeIngress)
 		if err != nil {
@@ -72,40 +98,48 @@ func (s *statusSyncer) Sync(ctx context.Context, snap *v1.StatusSnapshot) error
 		if _, err := s.ingressClient.Write(updatedIngress, clients.WriteOpts{Ctx: ctx, OverwriteExisting: true}); err != nil {
 			return errors.Wrapf(err, "writing updated status to kubernetes")
 		}
-		logger.Infof("updated ingress %v with status %v", ing.GetMetadata().Ref(), lbStatus)
+		logger.Infof("updated ingress %v with status %v", ing.GetMetadata().Ref(), kubeIngress.Status.LoadBalancer.Ingress)
 	}
 
 	return nil
 }
 
-func getLbStatus(services v1.KubeServiceList) ([]networkv1.IngressLoadBalancerIngress, error) {
-	switch len(services) {
-	case 0:
-		return nil, nil
-	case 1:
-		kubeSvc, err := service.ToKube(services[0])
+// proxyServices returns the services of the proxies which serve the ingress class of gloo
+func (s *statusSyncer) proxyServices(services v1.KubeServiceList) v1.KubeServiceList {
+	ingressClass := s.ingressClass
+	if ingressClass == "" {
+		ingressClass = translator.DefaultIngressClass
+	}
+	var proxyServices v1.KubeServiceList
+	for _, svc := range services {
+		if class, ok := svc.GetMetadata().GetAnnotations()[IngressClassAnnotation]; ok && class != ingressClass {
+			continue
+		}
+		proxyServices = append(proxyServices, svc)
+	}
+	return proxyServices
+}
+
+func getLbStatus(services v1.KubeServiceList, pods []*kubev1.Pod) ([]networkv1.IngressLoadBalancerIngress, error) {
+	var addrs []string
+	for _, svc := range services {
+		kubeSvc, err := service.ToKube(svc)
 		if err != nil {
 			return nil, errors.Wrapf(err, "internal error: converting proto svc to kube service")
 		}
 
-		kubeSvcRef := services[0].GetMetadata().Ref()
-		kubeSvcAddrs, err := serviceAddrs(kubeSvc, kubeSvcRef)
+		kubeSvcRef := svc.GetMetadata().Ref()
+		kubeSvcAddrs, err := serviceAddrs(kubeSvc, kubeSvcRef, pods)
 		if err != nil {
 			return nil, errors.Wrapf(err, "internal err: extracting service addrs from kube service")
 		}
-
-		return ingressStatusFromAddrs(kubeSvcAddrs), nil
+		addrs = append(addrs, kubeSvcAddrs...)
 	}
-	return nil, errors.Errorf("failed to get lb status: expected 1 ingress service, found %v", func() []*core.ResourceRef {
-		var refs []*core.ResourceRef
-		for _, svc := range services {
-			refs = append(refs, svc.GetMetadata().Ref())
-		}
-		return refs
-	}())
+
+	return ingressStatusFromAddrs(addrs), nil
 }
 
-func serviceAddrs(svc *kubev1.Service, kubeSvcRef *core.ResourceRef) ([]string, error) {
+func serviceAddrs(svc *kubev1.Service, kubeSvcRef *core.ResourceRef, pods []*kubev1.Pod) ([]string, error) {
 	if svc.Spec.Type == kubev1.ServiceTypeExternalName {
 
 		// Remove the possibility of using localhost in ExternalNames as endpoints
@@ -126,12 +160,44 @@ func serviceAddrs(svc *kubev1.Service, kubeSvcRef *core.ResourceRef) ([]string,
 	}
 	addrs = append(addrs, svc.Spec.ExternalIPs...)
 
+	if len(addrs) == 0 {
+		addrs = nodeAddrs(svc, pods)
+	}
+
 	return addrs, nil
 }
 
+// nodeAddrs returns the addresses of the nodes of the running pods of the service, which serve the service on
+// the addresses of their nodes for the NodePort services, or if they use the network of their nodes
+func nodeAddrs(svc *kubev1.Service, pods []*kubev1.Pod) []string {
+	if len(svc.Spec.Selector) == 0 {
+		return nil
+	}
+	selector := labels.SelectorFromSet(svc.Spec.Selector)
+	var addrs []string
+	for _, p := range pods {
+		if p.Namespace != svc.Namespace || !selector.Matches(labels.Set(p.Labels)) {
+			continue
+		}
+		if p.Status.Phase != kubev1.PodRunning || p.Status.HostIP == "" {
+			continue
+		}
+		if svc.Spec.Type == kubev1.ServiceTypeNodePort || p.Spec.HostNetwork {
+			addrs = append(addrs, p.Status.HostIP)
+		}
+	}
+	return addrs
+}
+
 func ingressStatusFromAddrs(addrs []string) []networkv1.IngressLoadBalancerIngress {
 	var lbi []networkv1.IngressLoadBalancerIngress
+	published := make(map[string]bool)
 	for _, ep := range addrs {
+		// the proxies may share their addresses, e.g. the nodes of their pods
+		if published[ep] {
+			continue
+		}
+		published[ep] = true
 		if net.ParseIP(ep) == nil {
 			lbi = append(lbi, networkv1.IngressLoadBalancerIngress{Hostname: ep})
 		} else {
@@ -140,8 +206,29 @@ func ingressStatusFromAddrs(addrs []string) []networkv1.IngressLoadBalancerIngre
 	}
 
 	sort.SliceStable(lbi, func(a, b int) bool {
-		return lbi[a].IP < lbi[b].IP
+		if lbi[a].IP != lbi[b].IP {
+			return lbi[a].IP < lbi[b].IP
+		}
+		return lbi[a].Hostname < lbi[b].Hostname
 	})
 
 	return lbi
 }
+
+// withoutAddrs returns the status of an ingress without the given addresses
+func withoutAddrs(lbi, addrs []networkv1.IngressLoadBalancerIngress) []networkv1.IngressLoadBalancerIngress {
+	var remaining []networkv1.IngressLoadBalancerIngress
+	for _, ingress := range lbi {
+		published := false
+		for _, addr := range addrs {
+			if ingress.IP == addr.IP && ingress.Hostname == addr.Hostname {
+				published = true
+				break
+			}
+		}
+		if !published {
+			remaining = append(remaining, ingress)
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,159 @@
+package status_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
+	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/ingress/pkg/status"
+	"github.com/solo-io/gloo/projects/ingress/pkg/translator"
+	"github.com/solo-io/solo-kit/pkg/api/external/kubernetes/pod"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/factory"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/memory"
+	skkube "github.com/solo-io/solo-kit/pkg/api/v1/resources/common/kubernetes"
+	kubev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("StatusSyncer", func() {
+
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		ingressClient v1.IngressClient
+		namespace     = "ns"
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		var err error
+		ingressClient, err = v1.NewIngressClient(ctx, &factory.MemoryResourceClientFactory{
+			Cache: memory.NewInMemoryResourceCache(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	writeIngress := func(name, ingressClass string, lbi ...networkingv1.IngressLoadBalancerIngress) *v1.Ingress {
+		ing, err := ingress.FromKube(&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: map[string]string{translator.IngressClassKey: ingressClass},
+			},
+			Status: networkingv1.IngressStatus{
+				LoadBalancer: networkingv1.IngressLoadBalancerStatus{Ingress: lbi},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		written, err := ingressClient.Write(ing, clients.WriteOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		return written
+	}
+
+	readStatus := func(name string) []networkingv1.IngressLoadBalancerIngress {
+		ing, err := ingressClient.Read(namespace, name, clients.ReadOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		kubeIngress, err := ingress.ToKube(ing)
+		Expect(err).NotTo(HaveOccurred())
+		return kubeIngress.Status.LoadBalancer.Ingress
+	}
+
+	makeService := func(name string, annotations map[string]string, spec kubev1.ServiceSpec, lbi ...kubev1.LoadBalancerIngress) *v1.KubeService {
+		svc, err := service.FromKube(&kubev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Annotations: annotations},
+			Spec:       spec,
+			Status: kubev1.ServiceStatus{
+				LoadBalancer: kubev1.LoadBalancerStatus{Ingress: lbi},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return svc
+	}
+
+	makePod := func(name, hostIP string, hostNetwork bool, phase kubev1.PodPhase) *skkube.Pod {
+		return pod.FromKubePod(&kubev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"gloo": "ingress-proxy"}},
+			Spec:       kubev1.PodSpec{HostNetwork: hostNetwork},
+			Status:     kubev1.PodStatus{Phase: phase, HostIP: hostIP},
+		})
+	}
+
+	It("publishes the addresses of all the services of the proxies of the ingress class", func() {
+		ing := writeIngress("ing", "gloo")
+		err := status.NewSyncer(ingressClient, true, "").Sync(ctx, &v1.StatusSnapshot{
+			Ingresses: v1.IngressList{ing},
+			Services: v1.KubeServiceList{
+				makeService("external", nil, kubev1.ServiceSpec{Type: kubev1.ServiceTypeLoadBalancer},
+					kubev1.LoadBalancerIngress{Hostname: "proxy.example.com"},
+					kubev1.LoadBalancerIngress{IP: "1.2.3.4"},
+				),
+				makeService("internal", map[string]string{status.IngressClassAnnotation: "gloo"},
+					kubev1.ServiceSpec{Type: kubev1.ServiceTypeClusterIP, ExternalIPs: []string{"10.0.0.1", "1.2.3.4"}},
+				),
+				makeService("other-class", map[string]string{status.IngressClassAnnotation: "other"},
+					kubev1.ServiceSpec{Type: kubev1.ServiceTypeLoadBalancer},
+					kubev1.LoadBalancerIngress{IP: "5.6.7.8"},
+				),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(readStatus("ing")).To(Equal([]networkingv1.IngressLoadBalancerIngress{
+			{Hostname: "proxy.example.com"},
+			{IP: "1.2.3.4"},
+			{IP: "10.0.0.1"},
+		}))
+	})
+
+	It("publishes the addresses of the nodes of the proxies exposed on their nodes", func() {
+		ing := writeIngress("ing", "gloo")
+		selector := map[string]string{"gloo": "ingress-proxy"}
+		err := status.NewSyncer(ingressClient, false, "").Sync(ctx, &v1.StatusSnapshot{
+			Ingresses: v1.IngressList{ing},
+			Services: v1.KubeServiceList{
+				makeService("node-port", nil, kubev1.ServiceSpec{Type: kubev1.ServiceTypeNodePort, Selector: selector}),
+				makeService("host-network", nil, kubev1.ServiceSpec{Type: kubev1.ServiceTypeClusterIP, Selector: selector}),
+			},
+			Pods: skkube.PodList{
+				makePod("running", "192.168.0.1", false, kubev1.PodRunning),
+				makePod("host-network", "192.168.0.2", true, kubev1.PodRunning),
+				makePod("pending", "192.168.0.3", false, kubev1.PodPending),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(readStatus("ing")).To(Equal([]networkingv1.IngressLoadBalancerIngress{
+			{IP: "192.168.0.1"},
+			{IP: "192.168.0.2"},
+		}))
+	})
+
+	It("removes the addresses of the proxies from the ingresses of other classes", func() {
+		ours := writeIngress("ours", "gloo")
+		theirs := writeIngress("theirs", "nginx",
+			networkingv1.IngressLoadBalancerIngress{IP: "1.2.3.4"},
+			networkingv1.IngressLoadBalancerIngress{IP: "5.6.7.8"},
+		)
+		err := status.NewSyncer(ingressClient, true, "").Sync(ctx, &v1.StatusSnapshot{
+			Ingresses: v1.IngressList{ours, theirs},
+			Services: v1.KubeServiceList{
+				makeService("proxy", nil, kubev1.ServiceSpec{Type: kubev1.ServiceTypeLoadBalancer},
+					kubev1.LoadBalancerIngress{IP: "1.2.3.4"},
+				),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(readStatus("ours")).To(Equal([]networkingv1.IngressLoadBalancerIngress{{IP: "1.2.3.4"}}))
+		Expect(readStatus("theirs")).To(Equal([]networkingv1.IngressLoadBalancerIngress{{IP: "5.6.7.8"}}))
+	})
+})
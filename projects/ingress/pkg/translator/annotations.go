@@ -0,0 +1,174 @@
+package translator
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	errors "github.com/rotisserie/eris"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// the annotations of the ingresses which are translated to the options of their routes
+const (
+	annotationPrefix = "ingress.solo.io/"
+
+	// replaces the path of the ingress in the requests, e.g. "/" for the path "/api" forwards "/api/pets" as "/pets".
+	// for the paths of the ImplementationSpecific type, which are regexes, the target may refer to their capture
+	// groups, e.g. "/\1" for the path "/api/(.*)"
+	RewriteTargetAnnotation = annotationPrefix + "rewrite-target"
+	// the timeout of the requests, as a duration, e.g. "30s"
+	TimeoutAnnotation = annotationPrefix + "timeout"
+	// the idle timeout of the requests, as a duration, e.g. "5m"
+	IdleTimeoutAnnotation = annotationPrefix + "idle-timeout"
+	// enables the CORS policy, which is configured by the annotations below, if set to "true"
+	EnableCorsAnnotation = annotationPrefix + "enable-cors"
+	// the comma-separated origins which are allowed, defaults to "*"
+	CorsAllowOriginAnnotation = annotationPrefix + "cors-allow-origin"
+	// the comma-separated methods which are allowed
+	CorsAllowMethodsAnnotation = annotationPrefix + "cors-allow-methods"
+	// the comma-separated headers which are allowed
+	CorsAllowHeadersAnnotation = annotationPrefix + "cors-allow-headers"
+	// the comma-separated headers which are exposed
+	CorsExposeHeadersAnnotation = annotationPrefix + "cors-expose-headers"
+	// how long the results of the preflight requests can be cached, in seconds
+	CorsMaxAgeAnnotation = annotationPrefix + "cors-max-age"
+	// whether the requests can include credentials, "true" or "false"
+	CorsAllowCredentialsAnnotation = annotationPrefix + "cors-allow-credentials"
+	// redirects the http requests for the hosts of the tls section of the ingress to https if set to "true"
+	SslRedirectAnnotation = annotationPrefix + "ssl-redirect"
+)
+
+type ingressAnnotations struct {
+	rewriteTarget *string
+	timeout       *duration.Duration
+	idleTimeout   *duration.Duration
+	cors          *cors.CorsPolicy
+	sslRedirect   bool
+}
+
+// parseAnnotations parses the annotations of the ingress which are translated to the options of its routes
+func parseAnnotations(annotations map[string]string) (*ingressAnnotations, error) {
+	var (
+		parsed = &ingressAnnotations{}
+		err    error
+	)
+	if target, ok := annotations[RewriteTargetAnnotation]; ok {
+		if !strings.HasPrefix(target, "/") {
+			return nil, errors.Errorf("invalid %v annotation %q: the target must start with /", RewriteTargetAnnotation, target)
+		}
+		parsed.rewriteTarget = &target
+	}
+	if parsed.timeout, err = parseDuration(annotations, TimeoutAnnotation); err != nil {
+		return nil, err
+	}
+	if parsed.idleTimeout, err = parseDuration(annotations, IdleTimeoutAnnotation); err != nil {
+		return nil, err
+	}
+	if parsed.sslRedirect, err = parseBool(annotations, SslRedirectAnnotation); err != nil {
+		return nil, err
+	}
+
+	enableCors, err := parseBool(annotations, EnableCorsAnnotation)
+	if err != nil || !enableCors {
+		return parsed, err
+	}
+	parsed.cors = &cors.CorsPolicy{
+		AllowMethods:  splitList(annotations[CorsAllowMethodsAnnotation]),
+		AllowHeaders:  splitList(annotations[CorsAllowHeadersAnnotation]),
+		ExposeHeaders: splitList(annotations[CorsExposeHeadersAnnotation]),
+		MaxAge:        annotations[CorsMaxAgeAnnotation],
+	}
+	origins := splitList(annotations[CorsAllowOriginAnnotation])
+	if len(origins) == 0 {
+		origins = []string{"*"}
+	}
+	for _, origin := range origins {
+		if origin == "*" {
+			parsed.cors.AllowOriginRegex = append(parsed.cors.GetAllowOriginRegex(), ".*")
+		} else {
+			parsed.cors.AllowOrigin = append(parsed.cors.GetAllowOrigin(), origin)
+		}
+	}
+	if maxAge := parsed.cors.GetMaxAge(); maxAge != "" {
+		if _, err := strconv.ParseUint(maxAge, 10, 32); err != nil {
+			return nil, errors.Wrapf(err, "invalid %v annotation %q", CorsMaxAgeAnnotation, maxAge)
+		}
+	}
+	if parsed.cors.AllowCredentials, err = parseBool(annotations, CorsAllowCredentialsAnnotation); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// routeOptions returns the options of the route of the path, or nil if the annotations set none
+func (a *ingressAnnotations) routeOptions(path networkingv1.HTTPIngressPath) *gloov1.RouteOptions {
+	if a.rewriteTarget == nil && a.timeout == nil && a.idleTimeout == nil && a.cors == nil {
+		return nil
+	}
+	options := &gloov1.RouteOptions{
+		Timeout:     a.timeout,
+		IdleTimeout: a.idleTimeout,
+		Cors:        a.cors,
+	}
+	if a.rewriteTarget != nil {
+		target := *a.rewriteTarget
+		switch pathType(path) {
+		case networkingv1.PathTypeExact:
+			options.PrefixRewrite = &wrappers.StringValue{Value: target}
+		case networkingv1.PathTypePrefix:
+			// the path matches the whole elements of the request paths, so it is replaced along with its trailing slash
+			options.RegexRewrite = &v3.RegexMatchAndSubstitute{
+				Pattern:      &v3.RegexMatcher{Regex: "^" + regexp.QuoteMeta(strings.TrimSuffix(path.Path, "/")) + "/?"},
+				Substitution: strings.TrimSuffix(target, "/") + "/",
+			}
+		default:
+			options.RegexRewrite = &v3.RegexMatchAndSubstitute{
+				Pattern:      &v3.RegexMatcher{Regex: "^(?:" + pathRegex(path) + ")$"},
+				Substitution: target,
+			}
+		}
+	}
+	return options
+}
+
+func parseDuration(annotations map[string]string, key string) (*duration.Duration, error) {
+	value, ok := annotations[key]
+	if !ok {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %v annotation %q", key, value)
+	}
+	return prototime.DurationToProto(d), nil
+}
+
+func parseBool(annotations map[string]string, key string) (bool, error) {
+	value, ok := annotations[key]
+	if !ok {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid %v annotation %q", key, value)
+	}
+	return b, nil
+}
+
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
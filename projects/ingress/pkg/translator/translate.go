@@ -2,7 +2,9 @@ package translator
 
 import (
 	"context"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	"github.com/solo-io/go-utils/contextutils"
@@ -14,21 +16,26 @@ import (
 	errors "github.com/rotisserie/eris"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	glooutils "github.com/solo-io/gloo/projects/gloo/pkg/utils"
+	networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/go-utils/log"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
 	networkingv1 "k8s.io/api/networking/v1"
 )
 
-const defaultIngressClass = "gloo"
+const DefaultIngressClass = "gloo"
 
 const IngressClassKey = "kubernetes.io/ingress.class"
 
+// the controller of the IngressClasses of gloo
+const IngressClassController = "solo.io/gloo-ingress"
+
 func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSnapshot, requireIngressClass bool, ingressClass string) *gloov1.Proxy {
 
 	if ingressClass == "" {
-		ingressClass = defaultIngressClass
+		ingressClass = DefaultIngressClass
 	}
 
 	var ingresses []*networkingv1.Ingress
@@ -53,7 +60,9 @@ func translateProxy(ctx context.Context, namespace string, snap *v1.TranslatorSn
 
 	upstreams := snap.Upstreams
 
-	virtualHostsHttp, secureVirtualHosts := virtualHosts(ctx, ingresses, upstreams, services, requireIngressClass, ingressClass)
+	ingressClasses := IngressClassesByName(ctx, snap.Ingressclasses)
+
+	virtualHostsHttp, secureVirtualHosts := virtualHosts(ctx, ingresses, ingressClasses, upstreams, services, requireIngressClass, ingressClass)
 
 	var virtualHostsHttps []*gloov1.VirtualHost
 	var sslConfigs []*ssl.SslConfig
@@ -167,13 +176,18 @@ type secureVirtualHost struct {
 	secret core.ResourceRef
 }
 
-func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstreams gloov1.UpstreamList, services []*kubev1.Service, requireIngressClass bool, ingressClass string) ([]*gloov1.VirtualHost, []secureVirtualHost) {
+func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, ingressClasses map[string]*networkingv1.IngressClass, upstreams gloov1.UpstreamList, services []*kubev1.Service, requireIngressClass bool, ingressClass string) ([]*gloov1.VirtualHost, []secureVirtualHost) {
 	routesByHostHttp := make(map[string][]*gloov1.Route)
 	routesByHostHttps := make(map[string][]*gloov1.Route)
 	secretsByHost := make(map[string]*core.ResourceRef)
 	var defaultBackend *networkingv1.IngressBackend
 	for _, ing := range ingresses {
-		if requireIngressClass && !isOurIngress(ing, ingressClass) {
+		if requireIngressClass && !isOurIngress(ing, ingressClass, ingressClasses) {
+			continue
+		}
+		annotations, err := parseAnnotations(ing.Annotations)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("ignoring ingress %v: %v", ing.Name, err)
 			continue
 		}
 		spec := ing.Spec
@@ -211,23 +225,15 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 				log.Warnf("rule %v in ingress %v is missing HTTP field", i, ing.Name)
 				continue
 			}
-			for _, route := range rule.HTTP.Paths {
-				upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, route.Backend)
+			for _, path := range rule.HTTP.Paths {
+				upstream, err := upstreamForBackend(upstreams, services, ing.Namespace, path.Backend)
 				if err != nil {
 					contextutils.LoggerFrom(ctx).Errorf("lookup upstream for ingress %v: %v", ing.Name, err)
 					continue
 				}
 
-				pathRegex := route.Path
-				if pathRegex == "" {
-					pathRegex = ".*"
-				}
 				route := &gloov1.Route{
-					Matchers: []*matchers.Matcher{{
-						PathSpecifier: &matchers.Matcher_Regex{
-							Regex: pathRegex,
-						},
-					}},
+					Matchers: pathMatchers(path),
 					Action: &gloov1.Route_RouteAction{
 						RouteAction: &gloov1.RouteAction{
 							Destination: &gloov1.RouteAction_Single{
@@ -239,9 +245,13 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 							},
 						},
 					},
+					Options: annotations.routeOptions(path),
 				}
 				if _, useTls := secretsByHost[host]; useTls {
 					routesByHostHttps[host] = append(routesByHostHttps[host], route)
+					if annotations.sslRedirect {
+						routesByHostHttp[host] = append(routesByHostHttp[host], httpsRedirectRoute(route))
+					}
 				} else {
 					routesByHostHttp[host] = append(routesByHostHttp[host], route)
 				}
@@ -287,6 +297,113 @@ func virtualHosts(ctx context.Context, ingresses []*networkingv1.Ingress, upstre
 	return virtualHostsHttp, virtualHostsHttps
 }
 
-func isOurIngress(ingress *networkingv1.Ingress, ingressClassToUse string) bool {
-	return ingress.Annotations[IngressClassKey] == ingressClassToUse
+// IsOurIngress returns true if gloo processes the ingress, i.e. if gloo does not require an ingress class,
+// or if the ingress belongs to the ingress class of gloo
+func IsOurIngress(ingress *networkingv1.Ingress, requireIngressClass bool, ingressClass string, ingressClasses map[string]*networkingv1.IngressClass) bool {
+	if !requireIngressClass {
+		return true
+	}
+	if ingressClass == "" {
+		ingressClass = DefaultIngressClass
+	}
+	return isOurIngress(ingress, ingressClass, ingressClasses)
+}
+
+// IngressClassesByName returns the IngressClasses of the snapshot by name
+func IngressClassesByName(ctx context.Context, ingressClassList networking.IngressClassList) map[string]*networkingv1.IngressClass {
+	ingressClasses := make(map[string]*networkingv1.IngressClass)
+	for _, ic := range ingressClassList {
+		kubeIngressClass, err := ingressclass.ToKube(ic)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("internal error: parsing internal ingress class representation: %v", err)
+			continue
+		}
+		ingressClasses[kubeIngressClass.Name] = kubeIngressClass
+	}
+	return ingressClasses
+}
+
+// isOurIngress returns true if the ingress belongs to the ingress class of gloo, according to:
+// - the kubernetes.io/ingress.class annotation of the ingress, which takes precedence over its ingressClassName
+// - or the ingressClassName of the ingress. The IngressClass of that name, if any, must have the controller of gloo
+// - or the IngressClass of gloo, if it has the controller of gloo and is the default class of the cluster,
+// for the ingresses without a class
+func isOurIngress(ingress *networkingv1.Ingress, ingressClassToUse string, ingressClasses map[string]*networkingv1.IngressClass) bool {
+	if class := ingress.Annotations[IngressClassKey]; class != "" {
+		return class == ingressClassToUse
+	}
+	class, classExists := ingressClasses[ingressClassToUse]
+	if ingress.Spec.IngressClassName != nil {
+		if *ingress.Spec.IngressClassName != ingressClassToUse {
+			return false
+		}
+		return !classExists || class.Spec.Controller == IngressClassController
+	}
+	return classExists && class.Spec.Controller == IngressClassController &&
+		class.Annotations[networkingv1.AnnotationIsDefaultIngressClass] == "true"
+}
+
+// pathType returns the type of the path, which defaults to ImplementationSpecific
+func pathType(path networkingv1.HTTPIngressPath) networkingv1.PathType {
+	if path.PathType == nil {
+		return networkingv1.PathTypeImplementationSpecific
+	}
+	return *path.PathType
+}
+
+// pathRegex returns the regex of a path of the ImplementationSpecific type
+func pathRegex(path networkingv1.HTTPIngressPath) string {
+	if path.Path == "" {
+		return ".*"
+	}
+	return path.Path
+}
+
+// pathMatchers returns the matchers of the path, which follow the semantics of its type:
+// - the Exact paths match the request paths exactly
+// - the Prefix paths match the elements of the request paths, e.g. /foo matches /foo and /foo/bar, but not /foobar
+// - the ImplementationSpecific paths are regexes, which match the whole request paths
+func pathMatchers(path networkingv1.HTTPIngressPath) []*matchers.Matcher {
+	switch pathType(path) {
+	case networkingv1.PathTypeExact:
+		return []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Exact{
+				Exact: path.Path,
+			},
+		}}
+	case networkingv1.PathTypePrefix:
+		prefix := strings.TrimSuffix(path.Path, "/")
+		if prefix == "" {
+			return []*matchers.Matcher{{
+				PathSpecifier: &matchers.Matcher_Prefix{
+					Prefix: "/",
+				},
+			}}
+		}
+		// a regex rather than a prefix matcher, so that the exact paths are matched first
+		// and the longest prefixes before the shorter ones
+		return []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Regex{
+				Regex: regexp.QuoteMeta(prefix) + "(/.*)?",
+			},
+		}}
+	default:
+		return []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Regex{
+				Regex: pathRegex(path),
+			},
+		}}
+	}
+}
+
+// httpsRedirectRoute returns a route which redirects the requests matched by the route to https
+func httpsRedirectRoute(route *gloov1.Route) *gloov1.Route {
+	return &gloov1.Route{
+		Matchers: route.GetMatchers(),
+		Action: &gloov1.Route_RedirectAction{
+			RedirectAction: &gloov1.RedirectAction{
+				HttpsRedirect: true,
+			},
+		},
+	}
 }
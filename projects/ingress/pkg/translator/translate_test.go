@@ -2,17 +2,24 @@ package translator
 
 import (
 	"context"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	v3 "github.com/solo-io/gloo/projects/gloo/pkg/api/external/envoy/type/matcher/v3"
 	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/core/matchers"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/cors"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/kubernetes"
 	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/ssl"
+	networking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
 	ingresstype "github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"github.com/solo-io/solo-kit/pkg/utils/prototime"
 	kubev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -475,8 +482,277 @@ var _ = Describe("Translate", func() {
 		// successful translation
 		Expect(vhosts).To(HaveLen(1))
 	})
+
+	Context("ingress classes", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		makeClassedIng := func(name, host string, ingressClassName *string) *v1.Ingress {
+			kubeIngress := makeKubeIng(name, namespace, host, makePath(networkingv1.PathTypePrefix, "/", "svc", 8081))
+			kubeIngress.Spec.IngressClassName = ingressClassName
+			ing, err := ingresstype.FromKube(kubeIngress)
+			Expect(err).NotTo(HaveOccurred())
+			return ing
+		}
+
+		makeIngressClass := func(name, controller string, isDefault bool) *networking.IngressClass {
+			ic := &networkingv1.IngressClass{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       networkingv1.IngressClassSpec{Controller: controller},
+			}
+			if isDefault {
+				ic.Annotations = map[string]string{networkingv1.AnnotationIsDefaultIngressClass: "true"}
+			}
+			return ingressclass.FromKube(ic)
+		}
+
+		// the domains of the ingresses translated by the gloo of the given ingress class
+		translatedDomainsOf := func(ingressClass string, ingressClasses ...*networking.IngressClass) []string {
+			gloo, glooInternal, nginx := "gloo", "gloo-internal", "nginx"
+			annotated := makeIng("annotated", namespace, "gloo", "annotated.com", "svc", intstr.FromInt(8081))
+			annotatedInternal := makeIng("annotated-internal", namespace, "gloo-internal", "annotated-internal.com", "svc", intstr.FromInt(8081))
+			annotatedNginx := makeIng("annotated-nginx", namespace, "nginx", "annotated-nginx.com", "svc", intstr.FromInt(8081))
+			proxy := translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: []*v1.Ingress{
+					annotated,
+					annotatedInternal,
+					annotatedNginx,
+					makeClassedIng("gloo", "gloo.com", &gloo),
+					makeClassedIng("gloo-internal", "gloo-internal.com", &glooInternal),
+					makeClassedIng("nginx", "nginx.com", &nginx),
+					makeClassedIng("unclassed", "unclassed.com", nil),
+				},
+				Ingressclasses: ingressClasses,
+			}, true, ingressClass)
+
+			var domains []string
+			for _, vh := range proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts() {
+				domains = append(domains, vh.GetDomains()[0])
+			}
+			return domains
+		}
+
+		translatedDomains := func(ingressClasses ...*networking.IngressClass) []string {
+			return translatedDomainsOf("", ingressClasses...)
+		}
+
+		It("translates the ingresses of the ingress class of gloo", func() {
+			Expect(translatedDomains()).To(Equal([]string{"annotated.com", "gloo.com"}))
+			Expect(translatedDomains(
+				makeIngressClass("gloo", IngressClassController, false),
+				makeIngressClass("nginx", "k8s.io/ingress-nginx", true),
+			)).To(Equal([]string{"annotated.com", "gloo.com"}))
+		})
+
+		It("translates the ingresses without a class if the IngressClass of gloo is the default class", func() {
+			Expect(translatedDomains(
+				makeIngressClass("gloo", IngressClassController, true),
+				makeIngressClass("nginx", "k8s.io/ingress-nginx", false),
+			)).To(Equal([]string{"annotated.com", "gloo.com", "unclassed.com"}))
+		})
+
+		It("translates only the ingresses of its own class when several IngressClasses are of gloo", func() {
+			ingressClasses := []*networking.IngressClass{
+				makeIngressClass("gloo", IngressClassController, false),
+				makeIngressClass("gloo-internal", IngressClassController, true),
+			}
+			Expect(translatedDomainsOf("gloo", ingressClasses...)).To(Equal([]string{"annotated.com", "gloo.com"}))
+			Expect(translatedDomainsOf("gloo-internal", ingressClasses...)).To(Equal([]string{"annotated-internal.com", "gloo-internal.com", "unclassed.com"}))
+		})
+
+		It("translates the ingresses without a class only if the default IngressClass is its own", func() {
+			Expect(translatedDomainsOf("gloo-internal",
+				makeIngressClass("gloo", IngressClassController, true),
+				makeIngressClass("gloo-internal", IngressClassController, false),
+			)).To(Equal([]string{"annotated-internal.com", "gloo-internal.com"}))
+		})
+
+		It("ignores the ingresses which name an IngressClass of another controller", func() {
+			Expect(translatedDomains(
+				makeIngressClass("gloo", "k8s.io/ingress-nginx", true),
+			)).To(Equal([]string{"annotated.com"}))
+		})
+	})
+
+	Context("paths and annotations", func() {
+
+		var (
+			namespace = "ns"
+			svc       *v1.KubeService
+			us        *gloov1.Upstream
+		)
+
+		BeforeEach(func() {
+			svc = makeService("svc", namespace, "http", 8081)
+			us = makeUpstream("us", namespace, svc)
+		})
+
+		translate := func(kubeIngresses ...*networkingv1.Ingress) *gloov1.Proxy {
+			var ingresses []*v1.Ingress
+			for _, kubeIngress := range kubeIngresses {
+				ing, err := ingresstype.FromKube(kubeIngress)
+				Expect(err).NotTo(HaveOccurred())
+				ingresses = append(ingresses, ing)
+			}
+			return translateProxy(ctx, "write-namespace", &v1.TranslatorSnapshot{
+				Upstreams: []*gloov1.Upstream{us},
+				Services:  []*v1.KubeService{svc},
+				Ingresses: ingresses,
+			}, false, "")
+		}
+
+		It("translates the paths according to their types", func() {
+			proxy := translate(makeKubeIng("ing", namespace, "host.com",
+				makePath(networkingv1.PathTypePrefix, "/", "svc", 8081),
+				makePath(networkingv1.PathTypeImplementationSpecific, "/regex/.*", "svc", 8081),
+				makePath(networkingv1.PathTypePrefix, "/foo/", "svc", 8081),
+				makePath(networkingv1.PathTypeExact, "/foo", "svc", 8081),
+				makePath(networkingv1.PathTypePrefix, "/foo/bar", "svc", 8081),
+			))
+
+			var paths []*matchers.Matcher
+			for _, route := range proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes() {
+				Expect(route.GetMatchers()).To(HaveLen(1))
+				paths = append(paths, route.GetMatchers()[0])
+			}
+			// the exact paths first, then the longest prefixes
+			Expect(paths).To(Equal([]*matchers.Matcher{
+				{PathSpecifier: &matchers.Matcher_Exact{Exact: "/foo"}},
+				{PathSpecifier: &matchers.Matcher_Regex{Regex: "/regex/.*"}},
+				{PathSpecifier: &matchers.Matcher_Regex{Regex: "/foo/bar(/.*)?"}},
+				{PathSpecifier: &matchers.Matcher_Regex{Regex: "/foo(/.*)?"}},
+				{PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"}},
+			}))
+		})
+
+		It("translates the annotations to the options of the routes", func() {
+			kubeIngress := makeKubeIng("ing", namespace, "host.com",
+				makePath(networkingv1.PathTypeExact, "/exact", "svc", 8081),
+				makePath(networkingv1.PathTypePrefix, "/prefix/", "svc", 8081),
+				makePath(networkingv1.PathTypeImplementationSpecific, "/regex/(.*)", "svc", 8081),
+			)
+			kubeIngress.Annotations = map[string]string{
+				RewriteTargetAnnotation:        "/api",
+				TimeoutAnnotation:              "30s",
+				IdleTimeoutAnnotation:          "5m",
+				EnableCorsAnnotation:           "true",
+				CorsAllowOriginAnnotation:      "https://a.com, *",
+				CorsAllowMethodsAnnotation:     "GET,POST",
+				CorsAllowHeadersAnnotation:     "x-a",
+				CorsExposeHeadersAnnotation:    "x-b",
+				CorsMaxAgeAnnotation:           "60",
+				CorsAllowCredentialsAnnotation: "true",
+			}
+			proxy := translate(kubeIngress)
+
+			routes := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+			Expect(routes).To(HaveLen(3))
+			for _, route := range routes {
+				Expect(route.GetOptions().GetTimeout()).To(Equal(prototime.DurationToProto(30 * time.Second)))
+				Expect(route.GetOptions().GetIdleTimeout()).To(Equal(prototime.DurationToProto(5 * time.Minute)))
+				Expect(route.GetOptions().GetCors()).To(Equal(&cors.CorsPolicy{
+					AllowOrigin:      []string{"https://a.com"},
+					AllowOriginRegex: []string{".*"},
+					AllowMethods:     []string{"GET", "POST"},
+					AllowHeaders:     []string{"x-a"},
+					ExposeHeaders:    []string{"x-b"},
+					MaxAge:           "60",
+					AllowCredentials: true,
+				}))
+			}
+			Expect(routes[0].GetMatchers()[0].GetExact()).To(Equal("/exact"))
+			Expect(routes[0].GetOptions().GetPrefixRewrite()).To(Equal(&wrappers.StringValue{Value: "/api"}))
+			Expect(routes[1].GetMatchers()[0].GetRegex()).To(Equal("/regex/(.*)"))
+			Expect(routes[1].GetOptions().GetRegexRewrite()).To(Equal(&v3.RegexMatchAndSubstitute{
+				Pattern:      &v3.RegexMatcher{Regex: "^(?:/regex/(.*))$"},
+				Substitution: "/api",
+			}))
+			Expect(routes[2].GetMatchers()[0].GetRegex()).To(Equal("/prefix(/.*)?"))
+			Expect(routes[2].GetOptions().GetRegexRewrite()).To(Equal(&v3.RegexMatchAndSubstitute{
+				Pattern:      &v3.RegexMatcher{Regex: "^/prefix/?"},
+				Substitution: "/api/",
+			}))
+		})
+
+		It("redirects the http requests of the tls hosts to https", func() {
+			kubeIngress := makeKubeIng("ing", namespace, "host.com", makePath(networkingv1.PathTypePrefix, "/", "svc", 8081))
+			kubeIngress.Annotations = map[string]string{SslRedirectAnnotation: "true"}
+			kubeIngress.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{"host.com"}, SecretName: "secret"}}
+			proxy := translate(kubeIngress)
+
+			Expect(proxy.GetListeners()).To(HaveLen(2))
+			httpRoutes := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+			httpsRoutes := proxy.GetListeners()[1].GetHttpListener().GetVirtualHosts()[0].GetRoutes()
+			Expect(httpRoutes).To(Equal([]*gloov1.Route{{
+				Matchers: httpsRoutes[0].GetMatchers(),
+				Action: &gloov1.Route_RedirectAction{
+					RedirectAction: &gloov1.RedirectAction{HttpsRedirect: true},
+				},
+			}}))
+			Expect(httpsRoutes[0].GetRouteAction()).NotTo(BeNil())
+		})
+
+		It("ignores the ingresses with invalid annotations", func() {
+			invalid := makeKubeIng("invalid", namespace, "invalid.com", makePath(networkingv1.PathTypePrefix, "/", "svc", 8081))
+			invalid.Annotations = map[string]string{TimeoutAnnotation: "30"}
+			valid := makeKubeIng("valid", namespace, "valid.com", makePath(networkingv1.PathTypePrefix, "/", "svc", 8081))
+			proxy := translate(invalid, valid)
+
+			vhosts := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()
+			Expect(vhosts).To(HaveLen(1))
+			Expect(vhosts[0].GetDomains()).To(Equal([]string{"valid.com", "valid.com:8080"}))
+			Expect(vhosts[0].GetRoutes()[0].GetOptions()).To(BeNil())
+		})
+	})
 })
 
+func makeKubeIng(name, namespace, host string, paths ...networkingv1.HTTPIngressPath) *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: paths,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func makePath(pathType networkingv1.PathType, path, svcName string, servicePort int32) networkingv1.HTTPIngressPath {
+	return networkingv1.HTTPIngressPath{
+		Path:     path,
+		PathType: &pathType,
+		Backend: networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: svcName,
+				Port: networkingv1.ServiceBackendPort{
+					Number: servicePort,
+				},
+			},
+		},
+	}
+}
+
 func getFirstPort(svc *kubev1.Service) int32 {
 	return svc.Spec.Ports[0].Port
 }
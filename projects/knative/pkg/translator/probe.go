@@ -0,0 +1,126 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	errors "github.com/rotisserie/eris"
+	"github.com/solo-io/gloo/projects/knative/api/external/knative"
+	v1alpha1 "github.com/solo-io/gloo/projects/knative/pkg/api/external/knative"
+	network "knative.dev/networking/pkg"
+	knativev1alpha1 "knative.dev/networking/pkg/apis/networking/v1alpha1"
+	knativeingress "knative.dev/networking/pkg/ingress"
+	"knative.dev/networking/pkg/prober"
+)
+
+const (
+	// the ingresses which the proxy does not serve after they were probed for this long are marked not ready,
+	// with the reason why, and they are probed until the proxy serves them
+	probeTimeout = time.Minute
+
+	probeRequestTimeout = 5 * time.Second
+)
+
+// ingressWithProbes returns a copy of the ingress with the probe paths of knative, which replace the
+// hash header of the probes with the hash of the ingress. The upstreams echo the header back, so that
+// the probes can tell whether the proxy serves the current version of the ingress.
+func ingressWithProbes(ing *v1alpha1.Ingress) (*v1alpha1.Ingress, error) {
+	kubeIngress := knativev1alpha1.Ingress(ing.Ingress)
+	probed := kubeIngress.DeepCopy()
+	if _, err := knativeingress.InsertProbe(probed); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.Ingress{Ingress: knative.Ingress(*probed)}, nil
+}
+
+// externalIngress returns a copy of the ingress which only has the rules exposed by the external proxy
+func externalIngress(ing *v1alpha1.Ingress) *v1alpha1.Ingress {
+	external := ing.Ingress.Clone()
+	external.Spec.Rules = nil
+	for _, rule := range ing.Spec.Rules {
+		if rule.Visibility != knativev1alpha1.IngressVisibilityClusterLocal {
+			external.Spec.Rules = append(external.Spec.Rules, rule)
+		}
+	}
+	return &v1alpha1.Ingress{Ingress: *external}
+}
+
+// probeIngress sends a probe for the first path of the ingress served over http by the proxy, and
+// returns true if the proxy serves the current version of the ingress
+func probeIngress(ctx context.Context, transport http.RoundTripper, proxyAddress string, ing *v1alpha1.Ingress, external bool) (bool, error) {
+	kubeIngress := knativev1alpha1.Ingress(ing.Ingress)
+	hash, err := knativeingress.ComputeHash(&kubeIngress)
+	if err != nil {
+		return false, err
+	}
+
+	host, path, ok := probeTarget(ing, external)
+	if !ok {
+		// the ingress cannot be probed over http
+		return true, nil
+	}
+
+	ops := []interface{}{
+		prober.WithHost(host),
+		prober.WithHeader(network.ProbeHeaderName, network.ProbeHeaderValue),
+		prober.WithHeader(network.HashHeaderName, network.HashHeaderValue),
+		verifyProbe(fmt.Sprintf("%x", hash)),
+	}
+	for name, match := range path.Headers {
+		ops = append(ops, prober.WithHeader(name, match.Exact))
+	}
+	target := url.URL{
+		Scheme: "http",
+		Host:   proxyAddress,
+		Path:   path.Path,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeRequestTimeout)
+	defer cancel()
+	return prober.Do(ctx, transport, target.String(), ops...)
+}
+
+// probeTarget returns the host and the path of the first rule of the ingress which is served over http
+func probeTarget(ing *v1alpha1.Ingress, external bool) (string, knativev1alpha1.HTTPIngressPath, bool) {
+	for _, rule := range ing.Spec.Rules {
+		if external && rule.Visibility == knativev1alpha1.IngressVisibilityClusterLocal {
+			continue
+		}
+		if rule.HTTP == nil || len(rule.HTTP.Paths) == 0 || !servedOverHttp(ing.GetMetadata(), ing.Spec, rule) {
+			continue
+		}
+		for _, host := range expandHosts(rule.Hosts) {
+			if strings.Contains(host, "*") {
+				continue
+			}
+			path := rule.HTTP.Paths[0]
+			if path.Path == "" {
+				path.Path = "/"
+			}
+			return host, path, true
+		}
+	}
+	return "", knativev1alpha1.HTTPIngressPath{}, false
+}
+
+// verifyProbe verifies the probe responses like knative: the hash of the response must match the hash of
+// the ingress, and any response which does not tell the version of the ingress is assumed to be successful
+func verifyProbe(hash string) prober.Verifier {
+	return func(r *http.Response, _ []byte) (bool, error) {
+		switch r.StatusCode {
+		case http.StatusOK:
+			if responseHash := r.Header.Get(network.HashHeaderName); responseHash != "" && responseHash != hash {
+				return false, errors.Errorf("unexpected hash: want %q, got %q", hash, responseHash)
+			}
+			return true, nil
+		case http.StatusNotFound, http.StatusServiceUnavailable:
+			return false, errors.Errorf("unexpected status code: want %v, got %v", http.StatusOK, r.StatusCode)
+		default:
+			return true, nil
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/knative/api/external/knative"
+	v1alpha1 "github.com/solo-io/gloo/projects/knative/pkg/api/external/knative"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	network "knative.dev/networking/pkg"
+	knativev1alpha1 "knative.dev/networking/pkg/apis/networking/v1alpha1"
+	knativeingress "knative.dev/networking/pkg/ingress"
+)
+
+var _ = Describe("Probes", func() {
+
+	var (
+		ingress *v1alpha1.Ingress
+		server  *httptest.Server
+		// the status code and the hash of the responses of the proxy
+		responseCode int
+		responseHash string
+		requests     []*http.Request
+	)
+
+	BeforeEach(func() {
+		ingress = &v1alpha1.Ingress{Ingress: knative.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "ing", Namespace: "example", Generation: 1},
+			Spec: knativev1alpha1.IngressSpec{
+				Rules: []knativev1alpha1.IngressRule{
+					{
+						Hosts:      []string{"local.example.svc.cluster.local"},
+						Visibility: knativev1alpha1.IngressVisibilityClusterLocal,
+						HTTP: &knativev1alpha1.HTTPIngressRuleValue{
+							Paths: []knativev1alpha1.HTTPIngressPath{{Path: "/local"}},
+						},
+					},
+					{
+						Hosts: []string{"external.example.com"},
+						HTTP: &knativev1alpha1.HTTPIngressRuleValue{
+							Paths: []knativev1alpha1.HTTPIngressPath{{
+								Path:    "/external",
+								Headers: map[string]knativev1alpha1.HeaderMatch{"tag": {Exact: "v2"}},
+							}},
+						},
+					},
+				},
+			},
+		}}
+
+		responseCode = http.StatusOK
+		requests = nil
+		kubeIngress := knativev1alpha1.Ingress(ingress.Ingress)
+		hash, err := knativeingress.ComputeHash(&kubeIngress)
+		Expect(err).NotTo(HaveOccurred())
+		responseHash = fmt.Sprintf("%x", hash)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			w.Header().Set(network.HashHeaderName, responseHash)
+			w.WriteHeader(responseCode)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	probe := func(external bool) (bool, error) {
+		return probeIngress(context.Background(), http.DefaultTransport, strings.TrimPrefix(server.URL, "http://"), ingress, external)
+	}
+
+	It("probes the first rule of the visibility of the proxy", func() {
+		Expect(probe(true)).To(BeTrue())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Host).To(Equal("external.example.com"))
+		Expect(requests[0].URL.Path).To(Equal("/external"))
+		Expect(requests[0].Header.Get("tag")).To(Equal("v2"))
+		Expect(requests[0].Header.Get(network.ProbeHeaderName)).To(Equal(network.ProbeHeaderValue))
+		Expect(requests[0].Header.Get(network.HashHeaderName)).To(Equal(network.HashHeaderValue))
+
+		Expect(probe(false)).To(BeTrue())
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Host).To(Equal("local.example"))
+		Expect(requests[1].URL.Path).To(Equal("/local"))
+	})
+
+	It("fails while the proxy serves another version of the ingress", func() {
+		responseHash = "previous"
+		ok, err := probe(true)
+		Expect(ok).To(BeFalse())
+		Expect(err).To(MatchError(ContainSubstring("unexpected hash")))
+	})
+
+	It("fails while the proxy does not route the ingress", func() {
+		responseCode = http.StatusNotFound
+		ok, err := probe(true)
+		Expect(ok).To(BeFalse())
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code")))
+	})
+
+	It("does not probe the rules which are only served over https", func() {
+		ingress.Spec.TLS = []knativev1alpha1.IngressTLS{{Hosts: []string{"external.example.com"}, SecretName: "cert"}}
+		ingress.Spec.HTTPOption = knativev1alpha1.HTTPOptionRedirected
+		Expect(probe(true)).To(BeTrue())
+		Expect(requests).To(BeEmpty())
+	})
+})
@@ -79,12 +79,19 @@ func translateProxy(ctx context.Context, proxyName, proxyNamespace string, ingre
 		meta := ing.GetMetadata()
 		ingressSpecsByRef[meta] = ing.Spec
 	}
-	return TranslateProxyFromSpecs(ctx, proxyName, proxyNamespace, ingressSpecsByRef)
+	return translateProxyFromSpecs(ctx, proxyName, proxyNamespace, ingressSpecsByRef, prefixPathMatcher, true)
 }
 
-// made public to be shared with the (soon to be deprecated) clusteringress controller
+// made public to be shared with the (soon to be deprecated) clusteringress controller,
+// whose paths are regexes, and whose appended headers are appended to the headers of the requests
 func TranslateProxyFromSpecs(ctx context.Context, proxyName, proxyNamespace string, ingresses map[*core.Metadata]knativev1alpha1.IngressSpec) (*gloov1.Proxy, error) {
-	virtualHostsHttp, virtualHostsHttps, sslConfigs, err := routingConfig(ctx, ingresses)
+	return translateProxyFromSpecs(ctx, proxyName, proxyNamespace, ingresses, regexPathMatcher, false)
+}
+
+// the appended headers of knative ingresses replace the headers of the requests when replaceHeaders is set,
+// as required by the knative ingress conformance
+func translateProxyFromSpecs(ctx context.Context, proxyName, proxyNamespace string, ingresses map[*core.Metadata]knativev1alpha1.IngressSpec, pathMatcher func(path string) *matchers.Matcher, replaceHeaders bool) (*gloov1.Proxy, error) {
+	virtualHostsHttp, virtualHostsHttps, sslConfigs, err := routingConfig(ctx, ingresses, pathMatcher, replaceHeaders)
 	if err != nil {
 		return nil, errors.Wrapf(err, "computing virtual hosts")
 	}
@@ -123,7 +130,31 @@ func TranslateProxyFromSpecs(ctx context.Context, proxyName, proxyNamespace stri
 	}, nil
 }
 
-func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1alpha1.IngressSpec) ([]*gloov1.VirtualHost, []*gloov1.VirtualHost, []*ssl.SslConfig, error) {
+// the path of a knative ingress is a literal prefix
+func prefixPathMatcher(path string) *matchers.Matcher {
+	if path == "" {
+		path = "/"
+	}
+	return &matchers.Matcher{
+		PathSpecifier: &matchers.Matcher_Prefix{
+			Prefix: path,
+		},
+	}
+}
+
+// the path of a cluster ingress is a regex
+func regexPathMatcher(path string) *matchers.Matcher {
+	if path == "" {
+		path = ".*"
+	}
+	return &matchers.Matcher{
+		PathSpecifier: &matchers.Matcher_Regex{
+			Regex: path,
+		},
+	}
+}
+
+func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1alpha1.IngressSpec, pathMatcher func(path string) *matchers.Matcher, replaceHeaders bool) ([]*gloov1.VirtualHost, []*gloov1.VirtualHost, []*ssl.SslConfig, error) {
 
 	var virtualHostsHttp, virtualHostsHttps []*gloov1.VirtualHost
 	var sslConfigs []*ssl.SslConfig
@@ -164,53 +195,42 @@ func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1al
 				continue
 			}
 			for _, route := range rule.HTTP.Paths {
-				pathRegex := route.Path
-				if pathRegex == "" {
-					pathRegex = ".*"
-				}
-
-				action, err := routeActionFromSplits(route.Splits)
+				action, err := routeActionFromSplits(route.Splits, replaceHeaders)
 				if err != nil {
 					return nil, nil, nil, errors.Wrapf(err, "")
 				}
 
-				route := &gloov1.Route{
-					Matchers: []*matchers.Matcher{{
-						PathSpecifier: &matchers.Matcher_Regex{
-							Regex: pathRegex,
-						},
-					}},
+				matcher := pathMatcher(route.Path)
+				matcher.Headers = headerMatchers(route.Headers)
+
+				options := &gloov1.RouteOptions{
+					HeaderManipulation: getHeaderManipulation(route.AppendHeaders, replaceHeaders),
+				}
+				if route.RewriteHost != "" {
+					options.HostRewriteType = &gloov1.RouteOptions_HostRewrite{HostRewrite: route.RewriteHost}
+				}
+
+				routes = append(routes, &gloov1.Route{
+					Matchers: []*matchers.Matcher{matcher},
 					Action: &gloov1.Route_RouteAction{
 						RouteAction: action,
 					},
-					Options: &gloov1.RouteOptions{
-						HeaderManipulation: getHeaderManipulation(route.AppendHeaders),
-					},
-				}
-				routes = append(routes, route)
-
+					Options: options,
+				})
 			}
 
-			var hosts []string
-			for _, host := range expandHosts(rule.Hosts) {
-				hosts = append(hosts, host)
-				if useTls {
-					hosts = append(hosts, fmt.Sprintf("%v:%v", host, bindPortHttps))
-				} else {
-					hosts = append(hosts, fmt.Sprintf("%v:%v", host, bindPortHttp))
+			name := ing.Ref().Key() + "-" + strconv.Itoa(i)
+			hosts := expandHosts(rule.Hosts)
+
+			// cluster local rules are only served over http
+			if useTls && rule.Visibility != knativev1alpha1.IngressVisibilityClusterLocal {
+				virtualHostsHttps = append(virtualHostsHttps, virtualHost(name, hosts, bindPortHttps, routes))
+				if spec.HTTPOption == knativev1alpha1.HTTPOptionRedirected {
+					virtualHostsHttp = append(virtualHostsHttp, virtualHost(name, hosts, bindPortHttp, []*gloov1.Route{httpsRedirectRoute()}))
 				}
 			}
-
-			vh := &gloov1.VirtualHost{
-				Name:    ing.Ref().Key() + "-" + strconv.Itoa(i),
-				Domains: hosts,
-				Routes:  routes,
-			}
-
-			if useTls {
-				virtualHostsHttps = append(virtualHostsHttps, vh)
-			} else {
-				virtualHostsHttp = append(virtualHostsHttp, vh)
+			if servedOverHttp(ing, spec, rule) {
+				virtualHostsHttp = append(virtualHostsHttp, virtualHost(name, hosts, bindPortHttp, routes))
 			}
 		}
 	}
@@ -224,7 +244,61 @@ func routingConfig(ctx context.Context, ingresses map[*core.Metadata]knativev1al
 	return virtualHostsHttp, virtualHostsHttps, sslConfigs, nil
 }
 
-func routeActionFromSplits(splits []knativev1alpha1.IngressBackendSplit) (*gloov1.RouteAction, error) {
+// servedOverHttp returns true if the rule of the ingress is routed by the http listener,
+// rather than only by the https listener. The http option of older ingresses is empty,
+// their tls rules are only served over https.
+func servedOverHttp(ing *core.Metadata, spec knativev1alpha1.IngressSpec, rule knativev1alpha1.IngressRule) bool {
+	useTls := len(spec.TLS) > 0 || sslConfigFromAnnotations(ing.GetAnnotations(), ing.GetNamespace()) != nil
+	return !useTls ||
+		rule.Visibility == knativev1alpha1.IngressVisibilityClusterLocal ||
+		spec.HTTPOption == knativev1alpha1.HTTPOptionEnabled
+}
+
+func virtualHost(name string, hosts []string, port int, routes []*gloov1.Route) *gloov1.VirtualHost {
+	var domains []string
+	for _, host := range hosts {
+		domains = append(domains, host, fmt.Sprintf("%v:%v", host, port))
+	}
+	return &gloov1.VirtualHost{
+		Name:    name,
+		Domains: domains,
+		Routes:  routes,
+	}
+}
+
+func httpsRedirectRoute() *gloov1.Route {
+	return &gloov1.Route{
+		Matchers: []*matchers.Matcher{{
+			PathSpecifier: &matchers.Matcher_Prefix{
+				Prefix: "/",
+			},
+		}},
+		Action: &gloov1.Route_RedirectAction{
+			RedirectAction: &gloov1.RedirectAction{
+				HttpsRedirect: true,
+			},
+		},
+	}
+}
+
+func headerMatchers(headerMatches map[string]knativev1alpha1.HeaderMatch) []*matchers.HeaderMatcher {
+	var names []string
+	for name := range headerMatches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []*matchers.HeaderMatcher
+	for _, name := range names {
+		result = append(result, &matchers.HeaderMatcher{
+			Name:  name,
+			Value: headerMatches[name].Exact,
+		})
+	}
+	return result
+}
+
+func routeActionFromSplits(splits []knativev1alpha1.IngressBackendSplit, replaceHeaders bool) (*gloov1.RouteAction, error) {
 	switch len(splits) {
 	case 0:
 		return nil, errors.Errorf("invalid cluster ingress: must provide at least 1 split")
@@ -233,7 +307,7 @@ func routeActionFromSplits(splits []knativev1alpha1.IngressBackendSplit) (*gloov
 	var destinations []*gloov1.WeightedDestination
 	for _, split := range splits {
 		var weightedDestinationPlugins *gloov1.WeightedDestinationOptions
-		if headerManipulaion := getHeaderManipulation(split.AppendHeaders); headerManipulaion != nil {
+		if headerManipulaion := getHeaderManipulation(split.AppendHeaders, replaceHeaders); headerManipulaion != nil {
 			weightedDestinationPlugins = &gloov1.WeightedDestinationOptions{
 				HeaderManipulation: headerManipulaion,
 			}
@@ -268,13 +342,26 @@ func serviceForSplit(split knativev1alpha1.IngressBackendSplit) *gloov1.Destinat
 	}
 }
 
-func getHeaderManipulation(headersToAppend map[string]string) *headers.HeaderManipulation {
+func getHeaderManipulation(headersToAppend map[string]string, replaceHeaders bool) *headers.HeaderManipulation {
 	if len(headersToAppend) == 0 {
 		return nil
 	}
+	var names []string
+	for name := range headersToAppend {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
 	var headersToAdd []*envoycore_sk.HeaderValueOption
-	for name, value := range headersToAppend {
-		headersToAdd = append(headersToAdd, &envoycore_sk.HeaderValueOption{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: name, Value: value}}})
+	for _, name := range names {
+		headerToAdd := &envoycore_sk.HeaderValueOption{
+			HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: name, Value: headersToAppend[name]}},
+		}
+		if replaceHeaders {
+			// the headers replace the values of the request, so that the probe hash replaces the value of the prober
+			headerToAdd.Append = &wrappers.BoolValue{Value: false}
+		}
+		headersToAdd = append(headersToAdd, headerToAdd)
 	}
 	return &headers.HeaderManipulation{
 		RequestHeadersToAdd: headersToAdd,
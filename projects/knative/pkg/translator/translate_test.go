@@ -167,8 +167,8 @@ var _ = Describe("Translate", func() {
 									Routes: []*gloov1.Route{
 										{
 											Matchers: []*matchers.Matcher{{
-												PathSpecifier: &matchers.Matcher_Regex{
-													Regex: "/",
+												PathSpecifier: &matchers.Matcher_Prefix{
+													Prefix: "/",
 												},
 											}},
 											Action: &gloov1.Route_RouteAction{
@@ -197,7 +197,7 @@ var _ = Describe("Translate", func() {
 											},
 											Options: &gloov1.RouteOptions{
 												HeaderManipulation: &headers.HeaderManipulation{
-													RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "add", Value: "me"}}}},
+													RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "add", Value: "me"}}, Append: &wrappers.BoolValue{Value: false}}},
 												},
 											},
 										},
@@ -216,8 +216,8 @@ var _ = Describe("Translate", func() {
 									Routes: []*gloov1.Route{
 										{
 											Matchers: []*matchers.Matcher{{
-												PathSpecifier: &matchers.Matcher_Regex{
-													Regex: "/hay",
+												PathSpecifier: &matchers.Matcher_Prefix{
+													Prefix: "/hay",
 												},
 											}},
 											Action: &gloov1.Route_RouteAction{
@@ -246,7 +246,7 @@ var _ = Describe("Translate", func() {
 											},
 											Options: &gloov1.RouteOptions{
 												HeaderManipulation: &headers.HeaderManipulation{
-													RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "add", Value: "me"}}}},
+													RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "add", Value: "me"}}, Append: &wrappers.BoolValue{Value: false}}},
 												},
 											},
 										},
@@ -274,8 +274,8 @@ var _ = Describe("Translate", func() {
 									Routes: []*gloov1.Route{
 										{
 											Matchers: []*matchers.Matcher{{
-												PathSpecifier: &matchers.Matcher_Regex{
-													Regex: "/",
+												PathSpecifier: &matchers.Matcher_Prefix{
+													Prefix: "/",
 												},
 											}},
 											Action: &gloov1.Route_RouteAction{
@@ -304,7 +304,7 @@ var _ = Describe("Translate", func() {
 											},
 											Options: &gloov1.RouteOptions{
 												HeaderManipulation: &headers.HeaderManipulation{
-													RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "add", Value: "me"}}}},
+													RequestHeadersToAdd: []*envoycore_sk.HeaderValueOption{{HeaderOption: &envoycore_sk.HeaderValueOption_Header{Header: &envoycore_sk.HeaderValue{Key: "add", Value: "me"}}, Append: &wrappers.BoolValue{Value: false}}},
 												},
 											},
 										},
@@ -391,6 +391,87 @@ var _ = Describe("Translate", func() {
 		Expect(proxy.Listeners[0].SslConfigurations[0].SslSecrets).To(Equal(&ssl.SslConfig_SecretRef{SecretRef: &core.ResourceRef{Name: secretName, Namespace: secretNamespace}}))
 		Expect(proxy.Listeners[0].SslConfigurations[0].SniDomains).To(Equal([]string{"domain.com", "domain.io"}))
 	})
+
+	It("translates the header matches, host rewrites, visibility and http option of the rules", func() {
+		split := v1alpha1.IngressBackendSplit{
+			IngressBackend: v1alpha1.IngressBackend{
+				ServiceName:      "svc",
+				ServiceNamespace: "example",
+				ServicePort:      intstr.FromInt(80),
+			},
+		}
+		ingress := &v1alpha12.Ingress{Ingress: knative.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ing",
+				Namespace: "example",
+			},
+			Spec: v1alpha1.IngressSpec{
+				TLS: []v1alpha1.IngressTLS{{
+					Hosts:      []string{"external.com"},
+					SecretName: "cert",
+				}},
+				HTTPOption: v1alpha1.HTTPOptionRedirected,
+				Rules: []v1alpha1.IngressRule{
+					{
+						Hosts: []string{"external.com"},
+						HTTP: &v1alpha1.HTTPIngressRuleValue{
+							Paths: []v1alpha1.HTTPIngressPath{{
+								Headers: map[string]v1alpha1.HeaderMatch{
+									"tag":    {Exact: "v2"},
+									"canary": {Exact: "true"},
+								},
+								RewriteHost: "v2.external.com",
+								Splits:      []v1alpha1.IngressBackendSplit{split},
+							}},
+						},
+					},
+					{
+						Hosts:      []string{"local.example.svc.cluster.local"},
+						Visibility: v1alpha1.IngressVisibilityClusterLocal,
+						HTTP: &v1alpha1.HTTPIngressRuleValue{
+							Paths: []v1alpha1.HTTPIngressPath{{
+								Splits: []v1alpha1.IngressBackendSplit{split},
+							}},
+						},
+					},
+				},
+			},
+		}}
+		proxy, err := translateProxy(context.TODO(), "test", "example", v1alpha12.IngressList{ingress})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxy.GetListeners()).To(HaveLen(2))
+
+		// the external rule is served over https, and redirected to https over http
+		httpsVirtualHosts := proxy.GetListeners()[1].GetHttpListener().GetVirtualHosts()
+		Expect(httpsVirtualHosts).To(HaveLen(1))
+		Expect(httpsVirtualHosts[0].GetDomains()).To(Equal([]string{"external.com", "external.com:8443"}))
+		route := httpsVirtualHosts[0].GetRoutes()[0]
+		Expect(route.GetMatchers()).To(ConsistOf(MatchProto(&matchers.Matcher{
+			PathSpecifier: &matchers.Matcher_Prefix{Prefix: "/"},
+			Headers: []*matchers.HeaderMatcher{
+				{Name: "canary", Value: "true"},
+				{Name: "tag", Value: "v2"},
+			},
+		})))
+		Expect(route.GetOptions().GetHostRewrite()).To(Equal("v2.external.com"))
+
+		httpVirtualHosts := proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()
+		Expect(httpVirtualHosts).To(HaveLen(2))
+		Expect(httpVirtualHosts[0].GetDomains()).To(Equal([]string{"external.com", "external.com:8080"}))
+		Expect(httpVirtualHosts[0].GetRoutes()).To(HaveLen(1))
+		Expect(httpVirtualHosts[0].GetRoutes()[0].GetRedirectAction().GetHttpsRedirect()).To(BeTrue())
+
+		// the cluster local rule is only served over http
+		Expect(httpVirtualHosts[1].GetDomains()).To(ContainElement("local.example"))
+		Expect(httpVirtualHosts[1].GetRoutes()[0].GetRouteAction()).NotTo(BeNil())
+
+		// the external rule is served over http too once http is enabled
+		ingress.Spec.HTTPOption = v1alpha1.HTTPOptionEnabled
+		proxy, err = translateProxy(context.TODO(), "test", "example", v1alpha12.IngressList{ingress})
+		Expect(err).NotTo(HaveOccurred())
+		httpVirtualHosts = proxy.GetListeners()[0].GetHttpListener().GetVirtualHosts()
+		Expect(httpVirtualHosts[0].GetRoutes()[0]).To(MatchProto(httpsVirtualHosts[0].GetRoutes()[0]))
+	})
 })
 
 func durptr(d int) *duration.Duration {
@@ -2,6 +2,8 @@ package translator
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/solo-io/gloo/pkg/utils/syncutil"
@@ -38,6 +40,8 @@ type translatorSyncer struct {
 
 	// injection for testing
 	translateProxy func(ctx context.Context, proxyName, proxyNamespace string, ingresses v1alpha1.IngressList) (*gloov1.Proxy, error)
+	probeIngress   func(ctx context.Context, proxyAddress string, ingress *v1alpha1.Ingress, external bool) (bool, error)
+	probeTimeout   time.Duration
 }
 
 func NewSyncer(externalProxyAddress, internalProxyAddress, writeNamespace string, proxyClient gloov1.ProxyClient, ingressClient knativeclient.IngressesGetter, writeErrs chan error, requireIngressClass bool, statusClient resources.StatusClient) v1.TranslatorSyncer {
@@ -52,23 +56,28 @@ func NewSyncer(externalProxyAddress, internalProxyAddress, writeNamespace string
 		requireIngressClass:  requireIngressClass,
 		statusClient:         statusClient,
 		translateProxy:       translateProxy,
+		probeIngress: func(ctx context.Context, proxyAddress string, ingress *v1alpha1.Ingress, external bool) (bool, error) {
+			return probeIngress(ctx, http.DefaultTransport, proxyAddress, ingress, external)
+		},
+		probeTimeout: probeTimeout,
 	}
 }
 
 const (
 	externalProxyName = "knative-external-proxy"
 	internalProxyName = "knative-internal-proxy"
+
+	// the reason of the ingresses which the proxy does not serve after the probe timeout
+	probesFailedReason = "ProbesFailed"
 )
 
-// enforce ingress class if requirement is set
+// ignore the ingresses of other classes, and the ingresses without class if the requirement is set
 func (s *translatorSyncer) shouldProcess(ingress *v1alpha1.Ingress) bool {
-	if !s.requireIngressClass {
-		return true
-	}
-	if len(ingress.Annotations) == 0 {
-		return false
+	ingressClass, ok := ingress.Annotations[ingressClassAnnotation]
+	if !ok {
+		return !s.requireIngressClass
 	}
-	return ingress.Annotations[ingressClassAnnotation] == glooIngressClass
+	return ingressClass == glooIngressClass
 }
 
 func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot) error {
@@ -89,26 +98,36 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 
 	// split ingresses by their visibility, create a proxy for each
 	var externalIngresses, internalIngresses v1alpha1.IngressList
+	// the ingresses translated to each proxy, with the probe paths and the rules of the visibility of the proxy
+	var translatedExternalIngresses, translatedInternalIngresses v1alpha1.IngressList
 
 	for _, ing := range snap.Ingresses {
 		if !s.shouldProcess(ing) {
 			continue
 		}
 
+		translated, err := ingressWithProbes(ing)
+		if err != nil {
+			logger.Warnf("failed to add the probes to knative ingress %v: %v", ing.GetMetadata().Ref(), err)
+			translated = ing
+		}
+
 		if ing.IsPublic() {
 			externalIngresses = append(externalIngresses, ing)
+			translatedExternalIngresses = append(translatedExternalIngresses, externalIngress(translated))
 		}
 		internalIngresses = append(internalIngresses, ing)
+		translatedInternalIngresses = append(translatedInternalIngresses, translated)
 	}
 
-	externalProxy, err := s.translateProxy(ctx, externalProxyName, s.writeNamespace, externalIngresses)
+	externalProxy, err := s.translateProxy(ctx, externalProxyName, s.writeNamespace, translatedExternalIngresses)
 	if err != nil {
 		logger.Warnf("snapshot %v was rejected due to invalid config: %v\n"+
 			"knative ingress externalProxy will not be updated.", snapHash, err)
 		return err
 	}
 
-	internalProxy, err := s.translateProxy(ctx, internalProxyName, s.writeNamespace, internalIngresses)
+	internalProxy, err := s.translateProxy(ctx, internalProxyName, s.writeNamespace, translatedInternalIngresses)
 	if err != nil {
 		logger.Warnf("snapshot %v was rejected due to invalid config: %v\n"+
 			"knative ingress externalProxy will not be updated.", snapHash, err)
@@ -143,14 +162,14 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 
 	g := &errgroup.Group{}
 	g.Go(func() error {
-		if err := s.propagateProxyStatus(ctx, externalProxy, externalIngresses); err != nil {
+		if err := s.propagateProxyStatus(ctx, externalProxy, s.externalProxyAddress, externalIngresses, true); err != nil {
 			return eris.Wrapf(err, "failed to propagate external proxy status "+
 				"to ingress objects")
 		}
 		return nil
 	})
 	g.Go(func() error {
-		if err := s.propagateProxyStatus(ctx, internalProxy, internalIngresses); err != nil {
+		if err := s.propagateProxyStatus(ctx, internalProxy, s.internalProxyAddress, internalIngresses, false); err != nil {
 			return eris.Wrapf(err, "failed to propagate internal proxy status "+
 				"to ingress objects")
 		}
@@ -160,11 +179,13 @@ func (s *translatorSyncer) Sync(ctx context.Context, snap *v1.TranslatorSnapshot
 	return nil
 }
 
-// propagate to all ingresses the status of the proxy
-func (s *translatorSyncer) propagateProxyStatus(ctx context.Context, proxy *gloov1.Proxy, ingresses v1alpha1.IngressList) error {
+// propagate to all ingresses the status of the proxy, once the proxy serves them
+func (s *translatorSyncer) propagateProxyStatus(ctx context.Context, proxy *gloov1.Proxy, proxyAddress string, ingresses v1alpha1.IngressList, external bool) error {
 	if proxy == nil {
 		return nil
 	}
+	var acceptedAt time.Time
+	markedNotReady := false
 	ticker := time.Tick(time.Second / 2)
 	for {
 		select {
@@ -185,12 +206,67 @@ func (s *translatorSyncer) propagateProxyStatus(ctx context.Context, proxy *gloo
 				contextutils.LoggerFrom(ctx).Errorf("proxy was rejected by gloo: %v", updatedProxyStatus.GetReason())
 				continue
 			case core.Status_Accepted:
-				return s.markIngressesReady(ctx, ingresses)
+				if acceptedAt.IsZero() {
+					acceptedAt = time.Now()
+				}
+				var probeErrs map[*v1alpha1.Ingress]error
+				ingresses, probeErrs = s.probeIngresses(ctx, proxyAddress, ingresses, external)
+				if len(ingresses) == 0 {
+					return nil
+				}
+				if !markedNotReady && time.Since(acceptedAt) > s.probeTimeout {
+					s.markIngressesNotReady(ctx, proxyAddress, ingresses, probeErrs)
+					markedNotReady = true
+				}
 			}
 		}
 	}
 }
 
+// probeIngresses marks the ingresses which the proxy serves ready, and returns the ingresses which it does not serve yet,
+// with the errors of their probes
+func (s *translatorSyncer) probeIngresses(ctx context.Context, proxyAddress string, ingresses v1alpha1.IngressList, external bool) (v1alpha1.IngressList, map[*v1alpha1.Ingress]error) {
+	var served, pending v1alpha1.IngressList
+	probeErrs := map[*v1alpha1.Ingress]error{}
+	for _, ingress := range ingresses {
+		if ingress.Status.ObservedGeneration == ingress.Generation {
+			continue
+		}
+		ok, err := s.probeIngress(ctx, proxyAddress, ingress, external)
+		if ok {
+			served = append(served, ingress)
+			continue
+		}
+		contextutils.LoggerFrom(ctx).Debugf("probe of knative ingress %v failed: %v", ingress.GetMetadata().Ref(), err)
+		pending = append(pending, ingress)
+		probeErrs[ingress] = err
+	}
+	if err := s.markIngressesReady(ctx, served); err != nil {
+		contextutils.LoggerFrom(ctx).Errorf("failed to mark knative ingresses ready: %v", err)
+	}
+	return pending, probeErrs
+}
+
+// markIngressesNotReady reports why the proxy does not serve the ingresses yet. Their observed generation is not updated,
+// so that they are still probed, and marked ready once the proxy serves them.
+func (s *translatorSyncer) markIngressesNotReady(ctx context.Context, proxyAddress string, ingresses v1alpha1.IngressList, probeErrs map[*v1alpha1.Ingress]error) {
+	for _, wrappedCi := range ingresses {
+		message := fmt.Sprintf("proxy %s does not serve the ingress after %v", proxyAddress, s.probeTimeout)
+		if err := probeErrs[wrappedCi]; err != nil {
+			message = fmt.Sprintf("%s: %v", message, err)
+		}
+		contextutils.LoggerFrom(ctx).Warnf("knative ingress %v is not ready: %s", wrappedCi.GetMetadata().Ref(), message)
+
+		ci := knativev1alpha1.Ingress(wrappedCi.Ingress)
+		ci.Status.InitializeConditions()
+		ci.Status.MarkNetworkConfigured()
+		ci.Status.MarkIngressNotReady(probesFailedReason, message)
+		if _, err := s.ingressClient.Ingresses(ci.Namespace).UpdateStatus(ctx, &ci, v1machinery.UpdateOptions{}); err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("failed to update Ingress %v status with error %v", ci.Name, err)
+		}
+	}
+}
+
 func (s *translatorSyncer) markIngressesReady(ctx context.Context, ingresses v1alpha1.IngressList) error {
 	var updatedIngresses []*knativev1alpha1.Ingress
 	for _, wrappedCi := range ingresses {
@@ -2,6 +2,7 @@ package translator
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	gloostatusutils "github.com/solo-io/gloo/pkg/utils/statusutils"
@@ -22,6 +23,7 @@ import (
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
+	network "knative.dev/networking/pkg"
 	knativev1alpha1 "knative.dev/networking/pkg/apis/networking/v1alpha1"
 	v1alpha13 "knative.dev/networking/pkg/client/clientset/versioned/typed/networking/v1alpha1"
 )
@@ -111,9 +113,30 @@ var _ = Describe("TranslatorSyncer", func() {
 		Expect(proxies[0].Listeners[0].GetHttpListener().VirtualHosts).To(HaveLen(1))
 	})
 
+	It("ignores the ingresses of other classes", func() {
+		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false, statusClient).(*translatorSyncer)
+
+		ingress.Annotations = map[string]string{
+			ingressClassAnnotation: "istio.ingress.networking.knative.dev",
+		}
+		err := syncer.Sync(context.TODO(), &knativev1.TranslatorSnapshot{
+			Ingresses: []*v1alpha1.Ingress{ingress},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		proxies, err := proxyClient.List(namespace, clients.ListOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxies).To(HaveLen(2))
+		Expect(proxies[0].Listeners).To(BeEmpty())
+		Expect(proxies[1].Listeners).To(BeEmpty())
+	})
+
 	It("propagates successful proxy status to the ingresses it was created from", func() {
 		// requireIngressClass = true
 		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false, statusClient).(*translatorSyncer)
+		syncer.probeIngress = func(ctx context.Context, proxyAddress string, ingress *v1alpha1.Ingress, external bool) (bool, error) {
+			return true, nil
+		}
 
 		go func() {
 			defer GinkgoRecover()
@@ -127,7 +150,7 @@ var _ = Describe("TranslatorSyncer", func() {
 			Expect(err).NotTo(HaveOccurred())
 		}()
 
-		err := syncer.propagateProxyStatus(context.TODO(), proxy, v1alpha1.IngressList{ingress})
+		err := syncer.propagateProxyStatus(context.TODO(), proxy, proxyAddressExternal, v1alpha1.IngressList{ingress}, true)
 		Expect(err).NotTo(HaveOccurred())
 
 		// _ formally used as 'ci'
@@ -137,6 +160,98 @@ var _ = Describe("TranslatorSyncer", func() {
 		Expect(ci.IsReady()).To(BeTrue())
 	})
 
+	It("marks the ingresses ready once the proxy serves them", func() {
+		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false, statusClient).(*translatorSyncer)
+		probes := 0
+		syncer.probeIngress = func(ctx context.Context, proxyAddress string, ingress *v1alpha1.Ingress, external bool) (bool, error) {
+			Expect(proxyAddress).To(Equal(proxyAddressInternal))
+			Expect(external).To(BeFalse())
+			probes++
+			return probes == 3, nil
+		}
+
+		statusClient.SetStatus(proxy, &core.Status{
+			State: core.Status_Accepted,
+		})
+		_, err := proxyClient.Write(proxy, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = syncer.propagateProxyStatus(context.TODO(), proxy, proxyAddressInternal, v1alpha1.IngressList{ingress}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(probes).To(Equal(3))
+
+		ci, err := knativeClient.Ingresses(ingress.Namespace).Get(ctx, ingress.Name, v12.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ci.IsReady()).To(BeTrue())
+	})
+
+	It("keeps the ingresses not ready with a reason until the proxy serves them", func() {
+		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false, statusClient).(*translatorSyncer)
+		syncer.probeTimeout = 0
+		probes := 0
+		syncer.probeIngress = func(ctx context.Context, proxyAddress string, ingress *v1alpha1.Ingress, external bool) (bool, error) {
+			probes++
+			if probes < 3 {
+				return false, errors.New("connection refused")
+			}
+			// the probes timed out after the first one
+			ci, err := knativeClient.Ingresses(ingress.Namespace).Get(ctx, ingress.Name, v12.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ci.IsReady()).To(BeFalse())
+			ready := ci.Status.GetCondition(knativev1alpha1.IngressConditionReady)
+			Expect(ready.IsUnknown()).To(BeTrue())
+			Expect(ready.Reason).To(Equal(probesFailedReason))
+			Expect(ready.Message).To(ContainSubstring("connection refused"))
+			return true, nil
+		}
+
+		statusClient.SetStatus(proxy, &core.Status{
+			State: core.Status_Accepted,
+		})
+		_, err := proxyClient.Write(proxy, clients.WriteOpts{OverwriteExisting: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = syncer.propagateProxyStatus(context.TODO(), proxy, proxyAddressInternal, v1alpha1.IngressList{ingress}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(probes).To(Equal(3))
+
+		ci, err := knativeClient.Ingresses(ingress.Namespace).Get(ctx, ingress.Name, v12.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ci.IsReady()).To(BeTrue())
+	})
+
+	It("translates the probe paths and only the external rules to the external proxy", func() {
+		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false, statusClient).(*translatorSyncer)
+
+		proxiesWithIngresses := make(map[string]v1alpha1.IngressList)
+		syncer.translateProxy = func(ctx context.Context, proxyName, proxyNamespace string, ingresses v1alpha1.IngressList) (proxy *v1.Proxy, err error) {
+			proxiesWithIngresses[proxyName] = ingresses
+			return nil, nil
+		}
+
+		localRule := ingress.Spec.Rules[0].DeepCopy()
+		localRule.Hosts = []string{"local.svc.cluster.local"}
+		localRule.Visibility = knativev1alpha1.IngressVisibilityClusterLocal
+		ingress.Spec.Rules = append(ingress.Spec.Rules, *localRule)
+
+		err := syncer.Sync(context.TODO(), &knativev1.TranslatorSnapshot{
+			Ingresses: []*v1alpha1.Ingress{ingress},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(proxiesWithIngresses[externalProxyName]).To(HaveLen(1))
+		Expect(proxiesWithIngresses[externalProxyName][0].Spec.Rules).To(HaveLen(1))
+		Expect(proxiesWithIngresses[internalProxyName]).To(HaveLen(1))
+		Expect(proxiesWithIngresses[internalProxyName][0].Spec.Rules).To(HaveLen(2))
+
+		// the probe path is inserted before the path of the rule
+		paths := proxiesWithIngresses[externalProxyName][0].Spec.Rules[0].HTTP.Paths
+		Expect(paths).To(HaveLen(2))
+		Expect(paths[0].Headers).To(HaveKeyWithValue(network.HashHeaderName, knativev1alpha1.HeaderMatch{Exact: network.HashHeaderValue}))
+		Expect(paths[0].AppendHeaders).To(HaveKey(network.HashHeaderName))
+		Expect(paths[1]).To(Equal(ingress.Spec.Rules[0].HTTP.Paths[0]))
+	})
+
 	It("puts all ingresses on the internal proxy", func() {
 		syncer := NewSyncer(proxyAddressExternal, proxyAddressInternal, namespace, proxyClient, knativeClient, make(chan error), false, statusClient).(*translatorSyncer)
 
@@ -0,0 +1,96 @@
+package e2e_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/gateway/pkg/acme"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/test/e2e"
+	"github.com/solo-io/gloo/test/gomega/matchers"
+	"github.com/solo-io/gloo/test/testutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+)
+
+var _ = Describe("ACME challenges", func() {
+
+	var (
+		testContext *e2e.TestContext
+
+		// a challenge for the domain of the default virtual service, and one for a domain which no virtual service serves
+		vsChallenge = acme.Challenge{
+			Domain:           e2e.DefaultHost,
+			Token:            "vs-token",
+			KeyAuthorization: "vs-token.key-authorization",
+		}
+		otherChallenge = acme.Challenge{
+			Domain:           "other.example.com",
+			Token:            "other-token",
+			KeyAuthorization: "other-token.key-authorization",
+		}
+	)
+
+	BeforeEach(func() {
+		testContext = testContextFactory.NewTestContext()
+		testContext.BeforeEach()
+
+		testContext.ResourcesToCreate().Secrets = gloov1.SecretList{
+			acme.ChallengeSecret(writeNamespace, vsChallenge),
+			acme.ChallengeSecret(writeNamespace, otherChallenge),
+		}
+	})
+
+	AfterEach(func() {
+		testContext.AfterEach()
+	})
+
+	JustBeforeEach(func() {
+		testContext.JustBeforeEach()
+	})
+
+	JustAfterEach(func() {
+		testContext.JustAfterEach()
+	})
+
+	challengeRequest := func(challenge acme.Challenge) *http.Request {
+		return testContext.GetHttpRequestBuilder().
+			WithHost(challenge.Domain).
+			WithPath(challenge.Path()[1:]).
+			Build()
+	}
+
+	It("serves the key authorizations of the pending challenges before the routes of the virtual services", func() {
+		for _, challenge := range []acme.Challenge{vsChallenge, otherChallenge} {
+			request := challengeRequest(challenge)
+			Eventually(func(g Gomega) {
+				g.Expect(testutils.DefaultHttpClient.Do(request)).Should(matchers.HaveHttpResponse(&matchers.HttpResponse{
+					StatusCode: http.StatusOK,
+					Body:       challenge.KeyAuthorization,
+				}))
+			}, "5s", ".5s").Should(Succeed())
+		}
+
+		// the other paths of the domain are still routed to the upstream of the virtual service
+		Eventually(func(g Gomega) {
+			g.Expect(testutils.DefaultHttpClient.Do(testContext.GetHttpRequestBuilder().WithPath("other").Build())).
+				Should(matchers.HaveOkResponse())
+		}, "5s", ".5s").Should(Succeed())
+	})
+
+	It("stops serving a challenge once it is cleaned up", func() {
+		request := challengeRequest(otherChallenge)
+		Eventually(func(g Gomega) {
+			g.Expect(testutils.DefaultHttpClient.Do(request)).Should(matchers.HaveExactResponseBody(otherChallenge.KeyAuthorization))
+		}, "5s", ".5s").Should(Succeed())
+
+		secret := acme.ChallengeSecret(writeNamespace, otherChallenge)
+		err := testContext.TestClients().SecretClient.Delete(writeNamespace, secret.GetMetadata().GetName(), clients.DeleteOpts{Ctx: testContext.Ctx()})
+		Expect(err).NotTo(HaveOccurred())
+
+		// no virtual host serves the domain anymore
+		Eventually(func(g Gomega) {
+			g.Expect(testutils.DefaultHttpClient.Do(request)).Should(matchers.HaveStatusCode(http.StatusNotFound))
+		}, "5s", ".5s").Should(Succeed())
+	})
+})
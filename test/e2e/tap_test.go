@@ -0,0 +1,123 @@
+package e2e_test
+
+import (
+	"context"
+	"net"
+	"time"
+
+	envoytap "github.com/envoyproxy/go-control-plane/envoy/service/tap/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo/projects/accesslogger/pkg/tapservice"
+	gatewayv1 "github.com/solo-io/gloo/projects/gateway/pkg/api/v1"
+	gatewaydefaults "github.com/solo-io/gloo/projects/gateway/pkg/defaults"
+	gloov1 "github.com/solo-io/gloo/projects/gloo/pkg/api/v1"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/static"
+	"github.com/solo-io/gloo/projects/gloo/pkg/api/v1/options/tap"
+	"github.com/solo-io/gloo/test/e2e"
+	"github.com/solo-io/gloo/test/gomega/matchers"
+	"github.com/solo-io/gloo/test/testutils"
+	"github.com/solo-io/solo-kit/pkg/api/v1/resources/core"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Tap", func() {
+
+	var (
+		testContext *e2e.TestContext
+		tapServer   *grpc.Server
+		traces      <-chan *envoytap.StreamTapsRequest
+	)
+
+	BeforeEach(func() {
+		testContext = testContextFactory.NewTestContext()
+		testContext.BeforeEach()
+
+		var tapServerPort uint32
+		tapServer, tapServerPort, traces = runTapServer(testContext.Ctx())
+
+		tapServerUpstream := &gloov1.Upstream{
+			Metadata: &core.Metadata{Name: "tap-server", Namespace: writeNamespace},
+			UseHttp2: &wrappers.BoolValue{Value: true},
+			UpstreamType: &gloov1.Upstream_Static{
+				Static: &static.UpstreamSpec{
+					Hosts: []*static.Host{{
+						Addr: testContext.EnvoyInstance().GlooAddr,
+						Port: tapServerPort,
+					}},
+				},
+			},
+		}
+		testContext.ResourcesToCreate().Upstreams = append(testContext.ResourcesToCreate().Upstreams, tapServerUpstream)
+
+		gw := gatewaydefaults.DefaultGateway(writeNamespace)
+		gw.GetHttpGateway().Options = &gloov1.HttpListenerOptions{
+			Tap: &tap.Tap{
+				Sinks: []*tap.Sink{{
+					SinkType: &tap.Sink_GrpcService{
+						GrpcService: &tap.GrpcService{TapServer: tapServerUpstream.GetMetadata().Ref()},
+					},
+				}},
+			},
+		}
+		testContext.ResourcesToCreate().Gateways = gatewayv1.GatewayList{
+			gw,
+		}
+	})
+
+	AfterEach(func() {
+		tapServer.Stop()
+		testContext.AfterEach()
+	})
+
+	JustBeforeEach(func() {
+		testContext.JustBeforeEach()
+	})
+
+	JustAfterEach(func() {
+		testContext.JustAfterEach()
+	})
+
+	It("streams the traces to a grpc tap server", func() {
+		requestBuilder := testContext.GetHttpRequestBuilder().
+			WithPath("tapped").
+			WithPostBody("tapped body")
+		Eventually(func(g Gomega) {
+			g.Expect(testutils.DefaultHttpClient.Do(requestBuilder.Build())).Should(matchers.HaveOkResponse())
+
+			var trace *envoytap.StreamTapsRequest
+			g.Eventually(traces, 2*time.Second).Should(Receive(&trace))
+			request := trace.GetTrace().GetHttpBufferedTrace().GetRequest()
+			g.Expect(request.GetHeaders()).To(ContainElement(HaveField("Value", "/tapped")))
+			g.Expect(string(request.GetBody().GetAsBytes())).To(Equal("tapped body"))
+		}, time.Second*21, time.Second*2).Should(Succeed())
+	})
+})
+
+// runTapServer starts the tap sink service of the access logger and returns its port and the traces that it receives
+func runTapServer(ctx context.Context) (*grpc.Server, uint32, <-chan *envoytap.StreamTapsRequest) {
+	traces := make(chan *envoytap.StreamTapsRequest, 10)
+
+	lis, err := net.Listen("tcp", ":0")
+	Expect(err).NotTo(HaveOccurred())
+
+	srv := grpc.NewServer()
+	envoytap.RegisterTapSinkServiceServer(srv, tapservice.NewServer(tapservice.Options{
+		Callbacks: tapservice.TapCallbackList{
+			func(ctx context.Context, message *envoytap.StreamTapsRequest) error {
+				select {
+				case traces <- message:
+				case <-ctx.Done():
+				}
+				return nil
+			},
+		},
+		Ctx: ctx,
+	}))
+	go func() {
+		defer GinkgoRecover()
+		_ = srv.Serve(lis)
+	}()
+	return srv, uint32(lis.Addr().(*net.TCPAddr).Port), traces
+}
@@ -8,12 +8,17 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	ingressnetworking "github.com/solo-io/gloo/projects/ingress/pkg/api/external/networking"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingress"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/ingressclass"
+	"github.com/solo-io/gloo/projects/ingress/pkg/api/pod"
 	"github.com/solo-io/gloo/projects/ingress/pkg/api/service"
 	v1 "github.com/solo-io/gloo/projects/ingress/pkg/api/v1"
 	"github.com/solo-io/gloo/projects/ingress/pkg/status"
 	"github.com/solo-io/k8s-utils/kubeutils"
+	skpod "github.com/solo-io/solo-kit/pkg/api/external/kubernetes/pod"
 	"github.com/solo-io/solo-kit/pkg/api/v1/clients"
+	"github.com/solo-io/solo-kit/pkg/api/v1/clients/kube/cache"
 	"github.com/solo-io/solo-kit/test/helpers"
 	"github.com/solo-io/solo-kit/test/setup"
 	kubev1 "k8s.io/api/core/v1"
@@ -67,8 +72,14 @@ var _ = Describe("StatusSyncer", func() {
 			kubeServiceClient = service.NewClientWithSelector(kubeServiceClient, map[string]string{
 				"gloo": "ingress-proxy",
 			})
-			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-			statusSync := status.NewSyncer(ingressClient)
+			ingressClassClient := ingressnetworking.NewIngressClassClientWithBase(ingressclass.NewResourceClient(kube))
+			kubeCoreCache, err := cache.NewKubeCoreCacheWithOptions(ctx, kube, time.Hour, []string{namespace})
+			Expect(err).NotTo(HaveOccurred())
+			podClient := pod.NewClientWithSelector(skpod.NewPodClient(kube, kubeCoreCache), map[string]string{
+				"gloo": "ingress-proxy",
+			})
+			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient, ingressClassClient, podClient)
+			statusSync := status.NewSyncer(ingressClient, false, "")
 			statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 			statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 			Expect(err).NotTo(HaveOccurred())
@@ -227,8 +238,14 @@ var _ = Describe("StatusSyncer", func() {
 			kubeServiceClient = service.NewClientWithSelector(kubeServiceClient, map[string]string{
 				"gloo": "ingress-proxy",
 			})
-			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-			statusSync := status.NewSyncer(ingressClient)
+			ingressClassClient := ingressnetworking.NewIngressClassClientWithBase(ingressclass.NewResourceClient(kubeClientset))
+			kubeCoreCache, err := cache.NewKubeCoreCacheWithOptions(ctx, kubeClientset, time.Hour, []string{namespace})
+			Expect(err).NotTo(HaveOccurred())
+			podClient := pod.NewClientWithSelector(skpod.NewPodClient(kubeClientset, kubeCoreCache), map[string]string{
+				"gloo": "ingress-proxy",
+			})
+			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient, ingressClassClient, podClient)
+			statusSync := status.NewSyncer(ingressClient, false, "")
 			statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 			statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 			Expect(err).NotTo(HaveOccurred())
@@ -370,8 +387,14 @@ var _ = Describe("StatusSyncer", func() {
 			kubeServiceClient = service.NewClientWithSelector(kubeServiceClient, map[string]string{
 				"gloo": "ingress-proxy",
 			})
-			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient)
-			statusSync := status.NewSyncer(ingressClient)
+			ingressClassClient := ingressnetworking.NewIngressClassClientWithBase(ingressclass.NewResourceClient(kubeClientset))
+			kubeCoreCache, err := cache.NewKubeCoreCacheWithOptions(ctx, kubeClientset, time.Hour, []string{namespace})
+			Expect(err).NotTo(HaveOccurred())
+			podClient := pod.NewClientWithSelector(skpod.NewPodClient(kubeClientset, kubeCoreCache), map[string]string{
+				"gloo": "ingress-proxy",
+			})
+			statusEmitter := v1.NewStatusEmitter(kubeServiceClient, ingressClient, ingressClassClient, podClient)
+			statusSync := status.NewSyncer(ingressClient, false, "")
 			statusEventLoop := v1.NewStatusEventLoop(statusEmitter, statusSync)
 			statusEventLoopErrs, err := statusEventLoop.Run([]string{namespace}, clients.WatchOpts{Ctx: context.TODO()})
 			Expect(err).NotTo(HaveOccurred())
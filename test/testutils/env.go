@@ -27,6 +27,13 @@ const (
 	// RunConsulTests is used to enable any tests which depend on Consul.
 	RunConsulTests = "RUN_CONSUL_TESTS"
 
+	// PebbleDirectoryUrl is used to enable the tests which issue certificates from a Pebble ACME test server, and
+	// is the url of its directory (ie https://localhost:14000/dir)
+	PebbleDirectoryUrl = "PEBBLE_DIRECTORY_URL"
+
+	// PebbleRootCa is the path to the PEM certificate of the CA which signs the directory of the Pebble ACME test server
+	PebbleRootCa = "PEBBLE_ROOT_CA"
+
 	// WaitOnFail is used to halt execution of a failed test to give the developer a chance to inspect
 	// any assets before they are cleaned up when the test completes
 	// This functionality is defined: https://github.com/solo-io/solo-kit/blob/main/test/helpers/fail_handler.go